@@ -0,0 +1,135 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/logger"
+	"github.com/DeRuina/KUHA-REST-API/internal/usage"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+type logLevelRequest struct {
+	Level string `json:"level" validate:"required,oneof=debug info warn error dpanic panic fatal"`
+}
+
+// getLogLevelHandler returns the current log level
+func (app *api) getLogLevelHandler(w http.ResponseWriter, r *http.Request) {
+	utils.WriteJSON(w, http.StatusOK, map[string]string{"level": logger.Level()})
+}
+
+// setLogLevelHandler changes the log level at runtime
+func (app *api) setLogLevelHandler(w http.ResponseWriter, r *http.Request) {
+	var req logLevelRequest
+	if err := utils.ReadJSON(w, r, &req); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	if err := utils.GetValidator().Struct(req); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	previous := logger.Level()
+	if err := logger.SetLevel(req.Level); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	logger.Logger.Warnw("log level changed", "from", previous, "to", logger.Level())
+	utils.WriteJSON(w, http.StatusOK, map[string]string{"level": logger.Level()})
+}
+
+type usageParams struct {
+	From   string `validate:"omitempty,datetime=2006-01-02"`
+	To     string `validate:"omitempty,datetime=2006-01-02"`
+	Client string `validate:"omitempty,max=200"`
+	Group  string `validate:"omitempty,max=100"`
+	Format string `validate:"omitempty,oneof=json csv"`
+}
+
+// getUsageHandler returns per-client, per-route-group usage over a date range (default: last 30 days)
+func (app *api) getUsageHandler(w http.ResponseWriter, r *http.Request) {
+	if app.store.Auth == nil {
+		utils.ServiceUnavailableDBResponse(w, r, "Auth")
+		return
+	}
+
+	if err := utils.ValidateParams(r, []string{"from", "to", "client", "group", "format"}); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	q := r.URL.Query()
+	params := usageParams{
+		From:   q.Get("from"),
+		To:     q.Get("to"),
+		Client: q.Get("client"),
+		Group:  q.Get("group"),
+		Format: q.Get("format"),
+	}
+	if err := utils.GetValidator().Struct(params); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	to := time.Now().UTC().Truncate(24 * time.Hour)
+	if params.To != "" {
+		to, _ = utils.ParseDate(params.To)
+	}
+	from := to.AddDate(0, 0, -30)
+	if params.From != "" {
+		from, _ = utils.ParseDate(params.From)
+	}
+	if from.After(to) {
+		utils.UnprocessableEntityResponse(w, r, utils.ErrInvalidDateRange)
+		return
+	}
+
+	rows, err := app.store.Auth.Usage().GetUsageRollups(r.Context(), from, to, utils.NilIfEmpty(&params.Client), utils.NilIfEmpty(&params.Group))
+	if err != nil {
+		utils.HandleDatabaseError(w, r, err)
+		return
+	}
+
+	summaries := usage.Summarize(rows)
+
+	if params.Format == "csv" || (params.Format == "" && strings.Contains(r.Header.Get("Accept"), "text/csv")) {
+		writeUsageCSV(w, from, to, summaries)
+		return
+	}
+
+	utils.WriteJSON(w, http.StatusOK, map[string]any{
+		"from":  from.Format("2006-01-02"),
+		"to":    to.Format("2006-01-02"),
+		"usage": summaries,
+	})
+}
+
+func writeUsageCSV(w http.ResponseWriter, from, to time.Time, summaries []usage.Summary) {
+	filename := fmt.Sprintf("usage_%s_%s.csv", from.Format("20060102"), to.Format("20060102"))
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	w.WriteHeader(http.StatusOK)
+
+	f := func(v float64) string { return strconv.FormatFloat(v, 'f', 2, 64) }
+	i := func(v int64) string { return strconv.FormatInt(v, 10) }
+
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{
+		"client_name", "route_group", "requests", "client_errors", "server_errors", "error_rate",
+		"bytes_in", "bytes_out", "avg_ms", "p50_ms", "p95_ms", "p99_ms",
+	})
+	for _, s := range summaries {
+		_ = cw.Write([]string{
+			s.ClientName, s.RouteGroup, i(s.Requests), i(s.ClientErrors), i(s.ServerErrors),
+			strconv.FormatFloat(s.ErrorRate, 'f', 4, 64),
+			i(s.BytesIn), i(s.BytesOut), f(s.AvgMs), f(s.P50Ms), f(s.P95Ms), f(s.P99Ms),
+		})
+	}
+	cw.Flush()
+}
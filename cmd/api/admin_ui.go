@@ -0,0 +1,153 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"expvar"
+	"html/template"
+	"net/http"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/logger"
+	"github.com/DeRuina/KUHA-REST-API/internal/ratelimiter"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+//go:embed templates/*.html
+var templateFS embed.FS
+
+var adminTemplate = template.Must(template.ParseFS(templateFS, "templates/admin.html"))
+
+const adminTokenLogLimit = 25
+
+type adminNameValue struct {
+	Name   string
+	Value  string
+	Status string
+}
+
+type adminTokenLog struct {
+	CreatedAt  string
+	ClientName string
+	TokenType  string
+	Action     string
+	IP         string
+	UserAgent  string
+}
+
+type adminClient struct {
+	ID        int32
+	Name      string
+	Roles     string
+	CreatedAt string
+}
+
+type adminPage struct {
+	Version       string
+	Env           string
+	Uptime        string
+	Goroutines    int
+	Now           string
+	Dependencies  []adminNameValue
+	Cache         []adminNameValue
+	Rejections    []adminNameValue
+	AuthAvailable bool
+	TokenLogs     []adminTokenLog
+	Clients       []adminClient
+}
+
+// adminUIHandler renders the operator dashboard
+func (app *api) adminUIHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	page := adminPage{
+		Version:       version,
+		Env:           app.config.env,
+		Uptime:        time.Since(startTime).Truncate(time.Second).String(),
+		Goroutines:    runtime.NumGoroutine(),
+		Now:           time.Now().Format(time.RFC3339),
+		AuthAvailable: app.store.Auth != nil,
+	}
+
+	// Dependencies
+	for name, state := range app.dependencyStatus(ctx) {
+		page.Dependencies = append(page.Dependencies, adminNameValue{Name: name, Status: state})
+	}
+	sort.Slice(page.Dependencies, func(i, j int) bool { return page.Dependencies[i].Name < page.Dependencies[j].Name })
+
+	// Cache
+	if app.cacheStorage != nil {
+		if stats, err := app.cacheStorage.Stats(ctx); err == nil {
+			for k, v := range stats {
+				page.Cache = append(page.Cache, adminNameValue{Name: k, Value: v})
+			}
+			sort.Slice(page.Cache, func(i, j int) bool { return page.Cache[i].Name < page.Cache[j].Name })
+		} else {
+			logger.Logger.Warnw("admin ui: failed to read cache stats", "error", err)
+		}
+	}
+
+	// Rate limit rejections
+	ratelimiter.Rejections.Do(func(kv expvar.KeyValue) {
+		page.Rejections = append(page.Rejections, adminNameValue{Name: kv.Key, Value: kv.Value.String()})
+	})
+	sort.Slice(page.Rejections, func(i, j int) bool {
+		a, _ := strconv.Atoi(page.Rejections[i].Value)
+		b, _ := strconv.Atoi(page.Rejections[j].Value)
+		return a > b
+	})
+
+	// Token activity and clients
+	if app.store.Auth != nil {
+		logs, err := app.store.Auth.Admin().ListRecentTokenLogs(ctx, adminTokenLogLimit)
+		if err != nil {
+			logger.Logger.Warnw("admin ui: failed to read token logs", "error", err)
+		}
+		for _, l := range logs {
+			entry := adminTokenLog{
+				ClientName: l.ClientName,
+				TokenType:  l.TokenType,
+				Action:     l.Action,
+				IP:         l.IpAddress.String,
+				UserAgent:  l.UserAgent.String,
+			}
+			if l.CreatedAt.Valid {
+				entry.CreatedAt = l.CreatedAt.Time.Format(time.RFC3339)
+			}
+			page.TokenLogs = append(page.TokenLogs, entry)
+		}
+
+		clients, err := app.store.Auth.Admin().ListClients(ctx)
+		if err != nil {
+			logger.Logger.Warnw("admin ui: failed to read clients", "error", err)
+		}
+		for _, c := range clients {
+			entry := adminClient{
+				ID:    c.ID,
+				Name:  c.ClientName,
+				Roles: strings.Join(c.Role, ", "),
+			}
+			if c.CreatedAt.Valid {
+				entry.CreatedAt = c.CreatedAt.Time.Format("2006-01-02")
+			}
+			page.Clients = append(page.Clients, entry)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := adminTemplate.Execute(&buf, page); err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	_, _ = buf.WriteTo(w)
+}
@@ -13,12 +13,19 @@ import (
 	"time"
 
 	"github.com/DeRuina/KUHA-REST-API/docs" // This is required to generate swagger docs
+	"github.com/DeRuina/KUHA-REST-API/internal/db"
 	"github.com/DeRuina/KUHA-REST-API/internal/env"
+	"github.com/DeRuina/KUHA-REST-API/internal/idempotency"
+	"github.com/DeRuina/KUHA-REST-API/internal/jobs"
 	"github.com/DeRuina/KUHA-REST-API/internal/logger"
+	"github.com/DeRuina/KUHA-REST-API/internal/openapi"
 	"github.com/DeRuina/KUHA-REST-API/internal/ratelimiter"
 	"github.com/DeRuina/KUHA-REST-API/internal/store"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
+	"github.com/DeRuina/KUHA-REST-API/internal/stream"
+	"github.com/DeRuina/KUHA-REST-API/internal/usage"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/internal/webhooks"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
@@ -27,6 +34,8 @@ import (
 	archapi "github.com/DeRuina/KUHA-REST-API/cmd/api/archinisis"
 	authapi "github.com/DeRuina/KUHA-REST-API/cmd/api/auth"
 	fisapi "github.com/DeRuina/KUHA-REST-API/cmd/api/fis"
+	graphqlapi "github.com/DeRuina/KUHA-REST-API/cmd/api/graphql"
+	grpcapi "github.com/DeRuina/KUHA-REST-API/cmd/api/grpc"
 	kamkapi "github.com/DeRuina/KUHA-REST-API/cmd/api/kamk"
 	klabapi "github.com/DeRuina/KUHA-REST-API/cmd/api/klab"
 	tietoevryapi "github.com/DeRuina/KUHA-REST-API/cmd/api/tietoevry"
@@ -39,16 +48,40 @@ type api struct {
 	cacheStorage     *cache.Storage
 	redisRateLimiter *ratelimiter.RedisSlidingLimiter
 	localRateLimiter *ratelimiter.FixedWindowRateLimiter
+	usage            *usage.Collector
+	idempotency      idempotency.Store
+	jobs             *jobs.Runner
+	webhooks         *webhooks.Dispatcher
+	stream           *stream.Hub
+	openapi          *openapi.Spec
+	// v1 is the v1 router, to find the route of a request before routing
+	v1 *chi.Mux
+	// v2Targets are the v2 routes on a v1 that records requests, see v1Route
+	v2Targets http.Handler
 }
 
 type config struct {
-	addr        string
-	db          dbConfig
-	env         string
-	apiURL      string
-	auth        authConfig
-	redisCfg    redisConfig
-	rateLimiter ratelimiter.Config
+	addr          string
+	db            dbConfig
+	env           string
+	apiURL        string
+	auth          authConfig
+	redisCfg      redisConfig
+	rateLimiter   ratelimiter.Config
+	usage         usageConfig
+	compression   compressionConfig
+	idempotency   idempotencyConfig
+	jobs          jobs.Config
+	v1Deprecation deprecationConfig
+	grpc          grpcapi.Config
+	webhooks      webhooks.Config
+	stream        stream.Config
+	openapi       openAPIConfig
+}
+
+type usageConfig struct {
+	enabled       bool
+	flushInterval time.Duration
 }
 
 type redisConfig struct {
@@ -85,19 +118,23 @@ type dbConfig struct {
 	maxOpenConns   int
 	maxIdleConns   int
 	maxIdleTime    string
+	queryLog       db.QueryLogConfig
 }
 
 func (app *api) mount() http.Handler {
 	r := chi.NewRouter()
 
 	// Middlewares
-	r.Use(middleware.Timeout(60 * time.Second))
+	// RequestID comes first so every error response, rate limits included, carries it
+	r.Use(middleware.RequestID)
+	r.Use(app.TimeoutMiddleware(60 * time.Second))
 	r.Use(middleware.Recoverer)
 	r.Use(middleware.RealIP)
 	r.Use(ExtractClientIDMiddleware())
+	r.Use(app.UsageMiddleware)
 	r.Use(app.RateLimiterMiddleware)
-	r.Use(middleware.RequestID)
 	r.Use(logger.LoggerMiddleware)
+	r.Use(app.CompressionMiddleware)
 
 	origins := strings.Split(env.GetString("CORS_ALLOWED_ORIGIN", ""), ",")
 	for i := range origins {
@@ -106,415 +143,512 @@ func (app *api) mount() http.Handler {
 
 	r.Use(cors.Handler(cors.Options{
 		AllowedOrigins:   origins,
-		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
-		ExposedHeaders:   []string{"Link"},
+		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "Last-Event-ID", idempotency.Header},
+		ExposedHeaders:   []string{"Link", "Location", "Retry-After", "Deprecation", "Sunset", idempotency.ReplayedHeader},
 		AllowCredentials: false,
 		MaxAge:           300,
 	}))
 
-	r.Route("/v1", func(r chi.Router) {
-		// Auth routes
+	// athlete references are swapped for native ids before validation sees them
+	r.Use(app.IdentityResolutionMiddleware)
+
+	// after CORS so preflights are never validated
+	r.Use(app.OpenAPIValidationMiddleware)
+
+	r.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		utils.NotFoundResponse(w, r, fmt.Errorf("no route for %s %s", r.Method, logger.RedactPath(r)))
+	})
+	r.MethodNotAllowed(utils.MethodNotAllowedResponse)
+
+	// v1 stays mounted until its sunset date; v2 serves the same handlers under resource paths
+	v1 := chi.NewRouter()
+	app.v1Routes(v1, r)
+	app.v1 = v1
+	r.With(DeprecationMiddleware(app.config.v1Deprecation)).Mount("/v1", v1)
+	r.Mount("/v2", app.v2Routes(v1))
+	app.v2Targets = app.v2Routes(recordV1)
+
+	return r
+}
+
+// v1Routes registers the v1 API on r; root is the whole router, which batch
+// sub-requests are sent through
+func (app *api) v1Routes(r chi.Router, root http.Handler) {
+	// Auth routes
+	if app.store.Auth != nil {
+		r.Route("/auth", func(r chi.Router) {
+			authHandler := authapi.NewAuthHandler(app.store.Auth)
+			r.Post("/token", authHandler.IssueTokens)
+			r.Post("/refresh", authHandler.RefreshToken)
+		})
+	} else {
+		logger.Logger.Warn("Auth routes disabled: database not connected")
+		r.Route("/auth", func(r chi.Router) {
+			r.Handle("/*", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				utils.ServiceUnavailableDBResponse(w, r, "Auth")
+			}))
+		})
+	}
+
+	// Healthcheck
+	r.Get("/health", app.healthCheckHandler)
+
+	// Metrics
+	r.With(app.BasicAuthMiddleware()).Get("/metrics", expvar.Handler().ServeHTTP)
+
+	// Admin
+	r.Route("/admin", func(r chi.Router) {
+		r.Use(app.AdminAuthMiddleware())
+		r.Get("/ui", app.adminUIHandler)
+		r.Get("/log-level", app.getLogLevelHandler)
+		r.Put("/log-level", app.setLogLevelHandler)
+		r.Get("/usage", app.getUsageHandler)
+	})
+
+	// Swagger docs
+	docsURL := fmt.Sprintf("%s/swagger/doc.json", app.config.addr)
+	r.Get("/docs", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/v1/docs/", http.StatusMovedPermanently)
+	})
+	r.Get("/docs/*", httpSwagger.Handler(httpSwagger.URL(docsURL)))
+
+	// OpenAPI 3.1 document, the source of truth for the request contract
+	if app.openapi != nil {
+		r.Get("/openapi.yaml", app.openapi.YAMLHandler)
+		r.Get("/openapi.json", app.openapi.JSONHandler)
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Use(JWTMiddleware())
+
+		// Async import jobs
+		r.Get("/jobs/{id}", app.getJobHandler)
+
+		// Batched GETs
+		r.Post("/batch", app.batchHandler(root))
+
+		// Webhook subscriptions of the calling client
+		if app.webhooks != nil {
+			r.Route("/webhooks", func(r chi.Router) {
+				r.Post("/", app.createWebhookHandler)
+				r.Get("/", app.listWebhooksHandler)
+				r.Get("/dead-letters", app.listDeadLettersHandler)
+				r.Post("/deliveries/{id}/redeliver", app.redeliverWebhookHandler)
+				r.Get("/{id}", app.getWebhookHandler)
+				r.Put("/{id}", app.updateWebhookHandler)
+				r.Delete("/{id}", app.deleteWebhookHandler)
+				r.Get("/{id}/deliveries", app.listWebhookDeliveriesHandler)
+			})
+		} else if app.store.Auth == nil {
+			r.Route("/webhooks", func(r chi.Router) {
+				r.Handle("/*", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					utils.ServiceUnavailableDBResponse(w, r, "Auth")
+				}))
+			})
+		}
+
+		// Athlete identity registry: which source system ids are the same athlete
 		if app.store.Auth != nil {
-			r.Route("/auth", func(r chi.Router) {
-				authHandler := authapi.NewAuthHandler(app.store.Auth)
-				r.Post("/token", authHandler.IssueTokens)
-				r.Post("/refresh", authHandler.RefreshToken)
+			r.Route("/identities", func(r chi.Router) {
+				r.Post("/", app.linkIdentitiesHandler)
+				r.Get("/{id}", app.getIdentityHandler)
+				r.Delete("/{id}", app.deleteIdentityHandler)
+				r.Delete("/{id}/identifiers/{kind}", app.unlinkIdentifierHandler)
 			})
+
+			// One call for the latest data of an athlete; each section is authorized by its domain
+			r.Get("/athletes/{id}/overview", app.athleteOverviewHandler)
 		} else {
-			logger.Logger.Warn("Auth routes disabled: database not connected")
-			r.Route("/auth", func(r chi.Router) {
+			r.Route("/identities", func(r chi.Router) {
+				r.Handle("/*", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					utils.ServiceUnavailableDBResponse(w, r, "Auth")
+				}))
+			})
+			r.Route("/athletes", func(r chi.Router) {
 				r.Handle("/*", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 					utils.ServiceUnavailableDBResponse(w, r, "Auth")
 				}))
 			})
 		}
 
-		// Healthcheck
-		r.Get("/health", app.healthCheckHandler)
+		// Live updates about one athlete; each event is authorized by its domain
+		if app.stream != nil {
+			r.Get("/stream/athletes/{id}", app.streamAthleteHandler)
+		}
 
-		// Metrics
-		r.With(app.BasicAuthMiddleware()).Get("/metrics", expvar.Handler().ServeHTTP)
+		// GraphQL reads; each field is authorized on its own
+		graphqlHandler := graphqlapi.NewGraphQLHandler(&app.store)
+		r.Get("/graphql", graphqlHandler.Query)
+		r.Post("/graphql", graphqlHandler.Query)
+		r.Get("/graphql/schema", graphqlHandler.GetSchema)
+
+		// Tietoevry routes
+		if app.store.Tietoevry != nil {
+			r.Route("/tietoevry", func(r chi.Router) {
+				// Register handlers
+				userHandler := tietoevryapi.NewTietoevryUserHandler(app.store.Tietoevry.Users(), app.cacheStorage)
+				exerciseHandler := tietoevryapi.NewTietoevryExerciseHandler(app.store.Tietoevry.Exercises(), app.cacheStorage)
+				symptomHandler := tietoevryapi.NewTietoevrySymptomHandler(app.store.Tietoevry.Symptoms(), app.cacheStorage)
+				measurementHandler := tietoevryapi.NewTietoevryMeasurementHandler(app.store.Tietoevry.Measurements(), app.cacheStorage)
+				testResultHandler := tietoevryapi.NewTietoevryTestResultHandler(app.store.Tietoevry.TestResults(), app.cacheStorage)
+				questionnaireHandler := tietoevryapi.NewTietoevryQuestionnaireHandler(app.store.Tietoevry.Questionnaires(), app.cacheStorage)
+				activityZoneHandler := tietoevryapi.NewTietoevryActivityZoneHandler(app.store.Tietoevry.ActivityZones(), app.cacheStorage)
+
+				// user routes
+				r.Post("/users", userHandler.UpsertUser)
+				r.Delete("/users", userHandler.DeleteUser)
+				r.Get("/users", userHandler.GetUser)
+				r.Get("/deleted-users", userHandler.GetDeletedUsers)
+
+				// exercise routes
+				r.With(DecompressionMiddleware(), app.IdempotencyMiddleware, app.AsyncBulkMiddleware("tietoevry.exercises", exerciseHandler.ImportExercises)).Post("/exercises", exerciseHandler.InsertExercisesBulk)
+				r.Get("/exercises", exerciseHandler.GetExercises)
+
+				// symptom routes
+				r.With(DecompressionMiddleware()).Post("/symptoms", symptomHandler.InsertSymptomsBulk)
+				r.Get("/symptoms", symptomHandler.GetSymptoms)
+
+				// measurement routes
+				r.With(DecompressionMiddleware(), app.AsyncBulkMiddleware("tietoevry.measurements", measurementHandler.ImportMeasurements)).Post("/measurements", measurementHandler.InsertMeasurementsBulk)
+				r.Get("/measurements", measurementHandler.GetMeasurements)
+
+				// test result routes
+				r.With(DecompressionMiddleware()).Post("/test-results", testResultHandler.InsertTestResultsBulk)
+				r.Get("/test-results", testResultHandler.GetTestResults)
+
+				// questionnaire routes
+				r.With(DecompressionMiddleware()).Post("/questionnaires", questionnaireHandler.InsertQuestionnaireAnswersBulk)
+				r.Get("/questionnaires", questionnaireHandler.GetQuestionnaires)
+
+				// activity zone routes
+				r.With(DecompressionMiddleware()).Post("/activity-zones", activityZoneHandler.InsertActivityZonesBulk)
+				r.Get("/activity-zones", activityZoneHandler.GetActivityZones)
+			})
+		} else {
+			logger.Logger.Warn("tietoevry routes disabled: database not connected")
+			r.Route("/tietoevry", func(r chi.Router) {
+				r.Handle("/*", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					utils.ServiceUnavailableDBResponse(w, r, "Tietoevry")
+				}))
+			})
+		}
 
-		// Swagger docs
-		docsURL := fmt.Sprintf("%s/swagger/doc.json", app.config.addr)
-		r.Get("/docs", func(w http.ResponseWriter, r *http.Request) {
-			http.Redirect(w, r, "/v1/docs/", http.StatusMovedPermanently)
-		})
-		r.Get("/docs/*", httpSwagger.Handler(httpSwagger.URL(docsURL)))
-
-		r.Group(func(r chi.Router) {
-			r.Use(JWTMiddleware())
-
-			// Tietoevry routes
-			if app.store.Tietoevry != nil {
-				r.Route("/tietoevry", func(r chi.Router) {
-					// Register handlers
-					userHandler := tietoevryapi.NewTietoevryUserHandler(app.store.Tietoevry.Users(), app.cacheStorage)
-					exerciseHandler := tietoevryapi.NewTietoevryExerciseHandler(app.store.Tietoevry.Exercises(), app.cacheStorage)
-					symptomHandler := tietoevryapi.NewTietoevrySymptomHandler(app.store.Tietoevry.Symptoms(), app.cacheStorage)
-					measurementHandler := tietoevryapi.NewTietoevryMeasurementHandler(app.store.Tietoevry.Measurements(), app.cacheStorage)
-					testResultHandler := tietoevryapi.NewTietoevryTestResultHandler(app.store.Tietoevry.TestResults(), app.cacheStorage)
-					questionnaireHandler := tietoevryapi.NewTietoevryQuestionnaireHandler(app.store.Tietoevry.Questionnaires(), app.cacheStorage)
-					activityZoneHandler := tietoevryapi.NewTietoevryActivityZoneHandler(app.store.Tietoevry.ActivityZones(), app.cacheStorage)
-
-					// user routes
-					r.Post("/users", userHandler.UpsertUser)
-					r.Delete("/users", userHandler.DeleteUser)
-					r.Get("/users", userHandler.GetUser)
-					r.Get("/deleted-users", userHandler.GetDeletedUsers)
-
-					// exercise routes
-					r.With(GzipDecompressionMiddleware()).Post("/exercises", exerciseHandler.InsertExercisesBulk)
-					r.Get("/exercises", exerciseHandler.GetExercises)
-
-					// symptom routes
-					r.With(GzipDecompressionMiddleware()).Post("/symptoms", symptomHandler.InsertSymptomsBulk)
-					r.Get("/symptoms", symptomHandler.GetSymptoms)
-
-					// measurement routes
-					r.With(GzipDecompressionMiddleware()).Post("/measurements", measurementHandler.InsertMeasurementsBulk)
-					r.Get("/measurements", measurementHandler.GetMeasurements)
-
-					// test result routes
-					r.With(GzipDecompressionMiddleware()).Post("/test-results", testResultHandler.InsertTestResultsBulk)
-					r.Get("/test-results", testResultHandler.GetTestResults)
-
-					// questionnaire routes
-					r.With(GzipDecompressionMiddleware()).Post("/questionnaires", questionnaireHandler.InsertQuestionnaireAnswersBulk)
-					r.Get("/questionnaires", questionnaireHandler.GetQuestionnaires)
-
-					// activity zone routes
-					r.With(GzipDecompressionMiddleware()).Post("/activity-zones", activityZoneHandler.InsertActivityZonesBulk)
-					r.Get("/activity-zones", activityZoneHandler.GetActivityZones)
-				})
-			} else {
-				logger.Logger.Warn("tietoevry routes disabled: database not connected")
-				r.Route("/tietoevry", func(r chi.Router) {
-					r.Handle("/*", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-						utils.ServiceUnavailableDBResponse(w, r, "Tietoevry")
-					}))
-				})
-			}
+		// KAMK routes
+		if app.store.KAMK != nil {
+			r.Route("/kamk", func(r chi.Router) {
+				// Register handlers
+				injuriesHandler := kamkapi.NewInjuriesHandler(app.store.KAMK.Injuries(), app.cacheStorage)
+				queriesHandler := kamkapi.NewQueriesHandler(app.store.KAMK.Queries(), app.cacheStorage)
+
+				// injury routes
+				r.Post("/injury", injuriesHandler.AddInjury)
+				r.Post("/injury-recovered", injuriesHandler.MarkRecovered)
+				r.Get("/injury", injuriesHandler.GetActive)
+				r.Get("/injury-id", injuriesHandler.GetMaxID)
+				r.Delete("/injury", injuriesHandler.DeleteInjury)
+
+				// questionnaire routes
+				r.Post("/questionnaire", queriesHandler.AddQuestionnaire)
+				r.Get("/questionnaire", queriesHandler.GetQuestionnaires)
+				r.Get("/is-quiz-done", queriesHandler.IsQuizDoneToday)
+				r.Post("/update-quiz", queriesHandler.UpdateQuestionnaireByID)
+				r.Delete("/delete-quiz", queriesHandler.DeleteQuestionnaire)
+			})
+		} else {
+			logger.Logger.Warn("kamk routes disabled: database not connected")
+			r.Route("/kamk", func(r chi.Router) {
+				r.Handle("/*", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					utils.ServiceUnavailableDBResponse(w, r, "kamk")
+				}))
+			})
+		}
 
-			// KAMK routes
-			if app.store.KAMK != nil {
-				r.Route("/kamk", func(r chi.Router) {
-					// Register handlers
-					injuriesHandler := kamkapi.NewInjuriesHandler(app.store.KAMK.Injuries(), app.cacheStorage)
-					queriesHandler := kamkapi.NewQueriesHandler(app.store.KAMK.Queries(), app.cacheStorage)
-
-					// injury routes
-					r.Post("/injury", injuriesHandler.AddInjury)
-					r.Post("/injury-recovered", injuriesHandler.MarkRecovered)
-					r.Get("/injury", injuriesHandler.GetActive)
-					r.Get("/injury-id", injuriesHandler.GetMaxID)
-					r.Delete("/injury", injuriesHandler.DeleteInjury)
-
-					// questionnaire routes
-					r.Post("/questionnaire", queriesHandler.AddQuestionnaire)
-					r.Get("/questionnaire", queriesHandler.GetQuestionnaires)
-					r.Get("/is-quiz-done", queriesHandler.IsQuizDoneToday)
-					r.Post("/update-quiz", queriesHandler.UpdateQuestionnaireByID)
-					r.Delete("/delete-quiz", queriesHandler.DeleteQuestionnaire)
-				})
-			} else {
-				logger.Logger.Warn("kamk routes disabled: database not connected")
-				r.Route("/kamk", func(r chi.Router) {
-					r.Handle("/*", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-						utils.ServiceUnavailableDBResponse(w, r, "kamk")
-					}))
-				})
-			}
+		// Archinisis routes
+		if app.store.ARCHINISIS != nil {
+			r.Route("/archinisis", func(r chi.Router) {
+				// Register handlers
+				dataHandler := archapi.NewDataHandler(app.store.ARCHINISIS.Data(), app.cacheStorage)
+				userHandler := archapi.NewUserDataHandler(app.store.ARCHINISIS.Users(), app.cacheStorage)
+
+				// data routes
+				r.Get("/race-report/sessions", dataHandler.GetRaceReportSessions)
+				r.Get("/race-report", dataHandler.GetRaceReportHTML)
+				r.With(DecompressionMiddleware()).Post("/race-report", dataHandler.PostRaceReport)
+				r.With(DecompressionMiddleware()).Post("/data", dataHandler.PostArchData)
+				r.Get("/data", dataHandler.GetArchData)
+
+				// user routes
+				r.Delete("/user", userHandler.DeleteUser)
+			})
+		} else {
+			logger.Logger.Warn("archinisis routes disabled: database not connected")
+			r.Route("/archinisis", func(r chi.Router) {
+				r.Handle("/*", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					utils.ServiceUnavailableDBResponse(w, r, "archinisis")
+				}))
+			})
+		}
 
-			// Archinisis routes
-			if app.store.ARCHINISIS != nil {
-				r.Route("/archinisis", func(r chi.Router) {
-					// Register handlers
-					dataHandler := archapi.NewDataHandler(app.store.ARCHINISIS.Data(), app.cacheStorage)
-					userHandler := archapi.NewUserDataHandler(app.store.ARCHINISIS.Users(), app.cacheStorage)
-
-					// data routes
-					r.Get("/race-report/sessions", dataHandler.GetRaceReportSessions)
-					r.Get("/race-report", dataHandler.GetRaceReportHTML)
-					r.With(GzipDecompressionMiddleware()).Post("/race-report", dataHandler.PostRaceReport)
-					r.Post("/data", dataHandler.PostArchData)
-					r.Get("/data", dataHandler.GetArchData)
-
-					// user routes
-					r.Delete("/user", userHandler.DeleteUser)
-				})
-			} else {
-				logger.Logger.Warn("archinisis routes disabled: database not connected")
-				r.Route("/archinisis", func(r chi.Router) {
-					r.Handle("/*", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-						utils.ServiceUnavailableDBResponse(w, r, "archinisis")
-					}))
-				})
-			}
+		// KLAB routes
+		if app.store.KLAB != nil {
+			r.Route("/klab", func(r chi.Router) {
+				// Register handlers
+				userDataHandler := klabapi.NewUserDataHandler(app.store.KLAB.Users(), app.cacheStorage)
+				klabDataHandler := klabapi.NewKlabDataHandler(app.store.KLAB.Data(), app.cacheStorage)
 
-			// KLAB routes
-			if app.store.KLAB != nil {
-				r.Route("/klab", func(r chi.Router) {
-					// Register handlers
-					userDataHandler := klabapi.NewUserDataHandler(app.store.KLAB.Users(), app.cacheStorage)
-					klabDataHandler := klabapi.NewKlabDataHandler(app.store.KLAB.Data(), app.cacheStorage)
+				// user routes
+				r.Get("/user", userDataHandler.GetUser)
+				r.Delete("/user", userDataHandler.DeleteUser)
+
+				// data routes
+				r.With(DecompressionMiddleware(), app.IdempotencyMiddleware).Post("/data", klabDataHandler.InsertKlabDataBulk)
+				r.Get("/data", klabDataHandler.GetKlabData)
+			})
+		} else {
+			logger.Logger.Warn("klab routes disabled: database not connected")
+			r.Route("/klab", func(r chi.Router) {
+				r.Handle("/*", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					utils.ServiceUnavailableDBResponse(w, r, "klab")
+				}))
+			})
+		}
 
-					// user routes
-					r.Get("/user", userDataHandler.GetUser)
-					r.Delete("/user", userDataHandler.DeleteUser)
+		// FIS routes
+		if app.store.FIS != nil {
+			r.Route("/fis", func(r chi.Router) {
+				// Register handlers
+				competitorHandler := fisapi.NewCompetitorHandler(app.store.FIS.Competitors(), app.cacheStorage)
+				raceCCHandler := fisapi.NewRaceCCHandler(app.store.FIS.RaceCC(), app.cacheStorage)
+				raceJPhandler := fisapi.NewRaceJPHandler(app.store.FIS.RaceJP(), app.cacheStorage)
+				raceNKhandler := fisapi.NewRaceNKHandler(app.store.FIS.RaceNK(), app.cacheStorage)
+				resultCCHandler := fisapi.NewResultCCHandler(app.store.FIS.ResultCC(), app.store.FIS.Competitors(), app.cacheStorage)
+				resultJPHandler := fisapi.NewResultJPHandler(app.store.FIS.ResultJP(), app.store.FIS.Competitors(), app.cacheStorage)
+				resultNKHandler := fisapi.NewResultNKHandler(app.store.FIS.ResultNK(), app.store.FIS.Competitors(), app.cacheStorage)
+				athleteHandler := fisapi.NewAthleteHandler(app.store.FIS.Athlete(), app.cacheStorage)
+				kamkRacesHandler := fisapi.NewRaceSearchHandler(app.store.FIS.RaceCC(), app.store.FIS.RaceJP(), app.store.FIS.RaceNK(), app.cacheStorage)
+				kamkResultsHandler := fisapi.NewResultKAMKHandler(app.store.FIS.ResultCC(), app.store.FIS.ResultJP(), app.store.FIS.ResultNK(), app.cacheStorage)
+
+				// kamk endpoints
+				r.Get("/races/search", kamkRacesHandler.SearchRaces)
+				r.Get("/races/by-ids", kamkRacesHandler.GetRacesByIDs)
+				r.Get("/races/count-by-category", kamkRacesHandler.GetRaceCategoryCounts)
+				r.Get("/races/count-by-nation", kamkRacesHandler.GetRaceCountsByNation)
+				r.Get("/races/count-total", kamkRacesHandler.GetRaceTotals)
+				r.Get("/competitor/seasons-catcodes", kamkResultsHandler.GetCompetitorSeasonsCatcodes)
+				r.Get("/competitor/latest-results", kamkResultsHandler.GetCompetitorLatestResults)
+				r.Get("/competitor/search", competitorHandler.SearchCompetitors)
+				r.Get("/competitor/count-by-nation", competitorHandler.GetCompetitorCountsByNation)
+				r.Get("/competitor/sectorcode", competitorHandler.GetSectorcodeByFiscode)
+
+				// athlete routes
+				r.Get("/fiscode", athleteHandler.GetAthletesBySporttiID)
+				r.Post("/athlete", athleteHandler.InsertAthlete)
+				r.Put("/athlete", athleteHandler.UpdateAthlete)
+				r.Delete("/athlete", athleteHandler.DeleteAthlete)
+
+				// competitor routes
+				r.Get("/athlete", competitorHandler.GetAthletesBySector)
+				r.Get("/nation", competitorHandler.GetNationsBySector)
+				r.Post("/competitor", competitorHandler.InsertCompetitor)
+				r.Put("/competitor", competitorHandler.UpdateCompetitor)
+				r.Delete("/competitor", competitorHandler.DeleteCompetitor)
+				r.Get("/lastrow/competitor", competitorHandler.GetLastRowCompetitor)
+
+				// racecc routes
+				r.Get("/seasoncodeCC", raceCCHandler.GetSeasonCodesCC)
+				r.Get("/disciplinecodeCC", raceCCHandler.GetDisciplineCodesCC)
+				r.Get("/catcodeCC", raceCCHandler.GetCategoryCodesCC)
+				r.Get("/racecc", raceCCHandler.GetRacesCC)
+				r.Get("/lastrow/racecc", raceCCHandler.GetLastRowRaceCC)
+				r.Post("/racecc", raceCCHandler.InsertRaceCC)
+				r.Put("/racecc", raceCCHandler.UpdateRaceCC)
+				r.Delete("/racecc", raceCCHandler.DeleteRaceCC)
+
+				// racejp routes
+				r.Get("/seasoncodeJP", raceJPhandler.GetSeasonCodesJP)
+				r.Get("/disciplinecodeJP", raceJPhandler.GetDisciplineCodesJP)
+				r.Get("/catcodeJP", raceJPhandler.GetCategoryCodesJP)
+				r.Get("/racejp", raceJPhandler.GetRacesJP)
+				r.Get("/lastrow/racejp", raceJPhandler.GetLastRowRaceJP)
+				r.Post("/racejp", raceJPhandler.InsertRaceJP)
+				r.Put("/racejp", raceJPhandler.UpdateRaceJP)
+				r.Delete("/racejp", raceJPhandler.DeleteRaceJP)
+
+				// racenk routes
+				r.Get("/seasoncodeNK", raceNKhandler.GetSeasonCodesNK)
+				r.Get("/disciplinecodeNK", raceNKhandler.GetDisciplineCodesNK)
+				r.Get("/catcodeNK", raceNKhandler.GetCategoryCodesNK)
+				r.Get("/racenk", raceNKhandler.GetRacesNK)
+				r.Get("/lastrow/racenk", raceNKhandler.GetLastRowRaceNK)
+				r.Post("/racenk", raceNKhandler.InsertRaceNK)
+				r.Put("/racenk", raceNKhandler.UpdateRaceNK)
+				r.Delete("/racenk", raceNKhandler.DeleteRaceNK)
+
+				// resultcc routes
+				r.Get("/resultcc", resultCCHandler.GetRaceResultsCC)
+				r.Get("/resultathletecc", resultCCHandler.GetAthleteResultsCC)
+				r.Get("/lastrow/resultcc", resultCCHandler.GetLastRowResultCC)
+				r.Post("/resultcc", resultCCHandler.InsertResultCC)
+				r.Put("/resultcc", resultCCHandler.UpdateResultCC)
+				r.Delete("/resultcc", resultCCHandler.DeleteResultCC)
+
+				// resultjp routes
+				r.Get("/resultjp", resultJPHandler.GetRaceResultsJP)
+				r.Get("/resultathletejp", resultJPHandler.GetAthleteResultsJP)
+				r.Get("/lastrow/resultjp", resultJPHandler.GetLastRowResultJP)
+				r.Post("/resultjp", resultJPHandler.InsertResultJP)
+				r.Put("/resultjp", resultJPHandler.UpdateResultJP)
+				r.Delete("/resultjp", resultJPHandler.DeleteResultJP)
+
+				// resultnk routes
+				r.Get("/resultnk", resultNKHandler.GetRaceResultsNK)
+				r.Get("/resultathletenk", resultNKHandler.GetAthleteResultsNK)
+				r.Get("/lastrow/resultnk", resultNKHandler.GetLastRowResultNK)
+				r.Post("/resultnk", resultNKHandler.InsertResultNK)
+				r.Put("/resultnk", resultNKHandler.UpdateResultNK)
+				r.Delete("/resultnk", resultNKHandler.DeleteResultNK)
 
-					// data routes
-					r.Post("/data", klabDataHandler.InsertKlabDataBulk)
-					r.Get("/data", klabDataHandler.GetKlabData)
-				})
-			} else {
-				logger.Logger.Warn("klab routes disabled: database not connected")
+			})
+		} else {
+			logger.Logger.Warn("fis routes disabled: database not reachable")
+			r.Route("/fis", func(r chi.Router) {
+				r.Handle("/*", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					utils.ServiceUnavailableDBResponse(w, r, "fis")
+				}))
+			})
+		}
+		// UTV routes
+		if app.store.UTV != nil {
+			r.Route("/utv", func(r chi.Router) {
+				// Register handlers
+				generalHandler := utvapi.NewGeneralDataHandler(
+					app.store.UTV.Oura(),
+					app.store.UTV.Polar(),
+					app.store.UTV.Suunto(),
+					app.store.UTV.Garmin(),
+					app.store.UTV.OuraToken(),
+					app.store.UTV.PolarToken(),
+					app.store.UTV.SuuntoToken(),
+					app.store.UTV.GarminToken(),
+					app.store.UTV.KlabToken(),
+					app.store.UTV.ArchinisisToken(),
+					app.cacheStorage,
+				)
+				ouraHandler := utvapi.NewOuraDataHandler(app.store.UTV.Oura(), app.cacheStorage)
+				polarHandler := utvapi.NewPolarDataHandler(app.store.UTV.Polar(), app.cacheStorage)
+				suuntoHandler := utvapi.NewSuuntoDataHandler(app.store.UTV.Suunto(), app.cacheStorage)
+				garminHandler := utvapi.NewGarminDataHandler(app.store.UTV.Garmin(), app.cacheStorage)
+				polarTokenHandler := utvapi.NewPolarTokenHandler(app.store.UTV.PolarToken(), app.cacheStorage)
+				ouraTokenHandler := utvapi.NewOuraTokenHandler(app.store.UTV.OuraToken(), app.cacheStorage)
+				suuntoTokenHandler := utvapi.NewSuuntoTokenHandler(app.store.UTV.SuuntoToken(), app.cacheStorage)
+				garminTokenHandler := utvapi.NewGarminTokenHandler(app.store.UTV.GarminToken(), app.cacheStorage)
+				klabTokenHandler := utvapi.NewKlabTokenHandler(app.store.UTV.KlabToken(), app.cacheStorage)
+				userDataHandler := utvapi.NewUserDataHandler(app.store.UTV.UserData(), app.cacheStorage)
+				coachtechHandler := utvapi.NewCoachtechDataHandler(app.store.UTV.Coachtech(), app.cacheStorage)
+				sourceCacheHandler := utvapi.NewSourceCacheHandler(app.store.UTV.SourceCache(), app.cacheStorage)
+				archinisisTokenHandler := utvapi.NewArchinisisTokenHandler(app.store.UTV.ArchinisisToken(), app.cacheStorage)
+
+				// General routes
+				r.Get("/latest", generalHandler.GetLatestData)
+				r.Get("/all", generalHandler.GetAllByType)
+				r.Delete("/disconnect", generalHandler.Disconnect)
+				r.Get("/tokens4update", generalHandler.GetTokensForUpdate)
+				r.Get("/data4update", generalHandler.GetDataForUpdate)
+				r.Get("/token", generalHandler.GetToken)
+
+				// User data routes
+				r.Get("/user", userDataHandler.GetUserData)
+				r.Post("/user", userDataHandler.UpsertUserData)
+				r.Delete("/user", userDataHandler.DeleteUserData)
+				r.Get("/user-id-by-sport-id", userDataHandler.GetUserIDBySportID)
+				r.Get("/user-linked-devices", userDataHandler.GetLinkedDevices)
+
+				// Klab routes
 				r.Route("/klab", func(r chi.Router) {
-					r.Handle("/*", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-						utils.ServiceUnavailableDBResponse(w, r, "klab")
-					}))
+					r.Get("/status", klabTokenHandler.GetStatus)
+					r.Post("/token", klabTokenHandler.UpsertToken)
+					r.Get("/sport_ids", klabTokenHandler.GetSportIDs)
+				})
+
+				// Archinisis routes
+				r.Route("/archinisis", func(r chi.Router) {
+					r.Get("/status", archinisisTokenHandler.GetStatus)
+					r.Post("/token", archinisisTokenHandler.UpsertToken)
+					r.Get("/sport_ids", archinisisTokenHandler.GetSportIDs)
 				})
-			}
 
-			// FIS routes
-			if app.store.FIS != nil {
-				r.Route("/fis", func(r chi.Router) {
-					// Register handlers
-					competitorHandler := fisapi.NewCompetitorHandler(app.store.FIS.Competitors(), app.cacheStorage)
-					raceCCHandler := fisapi.NewRaceCCHandler(app.store.FIS.RaceCC(), app.cacheStorage)
-					raceJPhandler := fisapi.NewRaceJPHandler(app.store.FIS.RaceJP(), app.cacheStorage)
-					raceNKhandler := fisapi.NewRaceNKHandler(app.store.FIS.RaceNK(), app.cacheStorage)
-					resultCCHandler := fisapi.NewResultCCHandler(app.store.FIS.ResultCC(), app.store.FIS.Competitors(), app.cacheStorage)
-					resultJPHandler := fisapi.NewResultJPHandler(app.store.FIS.ResultJP(), app.store.FIS.Competitors(), app.cacheStorage)
-					resultNKHandler := fisapi.NewResultNKHandler(app.store.FIS.ResultNK(), app.store.FIS.Competitors(), app.cacheStorage)
-					athleteHandler := fisapi.NewAthleteHandler(app.store.FIS.Athlete(), app.cacheStorage)
-					kamkRacesHandler := fisapi.NewRaceSearchHandler(app.store.FIS.RaceCC(), app.store.FIS.RaceJP(), app.store.FIS.RaceNK(), app.cacheStorage)
-					kamkResultsHandler := fisapi.NewResultKAMKHandler(app.store.FIS.ResultCC(), app.store.FIS.ResultJP(), app.store.FIS.ResultNK(), app.cacheStorage)
-
-					// kamk endpoints
-					r.Get("/races/search", kamkRacesHandler.SearchRaces)
-					r.Get("/races/by-ids", kamkRacesHandler.GetRacesByIDs)
-					r.Get("/races/count-by-category", kamkRacesHandler.GetRaceCategoryCounts)
-					r.Get("/races/count-by-nation", kamkRacesHandler.GetRaceCountsByNation)
-					r.Get("/races/count-total", kamkRacesHandler.GetRaceTotals)
-					r.Get("/competitor/seasons-catcodes", kamkResultsHandler.GetCompetitorSeasonsCatcodes)
-					r.Get("/competitor/latest-results", kamkResultsHandler.GetCompetitorLatestResults)
-					r.Get("/competitor/search", competitorHandler.SearchCompetitors)
-					r.Get("/competitor/count-by-nation", competitorHandler.GetCompetitorCountsByNation)
-					r.Get("/competitor/sectorcode", competitorHandler.GetSectorcodeByFiscode)
-
-					// athlete routes
-					r.Get("/fiscode", athleteHandler.GetAthletesBySporttiID)
-					r.Post("/athlete", athleteHandler.InsertAthlete)
-					r.Put("/athlete", athleteHandler.UpdateAthlete)
-					r.Delete("/athlete", athleteHandler.DeleteAthlete)
-
-					// competitor routes
-					r.Get("/athlete", competitorHandler.GetAthletesBySector)
-					r.Get("/nation", competitorHandler.GetNationsBySector)
-					r.Post("/competitor", competitorHandler.InsertCompetitor)
-					r.Put("/competitor", competitorHandler.UpdateCompetitor)
-					r.Delete("/competitor", competitorHandler.DeleteCompetitor)
-					r.Get("/lastrow/competitor", competitorHandler.GetLastRowCompetitor)
-
-					// racecc routes
-					r.Get("/seasoncodeCC", raceCCHandler.GetSeasonCodesCC)
-					r.Get("/disciplinecodeCC", raceCCHandler.GetDisciplineCodesCC)
-					r.Get("/catcodeCC", raceCCHandler.GetCategoryCodesCC)
-					r.Get("/racecc", raceCCHandler.GetRacesCC)
-					r.Get("/lastrow/racecc", raceCCHandler.GetLastRowRaceCC)
-					r.Post("/racecc", raceCCHandler.InsertRaceCC)
-					r.Put("/racecc", raceCCHandler.UpdateRaceCC)
-					r.Delete("/racecc", raceCCHandler.DeleteRaceCC)
-
-					// racejp routes
-					r.Get("/seasoncodeJP", raceJPhandler.GetSeasonCodesJP)
-					r.Get("/disciplinecodeJP", raceJPhandler.GetDisciplineCodesJP)
-					r.Get("/catcodeJP", raceJPhandler.GetCategoryCodesJP)
-					r.Get("/racejp", raceJPhandler.GetRacesJP)
-					r.Get("/lastrow/racejp", raceJPhandler.GetLastRowRaceJP)
-					r.Post("/racejp", raceJPhandler.InsertRaceJP)
-					r.Put("/racejp", raceJPhandler.UpdateRaceJP)
-					r.Delete("/racejp", raceJPhandler.DeleteRaceJP)
-
-					// racenk routes
-					r.Get("/seasoncodeNK", raceNKhandler.GetSeasonCodesNK)
-					r.Get("/disciplinecodeNK", raceNKhandler.GetDisciplineCodesNK)
-					r.Get("/catcodeNK", raceNKhandler.GetCategoryCodesNK)
-					r.Get("/racenk", raceNKhandler.GetRacesNK)
-					r.Get("/lastrow/racenk", raceNKhandler.GetLastRowRaceNK)
-					r.Post("/racenk", raceNKhandler.InsertRaceNK)
-					r.Put("/racenk", raceNKhandler.UpdateRaceNK)
-					r.Delete("/racenk", raceNKhandler.DeleteRaceNK)
-
-					// resultcc routes
-					r.Get("/resultcc", resultCCHandler.GetRaceResultsCC)
-					r.Get("/resultathletecc", resultCCHandler.GetAthleteResultsCC)
-					r.Get("/lastrow/resultcc", resultCCHandler.GetLastRowResultCC)
-					r.Post("/resultcc", resultCCHandler.InsertResultCC)
-					r.Put("/resultcc", resultCCHandler.UpdateResultCC)
-					r.Delete("/resultcc", resultCCHandler.DeleteResultCC)
-
-					// resultjp routes
-					r.Get("/resultjp", resultJPHandler.GetRaceResultsJP)
-					r.Get("/resultathletejp", resultJPHandler.GetAthleteResultsJP)
-					r.Get("/lastrow/resultjp", resultJPHandler.GetLastRowResultJP)
-					r.Post("/resultjp", resultJPHandler.InsertResultJP)
-					r.Put("/resultjp", resultJPHandler.UpdateResultJP)
-					r.Delete("/resultjp", resultJPHandler.DeleteResultJP)
-
-					// resultnk routes
-					r.Get("/resultnk", resultNKHandler.GetRaceResultsNK)
-					r.Get("/resultathletenk", resultNKHandler.GetAthleteResultsNK)
-					r.Get("/lastrow/resultnk", resultNKHandler.GetLastRowResultNK)
-					r.Post("/resultnk", resultNKHandler.InsertResultNK)
-					r.Put("/resultnk", resultNKHandler.UpdateResultNK)
-					r.Delete("/resultnk", resultNKHandler.DeleteResultNK)
+				// Coachtech routes
+				r.Route("/coachtech", func(r chi.Router) {
+					r.Get("/status", coachtechHandler.GetStatus)
+					r.Get("/data", coachtechHandler.GetData)
+					r.Post("/insert", coachtechHandler.Insert)
+				})
 
+				// Oura routes
+				r.Route("/oura", func(r chi.Router) {
+					r.Get("/dates", ouraHandler.GetDates)
+					r.Get("/types", ouraHandler.GetTypes)
+					r.Get("/data", ouraHandler.GetData)
+					r.Post("/data", ouraHandler.InsertData)
+					r.Delete("/data", ouraHandler.DeleteAllData)
+					r.Get("/status", ouraTokenHandler.GetStatus)
+					r.Post("/token", ouraTokenHandler.UpsertToken)
+					r.Get("/token-by-id", ouraTokenHandler.GetTokenByOuraID)
 				})
-			} else {
-				logger.Logger.Warn("fis routes disabled: database not reachable")
-				r.Route("/fis", func(r chi.Router) {
-					r.Handle("/*", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-						utils.ServiceUnavailableDBResponse(w, r, "fis")
-					}))
+
+				// Polar routes
+				r.Route("/polar", func(r chi.Router) {
+					r.Get("/dates", polarHandler.GetDates)
+					r.Get("/types", polarHandler.GetTypes)
+					r.Get("/data", polarHandler.GetData)
+					r.Post("/data", polarHandler.InsertData)
+					r.Delete("/data", polarHandler.DeleteAllData)
+					r.Get("/status", polarTokenHandler.GetStatus)
+					r.Post("/token", polarTokenHandler.UpsertToken)
+					r.Get("/token-by-id", polarTokenHandler.GetTokenByPolarID)
 				})
-			}
-			// UTV routes
-			if app.store.UTV != nil {
-				r.Route("/utv", func(r chi.Router) {
-					// Register handlers
-					generalHandler := utvapi.NewGeneralDataHandler(
-						app.store.UTV.Oura(),
-						app.store.UTV.Polar(),
-						app.store.UTV.Suunto(),
-						app.store.UTV.Garmin(),
-						app.store.UTV.OuraToken(),
-						app.store.UTV.PolarToken(),
-						app.store.UTV.SuuntoToken(),
-						app.store.UTV.GarminToken(),
-						app.store.UTV.KlabToken(),
-						app.store.UTV.ArchinisisToken(),
-						app.cacheStorage,
-					)
-					ouraHandler := utvapi.NewOuraDataHandler(app.store.UTV.Oura(), app.cacheStorage)
-					polarHandler := utvapi.NewPolarDataHandler(app.store.UTV.Polar(), app.cacheStorage)
-					suuntoHandler := utvapi.NewSuuntoDataHandler(app.store.UTV.Suunto(), app.cacheStorage)
-					garminHandler := utvapi.NewGarminDataHandler(app.store.UTV.Garmin(), app.cacheStorage)
-					polarTokenHandler := utvapi.NewPolarTokenHandler(app.store.UTV.PolarToken(), app.cacheStorage)
-					ouraTokenHandler := utvapi.NewOuraTokenHandler(app.store.UTV.OuraToken(), app.cacheStorage)
-					suuntoTokenHandler := utvapi.NewSuuntoTokenHandler(app.store.UTV.SuuntoToken(), app.cacheStorage)
-					garminTokenHandler := utvapi.NewGarminTokenHandler(app.store.UTV.GarminToken(), app.cacheStorage)
-					klabTokenHandler := utvapi.NewKlabTokenHandler(app.store.UTV.KlabToken(), app.cacheStorage)
-					userDataHandler := utvapi.NewUserDataHandler(app.store.UTV.UserData(), app.cacheStorage)
-					coachtechHandler := utvapi.NewCoachtechDataHandler(app.store.UTV.Coachtech(), app.cacheStorage)
-					sourceCacheHandler := utvapi.NewSourceCacheHandler(app.store.UTV.SourceCache(), app.cacheStorage)
-					archinisisTokenHandler := utvapi.NewArchinisisTokenHandler(app.store.UTV.ArchinisisToken(), app.cacheStorage)
-
-					// General routes
-					r.Get("/latest", generalHandler.GetLatestData)
-					r.Get("/all", generalHandler.GetAllByType)
-					r.Delete("/disconnect", generalHandler.Disconnect)
-					r.Get("/tokens4update", generalHandler.GetTokensForUpdate)
-					r.Get("/data4update", generalHandler.GetDataForUpdate)
-					r.Get("/token", generalHandler.GetToken)
-
-					// User data routes
-					r.Get("/user", userDataHandler.GetUserData)
-					r.Post("/user", userDataHandler.UpsertUserData)
-					r.Delete("/user", userDataHandler.DeleteUserData)
-					r.Get("/user-id-by-sport-id", userDataHandler.GetUserIDBySportID)
-					r.Get("/user-linked-devices", userDataHandler.GetLinkedDevices)
-
-					// Klab routes
-					r.Route("/klab", func(r chi.Router) {
-						r.Get("/status", klabTokenHandler.GetStatus)
-						r.Post("/token", klabTokenHandler.UpsertToken)
-						r.Get("/sport_ids", klabTokenHandler.GetSportIDs)
-					})
-
-					// Archinisis routes
-					r.Route("/archinisis", func(r chi.Router) {
-						r.Get("/status", archinisisTokenHandler.GetStatus)
-						r.Post("/token", archinisisTokenHandler.UpsertToken)
-						r.Get("/sport_ids", archinisisTokenHandler.GetSportIDs)
-					})
-
-					// Coachtech routes
-					r.Route("/coachtech", func(r chi.Router) {
-						r.Get("/status", coachtechHandler.GetStatus)
-						r.Get("/data", coachtechHandler.GetData)
-						r.Post("/insert", coachtechHandler.Insert)
-					})
-
-					// Oura routes
-					r.Route("/oura", func(r chi.Router) {
-						r.Get("/dates", ouraHandler.GetDates)
-						r.Get("/types", ouraHandler.GetTypes)
-						r.Get("/data", ouraHandler.GetData)
-						r.Post("/data", ouraHandler.InsertData)
-						r.Delete("/data", ouraHandler.DeleteAllData)
-						r.Get("/status", ouraTokenHandler.GetStatus)
-						r.Post("/token", ouraTokenHandler.UpsertToken)
-						r.Get("/token-by-id", ouraTokenHandler.GetTokenByOuraID)
-					})
-
-					// Polar routes
-					r.Route("/polar", func(r chi.Router) {
-						r.Get("/dates", polarHandler.GetDates)
-						r.Get("/types", polarHandler.GetTypes)
-						r.Get("/data", polarHandler.GetData)
-						r.Post("/data", polarHandler.InsertData)
-						r.Delete("/data", polarHandler.DeleteAllData)
-						r.Get("/status", polarTokenHandler.GetStatus)
-						r.Post("/token", polarTokenHandler.UpsertToken)
-						r.Get("/token-by-id", polarTokenHandler.GetTokenByPolarID)
-					})
-
-					// Suunto routes
-					r.Route("/suunto", func(r chi.Router) {
-						r.Get("/dates", suuntoHandler.GetDates)
-						r.Get("/types", suuntoHandler.GetTypes)
-						r.Get("/data", suuntoHandler.GetData)
-						r.Post("/data", suuntoHandler.InsertData)
-						r.Delete("/data", suuntoHandler.DeleteAllData)
-						r.Get("/status", suuntoTokenHandler.GetStatus)
-						r.Post("/token", suuntoTokenHandler.UpsertToken)
-						r.Get("/token-by-username", suuntoTokenHandler.GetTokenByUsername)
-					})
-
-					// Garmin routes
-					r.Route("/garmin", func(r chi.Router) {
-						r.Get("/dates", garminHandler.GetDates)
-						r.Get("/types", garminHandler.GetTypes)
-						r.Get("/data", garminHandler.GetData)
-						r.Post("/data", garminHandler.InsertData)
-						r.Delete("/data", garminHandler.DeleteAllData)
-						r.Get("/status", garminTokenHandler.GetStatus)
-						r.Post("/token", garminTokenHandler.UpsertToken)
-						r.Get("/token-exists", garminTokenHandler.TokenExists)
-						r.Get("/user-id-by-token", garminTokenHandler.GetUserIDByToken)
-					})
-
-					// Source cache routes
-					r.Route("/source_cache", func(r chi.Router) {
-						r.Get("/data-types", sourceCacheHandler.GetAllDataTypes)
-						r.Post("/data-types", sourceCacheHandler.UpsertDataTypes)
-					})
+
+				// Suunto routes
+				r.Route("/suunto", func(r chi.Router) {
+					r.Get("/dates", suuntoHandler.GetDates)
+					r.Get("/types", suuntoHandler.GetTypes)
+					r.Get("/data", suuntoHandler.GetData)
+					r.Post("/data", suuntoHandler.InsertData)
+					r.Delete("/data", suuntoHandler.DeleteAllData)
+					r.Get("/status", suuntoTokenHandler.GetStatus)
+					r.Post("/token", suuntoTokenHandler.UpsertToken)
+					r.Get("/token-by-username", suuntoTokenHandler.GetTokenByUsername)
 				})
-			} else {
-				logger.Logger.Warn("utv routes disabled: database not connected")
-				r.Route("/utv", func(r chi.Router) {
-					r.Handle("/*", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-						utils.ServiceUnavailableDBResponse(w, r, "utv")
-					}))
+
+				// Garmin routes
+				r.Route("/garmin", func(r chi.Router) {
+					r.Get("/dates", garminHandler.GetDates)
+					r.Get("/types", garminHandler.GetTypes)
+					r.Get("/data", garminHandler.GetData)
+					r.Post("/data", garminHandler.InsertData)
+					r.Delete("/data", garminHandler.DeleteAllData)
+					r.Get("/status", garminTokenHandler.GetStatus)
+					r.Post("/token", garminTokenHandler.UpsertToken)
+					r.Get("/token-exists", garminTokenHandler.TokenExists)
+					r.Get("/user-id-by-token", garminTokenHandler.GetUserIDByToken)
 				})
-			}
-		})
-	})
 
-	return r
+				// Source cache routes
+				r.Route("/source_cache", func(r chi.Router) {
+					r.Get("/data-types", sourceCacheHandler.GetAllDataTypes)
+					r.Post("/data-types", sourceCacheHandler.UpsertDataTypes)
+				})
+			})
+		} else {
+			logger.Logger.Warn("utv routes disabled: database not connected")
+			r.Route("/utv", func(r chi.Router) {
+				r.Handle("/*", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					utils.ServiceUnavailableDBResponse(w, r, "utv")
+				}))
+			})
+		}
+	})
 }
 
 func (app *api) run(mux http.Handler) error {
@@ -530,6 +664,27 @@ func (app *api) run(mux http.Handler) error {
 		ReadTimeout:  time.Second * 30,
 		IdleTimeout:  time.Minute,
 	}
+	// open athlete streams end when shutdown starts instead of holding it up
+	srv.RegisterOnShutdown(app.stream.Stop)
+
+	// gRPC ingestion on its own port
+	var grpcSrv *grpcapi.Server
+	if app.config.grpc.Enabled {
+		var err error
+		grpcSrv, err = grpcapi.NewServer(app.config.grpc, &app.store, app.cacheStorage)
+		if err != nil {
+			return err
+		}
+		if err := grpcSrv.Listen(); err != nil {
+			return err
+		}
+		go func() {
+			if err := grpcSrv.Serve(); err != nil {
+				logger.Logger.Errorw("gRPC server failed", "addr", app.config.grpc.Addr, "error", err)
+			}
+		}()
+		logger.Logger.Infow("gRPC server has started", "addr", app.config.grpc.Addr, "tls", app.config.grpc.CertFile != "")
+	}
 
 	shutdown := make(chan error)
 
@@ -544,6 +699,15 @@ func (app *api) run(mux http.Handler) error {
 
 		logger.Logger.Infow("signal caught", "signal", s.String())
 
+		if grpcSrv != nil {
+			// long-running streams are cut off when ctx runs out
+			go func() {
+				if err := grpcSrv.Shutdown(ctx); err != nil {
+					logger.Logger.Warnw("gRPC server did not stop gracefully", "error", err)
+				}
+			}()
+		}
+
 		shutdown <- srv.Shutdown(ctx)
 	}()
 
@@ -559,6 +723,15 @@ func (app *api) run(mux http.Handler) error {
 		return err
 	}
 
+	// flush the remaining usage rollups
+	app.usage.Stop()
+
+	// unfinished import jobs are marked failed; their items so far stay committed
+	app.jobs.Stop()
+
+	// queued events are stored as deliveries; pending ones are sent after the next start
+	app.webhooks.Stop()
+
 	logger.Logger.Infow("server has stopped", "addr", app.config.addr, "env", app.config.env)
 
 	return nil
@@ -5,34 +5,11 @@ import (
 
 	archsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/archinisis"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
-type ArchDataUpsertInput struct {
-	NationalID   string                 `json:"national_id"    validate:"required,numeric"`
-	FirstName    *string                `json:"first_name"     validate:"omitempty"`
-	LastName     *string                `json:"last_name"      validate:"omitempty"`
-	Initials     *string                `json:"initials"       validate:"omitempty"`
-	DateOfBirth  *string                `json:"date_of_birth"  validate:"omitempty"`
-	Height       *float64               `json:"height"         validate:"omitempty"`
-	Weight       *float64               `json:"weight"         validate:"omitempty"`
-	Measurements []ArchMeasurementInput `json:"measurements"   validate:"omitempty,dive"`
-}
-
-type ArchMeasurementInput struct {
-	Comment            *string `json:"comment"`
-	Discipline         *string `json:"discipline"`
-	MeasurementGroupID int32   `json:"measurement_group_id" validate:"required,gt=0"`
-	MeasurementID      int32   `json:"measurement_id"       validate:"required,gt=0"`
-	NbSegments         *int32  `json:"nb_segments"`
-	Place              *string `json:"place"`
-	RaceID             *int32  `json:"race_id"`
-	SessionName        *string `json:"session_name"`
-	StartTime          *string `json:"start_time"`
-	StopTime           *string `json:"stop_time"`
-}
-
 // Mapping
-func mapAthleteToParams(in ArchDataUpsertInput, sid string) (archsqlc.UpsertAthleteParams, error) {
+func mapAthleteToParams(in apitypes.ArchDataUpsertRequest, sid string) (archsqlc.UpsertAthleteParams, error) {
 	var dob *time.Time
 	var err error
 	if in.DateOfBirth != nil {
@@ -53,7 +30,7 @@ func mapAthleteToParams(in ArchDataUpsertInput, sid string) (archsqlc.UpsertAthl
 	}, nil
 }
 
-func mapMeasurementToParams(in ArchMeasurementInput, sid string) (archsqlc.UpsertMeasurementParams, error) {
+func mapMeasurementToParams(in apitypes.ArchMeasurementUpsert, sid string) (archsqlc.UpsertMeasurementParams, error) {
 	var (
 		start *time.Time
 		stop  *time.Time
@@ -3,14 +3,17 @@ package archapi
 import (
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
 	archsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/archinisis"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/archinisis"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 type DataHandler struct {
@@ -39,12 +42,12 @@ type RaceReportHTMLQuery struct {
 //	@Accept			json
 //	@Produce		json
 //	@Param			sportti_id	query		string	true	"Sportti ID"
-//	@Success		200			{object}	swagger.RaceReportSessionsResponse
-//	@Failure		400			{object}	swagger.ValidationErrorResponse
-//	@Failure		401			{object}	swagger.UnauthorizedResponse
-//	@Failure		403			{object}	swagger.ForbiddenResponse
-//	@Failure		500			{object}	swagger.InternalServerErrorResponse
-//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Success		200			{object}	apitypes.RaceReportSessionsResponse
+//	@Failure		400			{object}	apitypes.ValidationErrorResponse
+//	@Failure		401			{object}	apitypes.UnauthorizedResponse
+//	@Failure		403			{object}	apitypes.ForbiddenResponse
+//	@Failure		500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/archinisis/race-report/sessions [get]
 func (h *DataHandler) GetRaceReportSessions(w http.ResponseWriter, r *http.Request) {
@@ -87,11 +90,10 @@ func (h *DataHandler) GetRaceReportSessions(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, map[string]any{"race_report": sessionIDs}, ARCHCacheTTL)
+	resp := apitypes.RaceReportSessionsResponse{RaceReport: sessionIDs}
+	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, resp, ARCHCacheTTL)
 
-	utils.WriteJSON(w, http.StatusOK, map[string]any{
-		"race_report": sessionIDs,
-	})
+	utils.WriteJSON(w, http.StatusOK, resp)
 }
 
 // GetRaceReportHTML godoc
@@ -104,12 +106,12 @@ func (h *DataHandler) GetRaceReportSessions(w http.ResponseWriter, r *http.Reque
 //	@Param			sportti_id	query		string	true	"Sportti ID"
 //	@Param			session_id	query		string	true	"Session ID"
 //	@Success		200			{string}	string	"<!DOCTYPE html><html><head><title>Race Report</title></head><body><h1>HTML RACE REPORT</h1><p>full report returned in html DOCTYPE</p></body></html>"
-//	@Failure		400			{object}	swagger.ValidationErrorResponse
-//	@Failure		401			{object}	swagger.UnauthorizedResponse
-//	@Failure		403			{object}	swagger.ForbiddenResponse
-//	@Failure		404			{object}	swagger.NotFoundResponse
-//	@Failure		500			{object}	swagger.InternalServerErrorResponse
-//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400			{object}	apitypes.ValidationErrorResponse
+//	@Failure		401			{object}	apitypes.UnauthorizedResponse
+//	@Failure		403			{object}	apitypes.ForbiddenResponse
+//	@Failure		404			{object}	apitypes.NotFoundResponse
+//	@Failure		500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/archinisis/race-report [get]
 func (h *DataHandler) GetRaceReportHTML(w http.ResponseWriter, r *http.Request) {
@@ -174,12 +176,6 @@ func (h *DataHandler) GetRaceReportHTML(w http.ResponseWriter, r *http.Request)
 	_, _ = w.Write([]byte(html))
 }
 
-type RaceReportUpsertInput struct {
-	SporttiID  string `json:"sportti_id" validate:"required,numeric"`
-	SessionID  int32  `json:"session_id" validate:"required,gt=0"`
-	RaceReport string `json:"race_report" validate:"required"`
-}
-
 // PostRaceReport godoc
 //
 //	@Summary		Upsert a race report (HTML)
@@ -187,13 +183,13 @@ type RaceReportUpsertInput struct {
 //	@Tags			ARCHINISIS - Data
 //	@Accept			json
 //	@Produce		json
-//	@Param			data	body	swagger.ArchRaceReportUpsertRequest	true	"race report"
+//	@Param			data	body	apitypes.ArchRaceReportUpsertRequest	true	"race report"
 //	@Success		201		"Data processed successfully"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/archinisis/race-report [post]
 func (h *DataHandler) PostRaceReport(w http.ResponseWriter, r *http.Request) {
@@ -202,7 +198,7 @@ func (h *DataHandler) PostRaceReport(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var in RaceReportUpsertInput
+	var in apitypes.ArchRaceReportUpsertRequest
 	if err := utils.ReadJSON(w, r, &in); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -231,6 +227,7 @@ func (h *DataHandler) PostRaceReport(w http.ResponseWriter, r *http.Request) {
 	}
 
 	invalidateArchRaceReport(r.Context(), h.cache, sid, &in.SessionID)
+	events.Publish(events.ArchinisisDataInserted, events.ArchinisisData{SporttiID: sid, SessionID: &in.SessionID})
 
 	w.WriteHeader(http.StatusCreated)
 }
@@ -242,13 +239,13 @@ func (h *DataHandler) PostRaceReport(w http.ResponseWriter, r *http.Request) {
 //	@Tags			ARCHINISIS - Data
 //	@Accept			json
 //	@Produce		json
-//	@Param			data	body	swagger.ArchDataUpsertRequest	true	"athlete + measurements"
+//	@Param			data	body	apitypes.ArchDataUpsertRequest	true	"athlete + measurements"
 //	@Success		201		"Data processed successfully"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/archinisis/data [post]
 func (h *DataHandler) PostArchData(w http.ResponseWriter, r *http.Request) {
@@ -257,52 +254,141 @@ func (h *DataHandler) PostArchData(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var in ArchDataUpsertInput
-	if err := utils.ReadJSON(w, r, &in); err != nil {
-		utils.BadRequestResponse(w, r, err)
+	st := &archStream{}
+	err := h.store.UpsertDataStream(r.Context(), func(write func(archinisis.ArchDataPayload) error) error {
+		st.write = write
+		err := st.read(w, r)
+		if err != nil && st.writeErr == nil {
+			return &utils.InvalidInputError{Index: -1, Err: err}
+		}
+		return err
+	})
+	if err != nil {
+		var inputErr *utils.InvalidInputError
+		if errors.As(err, &inputErr) {
+			utils.BadRequestResponse(w, r, inputErr.Err)
+		} else {
+			utils.HandleDatabaseError(w, r, err)
+		}
 		return
 	}
 
-	if err := utils.GetValidator().Struct(in); err != nil {
-		utils.BadRequestResponse(w, r, err)
-		return
-	}
+	invalidateArchData(r.Context(), h.cache, st.sid)
+	events.Publish(events.ArchinisisDataInserted, events.ArchinisisData{SporttiID: st.sid})
 
-	sid, err := utils.ParseSporttiID(in.NationalID)
+	w.WriteHeader(http.StatusCreated)
+}
+
+// archChunk is how many measurements an upload hands to the store at a time
+const archChunk = 500
+
+// archStream validates measurements as they arrive and hands them to the
+// store every archChunk rows. The athlete, with the fields read before the
+// measurements, is upserted when they start, so the measurements can
+// reference it; the complete athlete follows once the body is read.
+// Measurements that come before national_id are held until then.
+type archStream struct {
+	write    func(archinisis.ArchDataPayload) error
+	writeErr error
+
+	sid   string
+	chunk []archsqlc.UpsertMeasurementParams
+	held  []apitypes.ArchMeasurementUpsert
+}
+
+func (st *archStream) read(w http.ResponseWriter, r *http.Request) error {
+	var in apitypes.ArchDataUpsertRequest
+	err := utils.ReadJSONObject(w, r, &in, map[string]func(s *utils.JSONReader, read func(v any) error) error{
+		"measurements": func(s *utils.JSONReader, read func(v any) error) error {
+			var head apitypes.ArchDataUpsertRequest
+			if err := read(&head); err != nil {
+				return err
+			}
+			if head.NationalID != "" && st.sid == "" {
+				ath, err := athleteParams(head)
+				if err != nil {
+					return err
+				}
+				st.sid = ath.NationalID
+				if err := st.flush(ath); err != nil {
+					return err
+				}
+			}
+
+			return s.Array(func(int) error {
+				var m apitypes.ArchMeasurementUpsert
+				if err := s.Decode(&m); err != nil {
+					return err
+				}
+				if err := utils.GetValidator().Struct(m); err != nil {
+					return err
+				}
+				if st.sid == "" {
+					st.held = append(st.held, m)
+					return nil
+				}
+				return st.add(m)
+			})
+		},
+	})
 	if err != nil {
-		utils.BadRequestResponse(w, r, err)
-		return
+		return err
 	}
 
-	ath, err := mapAthleteToParams(in, sid)
+	ath, err := athleteParams(in)
 	if err != nil {
-		utils.BadRequestResponse(w, r, err)
-		return
+		return err
 	}
-
-	measParams := make([]archsqlc.UpsertMeasurementParams, 0, len(in.Measurements))
-	for _, m := range in.Measurements {
-		mp, err := mapMeasurementToParams(m, sid)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
+	if st.sid != "" && st.sid != ath.NationalID {
+		return fmt.Errorf("national_id must appear only once")
+	}
+	st.sid = ath.NationalID
+	held := st.held
+	st.held = nil
+	if err := st.flush(ath); err != nil {
+		return err
+	}
+	for _, m := range held {
+		if err := st.add(m); err != nil {
+			return err
 		}
-		measParams = append(measParams, mp)
 	}
+	return st.flush(archsqlc.UpsertAthleteParams{})
+}
 
-	payload := archinisis.ArchDataPayload{
-		Athlete:      ath,
-		Measurements: measParams,
+// add queues a measurement of the athlete and writes the chunk once it is full
+func (st *archStream) add(m apitypes.ArchMeasurementUpsert) error {
+	mp, err := mapMeasurementToParams(m, st.sid)
+	if err != nil {
+		return err
 	}
-
-	if err := h.store.UpsertData(r.Context(), payload); err != nil {
-		utils.HandleDatabaseError(w, r, err)
-		return
+	st.chunk = append(st.chunk, mp)
+	if len(st.chunk) < archChunk {
+		return nil
 	}
+	return st.flush(archsqlc.UpsertAthleteParams{})
+}
 
-	invalidateArchData(r.Context(), h.cache, sid)
+// flush writes the queued measurements, after ath unless it is empty
+func (st *archStream) flush(ath archsqlc.UpsertAthleteParams) error {
+	if ath.NationalID == "" && len(st.chunk) == 0 {
+		return nil
+	}
+	st.writeErr = st.write(archinisis.ArchDataPayload{Athlete: ath, Measurements: st.chunk})
+	st.chunk = nil
+	return st.writeErr
+}
 
-	w.WriteHeader(http.StatusCreated)
+// athleteParams validates the athlete fields of an upload and maps them
+func athleteParams(in apitypes.ArchDataUpsertRequest) (archsqlc.UpsertAthleteParams, error) {
+	if err := utils.GetValidator().Struct(in); err != nil {
+		return archsqlc.UpsertAthleteParams{}, err
+	}
+	sid, err := utils.ParseSporttiID(in.NationalID)
+	if err != nil {
+		return archsqlc.UpsertAthleteParams{}, err
+	}
+	return mapAthleteToParams(in, sid)
 }
 
 type archUserParams struct {
@@ -317,13 +403,13 @@ type archUserParams struct {
 //	@Accept			json
 //	@Produce		json
 //	@Param			id	query		string	true	"Sportti ID (national_id)"
-//	@Success		200	{object}	swagger.ArchDataResponse
-//	@Failure		400	{object}	swagger.ValidationErrorResponse
-//	@Failure		401	{object}	swagger.UnauthorizedResponse
-//	@Failure		403	{object}	swagger.ForbiddenResponse
-//	@Failure		404	{object}	swagger.NotFoundResponse
-//	@Failure		500	{object}	swagger.InternalServerErrorResponse
-//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Success		200	{object}	apitypes.ArchDataResponse
+//	@Failure		400	{object}	apitypes.ValidationErrorResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		403	{object}	apitypes.ForbiddenResponse
+//	@Failure		404	{object}	apitypes.NotFoundResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/archinisis/data [get]
 func (h *DataHandler) GetArchData(w http.ResponseWriter, r *http.Request) {
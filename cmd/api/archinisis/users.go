@@ -33,12 +33,12 @@ type SporttiIDParam struct {
 //	@Produce		json
 //	@Param			sportti_id	query	string	true	"Sportti ID"
 //	@Success		200
-//	@Failure		400	{object}	swagger.ValidationErrorResponse
-//	@Failure		401	{object}	swagger.UnauthorizedResponse
-//	@Failure		403	{object}	swagger.ForbiddenResponse
-//	@Failure		404	{object}	swagger.NotFoundResponse
-//	@Failure		500	{object}	swagger.InternalServerErrorResponse
-//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400	{object}	apitypes.ValidationErrorResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		403	{object}	apitypes.ForbiddenResponse
+//	@Failure		404	{object}	apitypes.NotFoundResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/archinisis/user [delete]
 func (h *UserDataHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
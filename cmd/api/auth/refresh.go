@@ -5,16 +5,9 @@ import (
 	"net/http"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
-type RefreshRequest struct {
-	RefreshToken string `json:"refresh_token" validate:"required"`
-}
-
-type RefreshResponse struct {
-	JWT string `json:"jwt"`
-}
-
 // RefreshToken godoc
 //
 //	@Summary		Issue a new JWT token
@@ -22,15 +15,15 @@ type RefreshResponse struct {
 //	@Tags			Auth
 //	@Accept			json
 //	@Produce		json
-//	@Param			refresh_token	body		RefreshRequest	true	"Refresh Token"
-//	@Success		200				{object}	RefreshResponse	"New JWT token"
-//	@Failure		400				{object}	swagger.ValidationErrorResponse
-//	@Failure		401				{object}	swagger.UnauthorizedResponse
-//	@Failure		500				{object}	swagger.InternalServerErrorResponse
-//	@Failure		503				{object}	swagger.ServiceUnavailableResponse
+//	@Param			refresh_token	body		apitypes.RefreshRequest		true	"Refresh Token"
+//	@Success		200				{object}	apitypes.RefreshResponse	"New JWT token"
+//	@Failure		400				{object}	apitypes.ValidationErrorResponse
+//	@Failure		401				{object}	apitypes.UnauthorizedResponse
+//	@Failure		500				{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503				{object}	apitypes.ServiceUnavailableResponse
 //	@Router			/auth/refresh [post]
 func (h *AuthHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
-	var req RefreshRequest
+	var req apitypes.RefreshRequest
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		utils.BadRequestResponse(w, r, err)
@@ -51,7 +44,7 @@ func (h *AuthHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	utils.WriteJSON(w, http.StatusOK, map[string]interface{}{
-		"tokens": RefreshResponse{JWT: jwt},
+	utils.WriteJSON(w, http.StatusOK, apitypes.RefreshResponse{
+		Tokens: apitypes.RefreshedToken{JWT: jwt},
 	})
 }
@@ -5,17 +5,9 @@ import (
 	"net/http"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
-type TokenRequest struct {
-	ClientToken string `json:"client_token" validate:"required"`
-}
-
-type TokenResponse struct {
-	JWT          string `json:"jwt"`
-	RefreshToken string `json:"refresh_token"`
-}
-
 // IssueTokens godoc
 //
 //	@Summary		Issue JWT and Refresh token
@@ -23,15 +15,15 @@ type TokenResponse struct {
 //	@Tags			Auth
 //	@Accept			json
 //	@Produce		json
-//	@Param			client_token	body		TokenRequest	true	"Client Token"
-//	@Success		200				{object}	TokenResponse	"Tokens"
-//	@Failure		400				{object}	swagger.ValidationErrorResponse
-//	@Failure		401				{object}	swagger.UnauthorizedResponse
-//	@Failure		500				{object}	swagger.InternalServerErrorResponse
-//	@Failure		503				{object}	swagger.ServiceUnavailableResponse
+//	@Param			client_token	body		apitypes.TokenRequest	true	"Client Token"
+//	@Success		200				{object}	apitypes.TokenResponse	"Tokens"
+//	@Failure		400				{object}	apitypes.ValidationErrorResponse
+//	@Failure		401				{object}	apitypes.UnauthorizedResponse
+//	@Failure		500				{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503				{object}	apitypes.ServiceUnavailableResponse
 //	@Router			/auth/token [post]
 func (h *AuthHandler) IssueTokens(w http.ResponseWriter, r *http.Request) {
-	var req TokenRequest
+	var req apitypes.TokenRequest
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		utils.BadRequestResponse(w, r, err)
@@ -52,8 +44,8 @@ func (h *AuthHandler) IssueTokens(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	utils.WriteJSON(w, http.StatusOK, map[string]interface{}{
-		"tokens": TokenResponse{
+	utils.WriteJSON(w, http.StatusOK, apitypes.TokenResponse{
+		Tokens: apitypes.Tokens{
 			JWT:          tokens.JWT,
 			RefreshToken: tokens.RefreshToken,
 		},
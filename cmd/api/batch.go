@@ -0,0 +1,230 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/logger"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+const (
+	maxBatchRequests = 50
+	// sub-requests of one batch running at the same time
+	batchConcurrency = 8
+	// larger sub-responses are replaced by a payload_too_large problem
+	maxBatchResponse = 10 << 20
+)
+
+// headers a sub-request may set itself; auth and client address always come from the batch request
+var batchHeaders = []string{"Accept", "Accept-Language", "If-None-Match", "If-Modified-Since"}
+
+var errBatchResponseTooLarge = errors.New("response too large for a batch")
+
+// Batch godoc
+//
+//	@Summary		Run several GET requests in one call
+//	@Description	Runs up to 50 GET sub-requests concurrently through the API with the caller's token. Each sub-request is authorized, rate limited and logged like a separate call, so the batch can return a mix of statuses; the batch itself answers 200 with one entry per sub-request, in request order. path is the full API path with its query string (/v1/... or /v2/...). Only the Accept, Accept-Language, If-None-Match and If-Modified-Since headers may be set per sub-request. Sub-responses over 10 MB are replaced by a payload_too_large problem.
+//	@Tags			Batch
+//	@Accept			json
+//	@Produce		json
+//	@Param			batch	body		apitypes.BatchRequest	true	"Sub-requests"
+//	@Success		200		{object}	apitypes.BatchResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Security		BearerAuth
+//	@Router			/batch [post]
+func (app *api) batchHandler(root http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var input apitypes.BatchRequest
+		if err := utils.ReadJSON(w, r, &input); err != nil {
+			utils.BadRequestResponse(w, r, err)
+			return
+		}
+		if len(input.Requests) == 0 || len(input.Requests) > maxBatchRequests {
+			utils.BadRequestResponse(w, r, fmt.Errorf("requests must contain 1 to %d sub-requests", maxBatchRequests))
+			return
+		}
+		for i, sub := range input.Requests {
+			if err := validateBatchRequest(sub); err != nil {
+				utils.BadRequestResponse(w, r, fmt.Errorf("requests[%d]: %w", i, err))
+				return
+			}
+		}
+
+		// sub-requests start from a clean context so no routing or auth state of
+		// the batch request leaks into them; they are still cancelled with it
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		stop := context.AfterFunc(r.Context(), cancel)
+		defer stop()
+
+		out := make([]apitypes.BatchSubResponse, len(input.Requests))
+		sem := make(chan struct{}, batchConcurrency)
+		var wg sync.WaitGroup
+		for i, sub := range input.Requests {
+			wg.Add(1)
+			sem <- struct{}{}
+			go func() {
+				defer func() { <-sem; wg.Done() }()
+				out[i] = runBatchRequest(ctx, root, r, sub, i)
+			}()
+		}
+		wg.Wait()
+
+		utils.WriteJSON(w, http.StatusOK, apitypes.BatchResponse{Responses: out})
+	}
+}
+
+func validateBatchRequest(sub apitypes.BatchSubRequest) error {
+	if sub.Method != "" && !strings.EqualFold(sub.Method, http.MethodGet) {
+		return fmt.Errorf("only GET sub-requests are allowed")
+	}
+	u, err := url.Parse(sub.Path)
+	if err != nil || u.Scheme != "" || u.Host != "" {
+		return fmt.Errorf("path must be an API path such as /v1/utv/latest?user_id=...")
+	}
+	if !strings.HasPrefix(u.Path, "/v1/") && !strings.HasPrefix(u.Path, "/v2/") {
+		return fmt.Errorf("path must start with /v1/ or /v2/")
+	}
+	if strings.TrimSuffix(u.Path[3:], "/") == "/batch" {
+		return fmt.Errorf("batches cannot be nested")
+	}
+	if strings.HasPrefix(u.Path[3:], "/stream/") {
+		return fmt.Errorf("event streams cannot be batched")
+	}
+	for name := range sub.Headers {
+		if !isBatchHeader(name) {
+			return fmt.Errorf("header %s cannot be set per sub-request (allowed: %s)", name, strings.Join(batchHeaders, ", "))
+		}
+	}
+	return nil
+}
+
+func isBatchHeader(name string) bool {
+	for _, h := range batchHeaders {
+		if strings.EqualFold(h, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// runBatchRequest sends sub through the whole router, middleware included,
+// as the client of the batch request r
+func runBatchRequest(ctx context.Context, root http.Handler, r *http.Request, sub apitypes.BatchSubRequest, i int) (res apitypes.BatchSubResponse) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, sub.Path, nil)
+	if err != nil {
+		return problemResponse(sub.ID, utils.NewProblem(r, utils.CodeBadRequest, err.Error()))
+	}
+	req.RemoteAddr, req.Host = r.RemoteAddr, r.Host
+	for _, h := range []string{"Authorization", "X-Forwarded-For", "X-Real-IP", "True-Client-IP"} {
+		if v := r.Header.Get(h); v != "" {
+			req.Header.Set(h, v)
+		}
+	}
+	// log lines of sub-requests can be traced back to the batch
+	if id := middleware.GetReqID(r.Context()); id != "" {
+		req.Header.Set(middleware.RequestIDHeader, fmt.Sprintf("%s-%d", id, i))
+	}
+	for name, v := range sub.Headers {
+		req.Header.Set(name, v)
+	}
+
+	rec := &batchRecorder{header: make(http.Header), status: http.StatusOK}
+	defer func() {
+		// an aborted stream re-panics past Recoverer; it must not take the batch down
+		if p := recover(); p != nil {
+			if !rec.overflow && p != http.ErrAbortHandler {
+				logger.Logger.Errorw("batch sub-request panicked", "path", sub.Path, "panic", p)
+			}
+			res = rec.failure(req, sub.ID)
+		}
+	}()
+
+	root.ServeHTTP(rec, req)
+	if rec.overflow {
+		return rec.failure(req, sub.ID)
+	}
+	return rec.response(sub.ID)
+}
+
+func problemResponse(id string, p *utils.Problem) apitypes.BatchSubResponse {
+	body, _ := json.Marshal(p)
+	return apitypes.BatchSubResponse{
+		ID:      id,
+		Status:  p.Status,
+		Headers: map[string]string{"Content-Type": utils.ProblemContentType},
+		Body:    json.RawMessage(body),
+	}
+}
+
+// batchRecorder buffers a sub-response
+type batchRecorder struct {
+	header   http.Header
+	status   int
+	body     bytes.Buffer
+	wrote    bool
+	overflow bool
+}
+
+func (rw *batchRecorder) Header() http.Header {
+	return rw.header
+}
+
+func (rw *batchRecorder) WriteHeader(code int) {
+	if !rw.wrote {
+		rw.wrote = true
+		rw.status = code
+	}
+}
+
+func (rw *batchRecorder) Write(b []byte) (int, error) {
+	rw.WriteHeader(http.StatusOK)
+	if rw.overflow || rw.body.Len()+len(b) > maxBatchResponse {
+		rw.overflow = true
+		return 0, errBatchResponseTooLarge
+	}
+	return rw.body.Write(b)
+}
+
+func (rw *batchRecorder) response(id string) apitypes.BatchSubResponse {
+	res := apitypes.BatchSubResponse{ID: id, Status: rw.status, Headers: make(map[string]string, len(rw.header))}
+	for name, v := range rw.header {
+		res.Headers[name] = strings.Join(v, ", ")
+	}
+
+	body := rw.body.Bytes()
+	ct := rw.header.Get("Content-Type")
+	switch {
+	case len(body) == 0:
+	case (strings.Contains(ct, "application/json") || strings.Contains(ct, "+json")) && json.Valid(body):
+		res.Body = json.RawMessage(body)
+	case utf8.Valid(body):
+		res.Body = string(body)
+	default:
+		res.Body, res.BodyEncoding = base64.StdEncoding.EncodeToString(body), "base64"
+	}
+	return res
+}
+
+// failure stands in for a sub-response that could not be completed
+func (rw *batchRecorder) failure(req *http.Request, id string) apitypes.BatchSubResponse {
+	if rw.overflow {
+		return problemResponse(id, utils.NewProblem(req, utils.CodePayloadTooLarge,
+			fmt.Sprintf("response is larger than the %d MB batch limit; request it on its own", maxBatchResponse>>20)))
+	}
+	return problemResponse(id, utils.NewProblem(req, utils.CodeInternalError, "the sub-request failed"))
+}
@@ -0,0 +1,337 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
+)
+
+type compressionConfig struct {
+	enabled bool
+	minSize int
+}
+
+const (
+	encodingBrotli = "br"
+	encodingZstd   = "zstd"
+	encodingGzip   = "gzip"
+)
+
+// server preference when the client weighs several encodings equally
+var supportedEncodings = []string{encodingBrotli, encodingZstd, encodingGzip}
+
+var encoderPools = map[string]*sync.Pool{
+	encodingGzip: {New: func() any {
+		zw, _ := gzip.NewWriterLevel(nil, gzip.DefaultCompression)
+		return zw
+	}},
+	encodingZstd: {New: func() any {
+		zw, _ := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1), zstd.WithEncoderLevel(zstd.SpeedDefault))
+		return zw
+	}},
+	encodingBrotli: {New: func() any {
+		return brotli.NewWriterLevel(nil, 5)
+	}},
+}
+
+type resettableEncoder interface {
+	io.WriteCloser
+	Reset(w io.Writer)
+}
+
+// pooledEncoder returns the encoder to its pool once closed
+type pooledEncoder struct {
+	resettableEncoder
+	pool *sync.Pool
+}
+
+func (e *pooledEncoder) Close() error {
+	err := e.resettableEncoder.Close()
+	e.resettableEncoder.Reset(nil)
+	e.pool.Put(e.resettableEncoder)
+	return err
+}
+
+func (e *pooledEncoder) Flush() error {
+	if f, ok := e.resettableEncoder.(interface{ Flush() error }); ok {
+		return f.Flush()
+	}
+	return nil
+}
+
+func newEncoder(encoding string, w io.Writer) *pooledEncoder {
+	pool := encoderPools[encoding]
+	enc := pool.Get().(resettableEncoder)
+	enc.Reset(w)
+	return &pooledEncoder{resettableEncoder: enc, pool: pool}
+}
+
+func compressBytes(encoding string, b []byte) ([]byte, error) {
+	var out bytes.Buffer
+	enc := newEncoder(encoding, &out)
+	if _, err := enc.Write(b); err != nil {
+		enc.Close()
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// negotiateEncoding picks the best supported encoding from Accept-Encoding, "" for none
+func negotiateEncoding(header string) string {
+	if header == "" {
+		return ""
+	}
+
+	weights := map[string]float64{}
+	wildcard := -1.0
+	for _, part := range strings.Split(header, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				q = f
+			}
+		}
+		if name == "*" {
+			wildcard = q
+			continue
+		}
+		weights[name] = q
+	}
+
+	best, bestQ := "", 0.0
+	for _, enc := range supportedEncodings {
+		q, ok := weights[enc]
+		if !ok {
+			q = max(wildcard, 0)
+		}
+		if q > bestQ {
+			best, bestQ = enc, q
+		}
+	}
+	return best
+}
+
+func compressibleType(contentType string) bool {
+	mt, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	switch {
+	case mt == "text/event-stream":
+		// events have to reach the client as they are flushed
+		return false
+	case strings.HasPrefix(mt, "text/"),
+		mt == "application/json",
+		strings.HasSuffix(mt, "+json"),
+		mt == "application/x-ndjson",
+		mt == "application/xml",
+		mt == "application/javascript",
+		mt == "image/svg+xml":
+		return true
+	}
+	return false
+}
+
+// compressWriter buffers the response so small bodies can be sent as-is and
+// large ones compressed in one go. A Flush from a streaming handler switches
+// it to compressing on the fly.
+type compressWriter struct {
+	http.ResponseWriter
+	r        *http.Request
+	cache    *cache.Storage
+	entry    *cache.Entry
+	encoding string
+	minSize  int
+
+	status      int
+	buf         bytes.Buffer
+	wroteHeader bool
+	streaming   bool
+	enc         *pooledEncoder
+}
+
+func (cw *compressWriter) WriteHeader(code int) {
+	if cw.status == 0 {
+		cw.status = code
+	}
+}
+
+func (cw *compressWriter) Write(b []byte) (int, error) {
+	if cw.status == 0 {
+		cw.status = http.StatusOK
+	}
+	if cw.streaming {
+		if cw.enc != nil {
+			return cw.enc.Write(b)
+		}
+		return cw.ResponseWriter.Write(b)
+	}
+	return cw.buf.Write(b)
+}
+
+func (cw *compressWriter) Flush() {
+	if !cw.streaming {
+		cw.streaming = true
+		if cw.eligible() {
+			cw.startEncoding()
+			cw.enc = newEncoder(cw.encoding, cw.ResponseWriter)
+		}
+		cw.writeHeader()
+		if cw.buf.Len() > 0 {
+			if cw.enc != nil {
+				_, _ = cw.enc.Write(cw.buf.Bytes())
+			} else {
+				_, _ = cw.ResponseWriter.Write(cw.buf.Bytes())
+			}
+			cw.buf.Reset()
+		}
+	}
+	if cw.enc != nil {
+		_ = cw.enc.Flush()
+	}
+	// through the recorders below, which only offer Unwrap
+	_ = http.NewResponseController(cw.ResponseWriter).Flush()
+}
+
+func (cw *compressWriter) Unwrap() http.ResponseWriter {
+	return cw.ResponseWriter
+}
+
+func (cw *compressWriter) eligible() bool {
+	h := cw.Header()
+	if cw.status < http.StatusOK || cw.status == http.StatusNoContent || cw.status == http.StatusNotModified {
+		return false
+	}
+	if h.Get("Content-Encoding") != "" || cw.r.Method == http.MethodHead {
+		return false
+	}
+	return compressibleType(h.Get("Content-Type"))
+}
+
+func (cw *compressWriter) startEncoding() {
+	h := cw.Header()
+	h.Set("Content-Encoding", cw.encoding)
+	h.Del("Content-Length")
+}
+
+func (cw *compressWriter) writeHeader() {
+	if cw.wroteHeader {
+		return
+	}
+	cw.wroteHeader = true
+	if cw.status == 0 {
+		cw.status = http.StatusOK
+	}
+	cw.ResponseWriter.WriteHeader(cw.status)
+}
+
+// finish sends whatever the handler left in the buffer
+func (cw *compressWriter) finish() {
+	if cw.streaming {
+		if cw.enc != nil {
+			_ = cw.enc.Close()
+		}
+		return
+	}
+	if cw.status == 0 && cw.buf.Len() == 0 {
+		return
+	}
+
+	body := cw.buf.Bytes()
+	if cw.buf.Len() < cw.minSize || !cw.eligible() {
+		cw.writeHeader()
+		_, _ = cw.ResponseWriter.Write(body)
+		return
+	}
+
+	compressed, err := cw.compressed(body)
+	if err != nil {
+		cw.writeHeader()
+		_, _ = cw.ResponseWriter.Write(body)
+		return
+	}
+	cw.startEncoding()
+	cw.writeHeader()
+	_, _ = cw.ResponseWriter.Write(compressed)
+}
+
+// compressed reuses the variant stored next to the cache entry that produced
+// the body, so hot keys are not recompressed on every hit
+func (cw *compressWriter) compressed(body []byte) ([]byte, error) {
+	key := ""
+	if cw.cache != nil && cw.entry != nil {
+		key = cw.entry.Key()
+	}
+	if key == "" {
+		return compressBytes(cw.encoding, body)
+	}
+
+	sum := sha256.Sum256(body)
+	digest := hex.EncodeToString(sum[:8])
+	ctx := cw.r.Context()
+	if b, ok := cw.cache.GetCompressed(ctx, key, cw.encoding, digest); ok {
+		return b, nil
+	}
+
+	b, err := compressBytes(cw.encoding, body)
+	if err != nil {
+		return nil, err
+	}
+	_ = cw.cache.SetCompressed(ctx, key, cw.encoding, digest, b)
+	return b, nil
+}
+
+// CompressionMiddleware compresses responses with the best encoding the client accepts
+// (br, zstd or gzip) once they reach the configured minimum size
+func (app *api) CompressionMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !app.config.compression.enabled {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Add("Vary", "Accept-Encoding")
+		encoding := negotiateEncoding(r.Header.Get("Accept-Encoding"))
+		if encoding == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx, entry := cache.WithEntry(r.Context())
+		r = r.WithContext(ctx)
+
+		cw := &compressWriter{
+			ResponseWriter: w,
+			r:              r,
+			cache:          app.cacheStorage,
+			entry:          entry,
+			encoding:       encoding,
+			minSize:        app.config.compression.minSize,
+		}
+		defer func() {
+			// let Recoverer answer a panicking request instead of flushing a partial body
+			if rec := recover(); rec != nil {
+				panic(rec)
+			}
+			cw.finish()
+		}()
+
+		next.ServeHTTP(cw, r)
+	})
+}
@@ -2,6 +2,7 @@ package fisapi
 
 import (
 	"github.com/DeRuina/KUHA-REST-API/internal/store/fis"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 type SporttiIDParam struct {
@@ -12,21 +13,7 @@ type AthleteFiscodeParam struct {
 	Fiscode string `form:"fiscode" validate:"required,numeric"`
 }
 
-type InsertAthleteInput struct {
-	Fiscode   int32   `json:"fiscode" validate:"required"`
-	Sporttiid *int32  `json:"sporttiid"`
-	Firstname *string `json:"firstname"`
-	Lastname  *string `json:"lastname"`
-}
-
-type UpdateAthleteInput struct {
-	Fiscode   int32   `json:"fiscode" validate:"required"`
-	Sporttiid *int32  `json:"sporttiid"`
-	Firstname *string `json:"firstname"`
-	Lastname  *string `json:"lastname"`
-}
-
-func mapInsertAthleteInput(in InsertAthleteInput) fis.InsertAthleteClean {
+func mapInsertAthleteInput(in apitypes.FISInsertAthleteExample) fis.InsertAthleteClean {
 	return fis.InsertAthleteClean{
 		Fiscode:   in.Fiscode,
 		Sporttiid: in.Sporttiid,
@@ -35,7 +22,7 @@ func mapInsertAthleteInput(in InsertAthleteInput) fis.InsertAthleteClean {
 	}
 }
 
-func mapUpdateAthleteInput(in UpdateAthleteInput) fis.UpdateAthleteClean {
+func mapUpdateAthleteInput(in apitypes.FISUpdateAthleteExample) fis.UpdateAthleteClean {
 	return fis.UpdateAthleteClean{
 		Fiscode:   in.Fiscode,
 		Sporttiid: in.Sporttiid,
@@ -9,6 +9,7 @@ import (
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 type AthleteHandler struct {
@@ -27,13 +28,13 @@ func NewAthleteHandler(store fis.Athlete, cache *cache.Storage) *AthleteHandler
 //	@Accept		json
 //	@Produce	json
 //	@Param		sporttiid	query		integer	true	"SporttiID"
-//	@Success	200			{object}	swagger.FISAthletesResponse
-//	@Failure	400			{object}	swagger.ValidationErrorResponse
-//	@Failure	401			{object}	swagger.UnauthorizedResponse
-//	@Failure	403			{object}	swagger.ForbiddenResponse
-//	@Failure	404			{object}	swagger.NotFoundResponse
-//	@Failure	500			{object}	swagger.InternalServerErrorResponse
-//	@Failure	503			{object}	swagger.ServiceUnavailableResponse
+//	@Success	200			{object}	apitypes.FISAthletesResponse
+//	@Failure	400			{object}	apitypes.ValidationErrorResponse
+//	@Failure	401			{object}	apitypes.UnauthorizedResponse
+//	@Failure	403			{object}	apitypes.ForbiddenResponse
+//	@Failure	404			{object}	apitypes.NotFoundResponse
+//	@Failure	500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure	503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security	BearerAuth
 //	@Router		/fis/fiscode [get]
 func (h *AthleteHandler) GetAthletesBySporttiID(w http.ResponseWriter, r *http.Request) {
@@ -84,14 +85,14 @@ func (h *AthleteHandler) GetAthletesBySporttiID(w http.ResponseWriter, r *http.R
 //	@Tags			FIS - Athlete Management
 //	@Accept			json
 //	@Produce		json
-//	@Param			athlete	body	swagger.FISInsertAthleteExample	true	"Athlete payload"
+//	@Param			athlete	body	apitypes.FISInsertAthleteExample	true	"Athlete payload"
 //	@Success		201		"Created"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		409		{object}	swagger.ConflictResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		409		{object}	apitypes.ConflictResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/athlete [post]
 func (h *AthleteHandler) InsertAthlete(w http.ResponseWriter, r *http.Request) {
@@ -100,7 +101,7 @@ func (h *AthleteHandler) InsertAthlete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var in InsertAthleteInput
+	var in apitypes.FISInsertAthleteExample
 	if err := utils.ReadJSON(w, r, &in); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -126,14 +127,14 @@ func (h *AthleteHandler) InsertAthlete(w http.ResponseWriter, r *http.Request) {
 //	@Tags			FIS - Athlete Management
 //	@Accept			json
 //	@Produce		json
-//	@Param			athlete	body	swagger.FISUpdateAthleteExample	true	"Athlete payload"
+//	@Param			athlete	body	apitypes.FISUpdateAthleteExample	true	"Athlete payload"
 //	@Success		200		"Updated"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		404		{object}	swagger.NotFoundResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		404		{object}	apitypes.NotFoundResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/athlete [put]
 func (h *AthleteHandler) UpdateAthlete(w http.ResponseWriter, r *http.Request) {
@@ -142,7 +143,7 @@ func (h *AthleteHandler) UpdateAthlete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var in UpdateAthleteInput
+	var in apitypes.FISUpdateAthleteExample
 	if err := utils.ReadJSON(w, r, &in); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -174,12 +175,12 @@ func (h *AthleteHandler) UpdateAthlete(w http.ResponseWriter, r *http.Request) {
 //	@Produce		json
 //	@Param			fiscode	query	integer	true	"FIS code"
 //	@Success		200		"Deleted"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		404		{object}	swagger.NotFoundResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		404		{object}	apitypes.NotFoundResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/athlete [delete]
 func (h *AthleteHandler) DeleteAthlete(w http.ResponseWriter, r *http.Request) {
@@ -6,12 +6,9 @@ import (
 	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
-type NationsBySectorResponse struct {
-	Nations []string `json:"nations"`
-}
-
 // Query params
 type SectorParam struct {
 	Sector string `form:"sectorcode" validate:"required,oneof=JP NK CC"`
@@ -21,99 +18,7 @@ type CompetitorIDParam struct {
 	CompetitorID string `form:"id" validate:"required,numeric"`
 }
 
-type InsertCompetitorInput struct {
-	Competitorid       int32   `json:"competitorid" validate:"required"`
-	Personid           *int32  `json:"personid"`
-	Ipcid              *int32  `json:"ipcid"`
-	Fiscode            *int32  `json:"fiscode"`
-	Birthdate          *string `json:"birthdate"`
-	StatusDate         *string `json:"status_date"`
-	Fee                *string `json:"fee"`
-	Dateofcreation     *string `json:"dateofcreation"`
-	Injury             *int32  `json:"injury"`
-	Version            *int32  `json:"version"`
-	Compidmssql        *int32  `json:"compidmssql"`
-	Carving            *int32  `json:"carving"`
-	Photo              *int32  `json:"photo"`
-	Notallowed         *int32  `json:"notallowed"`
-	Published          *int32  `json:"published"`
-	Team               *int32  `json:"team"`
-	PhotoBig           *int32  `json:"photo_big"`
-	Lastupdate         *string `json:"lastupdate"`
-	Statusnextlist     *string `json:"statusnextlist"`
-	Alternatenamecheck *string `json:"alternatenamecheck"`
-	Deletedat          *string `json:"deletedat"`
-	Doped              *string `json:"doped"`
-	Createdby          *string `json:"createdby"`
-	Categorycode       *string `json:"categorycode"`
-	Classname          *string `json:"classname"`
-	Data               *string `json:"data"`
-	Lastupdateby       *string `json:"lastupdateby"`
-	Disciplines        *string `json:"disciplines"`
-	Type               *string `json:"type"`
-	Sectorcode         *string `json:"sectorcode"`
-	Classcode          *string `json:"classcode"`
-	Lastname           *string `json:"lastname"`
-	Firstname          *string `json:"firstname"`
-	Gender             *string `json:"gender"`
-	Natteam            *string `json:"natteam"`
-	Nationcode         *string `json:"nationcode"`
-	Nationalcode       *string `json:"nationalcode"`
-	Skiclub            *string `json:"skiclub"`
-	Association        *string `json:"association"`
-	Status             *string `json:"status"`
-	StatusOld          *string `json:"status_old"`
-	StatusBy           *string `json:"status_by"`
-	Tragroup           *string `json:"tragroup"`
-}
-
-type UpdateCompetitorInput struct {
-	Competitorid       int32   `json:"competitorid" validate:"required"`
-	Personid           *int32  `json:"personid"`
-	Ipcid              *int32  `json:"ipcid"`
-	Type               *string `json:"type"`
-	Sectorcode         *string `json:"sectorcode"`
-	Fiscode            *int32  `json:"fiscode"`
-	Lastname           *string `json:"lastname"`
-	Firstname          *string `json:"firstname"`
-	Gender             *string `json:"gender"`
-	Birthdate          *string `json:"birthdate"`
-	Nationcode         *string `json:"nationcode"`
-	Nationalcode       *string `json:"nationalcode"`
-	Skiclub            *string `json:"skiclub"`
-	Association        *string `json:"association"`
-	Status             *string `json:"status"`
-	StatusOld          *string `json:"status_old"`
-	StatusBy           *string `json:"status_by"`
-	StatusDate         *string `json:"status_date"`
-	Statusnextlist     *string `json:"statusnextlist"`
-	Alternatenamecheck *string `json:"alternatenamecheck"`
-	Fee                *string `json:"fee"`
-	Dateofcreation     *string `json:"dateofcreation"`
-	Createdby          *string `json:"createdby"`
-	Injury             *int32  `json:"injury"`
-	Version            *int32  `json:"version"`
-	Compidmssql        *int32  `json:"compidmssql"`
-	Carving            *int32  `json:"carving"`
-	Photo              *int32  `json:"photo"`
-	Notallowed         *int32  `json:"notallowed"`
-	Natteam            *string `json:"natteam"`
-	Tragroup           *string `json:"tragroup"`
-	Published          *int32  `json:"published"`
-	Doped              *string `json:"doped"`
-	Team               *int32  `json:"team"`
-	PhotoBig           *int32  `json:"photo_big"`
-	Data               *string `json:"data"`
-	Lastupdateby       *string `json:"lastupdateby"`
-	Disciplines        *string `json:"disciplines"`
-	Lastupdate         *string `json:"lastupdate"`
-	Deletedat          *string `json:"deletedat"`
-	Categorycode       *string `json:"categorycode"`
-	Classname          *string `json:"classname"`
-	Classcode          *string `json:"classcode"`
-}
-
-func mapInsertInput(in InsertCompetitorInput) (fis.InsertCompetitorClean, error) {
+func mapInsertInput(in apitypes.FISInsertCompetitorExample) (fis.InsertCompetitorClean, error) {
 	var birth, sdate, doc, lup *time.Time
 	var err error
 
@@ -190,7 +95,7 @@ func mapInsertInput(in InsertCompetitorInput) (fis.InsertCompetitorClean, error)
 	}, nil
 }
 
-func mapUpdateInput(in UpdateCompetitorInput) (fis.UpdateCompetitorClean, error) {
+func mapUpdateInput(in apitypes.FISUpdateCompetitorExample) (fis.UpdateCompetitorClean, error) {
 	var birth, sdate, doc, lup *time.Time
 	var err error
 
@@ -267,53 +172,7 @@ func mapUpdateInput(in UpdateCompetitorInput) (fis.UpdateCompetitorClean, error)
 	}, nil
 }
 
-type FISCompetitorResponse struct {
-	Competitorid       int32   `json:"competitorid"`
-	Personid           *int32  `json:"personid"`
-	Ipcid              *int32  `json:"ipcid"`
-	Type               *string `json:"type"`
-	Sectorcode         *string `json:"sectorcode"`
-	Fiscode            *int32  `json:"fiscode"`
-	Lastname           *string `json:"lastname"`
-	Firstname          *string `json:"firstname"`
-	Gender             *string `json:"gender"`
-	Birthdate          *string `json:"birthdate"`      // YYYY-MM-DD
-	StatusDate         *string `json:"status_date"`    // RFC3339
-	Dateofcreation     *string `json:"dateofcreation"` // YYYY-MM-DD
-	Lastupdate         *string `json:"lastupdate"`     // RFC3339
-	Nationcode         *string `json:"nationcode"`
-	Nationalcode       *string `json:"nationalcode"`
-	Skiclub            *string `json:"skiclub"`
-	Association        *string `json:"association"`
-	Status             *string `json:"status"`
-	StatusOld          *string `json:"status_old"`
-	StatusBy           *string `json:"status_by"`
-	Statusnextlist     *string `json:"statusnextlist"`
-	Alternatenamecheck *string `json:"alternatenamecheck"`
-	Fee                *string `json:"fee"`
-	Createdby          *string `json:"createdby"`
-	Injury             *int32  `json:"injury"`
-	Version            *int32  `json:"version"`
-	Compidmssql        *int32  `json:"compidmssql"`
-	Carving            *int32  `json:"carving"`
-	Photo              *int32  `json:"photo"`
-	Notallowed         *int32  `json:"notallowed"`
-	Natteam            *string `json:"natteam"`
-	Tragroup           *string `json:"tragroup"`
-	Published          *int32  `json:"published"`
-	Doped              *string `json:"doped"`
-	Team               *int32  `json:"team"`
-	PhotoBig           *int32  `json:"photo_big"`
-	Data               *string `json:"data"`
-	Lastupdateby       *string `json:"lastupdateby"`
-	Disciplines        *string `json:"disciplines"`
-	Deletedat          *string `json:"deletedat"`
-	Categorycode       *string `json:"categorycode"`
-	Classname          *string `json:"classname"`
-	Classcode          *string `json:"classcode"`
-}
-
-func FISCompetitorFullFromSqlc(row fissqlc.ACompetitor) FISCompetitorResponse {
+func FISCompetitorFullFromSqlc(row fissqlc.ACompetitor) apitypes.FISCompetitorFull {
 	var (
 		birthStr  *string
 		statusStr *string
@@ -334,7 +193,7 @@ func FISCompetitorFullFromSqlc(row fissqlc.ACompetitor) FISCompetitorResponse {
 		updateStr = utils.FormatTimestampPtr(row.Lastupdate)
 	}
 
-	return FISCompetitorResponse{
+	return apitypes.FISCompetitorFull{
 		Competitorid:       row.Competitorid,
 		Personid:           utils.Int32PtrOrNil(row.Personid),
 		Ipcid:              utils.Int32PtrOrNil(row.Ipcid),
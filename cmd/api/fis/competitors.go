@@ -13,6 +13,7 @@ import (
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 type CompetitorHandler struct {
@@ -31,12 +32,12 @@ func NewCompetitorHandler(store fis.Competitors, cache *cache.Storage) *Competit
 //	@Accept		json
 //	@Produce	json
 //	@Param		sectorcode	query		string	true	"Sector code (JP, NK, CC)"
-//	@Success	200			{object}	swagger.FISAthletesResponse
-//	@Failure	400			{object}	swagger.ValidationErrorResponse
-//	@Failure	401			{object}	swagger.UnauthorizedResponse
-//	@Failure	403			{object}	swagger.ForbiddenResponse
-//	@Failure	500			{object}	swagger.InternalServerErrorResponse
-//	@Failure	503			{object}	swagger.ServiceUnavailableResponse
+//	@Success	200			{object}	apitypes.FISAthletesResponse
+//	@Failure	400			{object}	apitypes.ValidationErrorResponse
+//	@Failure	401			{object}	apitypes.UnauthorizedResponse
+//	@Failure	403			{object}	apitypes.ForbiddenResponse
+//	@Failure	500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure	503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security	BearerAuth
 //	@Router		/fis/athlete [get]
 func (h *CompetitorHandler) GetAthletesBySector(w http.ResponseWriter, r *http.Request) {
@@ -84,12 +85,12 @@ func (h *CompetitorHandler) GetAthletesBySector(w http.ResponseWriter, r *http.R
 //	@Accept		json
 //	@Produce	json
 //	@Param		sectorcode	query		string	true	"Sector code (JP, NK, CC)"
-//	@Success	200			{object}	swagger.FISNationsBySectorResponse
-//	@Failure	400			{object}	swagger.ValidationErrorResponse
-//	@Failure	401			{object}	swagger.UnauthorizedResponse
-//	@Failure	403			{object}	swagger.ForbiddenResponse
-//	@Failure	500			{object}	swagger.InternalServerErrorResponse
-//	@Failure	503			{object}	swagger.ServiceUnavailableResponse
+//	@Success	200			{object}	apitypes.FISNationsBySectorResponse
+//	@Failure	400			{object}	apitypes.ValidationErrorResponse
+//	@Failure	401			{object}	apitypes.UnauthorizedResponse
+//	@Failure	403			{object}	apitypes.ForbiddenResponse
+//	@Failure	500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure	503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security	BearerAuth
 //	@Router		/fis/nation [get]
 func (h *CompetitorHandler) GetNationsBySector(w http.ResponseWriter, r *http.Request) {
@@ -124,7 +125,7 @@ func (h *CompetitorHandler) GetNationsBySector(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	body := NationsBySectorResponse{
+	body := apitypes.FISNationsBySectorResponse{
 		Nations: nations,
 	}
 
@@ -139,12 +140,12 @@ func (h *CompetitorHandler) GetNationsBySector(w http.ResponseWriter, r *http.Re
 //	@Tags			FIS - Competitor Management
 //	@Accept			json
 //	@Produce		json
-//	@Success		200	{object}	swagger.FISLastCompetitorResponse
-//	@Failure		401	{object}	swagger.UnauthorizedResponse
-//	@Failure		403	{object}	swagger.ForbiddenResponse
-//	@Failure		404	{object}	swagger.NotFoundResponse
-//	@Failure		500	{object}	swagger.InternalServerErrorResponse
-//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Success		200	{object}	apitypes.FISLastCompetitorResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		403	{object}	apitypes.ForbiddenResponse
+//	@Failure		404	{object}	apitypes.NotFoundResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/lastrow/competitor [get]
 func (h *CompetitorHandler) GetLastRowCompetitor(w http.ResponseWriter, r *http.Request) {
@@ -170,8 +171,7 @@ func (h *CompetitorHandler) GetLastRowCompetitor(w http.ResponseWriter, r *http.
 		return
 	}
 
-	resp := FISCompetitorFullFromSqlc(row)
-	body := map[string]any{"competitor": resp}
+	body := apitypes.FISLastCompetitorResponse{Competitor: FISCompetitorFullFromSqlc(row)}
 
 	cache.SetCacheJSON(r.Context(), h.cache, fisLastRowPrefix, body, FISCacheTTL)
 	utils.WriteJSON(w, http.StatusOK, body)
@@ -184,14 +184,14 @@ func (h *CompetitorHandler) GetLastRowCompetitor(w http.ResponseWriter, r *http.
 //	@Tags			FIS - Competitor Management
 //	@Accept			json
 //	@Produce		json
-//	@Param			competitor	body	swagger.FISInsertCompetitorExample	true	"Competitor payload"
+//	@Param			competitor	body	apitypes.FISInsertCompetitorExample	true	"Competitor payload"
 //	@Success		201			"Created"
-//	@Failure		400			{object}	swagger.ValidationErrorResponse
-//	@Failure		401			{object}	swagger.UnauthorizedResponse
-//	@Failure		403			{object}	swagger.ForbiddenResponse
-//	@Failure		409			{object}	swagger.ConflictResponse
-//	@Failure		500			{object}	swagger.InternalServerErrorResponse
-//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400			{object}	apitypes.ValidationErrorResponse
+//	@Failure		401			{object}	apitypes.UnauthorizedResponse
+//	@Failure		403			{object}	apitypes.ForbiddenResponse
+//	@Failure		409			{object}	apitypes.ConflictResponse
+//	@Failure		500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/competitor [post]
 func (h *CompetitorHandler) InsertCompetitor(w http.ResponseWriter, r *http.Request) {
@@ -200,7 +200,7 @@ func (h *CompetitorHandler) InsertCompetitor(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	var in InsertCompetitorInput
+	var in apitypes.FISInsertCompetitorExample
 	if err := utils.ReadJSON(w, r, &in); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -236,14 +236,14 @@ func (h *CompetitorHandler) InsertCompetitor(w http.ResponseWriter, r *http.Requ
 //	@Tags			FIS - Competitor Management
 //	@Accept			json
 //	@Produce		json
-//	@Param			competitor	body	swagger.FISUpdateCompetitorExample	true	"Competitor payload"
+//	@Param			competitor	body	apitypes.FISUpdateCompetitorExample	true	"Competitor payload"
 //	@Success		200			"Updated"
-//	@Failure		400			{object}	swagger.ValidationErrorResponse
-//	@Failure		401			{object}	swagger.UnauthorizedResponse
-//	@Failure		403			{object}	swagger.ForbiddenResponse
-//	@Failure		404			{object}	swagger.NotFoundResponse
-//	@Failure		500			{object}	swagger.InternalServerErrorResponse
-//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400			{object}	apitypes.ValidationErrorResponse
+//	@Failure		401			{object}	apitypes.UnauthorizedResponse
+//	@Failure		403			{object}	apitypes.ForbiddenResponse
+//	@Failure		404			{object}	apitypes.NotFoundResponse
+//	@Failure		500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/competitor [put]
 func (h *CompetitorHandler) UpdateCompetitor(w http.ResponseWriter, r *http.Request) {
@@ -252,7 +252,7 @@ func (h *CompetitorHandler) UpdateCompetitor(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	var in UpdateCompetitorInput
+	var in apitypes.FISUpdateCompetitorExample
 	if err := utils.ReadJSON(w, r, &in); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -292,12 +292,12 @@ func (h *CompetitorHandler) UpdateCompetitor(w http.ResponseWriter, r *http.Requ
 //	@Produce		json
 //	@Param			id	query	integer	true	"Competitor ID"
 //	@Success		200	"Deleted"
-//	@Failure		400	{object}	swagger.ValidationErrorResponse
-//	@Failure		401	{object}	swagger.UnauthorizedResponse
-//	@Failure		403	{object}	swagger.ForbiddenResponse
-//	@Failure		404	{object}	swagger.NotFoundResponse
-//	@Failure		500	{object}	swagger.InternalServerErrorResponse
-//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400	{object}	apitypes.ValidationErrorResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		403	{object}	apitypes.ForbiddenResponse
+//	@Failure		404	{object}	apitypes.NotFoundResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/competitor [delete]
 func (h *CompetitorHandler) DeleteCompetitor(w http.ResponseWriter, r *http.Request) {
@@ -352,12 +352,14 @@ func (h *CompetitorHandler) DeleteCompetitor(w http.ResponseWriter, r *http.Requ
 //	@Param			gender		query		string	false	"Gender filter (M/W)"
 //	@Param			agemin		query		int		false	"Minimum age in years (inclusive). For example, agemin=18 means competitors who are at least 18."
 //	@Param			agemax		query		int		false	"Maximum age in years (inclusive). For example, agemax=30 means competitors who are at most 30."
-//	@Success		200			{object}	swagger.FISCompetitorSearchResponse
-//	@Failure		400			{object}	swagger.ValidationErrorResponse
-//	@Failure		401			{object}	swagger.UnauthorizedResponse
-//	@Failure		403			{object}	swagger.ForbiddenResponse
-//	@Failure		500			{object}	swagger.InternalServerErrorResponse
-//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Param			limit		query		int		false	"Page size (1-1000); enables cursor pagination"
+//	@Param			cursor		query		string	false	"Opaque cursor from next_cursor of the previous page"
+//	@Success		200			{object}	apitypes.FISCompetitorSearchResponse
+//	@Failure		400			{object}	apitypes.ValidationErrorResponse
+//	@Failure		401			{object}	apitypes.UnauthorizedResponse
+//	@Failure		403			{object}	apitypes.ForbiddenResponse
+//	@Failure		500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/competitor/search [get]
 func (h *CompetitorHandler) SearchCompetitors(w http.ResponseWriter, r *http.Request) {
@@ -372,11 +374,19 @@ func (h *CompetitorHandler) SearchCompetitors(w http.ResponseWriter, r *http.Req
 		"gender",
 		"agemin",
 		"agemax",
+		"limit",
+		"cursor",
 	}); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
 
+	page, err := utils.ParsePage(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
 	q := r.URL.Query()
 
 	var nationPtr *string
@@ -436,13 +446,14 @@ func (h *CompetitorHandler) SearchCompetitors(w http.ResponseWriter, r *http.Req
 		}
 	}
 
-	rows, err := h.store.SearchCompetitors(
+	rows, next, err := h.store.SearchCompetitors(
 		r.Context(),
 		nationPtr,
 		sectorPtr,
 		genderPtr,
 		birthMinPtr,
 		birthMaxPtr,
+		page,
 	)
 	if err != nil {
 		utils.InternalServerError(w, r, err)
@@ -457,16 +468,12 @@ func (h *CompetitorHandler) SearchCompetitors(w http.ResponseWriter, r *http.Req
 	body := map[string]any{
 		"competitors": competitors,
 	}
+	utils.AddPageInfo(body, page, next)
 
+	utils.SetNextLink(w, r, next)
 	utils.WriteJSON(w, http.StatusOK, body)
 }
 
-// used only in this handler's response
-type competitorNationCountItem struct {
-	Nationcode  string `json:"nationcode" example:"FIN"`
-	Competitors int64  `json:"competitors" example:"123"`
-}
-
 // GetCompetitorCountsByNation godoc
 //
 //	@Summary		Get competitor counts by nation
@@ -479,12 +486,12 @@ type competitorNationCountItem struct {
 //	@Param			gender		query		string	false	"Gender filter (M/W)"
 //	@Param			agemin		query		int		false	"Minimum age in years"
 //	@Param			agemax		query		int		false	"Maximum age in years"
-//	@Success		200			{object}	swagger.FISCompetitorNationCountsResponse
-//	@Failure		400			{object}	swagger.ValidationErrorResponse
-//	@Failure		401			{object}	swagger.UnauthorizedResponse
-//	@Failure		403			{object}	swagger.ForbiddenResponse
-//	@Failure		500			{object}	swagger.InternalServerErrorResponse
-//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Success		200			{object}	apitypes.FISCompetitorNationCountsResponse
+//	@Failure		400			{object}	apitypes.ValidationErrorResponse
+//	@Failure		401			{object}	apitypes.UnauthorizedResponse
+//	@Failure		403			{object}	apitypes.ForbiddenResponse
+//	@Failure		500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/competitor/count-by-nation [get]
 func (h *CompetitorHandler) GetCompetitorCountsByNation(w http.ResponseWriter, r *http.Request) {
@@ -569,12 +576,12 @@ func (h *CompetitorHandler) GetCompetitorCountsByNation(w http.ResponseWriter, r
 		return
 	}
 
-	items := make([]competitorNationCountItem, 0, len(rows))
+	items := make([]apitypes.FISCompetitorNationCountItem, 0, len(rows))
 	for _, row := range rows {
 		if !row.Nationcode.Valid || strings.TrimSpace(row.Nationcode.String) == "" {
 			continue
 		}
-		items = append(items, competitorNationCountItem{
+		items = append(items, apitypes.FISCompetitorNationCountItem{
 			Nationcode:  strings.TrimSpace(row.Nationcode.String),
 			Competitors: row.Competitors,
 		})
@@ -599,12 +606,12 @@ func (h *CompetitorHandler) GetCompetitorCountsByNation(w http.ResponseWriter, r
 //	@Accept			json
 //	@Produce		json
 //	@Param			fiscode	query		int32	true	"FIS code"
-//	@Success		200		{object}	swagger.FISSectorcodeByFiscodeResponse
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Success		200		{object}	apitypes.FISSectorcodeByFiscodeResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/competitor/sectorcode [get]
 func (h *CompetitorHandler) GetSectorcodeByFiscode(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,110 @@
+package fisapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/export"
+)
+
+// Sparse fieldsets for the wide race and result rows: ?fields=raceid,racedate,place
+// or one of the presets "summary" and "full". The key column is always returned.
+
+const (
+	fieldsPresetSummary = "summary"
+	fieldsPresetFull    = "full"
+)
+
+type fieldSet struct {
+	key     string
+	columns []string
+	summary []string
+}
+
+var raceSummaryFields = []string{
+	"raceid", "racedate", "seasoncode", "disciplinecode", "catcode",
+	"gender", "place", "nationcode", "description",
+}
+
+var (
+	raceCCFields = fieldSet{key: "raceid", columns: fissqlc.ARaceccColumns, summary: raceSummaryFields}
+	raceJPFields = fieldSet{key: "raceid", columns: fissqlc.ARacejpColumns, summary: raceSummaryFields}
+	raceNKFields = fieldSet{key: "raceid", columns: fissqlc.ARacenkColumns, summary: raceSummaryFields}
+
+	resultCCFields = fieldSet{key: "recid", columns: fissqlc.AResultccColumns, summary: []string{
+		"recid", "raceid", "competitorid", "fiscode", "competitorname", "nationcode",
+		"position", "timetot", "racepoints", "cuppoints",
+	}}
+	resultJPFields = fieldSet{key: "recid", columns: fissqlc.AResultjpColumns, summary: []string{
+		"recid", "raceid", "competitorid", "fiscode", "competitorname", "nationcode",
+		"position", "distr1", "distr2", "tot", "racepoints", "cuppoints",
+	}}
+	resultNKFields = fieldSet{key: "recid", columns: fissqlc.AResultnkColumns, summary: []string{
+		"recid", "raceid", "competitorid", "fiscode", "competitorname", "nationcode",
+		"position", "pointsjump", "timetot", "racepoints", "cuppoints",
+	}}
+)
+
+// parseFields reads the fields parameter. A nil result means all columns.
+func parseFields(r *http.Request, fs fieldSet) ([]string, error) {
+	raw := parseListParam(r, "fields")
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	if len(raw) == 1 {
+		switch strings.ToLower(strings.TrimSpace(raw[0])) {
+		case fieldsPresetFull:
+			return nil, nil
+		case fieldsPresetSummary:
+			return fs.summary, nil
+		}
+	}
+
+	known := make(map[string]bool, len(fs.columns))
+	for _, c := range fs.columns {
+		known[c] = true
+	}
+
+	out := []string{fs.key}
+	seen := map[string]bool{fs.key: true}
+	for _, f := range raw {
+		f = strings.ToLower(strings.TrimSpace(f))
+		if f == "" || seen[f] {
+			continue
+		}
+		if !known[f] {
+			return nil, fmt.Errorf("invalid fields: unknown field %q", f)
+		}
+		seen[f] = true
+		out = append(out, f)
+	}
+	return out, nil
+}
+
+func fieldsCacheKey(fields []string) string {
+	if len(fields) == 0 {
+		return ""
+	}
+	return ":fields=" + strings.Join(fields, ",")
+}
+
+// projectFields drops every JSON key of the rows that is not in fields
+func projectFields[T any](rows []T, fields []string) (any, error) {
+	if len(fields) == 0 {
+		return rows, nil
+	}
+
+	out := make([]map[string]json.RawMessage, 0, len(rows))
+	for _, row := range rows {
+		m, err := export.ProjectJSON(row, fields)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, m)
+	}
+	return out, nil
+}
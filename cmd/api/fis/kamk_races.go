@@ -10,20 +10,9 @@ import (
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
-type RaceSearchItem struct {
-	Sectorcode     string  `json:"sectorcode" example:"CC"`
-	Gender         *string `json:"gender,omitempty" example:"M"`
-	Raceid         int32   `json:"raceid" example:"123456"`
-	Racedate       *string `json:"racedate,omitempty" example:"2025-02-15"` // YYYY-MM-DD
-	Catcode        *string `json:"catcode,omitempty" example:"WC"`
-	Description    *string `json:"description,omitempty" example:"World Cup Sprint"`
-	Place          *string `json:"place,omitempty" example:"Lahti"`
-	Nationcode     *string `json:"nationcode,omitempty" example:"FIN"`
-	Disciplinecode *string `json:"disciplinecode,omitempty" example:"DSPR"`
-}
-
 type RaceSearchHandler struct {
 	raceCC fis.Racecc
 	raceJP fis.Racejp
@@ -57,12 +46,12 @@ func NewRaceSearchHandler(
 //	@Param			nationcode	query		string		false	"Nation code (e.g. FIN)"
 //	@Param			gender		query		string		false	"Gender (M/W)"
 //	@Param			catcode		query		string		false	"Category code (e.g. WC)"
-//	@Success		200			{object}	swagger.FISRacesSearchResponse
-//	@Failure		400			{object}	swagger.ValidationErrorResponse
-//	@Failure		401			{object}	swagger.UnauthorizedResponse
-//	@Failure		403			{object}	swagger.ForbiddenResponse
-//	@Failure		500			{object}	swagger.InternalServerErrorResponse
-//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Success		200			{object}	apitypes.FISRacesSearchResponse
+//	@Failure		400			{object}	apitypes.ValidationErrorResponse
+//	@Failure		401			{object}	apitypes.UnauthorizedResponse
+//	@Failure		403			{object}	apitypes.ForbiddenResponse
+//	@Failure		500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/races/search [get]
 func (h *RaceSearchHandler) SearchRaces(w http.ResponseWriter, r *http.Request) {
@@ -145,7 +134,7 @@ func (h *RaceSearchHandler) SearchRaces(w http.ResponseWriter, r *http.Request)
 		catPtr = &cv
 	}
 
-	var results []RaceSearchItem
+	var results []apitypes.FISRacesSearchItem
 
 	for _, sector := range sectors {
 		switch sector {
@@ -157,7 +146,7 @@ func (h *RaceSearchHandler) SearchRaces(w http.ResponseWriter, r *http.Request)
 					return
 				}
 				for _, row := range rows {
-					results = append(results, RaceSearchItem{
+					results = append(results, apitypes.FISRacesSearchItem{
 						Sectorcode:     row.Sectorcode, // "CC"
 						Gender:         utils.StringPtrOrNil(row.Gender),
 						Raceid:         row.Raceid,
@@ -179,7 +168,7 @@ func (h *RaceSearchHandler) SearchRaces(w http.ResponseWriter, r *http.Request)
 					return
 				}
 				for _, row := range rows {
-					results = append(results, RaceSearchItem{
+					results = append(results, apitypes.FISRacesSearchItem{
 						Sectorcode:     row.Sectorcode, // "JP"
 						Gender:         utils.StringPtrOrNil(row.Gender),
 						Raceid:         row.Raceid,
@@ -201,7 +190,7 @@ func (h *RaceSearchHandler) SearchRaces(w http.ResponseWriter, r *http.Request)
 					return
 				}
 				for _, row := range rows {
-					results = append(results, RaceSearchItem{
+					results = append(results, apitypes.FISRacesSearchItem{
 						Sectorcode:     row.Sectorcode, // "NK"
 						Gender:         utils.StringPtrOrNil(row.Gender),
 						Raceid:         row.Raceid,
@@ -233,12 +222,12 @@ func (h *RaceSearchHandler) SearchRaces(w http.ResponseWriter, r *http.Request)
 //	@Produce		json
 //	@Param			sector	query		string	true	"Sector code (CC,JP,NK)"
 //	@Param			raceid	query		[]int32	true	"Race ID(s) – repeat or comma-separated (e.g. raceid=123&raceid=456 or raceid=123,456)"
-//	@Success		200		{object}	swagger.FISRacesByIDsResponse
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Success		200		{object}	apitypes.FISRacesByIDsResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/races/by-ids [get]
 func (h *RaceSearchHandler) GetRacesByIDs(w http.ResponseWriter, r *http.Request) {
@@ -364,12 +353,12 @@ func (h *RaceSearchHandler) GetRacesByIDs(w http.ResponseWriter, r *http.Request
 //	@Param			sector		query		[]string	true	"Sector code(s) (CC,JP,NK – repeat or comma-separated, e.g. sector=CC&sector=JP or sector=CC,JP)"
 //	@Param			nationcode	query		string		false	"Nation code filter (e.g. FIN)"
 //	@Param			gender		query		string		false	"Gender filter (e.g. M/W)"
-//	@Success		200			{object}	swagger.FISRacesCategoryCountsResponse
-//	@Failure		400			{object}	swagger.ValidationErrorResponse
-//	@Failure		401			{object}	swagger.UnauthorizedResponse
-//	@Failure		403			{object}	swagger.ForbiddenResponse
-//	@Failure		500			{object}	swagger.InternalServerErrorResponse
-//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Success		200			{object}	apitypes.FISRacesCategoryCountsResponse
+//	@Failure		400			{object}	apitypes.ValidationErrorResponse
+//	@Failure		401			{object}	apitypes.UnauthorizedResponse
+//	@Failure		403			{object}	apitypes.ForbiddenResponse
+//	@Failure		500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/races/count-by-category [get]
 func (h *RaceSearchHandler) GetRaceCategoryCounts(w http.ResponseWriter, r *http.Request) {
@@ -565,11 +554,6 @@ func (h *RaceSearchHandler) GetRaceCategoryCounts(w http.ResponseWriter, r *http
 	utils.WriteJSON(w, http.StatusOK, body)
 }
 
-type RaceNationCountItem struct {
-	Nationcode string `json:"nationcode" example:"FIN"`
-	Total      int64  `json:"total" example:"42"`
-}
-
 // GetRaceCountsByNation godoc
 //
 //	@Summary		Get race counts by nation
@@ -581,12 +565,12 @@ type RaceNationCountItem struct {
 //	@Param			seasoncode	query		int32		true	"Season code"
 //	@Param			gender		query		string		false	"Gender (M/W)"
 //	@Param			catcode		query		string		false	"Category code (e.g. WC)"
-//	@Success		200			{object}	swagger.FISRacesNationCountsResponse
-//	@Failure		400			{object}	swagger.ValidationErrorResponse
-//	@Failure		401			{object}	swagger.UnauthorizedResponse
-//	@Failure		403			{object}	swagger.ForbiddenResponse
-//	@Failure		500			{object}	swagger.InternalServerErrorResponse
-//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Success		200			{object}	apitypes.FISRacesNationCountsResponse
+//	@Failure		400			{object}	apitypes.ValidationErrorResponse
+//	@Failure		401			{object}	apitypes.UnauthorizedResponse
+//	@Failure		403			{object}	apitypes.ForbiddenResponse
+//	@Failure		500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/races/count-by-nation [get]
 func (h *RaceSearchHandler) GetRaceCountsByNation(w http.ResponseWriter, r *http.Request) {
@@ -722,9 +706,9 @@ func (h *RaceSearchHandler) GetRaceCountsByNation(w http.ResponseWriter, r *http
 		}
 	}
 
-	items := make([]RaceNationCountItem, 0, len(counts))
+	items := make([]apitypes.FISRacesNationCountItem, 0, len(counts))
 	for code, total := range counts {
-		items = append(items, RaceNationCountItem{
+		items = append(items, apitypes.FISRacesNationCountItem{
 			Nationcode: code,
 			Total:      total,
 		})
@@ -759,12 +743,12 @@ func (h *RaceSearchHandler) GetRaceCountsByNation(w http.ResponseWriter, r *http
 //	@Param			sector		query		[]string	true	"Sector code(s) (CC,JP,NK – repeat or comma-separated, e.g. sector=CC&sector=JP or sector=CC,JP)"
 //	@Param			catcode		query		string		false	"Category code filter (e.g. WC)"
 //	@Param			gender		query		string		false	"Gender filter (e.g. M/W)"
-//	@Success		200			{object}	swagger.FISRacesTotalsResponse
-//	@Failure		400			{object}	swagger.ValidationErrorResponse
-//	@Failure		401			{object}	swagger.UnauthorizedResponse
-//	@Failure		403			{object}	swagger.ForbiddenResponse
-//	@Failure		500			{object}	swagger.InternalServerErrorResponse
-//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Success		200			{object}	apitypes.FISRacesTotalsResponse
+//	@Failure		400			{object}	apitypes.ValidationErrorResponse
+//	@Failure		401			{object}	apitypes.UnauthorizedResponse
+//	@Failure		403			{object}	apitypes.ForbiddenResponse
+//	@Failure		500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/races/count-total [get]
 func (h *RaceSearchHandler) GetRaceTotals(w http.ResponseWriter, r *http.Request) {
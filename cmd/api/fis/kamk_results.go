@@ -1,6 +1,7 @@
 package fisapi
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 // Handles competitor/result-related KAMK endpoints.
@@ -42,12 +44,12 @@ func NewResultKAMKHandler(
 //	@Produce		json
 //	@Param			fiscode	query		int32	true	"FIS Code"
 //	@Param			sector	query		string	true	"Sector code (CC,JP,NK)"
-//	@Success		200		{object}	swagger.FISCompetitorSeasonsCatcodesResponse
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Success		200		{object}	apitypes.FISCompetitorSeasonsCatcodesResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/competitor/seasons-catcodes [get]
 func (h *ResultKAMKHandler) GetCompetitorSeasonsCatcodes(w http.ResponseWriter, r *http.Request) {
@@ -191,12 +193,12 @@ func (h *ResultKAMKHandler) GetCompetitorSeasonsCatcodes(w http.ResponseWriter,
 //	@Param			seasoncode	query		int32		false	"Season code filter"
 //	@Param			catcode		query		[]string	false	"Category code(s) – repeat or comma-separated (e.g. catcode=WC&catcode=COC or catcode=WC,COC)"
 //	@Param			limit		query		int32		false	"Maximum number of results to return (default 50)"
-//	@Success		200			{object}	swagger.FISLatestResultsResponse
-//	@Failure		400			{object}	swagger.ValidationErrorResponse
-//	@Failure		401			{object}	swagger.UnauthorizedResponse
-//	@Failure		403			{object}	swagger.ForbiddenResponse
-//	@Failure		500			{object}	swagger.InternalServerErrorResponse
-//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Success		200			{object}	apitypes.FISLatestResultsResponse
+//	@Failure		400			{object}	apitypes.ValidationErrorResponse
+//	@Failure		401			{object}	apitypes.UnauthorizedResponse
+//	@Failure		403			{object}	apitypes.ForbiddenResponse
+//	@Failure		500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/competitor/latest-results [get]
 func (h *ResultKAMKHandler) GetCompetitorLatestResults(w http.ResponseWriter, r *http.Request) {
@@ -279,42 +281,37 @@ func (h *ResultKAMKHandler) GetCompetitorLatestResults(w http.ResponseWriter, r
 		}
 	}
 
-	type latestResultItem struct {
-		Raceid         *int32  `json:"raceid,omitempty"`
-		Racedate       *string `json:"racedate,omitempty"`
-		Seasoncode     *int32  `json:"seasoncode,omitempty"`
-		Disciplinecode *string `json:"disciplinecode,omitempty"`
-		Catcode        *string `json:"catcode,omitempty"`
-		Place          *string `json:"place,omitempty"`
-		Nationcode     *string `json:"nationcode,omitempty"`
-		Position       *string `json:"position,omitempty"`
-		Timetot        *string `json:"timetot,omitempty"`
-		Distance       *string `json:"distance,omitempty"`
-		Hill           *int32  `json:"hill,omitempty"`
-		Posr1          *string `json:"posr1,omitempty"`
-		Speedr1        *string `json:"speedr1,omitempty"`
-		Distr1         *string `json:"distr1,omitempty"`
-		Judptsr1       *string `json:"judptsr1,omitempty"`
-		Windr1         *string `json:"windr1,omitempty"`
-		Windptsr1      *string `json:"windptsr1,omitempty"`
-		Gater1         *string `json:"gater1,omitempty"`
-		Poscc          *string `json:"poscc,omitempty"`
-		Timetotint     *int32  `json:"timetotint,omitempty"`
-		Pointsjump     *string `json:"pointsjump,omitempty"`
+	results, err := h.LatestResults(r.Context(), sector, fiscode, seasonPtr, catcodes, limit)
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	body := map[string]any{
+		"fiscode":    fiscode,
+		"sector":     sector,
+		"seasoncode": seasonPtr,
+		"catcodes":   catcodes,
+		"limit":      limit,
+		"results":    results,
 	}
 
-	results := make([]latestResultItem, 0)
+	utils.WriteJSON(w, http.StatusOK, body)
+}
+
+// LatestResults returns the competitor's latest results in one sector (CC, JP
+// or NK), newest first
+func (h *ResultKAMKHandler) LatestResults(ctx context.Context, sector string, fiscode int32, seasonPtr *int32, catcodes []string, limit int32) ([]apitypes.FISLatestResultItem, error) {
+	results := make([]apitypes.FISLatestResultItem, 0)
 
 	switch sector {
 	case "CC":
 		if h.resultCC == nil {
-			utils.InternalServerError(w, r, fmt.Errorf("resultCC store not configured"))
-			return
+			return nil, fmt.Errorf("resultCC store not configured")
 		}
-		rows, err := h.resultCC.GetLatestResultsCC(r.Context(), fiscode, seasonPtr, catcodes, &limit)
+		rows, err := h.resultCC.GetLatestResultsCC(ctx, fiscode, seasonPtr, catcodes, &limit)
 		if err != nil {
-			utils.InternalServerError(w, r, err)
-			return
+			return nil, err
 		}
 
 		for _, row := range rows {
@@ -332,7 +329,7 @@ func (h *ResultKAMKHandler) GetCompetitorLatestResults(w http.ResponseWriter, r
 				seasonOut = &v
 			}
 
-			item := latestResultItem{
+			item := apitypes.FISLatestResultItem{
 				Raceid:         raceIDPtr,
 				Racedate:       utils.FormatDatePtr(row.Racedate),
 				Seasoncode:     seasonOut,
@@ -365,13 +362,11 @@ func (h *ResultKAMKHandler) GetCompetitorLatestResults(w http.ResponseWriter, r
 
 	case "JP":
 		if h.resultJP == nil {
-			utils.InternalServerError(w, r, fmt.Errorf("resultJP store not configured"))
-			return
+			return nil, fmt.Errorf("resultJP store not configured")
 		}
-		rows, err := h.resultJP.GetLatestResultsJP(r.Context(), fiscode, seasonPtr, catcodes, &limit)
+		rows, err := h.resultJP.GetLatestResultsJP(ctx, fiscode, seasonPtr, catcodes, &limit)
 		if err != nil {
-			utils.InternalServerError(w, r, err)
-			return
+			return nil, err
 		}
 
 		for _, row := range rows {
@@ -393,7 +388,7 @@ func (h *ResultKAMKHandler) GetCompetitorLatestResults(w http.ResponseWriter, r
 				posPtr = &p
 			}
 
-			item := latestResultItem{
+			item := apitypes.FISLatestResultItem{
 				Raceid:         raceIDPtr,
 				Racedate:       utils.FormatDatePtr(row.Racedate),
 				Seasoncode:     seasonOut,
@@ -417,13 +412,11 @@ func (h *ResultKAMKHandler) GetCompetitorLatestResults(w http.ResponseWriter, r
 
 	case "NK":
 		if h.resultNK == nil {
-			utils.InternalServerError(w, r, fmt.Errorf("resultNK store not configured"))
-			return
+			return nil, fmt.Errorf("resultNK store not configured")
 		}
-		rows, err := h.resultNK.GetLatestResultsNK(r.Context(), fiscode, seasonPtr, catcodes, &limit)
+		rows, err := h.resultNK.GetLatestResultsNK(ctx, fiscode, seasonPtr, catcodes, &limit)
 		if err != nil {
-			utils.InternalServerError(w, r, err)
-			return
+			return nil, err
 		}
 
 		for _, row := range rows {
@@ -457,7 +450,7 @@ func (h *ResultKAMKHandler) GetCompetitorLatestResults(w http.ResponseWriter, r
 				timetotintPtr = &v
 			}
 
-			item := latestResultItem{
+			item := apitypes.FISLatestResultItem{
 				Raceid:         raceIDPtr,
 				Racedate:       utils.FormatDatePtr(row.Racedate),
 				Seasoncode:     seasonOut,
@@ -492,16 +485,10 @@ func (h *ResultKAMKHandler) GetCompetitorLatestResults(w http.ResponseWriter, r
 
 			results = append(results, item)
 		}
-	}
 
-	body := map[string]any{
-		"fiscode":    fiscode,
-		"sector":     sector,
-		"seasoncode": seasonPtr,
-		"catcodes":   catcodes,
-		"limit":      limit,
-		"results":    results,
+	default:
+		return nil, fmt.Errorf("invalid sector: %s", sector)
 	}
 
-	utils.WriteJSON(w, http.StatusOK, body)
+	return results, nil
 }
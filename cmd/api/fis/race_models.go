@@ -6,6 +6,7 @@ import (
 	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 type RacesCCQuery struct {
@@ -14,100 +15,7 @@ type RacesCCQuery struct {
 	CatCode        []string `form:"catcode"`
 }
 
-type InsertRaceCCInput struct {
-	Raceid            int32   `json:"raceid" validate:"required"`
-	Eventid           *int32  `json:"eventid"`
-	Seasoncode        *int32  `json:"seasoncode"`
-	Racecodex         *int32  `json:"racecodex"`
-	Disciplineid      *string `json:"disciplineid"`
-	Disciplinecode    *string `json:"disciplinecode"`
-	Catcode           *string `json:"catcode"`
-	Catcode2          *string `json:"catcode2"`
-	Catcode3          *string `json:"catcode3"`
-	Catcode4          *string `json:"catcode4"`
-	Gender            *string `json:"gender"`
-	Racedate          *string `json:"racedate"`       // YYYY-MM-DD
-	Starteventdate    *string `json:"starteventdate"` // YYYY-MM-DD
-	Description       *string `json:"description"`
-	Place             *string `json:"place"`
-	Nationcode        *string `json:"nationcode"`
-	Receiveddate      *string `json:"receiveddate"` // RFC3339 or date
-	Validdate         *string `json:"validdate"`    // RFC3339 or date
-	Td1id             *int32  `json:"td1id"`
-	Td1name           *string `json:"td1name"`
-	Td1nation         *string `json:"td1nation"`
-	Td1code           *int32  `json:"td1code"`
-	Td2id             *int32  `json:"td2id"`
-	Td2name           *string `json:"td2name"`
-	Td2nation         *string `json:"td2nation"`
-	Td2code           *int32  `json:"td2code"`
-	Calstatuscode     *string `json:"calstatuscode"`
-	Procstatuscode    *string `json:"procstatuscode"`
-	Displaystatus     *string `json:"displaystatus"`
-	Fisinterncomment  *string `json:"fisinterncomment"`
-	Webcomment        *string `json:"webcomment"`
-	Pursuit           *string `json:"pursuit"`
-	Masse             *string `json:"masse"`
-	Relay             *string `json:"relay"`
-	Distance          *string `json:"distance"`
-	Hill              *string `json:"hill"`
-	Style             *string `json:"style"`
-	Qualif            *string `json:"qualif"`
-	Finale            *string `json:"finale"`
-	Homol             *string `json:"homol"`
-	Published         *int32  `json:"published"`
-	Validforfispoints *int32  `json:"validforfispoints"`
-	Usedfislist       *string `json:"usedfislist"`
-	Tolist            *string `json:"tolist"`
-	Discforlistcode   *string `json:"discforlistcode"`
-	Calculatedpenalty *string `json:"calculatedpenalty"`
-	Appliedpenalty    *string `json:"appliedpenalty"`
-	Appliedscala      *string `json:"appliedscala"`
-	Penscafixed       *string `json:"penscafixed"`
-	Version           *int32  `json:"version"`
-	Nationraceid      *int32  `json:"nationraceid"`
-	Provraceid        *int32  `json:"provraceid"`
-	Msql7evid         *int32  `json:"msql7evid"`
-	Mssql7id          *int32  `json:"mssql7id"`
-	Topbanner         *string `json:"topbanner"`
-	Bottombanner      *string `json:"bottombanner"`
-	Toplogo           *string `json:"toplogo"`
-	Bottomlogo        *string `json:"bottomlogo"`
-	Gallery           *string `json:"gallery"`
-	Indi              *int32  `json:"indi"`
-	Team              *int32  `json:"team"`
-	Tabcount          *int32  `json:"tabcount"`
-	Columncount       *int32  `json:"columncount"`
-	Level             *string `json:"level"`
-	Hloc1             *string `json:"hloc1"`
-	Hloc2             *string `json:"hloc2"`
-	Hloc3             *string `json:"hloc3"`
-	Hcet1             *string `json:"hcet1"`
-	Hcet2             *string `json:"hcet2"`
-	Hcet3             *string `json:"hcet3"`
-	Live              *int32  `json:"live"`
-	Livestatus1       *string `json:"livestatus1"`
-	Livestatus2       *string `json:"livestatus2"`
-	Livestatus3       *string `json:"livestatus3"`
-	Liveinfo1         *string `json:"liveinfo1"`
-	Liveinfo2         *string `json:"liveinfo2"`
-	Liveinfo3         *string `json:"liveinfo3"`
-	Passwd            *string `json:"passwd"`
-	Timinglogo        *string `json:"timinglogo"`
-	Results           *int32  `json:"results"`
-	Pdf               *int32  `json:"pdf"`
-	Noepr             *int32  `json:"noepr"`
-	Tddoc             *int32  `json:"tddoc"`
-	Timingreport      *int32  `json:"timingreport"`
-	SpecialCupPoints  *int32  `json:"special_cup_points"`
-	SkipWcsl          *int32  `json:"skip_wcsl"`
-	Validforowg       *int32  `json:"validforowg"`
-	Lastupdate        *string `json:"lastupdate"` // RFC3339
-}
-
-type UpdateRaceCCInput = InsertRaceCCInput
-
-func mapInsertRaceCCInput(in InsertRaceCCInput) (fis.InsertRaceCCClean, error) {
+func mapInsertRaceCCInput(in apitypes.FISInsertRaceCCExample) (fis.InsertRaceCCClean, error) {
 	var rd, sed, recv, vald, lup *time.Time
 	var err error
 	if in.Racedate != nil {
@@ -232,104 +140,13 @@ func mapInsertRaceCCInput(in InsertRaceCCInput) (fis.InsertRaceCCClean, error) {
 	}, nil
 }
 
-func mapUpdateRaceCCInput(in UpdateRaceCCInput) (fis.UpdateRaceCCClean, error) {
+func mapUpdateRaceCCInput(in apitypes.FISUpdateRaceCCExample) (fis.UpdateRaceCCClean, error) {
 	// same parser as insert
-	clean, err := mapInsertRaceCCInput(InsertRaceCCInput(in))
+	clean, err := mapInsertRaceCCInput(apitypes.FISInsertRaceCCExample(in))
 	return fis.UpdateRaceCCClean(clean), err
 }
 
-type FISRaceCCFullResponse struct {
-	Raceid            int32   `json:"raceid"`
-	Eventid           *int32  `json:"eventid"`
-	Seasoncode        *int32  `json:"seasoncode"`
-	Racecodex         *int32  `json:"racecodex"`
-	Disciplineid      *string `json:"disciplineid"`
-	Disciplinecode    *string `json:"disciplinecode"`
-	Catcode           *string `json:"catcode"`
-	Catcode2          *string `json:"catcode2"`
-	Catcode3          *string `json:"catcode3"`
-	Catcode4          *string `json:"catcode4"`
-	Gender            *string `json:"gender"`
-	Racedate          *string `json:"racedate"`
-	Starteventdate    *string `json:"starteventdate"`
-	Description       *string `json:"description"`
-	Place             *string `json:"place"`
-	Nationcode        *string `json:"nationcode"`
-	Td1id             *int32  `json:"td1id"`
-	Td1name           *string `json:"td1name"`
-	Td1nation         *string `json:"td1nation"`
-	Td1code           *int32  `json:"td1code"`
-	Td2id             *int32  `json:"td2id"`
-	Td2name           *string `json:"td2name"`
-	Td2nation         *string `json:"td2nation"`
-	Td2code           *int32  `json:"td2code"`
-	Calstatuscode     *string `json:"calstatuscode"`
-	Procstatuscode    *string `json:"procstatuscode"`
-	Receiveddate      *string `json:"receiveddate"`
-	Pursuit           *string `json:"pursuit"`
-	Masse             *string `json:"masse"`
-	Relay             *string `json:"relay"`
-	Distance          *string `json:"distance"`
-	Hill              *string `json:"hill"`
-	Style             *string `json:"style"`
-	Qualif            *string `json:"qualif"`
-	Finale            *string `json:"finale"`
-	Homol             *string `json:"homol"`
-	Webcomment        *string `json:"webcomment"`
-	Displaystatus     *string `json:"displaystatus"`
-	Fisinterncomment  *string `json:"fisinterncomment"`
-	Published         *int32  `json:"published"`
-	Validforfispoints *int32  `json:"validforfispoints"`
-	Usedfislist       *string `json:"usedfislist"`
-	Tolist            *string `json:"tolist"`
-	Discforlistcode   *string `json:"discforlistcode"`
-	Calculatedpenalty *string `json:"calculatedpenalty"`
-	Appliedpenalty    *string `json:"appliedpenalty"`
-	Appliedscala      *string `json:"appliedscala"`
-	Penscafixed       *string `json:"penscafixed"`
-	Version           *int32  `json:"version"`
-	Nationraceid      *int32  `json:"nationraceid"`
-	Provraceid        *int32  `json:"provraceid"`
-	Msql7evid         *int32  `json:"msql7evid"`
-	Mssql7id          *int32  `json:"mssql7id"`
-	Results           *int32  `json:"results"`
-	Pdf               *int32  `json:"pdf"`
-	Topbanner         *string `json:"topbanner"`
-	Bottombanner      *string `json:"bottombanner"`
-	Toplogo           *string `json:"toplogo"`
-	Bottomlogo        *string `json:"bottomlogo"`
-	Gallery           *string `json:"gallery"`
-	Indi              *int32  `json:"indi"`
-	Team              *int32  `json:"team"`
-	Tabcount          *int32  `json:"tabcount"`
-	Columncount       *int32  `json:"columncount"`
-	Level             *string `json:"level"`
-	Hloc1             *string `json:"hloc1"`
-	Hloc2             *string `json:"hloc2"`
-	Hloc3             *string `json:"hloc3"`
-	Hcet1             *string `json:"hcet1"`
-	Hcet2             *string `json:"hcet2"`
-	Hcet3             *string `json:"hcet3"`
-	Live              *int32  `json:"live"`
-	Livestatus1       *string `json:"livestatus1"`
-	Livestatus2       *string `json:"livestatus2"`
-	Livestatus3       *string `json:"livestatus3"`
-	Liveinfo1         *string `json:"liveinfo1"`
-	Liveinfo2         *string `json:"liveinfo2"`
-	Liveinfo3         *string `json:"liveinfo3"`
-	Passwd            *string `json:"passwd"`
-	Timinglogo        *string `json:"timinglogo"`
-	Validdate         *string `json:"validdate"`
-	Noepr             *int32  `json:"noepr"`
-	Tddoc             *int32  `json:"tddoc"`
-	Timingreport      *int32  `json:"timingreport"`
-	SpecialCupPoints  *int32  `json:"special_cup_points"`
-	SkipWcsl          *int32  `json:"skip_wcsl"`
-	Validforowg       *int32  `json:"validforowg"`
-	Lastupdate        *string `json:"lastupdate"`
-}
-
-func FISRaceCCFullFromSqlc(row fissqlc.ARacecc) FISRaceCCFullResponse {
+func FISRaceCCFullFromSqlc(row fissqlc.ARacecc) apitypes.FISRaceCC {
 	var (
 		raceDateStr   *string
 		startEventStr *string
@@ -354,7 +171,7 @@ func FISRaceCCFullFromSqlc(row fissqlc.ARacecc) FISRaceCCFullResponse {
 		lastUpdateStr = utils.FormatTimestampPtr(row.Lastupdate)
 	}
 
-	return FISRaceCCFullResponse{
+	return apitypes.FISRaceCC{
 		Raceid:            row.Raceid,
 		Eventid:           utils.Int32PtrOrNil(row.Eventid),
 		Seasoncode:        utils.Int32PtrOrNil(row.Seasoncode),
@@ -452,100 +269,7 @@ type RacesJPQuery struct {
 	CatCode        []string `form:"catcode"`
 }
 
-type InsertRaceJPInput struct {
-	Raceid            int32   `json:"raceid" validate:"required"`
-	Eventid           *int32  `json:"eventid"`
-	Seasoncode        *int32  `json:"seasoncode"`
-	Racecodex         *int32  `json:"racecodex"`
-	Disciplineid      *string `json:"disciplineid"`
-	Disciplinecode    *string `json:"disciplinecode"`
-	Catcode           *string `json:"catcode"`
-	Catcode2          *string `json:"catcode2"`
-	Catcode3          *string `json:"catcode3"`
-	Catcode4          *string `json:"catcode4"`
-	Gender            *string `json:"gender"`
-	Racedate          *string `json:"racedate"`       // YYYY-MM-DD
-	Starteventdate    *string `json:"starteventdate"` // YYYY-MM-DD
-	Description       *string `json:"description"`
-	Place             *string `json:"place"`
-	Nationcode        *string `json:"nationcode"`
-	Receiveddate      *string `json:"receiveddate"` // RFC3339
-	Td1id             *int32  `json:"td1id"`
-	Td1name           *string `json:"td1name"`
-	Td1nation         *string `json:"td1nation"`
-	Td1code           *int32  `json:"td1code"`
-	Td2id             *int32  `json:"td2id"`
-	Td2name           *string `json:"td2name"`
-	Td2nation         *string `json:"td2nation"`
-	Td2code           *int32  `json:"td2code"`
-	Calstatuscode     *string `json:"calstatuscode"`
-	Procstatuscode    *string `json:"procstatuscode"`
-	Pursuit           *string `json:"pursuit"`
-	Masse             *string `json:"masse"`
-	Relay             *string `json:"relay"`
-	Distance          *string `json:"distance"`
-	Hill              *int32  `json:"hill"`
-	Style             *string `json:"style"`
-	Qualif            *string `json:"qualif"`
-	Finale            *string `json:"finale"`
-	Homol             *string `json:"homol"`
-	Webcomment        *string `json:"webcomment"`
-	Displaystatus     *string `json:"displaystatus"`
-	Fisinterncomment  *string `json:"fisinterncomment"`
-	Published         *int32  `json:"published"`
-	Validforfispoints *int32  `json:"validforfispoints"`
-	Usedfislist       *string `json:"usedfislist"`
-	Tolist            *string `json:"tolist"`
-	Discforlistcode   *string `json:"discforlistcode"`
-	Calculatedpenalty *string `json:"calculatedpenalty"`
-	Appliedpenalty    *string `json:"appliedpenalty"`
-	Appliedscala      *string `json:"appliedscala"`
-	Penscafixed       *string `json:"penscafixed"`
-	Version           *int32  `json:"version"`
-	Nationraceid      *int32  `json:"nationraceid"`
-	Provraceid        *int32  `json:"provraceid"`
-	Msql7evid         *int32  `json:"msql7evid"`
-	Mssql7id          *int32  `json:"mssql7id"`
-	Results           *int32  `json:"results"`
-	Pdf               *int32  `json:"pdf"`
-	Topbanner         *string `json:"topbanner"`
-	Bottombanner      *string `json:"bottombanner"`
-	Toplogo           *string `json:"toplogo"`
-	Bottomlogo        *string `json:"bottomlogo"`
-	Gallery           *string `json:"gallery"`
-	Indi              *int32  `json:"indi"`
-	Team              *int32  `json:"team"`
-	Tabcount          *int32  `json:"tabcount"`
-	Columncount       *int32  `json:"columncount"`
-	Level             *string `json:"level"`
-	Hloc1             *string `json:"hloc1"` // RFC3339 time-of-day
-	Hloc2             *string `json:"hloc2"`
-	Hloc3             *string `json:"hloc3"`
-	Hcet1             *string `json:"hcet1"`
-	Hcet2             *string `json:"hcet2"`
-	Hcet3             *string `json:"hcet3"`
-	Live              *int32  `json:"live"`
-	Livestatus1       *string `json:"livestatus1"`
-	Livestatus2       *string `json:"livestatus2"`
-	Livestatus3       *string `json:"livestatus3"`
-	Liveinfo1         *string `json:"liveinfo1"`
-	Liveinfo2         *string `json:"liveinfo2"`
-	Liveinfo3         *string `json:"liveinfo3"`
-	Passwd            *string `json:"passwd"`
-	Timinglogo        *string `json:"timinglogo"`
-	Validdate         *string `json:"validdate"` // RFC3339
-	Noepr             *int32  `json:"noepr"`
-	Tddoc             *int32  `json:"tddoc"`
-	Timingreport      *int32  `json:"timingreport"`
-	SpecialCupPoints  *int32  `json:"special_cup_points"`
-	SkipWcsl          *int32  `json:"skip_wcsl"`
-	Lastupdate        *string `json:"lastupdate"`  // RFC3339
-	Validforowg       *string `json:"validforowg"` // varchar
-}
-
-type UpdateRaceJPInput = InsertRaceJPInput
-
-func mapInsertRaceJPInput(in InsertRaceJPInput) (fis.InsertRaceJPClean, error) {
+func mapInsertRaceJPInput(in apitypes.FISInsertRaceJPExample) (fis.InsertRaceJPClean, error) {
 	var rd, sed, recv, vald, lup *time.Time
 	var h1, h2, h3, c1, c2, c3 *time.Time
 	var err error
@@ -710,103 +434,12 @@ func mapInsertRaceJPInput(in InsertRaceJPInput) (fis.InsertRaceJPClean, error) {
 	}, nil
 }
 
-func mapUpdateRaceJPInput(in UpdateRaceJPInput) (fis.UpdateRaceJPClean, error) {
-	clean, err := mapInsertRaceJPInput(InsertRaceJPInput(in))
+func mapUpdateRaceJPInput(in apitypes.FISUpdateRaceJPExample) (fis.UpdateRaceJPClean, error) {
+	clean, err := mapInsertRaceJPInput(apitypes.FISInsertRaceJPExample(in))
 	return fis.UpdateRaceJPClean(clean), err
 }
 
-type FISRaceJPFullResponse struct {
-	Raceid            int32   `json:"raceid"`
-	Eventid           *int32  `json:"eventid"`
-	Seasoncode        *int32  `json:"seasoncode"`
-	Racecodex         *int32  `json:"racecodex"`
-	Disciplineid      *string `json:"disciplineid"`
-	Disciplinecode    *string `json:"disciplinecode"`
-	Catcode           *string `json:"catcode"`
-	Catcode2          *string `json:"catcode2"`
-	Catcode3          *string `json:"catcode3"`
-	Catcode4          *string `json:"catcode4"`
-	Gender            *string `json:"gender"`
-	Racedate          *string `json:"racedate"`
-	Starteventdate    *string `json:"starteventdate"`
-	Description       *string `json:"description"`
-	Place             *string `json:"place"`
-	Nationcode        *string `json:"nationcode"`
-	Td1id             *int32  `json:"td1id"`
-	Td1name           *string `json:"td1name"`
-	Td1nation         *string `json:"td1nation"`
-	Td1code           *int32  `json:"td1code"`
-	Td2id             *int32  `json:"td2id"`
-	Td2name           *string `json:"td2name"`
-	Td2nation         *string `json:"td2nation"`
-	Td2code           *int32  `json:"td2code"`
-	Calstatuscode     *string `json:"calstatuscode"`
-	Procstatuscode    *string `json:"procstatuscode"`
-	Receiveddate      *string `json:"receiveddate"`
-	Pursuit           *string `json:"pursuit"`
-	Masse             *string `json:"masse"`
-	Relay             *string `json:"relay"`
-	Distance          *string `json:"distance"`
-	Hill              *int32  `json:"hill"`
-	Style             *string `json:"style"`
-	Qualif            *string `json:"qualif"`
-	Finale            *string `json:"finale"`
-	Homol             *string `json:"homol"`
-	Webcomment        *string `json:"webcomment"`
-	Displaystatus     *string `json:"displaystatus"`
-	Fisinterncomment  *string `json:"fisinterncomment"`
-	Published         *int32  `json:"published"`
-	Validforfispoints *int32  `json:"validforfispoints"`
-	Usedfislist       *string `json:"usedfislist"`
-	Tolist            *string `json:"tolist"`
-	Discforlistcode   *string `json:"discforlistcode"`
-	Calculatedpenalty *string `json:"calculatedpenalty"`
-	Appliedpenalty    *string `json:"appliedpenalty"`
-	Appliedscala      *string `json:"appliedscala"`
-	Penscafixed       *string `json:"penscafixed"`
-	Version           *int32  `json:"version"`
-	Nationraceid      *int32  `json:"nationraceid"`
-	Provraceid        *int32  `json:"provraceid"`
-	Msql7evid         *int32  `json:"msql7evid"`
-	Mssql7id          *int32  `json:"mssql7id"`
-	Results           *int32  `json:"results"`
-	Pdf               *int32  `json:"pdf"`
-	Topbanner         *string `json:"topbanner"`
-	Bottombanner      *string `json:"bottombanner"`
-	Toplogo           *string `json:"toplogo"`
-	Bottomlogo        *string `json:"bottomlogo"`
-	Gallery           *string `json:"gallery"`
-	Indi              *int32  `json:"indi"`
-	Team              *int32  `json:"team"`
-	Tabcount          *int32  `json:"tabcount"`
-	Columncount       *int32  `json:"columncount"`
-	Level             *string `json:"level"`
-	Hloc1             *string `json:"hloc1"`
-	Hloc2             *string `json:"hloc2"`
-	Hloc3             *string `json:"hloc3"`
-	Hcet1             *string `json:"hcet1"`
-	Hcet2             *string `json:"hcet2"`
-	Hcet3             *string `json:"hcet3"`
-	Live              *int32  `json:"live"`
-	Livestatus1       *string `json:"livestatus1"`
-	Livestatus2       *string `json:"livestatus2"`
-	Livestatus3       *string `json:"livestatus3"`
-	Liveinfo1         *string `json:"liveinfo1"`
-	Liveinfo2         *string `json:"liveinfo2"`
-	Liveinfo3         *string `json:"liveinfo3"`
-	Passwd            *string `json:"passwd"`
-	Timinglogo        *string `json:"timinglogo"`
-	Validdate         *string `json:"validdate"`
-	Noepr             *int32  `json:"noepr"`
-	Tddoc             *int32  `json:"tddoc"`
-	Timingreport      *int32  `json:"timingreport"`
-	SpecialCupPoints  *int32  `json:"special_cup_points"`
-	SkipWcsl          *int32  `json:"skip_wcsl"`
-	Lastupdate        *string `json:"lastupdate"`
-	Validforowg       *string `json:"validforowg"`
-}
-
-func FISRaceJPFullFromSqlc(row fissqlc.ARacejp) FISRaceJPFullResponse {
+func FISRaceJPFullFromSqlc(row fissqlc.ARacejp) apitypes.FISRaceJP {
 	var raceDateStr, startEventStr, receivedStr, validStr, lastUpdateStr *string
 	var h1, h2, h3, c1, c2, c3 *string
 
@@ -844,7 +477,7 @@ func FISRaceJPFullFromSqlc(row fissqlc.ARacejp) FISRaceJPFullResponse {
 		c3 = utils.FormatTimestampPtr(row.Hcet3)
 	}
 
-	return FISRaceJPFullResponse{
+	return apitypes.FISRaceJP{
 		Raceid:            row.Raceid,
 		Eventid:           utils.Int32PtrOrNil(row.Eventid),
 		Seasoncode:        utils.Int32PtrOrNil(row.Seasoncode),
@@ -944,100 +577,7 @@ type RacesNKQuery struct {
 
 // Insert / Update payloads (strings for dates/timestamps, parsed to time.Time)
 
-type InsertRaceNKInput struct {
-	Raceid            int32   `json:"raceid" validate:"required"`
-	Eventid           *int32  `json:"eventid"`
-	Seasoncode        *int32  `json:"seasoncode"`
-	Racecodex         *int32  `json:"racecodex"`
-	Disciplineid      *string `json:"disciplineid"`
-	Disciplinecode    *string `json:"disciplinecode"`
-	Catcode           *string `json:"catcode"`
-	Catcode2          *string `json:"catcode2"`
-	Catcode3          *string `json:"catcode3"`
-	Catcode4          *string `json:"catcode4"`
-	Gender            *string `json:"gender"`
-	Racedate          *string `json:"racedate"`       // YYYY-MM-DD
-	Starteventdate    *string `json:"starteventdate"` // YYYY-MM-DD
-	Description       *string `json:"description"`
-	Place             *string `json:"place"`
-	Nationcode        *string `json:"nationcode"`
-	Receiveddate      *string `json:"receiveddate"` // RFC3339
-	Validdate         *string `json:"validdate"`    // RFC3339
-	Td1id             *int32  `json:"td1id"`
-	Td1name           *string `json:"td1name"`
-	Td1nation         *string `json:"td1nation"`
-	Td1code           *int32  `json:"td1code"`
-	Td2id             *int32  `json:"td2id"`
-	Td2name           *string `json:"td2name"`
-	Td2nation         *string `json:"td2nation"`
-	Td2code           *int32  `json:"td2code"`
-	Calstatuscode     *string `json:"calstatuscode"`
-	Procstatuscode    *string `json:"procstatuscode"`
-	Pursuit           *string `json:"pursuit"`
-	Masse             *string `json:"masse"`
-	Relay             *string `json:"relay"`
-	Distance          *string `json:"distance"`
-	Hill              *int32  `json:"hill"`
-	Style             *string `json:"style"`
-	Qualif            *string `json:"qualif"`
-	Finale            *string `json:"finale"`
-	Homol             *string `json:"homol"`
-	Webcomment        *string `json:"webcomment"`
-	Displaystatus     *string `json:"displaystatus"`
-	Fisinterncomment  *string `json:"fisinterncomment"`
-	Published         *int32  `json:"published"`
-	Validforfispoints *int32  `json:"validforfispoints"`
-	Usedfislist       *string `json:"usedfislist"`
-	Tolist            *string `json:"tolist"`
-	Discforlistcode   *string `json:"discforlistcode"`
-	Calculatedpenalty *string `json:"calculatedpenalty"`
-	Appliedpenalty    *string `json:"appliedpenalty"`
-	Appliedscala      *string `json:"appliedscala"`
-	Penscafixed       *string `json:"penscafixed"`
-	Version           *int32  `json:"version"`
-	Nationraceid      *int32  `json:"nationraceid"`
-	Provraceid        *int32  `json:"provraceid"`
-	Msql7evid         *int32  `json:"msql7evid"`
-	Mssql7id          *int32  `json:"mssql7id"`
-	Results           *int32  `json:"results"`
-	Pdf               *int32  `json:"pdf"`
-	Topbanner         *string `json:"topbanner"`
-	Bottombanner      *string `json:"bottombanner"`
-	Toplogo           *string `json:"toplogo"`
-	Bottomlogo        *string `json:"bottomlogo"`
-	Gallery           *string `json:"gallery"`
-	Indi              *int32  `json:"indi"`
-	Team              *int32  `json:"team"`
-	Tabcount          *int32  `json:"tabcount"`
-	Columncount       *int32  `json:"columncount"`
-	Level             *string `json:"level"`
-	Hloc1             *string `json:"hloc1"` // RFC3339
-	Hloc2             *string `json:"hloc2"`
-	Hloc3             *string `json:"hloc3"`
-	Hcet1             *string `json:"hcet1"`
-	Hcet2             *string `json:"hcet2"`
-	Hcet3             *string `json:"hcet3"`
-	Live              *int32  `json:"live"`
-	Livestatus1       *string `json:"livestatus1"`
-	Livestatus2       *string `json:"livestatus2"`
-	Livestatus3       *string `json:"livestatus3"`
-	Liveinfo1         *string `json:"liveinfo1"`
-	Liveinfo2         *string `json:"liveinfo2"`
-	Liveinfo3         *string `json:"liveinfo3"`
-	Passwd            *string `json:"passwd"`
-	Timinglogo        *string `json:"timinglogo"`
-	Noepr             *int32  `json:"noepr"`
-	Tddoc             *int32  `json:"tddoc"`
-	Timingreport      *int32  `json:"timingreport"`
-	SpecialCupPoints  *int32  `json:"special_cup_points"`
-	SkipWcsl          *int32  `json:"skip_wcsl"`
-	Validforowg       *int32  `json:"validforowg"`
-	Lastupdate        *string `json:"lastupdate"` // RFC3339
-}
-
-type UpdateRaceNKInput = InsertRaceNKInput
-
-func mapInsertRaceNKInput(in InsertRaceNKInput) (fis.InsertRaceNKClean, error) {
+func mapInsertRaceNKInput(in apitypes.FISInsertRaceNKExample) (fis.InsertRaceNKClean, error) {
 	var (
 		rd, sed, recv, vald, lup *time.Time
 		hl1, hl2, hl3            *time.Time
@@ -1205,105 +745,14 @@ func mapInsertRaceNKInput(in InsertRaceNKInput) (fis.InsertRaceNKClean, error) {
 	}, nil
 }
 
-func mapUpdateRaceNKInput(in UpdateRaceNKInput) (fis.UpdateRaceNKClean, error) {
-	clean, err := mapInsertRaceNKInput(InsertRaceNKInput(in))
+func mapUpdateRaceNKInput(in apitypes.FISUpdateRaceNKExample) (fis.UpdateRaceNKClean, error) {
+	clean, err := mapInsertRaceNKInput(apitypes.FISInsertRaceNKExample(in))
 	return fis.UpdateRaceNKClean(clean), err
 }
 
 // Full response mapping from sqlc ARacenk
 
-type FISRaceNKFullResponse struct {
-	Raceid            int32   `json:"raceid"`
-	Eventid           *int32  `json:"eventid"`
-	Seasoncode        *int32  `json:"seasoncode"`
-	Racecodex         *int32  `json:"racecodex"`
-	Disciplineid      *string `json:"disciplineid"`
-	Disciplinecode    *string `json:"disciplinecode"`
-	Catcode           *string `json:"catcode"`
-	Catcode2          *string `json:"catcode2"`
-	Catcode3          *string `json:"catcode3"`
-	Catcode4          *string `json:"catcode4"`
-	Gender            *string `json:"gender"`
-	Racedate          *string `json:"racedate"`
-	Starteventdate    *string `json:"starteventdate"`
-	Description       *string `json:"description"`
-	Place             *string `json:"place"`
-	Nationcode        *string `json:"nationcode"`
-	Td1id             *int32  `json:"td1id"`
-	Td1name           *string `json:"td1name"`
-	Td1nation         *string `json:"td1nation"`
-	Td1code           *int32  `json:"td1code"`
-	Td2id             *int32  `json:"td2id"`
-	Td2name           *string `json:"td2name"`
-	Td2nation         *string `json:"td2nation"`
-	Td2code           *int32  `json:"td2code"`
-	Calstatuscode     *string `json:"calstatuscode"`
-	Procstatuscode    *string `json:"procstatuscode"`
-	Receiveddate      *string `json:"receiveddate"`
-	Pursuit           *string `json:"pursuit"`
-	Masse             *string `json:"masse"`
-	Relay             *string `json:"relay"`
-	Distance          *string `json:"distance"`
-	Hill              *int32  `json:"hill"`
-	Style             *string `json:"style"`
-	Qualif            *string `json:"qualif"`
-	Finale            *string `json:"finale"`
-	Homol             *string `json:"homol"`
-	Webcomment        *string `json:"webcomment"`
-	Displaystatus     *string `json:"displaystatus"`
-	Fisinterncomment  *string `json:"fisinterncomment"`
-	Published         *int32  `json:"published"`
-	Validforfispoints *int32  `json:"validforfispoints"`
-	Usedfislist       *string `json:"usedfislist"`
-	Tolist            *string `json:"tolist"`
-	Discforlistcode   *string `json:"discforlistcode"`
-	Calculatedpenalty *string `json:"calculatedpenalty"`
-	Appliedpenalty    *string `json:"appliedpenalty"`
-	Appliedscala      *string `json:"appliedscala"`
-	Penscafixed       *string `json:"penscafixed"`
-	Version           *int32  `json:"version"`
-	Nationraceid      *int32  `json:"nationraceid"`
-	Provraceid        *int32  `json:"provraceid"`
-	Msql7evid         *int32  `json:"msql7evid"`
-	Mssql7id          *int32  `json:"mssql7id"`
-	Results           *int32  `json:"results"`
-	Pdf               *int32  `json:"pdf"`
-	Topbanner         *string `json:"topbanner"`
-	Bottombanner      *string `json:"bottombanner"`
-	Toplogo           *string `json:"toplogo"`
-	Bottomlogo        *string `json:"bottomlogo"`
-	Gallery           *string `json:"gallery"`
-	Indi              *int32  `json:"indi"`
-	Team              *int32  `json:"team"`
-	Tabcount          *int32  `json:"tabcount"`
-	Columncount       *int32  `json:"columncount"`
-	Level             *string `json:"level"`
-	Hloc1             *string `json:"hloc1"`
-	Hloc2             *string `json:"hloc2"`
-	Hloc3             *string `json:"hloc3"`
-	Hcet1             *string `json:"hcet1"`
-	Hcet2             *string `json:"hcet2"`
-	Hcet3             *string `json:"hcet3"`
-	Live              *int32  `json:"live"`
-	Livestatus1       *string `json:"livestatus1"`
-	Livestatus2       *string `json:"livestatus2"`
-	Livestatus3       *string `json:"livestatus3"`
-	Liveinfo1         *string `json:"liveinfo1"`
-	Liveinfo2         *string `json:"liveinfo2"`
-	Liveinfo3         *string `json:"liveinfo3"`
-	Passwd            *string `json:"passwd"`
-	Timinglogo        *string `json:"timinglogo"`
-	Validdate         *string `json:"validdate"`
-	Noepr             *int32  `json:"noepr"`
-	Tddoc             *int32  `json:"tddoc"`
-	Timingreport      *int32  `json:"timingreport"`
-	SpecialCupPoints  *int32  `json:"special_cup_points"`
-	SkipWcsl          *int32  `json:"skip_wcsl"`
-	Validforowg       *int32  `json:"validforowg"`
-	Lastupdate        *string `json:"lastupdate"`
-}
-
-func FISRaceNKFullFromSqlc(row fissqlc.ARacenk) FISRaceNKFullResponse {
+func FISRaceNKFullFromSqlc(row fissqlc.ARacenk) apitypes.FISRaceNK {
 	var (
 		raceDateStr   *string
 		startEventStr *string
@@ -1349,7 +798,7 @@ func FISRaceNKFullFromSqlc(row fissqlc.ARacenk) FISRaceNKFullResponse {
 		hc3 = utils.FormatTimestampPtr(row.Hcet3)
 	}
 
-	return FISRaceNKFullResponse{
+	return apitypes.FISRaceNK{
 		Raceid:            row.Raceid,
 		Eventid:           utils.Int32PtrOrNil(row.Eventid),
 		Seasoncode:        utils.Int32PtrOrNil(row.Seasoncode),
@@ -10,9 +10,12 @@ import (
 	"strings"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/export"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 type RaceCCHandler struct {
@@ -30,12 +33,12 @@ func NewRaceCCHandler(store fis.Racecc, cache *cache.Storage) *RaceCCHandler {
 //	@Tags		FIS - Season Discipline & Category Codes
 //	@Accept		json
 //	@Produce	json
-//	@Success	200	{object}	swagger.FISSeasonsCCResponse
-//	@Failure	400	{object}	swagger.ValidationErrorResponse
-//	@Failure	401	{object}	swagger.UnauthorizedResponse
-//	@Failure	403	{object}	swagger.ForbiddenResponse
-//	@Failure	500	{object}	swagger.InternalServerErrorResponse
-//	@Failure	503	{object}	swagger.ServiceUnavailableResponse
+//	@Success	200	{object}	apitypes.FISSeasonsCCResponse
+//	@Failure	400	{object}	apitypes.ValidationErrorResponse
+//	@Failure	401	{object}	apitypes.UnauthorizedResponse
+//	@Failure	403	{object}	apitypes.ForbiddenResponse
+//	@Failure	500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure	503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security	BearerAuth
 //	@Router		/fis/seasoncodeCC [get]
 func (h *RaceCCHandler) GetSeasonCodesCC(w http.ResponseWriter, r *http.Request) {
@@ -58,7 +61,7 @@ func (h *RaceCCHandler) GetSeasonCodesCC(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	body := map[string]any{"seasons": rows}
+	body := apitypes.FISSeasonsCCResponse{Seasons: rows}
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
 	utils.WriteJSON(w, http.StatusOK, body)
 }
@@ -69,12 +72,12 @@ func (h *RaceCCHandler) GetSeasonCodesCC(w http.ResponseWriter, r *http.Request)
 //	@Tags		FIS - Season Discipline & Category Codes
 //	@Accept		json
 //	@Produce	json
-//	@Success	200	{object}	swagger.FISDisciplinesCCResponse
-//	@Failure	400	{object}	swagger.ValidationErrorResponse
-//	@Failure	401	{object}	swagger.UnauthorizedResponse
-//	@Failure	403	{object}	swagger.ForbiddenResponse
-//	@Failure	500	{object}	swagger.InternalServerErrorResponse
-//	@Failure	503	{object}	swagger.ServiceUnavailableResponse
+//	@Success	200	{object}	apitypes.FISDisciplinesCCResponse
+//	@Failure	400	{object}	apitypes.ValidationErrorResponse
+//	@Failure	401	{object}	apitypes.UnauthorizedResponse
+//	@Failure	403	{object}	apitypes.ForbiddenResponse
+//	@Failure	500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure	503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security	BearerAuth
 //	@Router		/fis/disciplinecodeCC [get]
 func (h *RaceCCHandler) GetDisciplineCodesCC(w http.ResponseWriter, r *http.Request) {
@@ -97,7 +100,7 @@ func (h *RaceCCHandler) GetDisciplineCodesCC(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	body := map[string]any{"disciplines": rows}
+	body := apitypes.FISDisciplinesCCResponse{Disciplines: rows}
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
 	utils.WriteJSON(w, http.StatusOK, body)
 }
@@ -108,12 +111,12 @@ func (h *RaceCCHandler) GetDisciplineCodesCC(w http.ResponseWriter, r *http.Requ
 //	@Tags		FIS - Season Discipline & Category Codes
 //	@Accept		json
 //	@Produce	json
-//	@Success	200	{object}	swagger.FISCategoriesCCResponse
-//	@Failure	400	{object}	swagger.ValidationErrorResponse
-//	@Failure	401	{object}	swagger.UnauthorizedResponse
-//	@Failure	403	{object}	swagger.ForbiddenResponse
-//	@Failure	500	{object}	swagger.InternalServerErrorResponse
-//	@Failure	503	{object}	swagger.ServiceUnavailableResponse
+//	@Success	200	{object}	apitypes.FISCategoriesCCResponse
+//	@Failure	400	{object}	apitypes.ValidationErrorResponse
+//	@Failure	401	{object}	apitypes.UnauthorizedResponse
+//	@Failure	403	{object}	apitypes.ForbiddenResponse
+//	@Failure	500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure	503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security	BearerAuth
 //	@Router		/fis/catcodeCC [get]
 func (h *RaceCCHandler) GetCategoryCodesCC(w http.ResponseWriter, r *http.Request) {
@@ -136,7 +139,7 @@ func (h *RaceCCHandler) GetCategoryCodesCC(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	body := map[string]any{"categories": rows}
+	body := apitypes.FISCategoriesCCResponse{Categories: rows}
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
 	utils.WriteJSON(w, http.StatusOK, body)
 }
@@ -146,16 +149,21 @@ func (h *RaceCCHandler) GetCategoryCodesCC(w http.ResponseWriter, r *http.Reques
 //	@Summary	Get list of Cross-Country races
 //	@Tags		FIS - Race Data
 //	@Accept		json
-//	@Produce	json
+//	@Produce	json,text/csv,application/x-ndjson,application/vnd.apache.parquet
 //	@Param		seasoncode		query		[]int32		false	"Season code (repeat or comma-separated)"
 //	@Param		disciplinecode	query		[]string	false	"Discipline code (repeat or comma-separated)"
 //	@Param		catcode			query		[]string	false	"Category code (repeat or comma-separated)"
-//	@Success	200				{object}	swagger.FISRacesCCResponse
-//	@Failure	400				{object}	swagger.ValidationErrorResponse
-//	@Failure	401				{object}	swagger.UnauthorizedResponse
-//	@Failure	403				{object}	swagger.ForbiddenResponse
-//	@Failure	500				{object}	swagger.InternalServerErrorResponse
-//	@Failure	503				{object}	swagger.ServiceUnavailableResponse
+//	@Param		limit			query		int			false	"Page size (1-1000); enables cursor pagination"
+//	@Param		cursor			query		string		false	"Opaque cursor from next_cursor of the previous page"
+//	@Param		fields			query		string		false	"Comma-separated columns to return, or a preset: summary, full (default)"
+//	@Param		format			query		string		false	"Response format: json (default), csv, ndjson, parquet; overrides Accept"
+//	@Param		stream			query		bool		false	"Stream the full listing row by row (implied for csv, ndjson and parquet; ignored with limit or cursor)"
+//	@Success	200				{object}	apitypes.FISRacesCCResponse
+//	@Failure	400				{object}	apitypes.ValidationErrorResponse
+//	@Failure	401				{object}	apitypes.UnauthorizedResponse
+//	@Failure	403				{object}	apitypes.ForbiddenResponse
+//	@Failure	500				{object}	apitypes.InternalServerErrorResponse
+//	@Failure	503				{object}	apitypes.ServiceUnavailableResponse
 //	@Security	BearerAuth
 //	@Router		/fis/racecc [get]
 func (h *RaceCCHandler) GetRacesCC(w http.ResponseWriter, r *http.Request) {
@@ -191,30 +199,89 @@ func (h *RaceCCHandler) GetRacesCC(w http.ResponseWriter, r *http.Request) {
 		seasons = append(seasons, int32(n))
 	}
 
-	cacheKey := fmt.Sprintf("%s:sc=%v:dc=%v:cc=%v", fisRaceCCListPrefix, seasons, discs, cats)
-	if h.cache != nil {
+	page, err := utils.ParsePage(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	fields, err := parseFields(r, raceCCFields)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	format, err := export.Negotiate(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	// full listings are streamed straight from the database
+	if !page.Paged() && (format != export.JSON || utils.WantsStream(r)) {
+		h.streamRacesCC(w, r, format, seasons, discs, cats, fields)
+		return
+	}
+
+	cacheKey := fmt.Sprintf("%s:sc=%v:dc=%v:cc=%v%s%s", fisRaceCCListPrefix, seasons, discs, cats, fieldsCacheKey(fields), page.CacheKey())
+	if format == export.JSON && h.cache != nil {
 		if raw, err := h.cache.Get(r.Context(), cacheKey); err == nil && raw != "" {
+			utils.SetNextLink(w, r, utils.CachedNextCursor(raw))
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(raw))
 			return
 		}
 	}
 
-	rows, err := h.store.GetRacesCC(r.Context(), seasons, discs, cats)
+	rows, next, err := h.store.GetRacesCC(r.Context(), seasons, discs, cats, fields, page)
 	if err != nil {
 		utils.InternalServerError(w, r, err)
 		return
 	}
 
-	out := make([]FISRaceCCFullResponse, 0, len(rows))
+	out := make([]apitypes.FISRaceCC, 0, len(rows))
 	for _, row := range rows {
 		out = append(out, FISRaceCCFullFromSqlc(row))
 	}
+	if format != export.JSON {
+		utils.SetNextLink(w, r, next)
+		if err := export.Write(w, format, "races_cc", out, fields); err != nil {
+			utils.InternalServerError(w, r, err)
+		}
+		return
+	}
+
+	races, err := projectFields(out, fields)
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
 
-	body := map[string]any{"races": out}
+	body := map[string]any{"races": races}
+	utils.AddPageInfo(body, page, next)
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
+	utils.SetNextLink(w, r, next)
 	utils.WriteJSON(w, http.StatusOK, body)
 }
 
+func (h *RaceCCHandler) streamRacesCC(w http.ResponseWriter, r *http.Request, format export.Format, seasons []int32, discs, cats, fields []string) {
+	stream, err := export.NewStream[apitypes.FISRaceCC](w, format, "races", "races_cc", fields)
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	err = h.store.StreamRacesCC(r.Context(), seasons, discs, cats, fields, func(row fissqlc.ARacecc) error {
+		return stream.Write(FISRaceCCFullFromSqlc(row))
+	})
+	if err == nil {
+		err = stream.Close()
+	}
+	if err != nil {
+		if stream.Started() {
+			utils.AbortStream(r, err)
+		}
+		utils.InternalServerError(w, r, err)
+	}
+}
+
 // GetLastRowRaceCC godoc
 //
 //	@Summary		Get last Cross-Country race record
@@ -222,13 +289,13 @@ func (h *RaceCCHandler) GetRacesCC(w http.ResponseWriter, r *http.Request) {
 //	@Tags			FIS - Race Management – Cross-Country
 //	@Accept			json
 //	@Produce		json
-//	@Success		200	{object}	swagger.FISLastRaceCCResponse
-//	@Failure		400	{object}	swagger.ValidationErrorResponse
-//	@Failure		401	{object}	swagger.UnauthorizedResponse
-//	@Failure		403	{object}	swagger.ForbiddenResponse
-//	@Failure		404	{object}	swagger.NotFoundResponse
-//	@Failure		500	{object}	swagger.InternalServerErrorResponse
-//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Success		200	{object}	apitypes.FISLastRaceCCResponse
+//	@Failure		400	{object}	apitypes.ValidationErrorResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		403	{object}	apitypes.ForbiddenResponse
+//	@Failure		404	{object}	apitypes.NotFoundResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/lastrow/racecc [get]
 func (h *RaceCCHandler) GetLastRowRaceCC(w http.ResponseWriter, r *http.Request) {
@@ -254,7 +321,7 @@ func (h *RaceCCHandler) GetLastRowRaceCC(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	body := map[string]any{"race": FISRaceCCFullFromSqlc(row)}
+	body := apitypes.FISLastRaceCCResponse{Race: FISRaceCCFullFromSqlc(row)}
 	cache.SetCacheJSON(r.Context(), h.cache, fisRaceCCLastRowPrefix, body, FISCacheTTL)
 	utils.WriteJSON(w, http.StatusOK, body)
 }
@@ -266,14 +333,14 @@ func (h *RaceCCHandler) GetLastRowRaceCC(w http.ResponseWriter, r *http.Request)
 //	@Tags			FIS - Race Management – Cross-Country
 //	@Accept			json
 //	@Produce		json
-//	@Param			racecc	body	swagger.FISInsertRaceCCExample	true	"Race payload"
+//	@Param			racecc	body	apitypes.FISInsertRaceCCExample	true	"Race payload"
 //	@Success		201		"Created"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		409		{object}	swagger.ConflictResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		409		{object}	apitypes.ConflictResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/racecc [post]
 func (h *RaceCCHandler) InsertRaceCC(w http.ResponseWriter, r *http.Request) {
@@ -282,7 +349,7 @@ func (h *RaceCCHandler) InsertRaceCC(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var in InsertRaceCCInput
+	var in apitypes.FISInsertRaceCCExample
 	if err := utils.ReadJSON(w, r, &in); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -314,14 +381,14 @@ func (h *RaceCCHandler) InsertRaceCC(w http.ResponseWriter, r *http.Request) {
 //	@Tags			FIS - Race Management – Cross-Country
 //	@Accept			json
 //	@Produce		json
-//	@Param			racecc	body	swagger.FISUpdateRaceCCExample	true	"Race payload"
+//	@Param			racecc	body	apitypes.FISUpdateRaceCCExample	true	"Race payload"
 //	@Success		200		"Updated"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		404		{object}	swagger.NotFoundResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		404		{object}	apitypes.NotFoundResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/racecc [put]
 func (h *RaceCCHandler) UpdateRaceCC(w http.ResponseWriter, r *http.Request) {
@@ -330,7 +397,7 @@ func (h *RaceCCHandler) UpdateRaceCC(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var in UpdateRaceCCInput
+	var in apitypes.FISUpdateRaceCCExample
 	if err := utils.ReadJSON(w, r, &in); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -368,12 +435,12 @@ func (h *RaceCCHandler) UpdateRaceCC(w http.ResponseWriter, r *http.Request) {
 //	@Produce		json
 //	@Param			id	query	int32	true	"Race ID"
 //	@Success		200	"Deleted"
-//	@Failure		400	{object}	swagger.ValidationErrorResponse
-//	@Failure		401	{object}	swagger.UnauthorizedResponse
-//	@Failure		403	{object}	swagger.ForbiddenResponse
-//	@Failure		404	{object}	swagger.NotFoundResponse
-//	@Failure		500	{object}	swagger.InternalServerErrorResponse
-//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400	{object}	apitypes.ValidationErrorResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		403	{object}	apitypes.ForbiddenResponse
+//	@Failure		404	{object}	apitypes.NotFoundResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/racecc [delete]
 func (h *RaceCCHandler) DeleteRaceCC(w http.ResponseWriter, r *http.Request) {
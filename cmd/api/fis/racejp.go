@@ -10,9 +10,12 @@ import (
 	"strings"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/export"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 type RaceJPHandler struct {
@@ -30,12 +33,12 @@ func NewRaceJPHandler(store fis.Racejp, cache *cache.Storage) *RaceJPHandler {
 //	@Tags		FIS - Season Discipline & Category Codes
 //	@Accept		json
 //	@Produce	json
-//	@Success	200	{object}	swagger.FISSeasonsJPResponse
-//	@Failure	400	{object}	swagger.ValidationErrorResponse
-//	@Failure	401	{object}	swagger.UnauthorizedResponse
-//	@Failure	403	{object}	swagger.ForbiddenResponse
-//	@Failure	500	{object}	swagger.InternalServerErrorResponse
-//	@Failure	503	{object}	swagger.ServiceUnavailableResponse
+//	@Success	200	{object}	apitypes.FISSeasonsJPResponse
+//	@Failure	400	{object}	apitypes.ValidationErrorResponse
+//	@Failure	401	{object}	apitypes.UnauthorizedResponse
+//	@Failure	403	{object}	apitypes.ForbiddenResponse
+//	@Failure	500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure	503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security	BearerAuth
 //	@Router		/fis/seasoncodeJP [get]
 func (h *RaceJPHandler) GetSeasonCodesJP(w http.ResponseWriter, r *http.Request) {
@@ -58,7 +61,7 @@ func (h *RaceJPHandler) GetSeasonCodesJP(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	body := map[string]any{"seasons": rows}
+	body := apitypes.FISSeasonsJPResponse{Seasons: rows}
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
 	utils.WriteJSON(w, http.StatusOK, body)
 }
@@ -69,12 +72,12 @@ func (h *RaceJPHandler) GetSeasonCodesJP(w http.ResponseWriter, r *http.Request)
 //	@Tags		FIS - Season Discipline & Category Codes
 //	@Accept		json
 //	@Produce	json
-//	@Success	200	{object}	swagger.FISDisciplinesJPResponse
-//	@Failure	400	{object}	swagger.ValidationErrorResponse
-//	@Failure	401	{object}	swagger.UnauthorizedResponse
-//	@Failure	403	{object}	swagger.ForbiddenResponse
-//	@Failure	500	{object}	swagger.InternalServerErrorResponse
-//	@Failure	503	{object}	swagger.ServiceUnavailableResponse
+//	@Success	200	{object}	apitypes.FISDisciplinesJPResponse
+//	@Failure	400	{object}	apitypes.ValidationErrorResponse
+//	@Failure	401	{object}	apitypes.UnauthorizedResponse
+//	@Failure	403	{object}	apitypes.ForbiddenResponse
+//	@Failure	500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure	503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security	BearerAuth
 //	@Router		/fis/disciplinecodeJP [get]
 func (h *RaceJPHandler) GetDisciplineCodesJP(w http.ResponseWriter, r *http.Request) {
@@ -97,7 +100,7 @@ func (h *RaceJPHandler) GetDisciplineCodesJP(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	body := map[string]any{"disciplines": rows}
+	body := apitypes.FISDisciplinesJPResponse{Disciplines: rows}
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
 	utils.WriteJSON(w, http.StatusOK, body)
 }
@@ -108,12 +111,12 @@ func (h *RaceJPHandler) GetDisciplineCodesJP(w http.ResponseWriter, r *http.Requ
 //	@Tags		FIS - Season Discipline & Category Codes
 //	@Accept		json
 //	@Produce	json
-//	@Success	200	{object}	swagger.FISCategoriesJPResponse
-//	@Failure	400	{object}	swagger.ValidationErrorResponse
-//	@Failure	401	{object}	swagger.UnauthorizedResponse
-//	@Failure	403	{object}	swagger.ForbiddenResponse
-//	@Failure	500	{object}	swagger.InternalServerErrorResponse
-//	@Failure	503	{object}	swagger.ServiceUnavailableResponse
+//	@Success	200	{object}	apitypes.FISCategoriesJPResponse
+//	@Failure	400	{object}	apitypes.ValidationErrorResponse
+//	@Failure	401	{object}	apitypes.UnauthorizedResponse
+//	@Failure	403	{object}	apitypes.ForbiddenResponse
+//	@Failure	500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure	503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security	BearerAuth
 //	@Router		/fis/catcodeJP [get]
 func (h *RaceJPHandler) GetCategoryCodesJP(w http.ResponseWriter, r *http.Request) {
@@ -136,7 +139,7 @@ func (h *RaceJPHandler) GetCategoryCodesJP(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	body := map[string]any{"categories": rows}
+	body := apitypes.FISCategoriesJPResponse{Categories: rows}
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
 	utils.WriteJSON(w, http.StatusOK, body)
 }
@@ -146,16 +149,21 @@ func (h *RaceJPHandler) GetCategoryCodesJP(w http.ResponseWriter, r *http.Reques
 //	@Summary	Get list of Ski Jumping races
 //	@Tags		FIS - Race Data
 //	@Accept		json
-//	@Produce	json
+//	@Produce	json,text/csv,application/x-ndjson,application/vnd.apache.parquet
 //	@Param		seasoncode		query		[]int32		false	"Season code (repeat or comma-separated)"
 //	@Param		disciplinecode	query		[]string	false	"Discipline code (repeat or comma-separated)"
 //	@Param		catcode			query		[]string	false	"Category code (repeat or comma-separated)"
-//	@Success	200				{object}	swagger.FISRacesJPResponse
-//	@Failure	400				{object}	swagger.ValidationErrorResponse
-//	@Failure	401				{object}	swagger.UnauthorizedResponse
-//	@Failure	403				{object}	swagger.ForbiddenResponse
-//	@Failure	500				{object}	swagger.InternalServerErrorResponse
-//	@Failure	503				{object}	swagger.ServiceUnavailableResponse
+//	@Param		limit			query		int			false	"Page size (1-1000); enables cursor pagination"
+//	@Param		cursor			query		string		false	"Opaque cursor from next_cursor of the previous page"
+//	@Param		fields			query		string		false	"Comma-separated columns to return, or a preset: summary, full (default)"
+//	@Param		format			query		string		false	"Response format: json (default), csv, ndjson, parquet; overrides Accept"
+//	@Param		stream			query		bool		false	"Stream the full listing row by row (implied for csv, ndjson and parquet; ignored with limit or cursor)"
+//	@Success	200				{object}	apitypes.FISRacesJPResponse
+//	@Failure	400				{object}	apitypes.ValidationErrorResponse
+//	@Failure	401				{object}	apitypes.UnauthorizedResponse
+//	@Failure	403				{object}	apitypes.ForbiddenResponse
+//	@Failure	500				{object}	apitypes.InternalServerErrorResponse
+//	@Failure	503				{object}	apitypes.ServiceUnavailableResponse
 //	@Security	BearerAuth
 //	@Router		/fis/racejp [get]
 func (h *RaceJPHandler) GetRacesJP(w http.ResponseWriter, r *http.Request) {
@@ -189,30 +197,89 @@ func (h *RaceJPHandler) GetRacesJP(w http.ResponseWriter, r *http.Request) {
 		seasons = append(seasons, int32(n))
 	}
 
-	cacheKey := fmt.Sprintf("%s:sc=%v:dc=%v:cc=%v", fisRaceJPListPrefix, seasons, discs, cats)
-	if h.cache != nil {
+	page, err := utils.ParsePage(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	fields, err := parseFields(r, raceJPFields)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	format, err := export.Negotiate(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	// full listings are streamed straight from the database
+	if !page.Paged() && (format != export.JSON || utils.WantsStream(r)) {
+		h.streamRacesJP(w, r, format, seasons, discs, cats, fields)
+		return
+	}
+
+	cacheKey := fmt.Sprintf("%s:sc=%v:dc=%v:cc=%v%s%s", fisRaceJPListPrefix, seasons, discs, cats, fieldsCacheKey(fields), page.CacheKey())
+	if format == export.JSON && h.cache != nil {
 		if raw, err := h.cache.Get(r.Context(), cacheKey); err == nil && raw != "" {
+			utils.SetNextLink(w, r, utils.CachedNextCursor(raw))
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(raw))
 			return
 		}
 	}
 
-	rows, err := h.store.GetRacesJP(r.Context(), seasons, discs, cats)
+	rows, next, err := h.store.GetRacesJP(r.Context(), seasons, discs, cats, fields, page)
 	if err != nil {
 		utils.InternalServerError(w, r, err)
 		return
 	}
 
-	out := make([]FISRaceJPFullResponse, 0, len(rows))
+	out := make([]apitypes.FISRaceJP, 0, len(rows))
 	for _, row := range rows {
 		out = append(out, FISRaceJPFullFromSqlc(row))
 	}
+	if format != export.JSON {
+		utils.SetNextLink(w, r, next)
+		if err := export.Write(w, format, "races_jp", out, fields); err != nil {
+			utils.InternalServerError(w, r, err)
+		}
+		return
+	}
+
+	races, err := projectFields(out, fields)
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
 
-	body := map[string]any{"races": out}
+	body := map[string]any{"races": races}
+	utils.AddPageInfo(body, page, next)
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
+	utils.SetNextLink(w, r, next)
 	utils.WriteJSON(w, http.StatusOK, body)
 }
 
+func (h *RaceJPHandler) streamRacesJP(w http.ResponseWriter, r *http.Request, format export.Format, seasons []int32, discs, cats, fields []string) {
+	stream, err := export.NewStream[apitypes.FISRaceJP](w, format, "races", "races_jp", fields)
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	err = h.store.StreamRacesJP(r.Context(), seasons, discs, cats, fields, func(row fissqlc.ARacejp) error {
+		return stream.Write(FISRaceJPFullFromSqlc(row))
+	})
+	if err == nil {
+		err = stream.Close()
+	}
+	if err != nil {
+		if stream.Started() {
+			utils.AbortStream(r, err)
+		}
+		utils.InternalServerError(w, r, err)
+	}
+}
+
 // GetLastRowRaceJP godoc
 //
 //	@Summary		Get last Ski Jumping race record
@@ -220,13 +287,13 @@ func (h *RaceJPHandler) GetRacesJP(w http.ResponseWriter, r *http.Request) {
 //	@Tags			FIS - Race Management – Ski Jumping
 //	@Accept			json
 //	@Produce		json
-//	@Success		200	{object}	swagger.FISLastRaceJPResponse
-//	@Failure		400	{object}	swagger.ValidationErrorResponse
-//	@Failure		401	{object}	swagger.UnauthorizedResponse
-//	@Failure		403	{object}	swagger.ForbiddenResponse
-//	@Failure		404	{object}	swagger.NotFoundResponse
-//	@Failure		500	{object}	swagger.InternalServerErrorResponse
-//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Success		200	{object}	apitypes.FISLastRaceJPResponse
+//	@Failure		400	{object}	apitypes.ValidationErrorResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		403	{object}	apitypes.ForbiddenResponse
+//	@Failure		404	{object}	apitypes.NotFoundResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/lastrow/racejp [get]
 func (h *RaceJPHandler) GetLastRowRaceJP(w http.ResponseWriter, r *http.Request) {
@@ -252,7 +319,7 @@ func (h *RaceJPHandler) GetLastRowRaceJP(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	body := map[string]any{"race": FISRaceJPFullFromSqlc(row)}
+	body := apitypes.FISLastRaceJPResponse{Race: FISRaceJPFullFromSqlc(row)}
 	cache.SetCacheJSON(r.Context(), h.cache, fisRaceJPLastRowPrefix, body, FISCacheTTL)
 	utils.WriteJSON(w, http.StatusOK, body)
 }
@@ -264,14 +331,14 @@ func (h *RaceJPHandler) GetLastRowRaceJP(w http.ResponseWriter, r *http.Request)
 //	@Tags			FIS - Race Management – Ski Jumping
 //	@Accept			json
 //	@Produce		json
-//	@Param			racejp	body	swagger.FISInsertRaceJPExample	true	"Race payload"
+//	@Param			racejp	body	apitypes.FISInsertRaceJPExample	true	"Race payload"
 //	@Success		201		"Created"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		409		{object}	swagger.ConflictResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		409		{object}	apitypes.ConflictResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/racejp [post]
 func (h *RaceJPHandler) InsertRaceJP(w http.ResponseWriter, r *http.Request) {
@@ -280,7 +347,7 @@ func (h *RaceJPHandler) InsertRaceJP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var in InsertRaceJPInput
+	var in apitypes.FISInsertRaceJPExample
 	if err := utils.ReadJSON(w, r, &in); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -312,14 +379,14 @@ func (h *RaceJPHandler) InsertRaceJP(w http.ResponseWriter, r *http.Request) {
 //	@Tags			FIS - Race Management – Ski Jumping
 //	@Accept			json
 //	@Produce		json
-//	@Param			racejp	body	swagger.FISUpdateRaceJPExample	true	"Race payload"
+//	@Param			racejp	body	apitypes.FISUpdateRaceJPExample	true	"Race payload"
 //	@Success		200		"Updated"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		404		{object}	swagger.NotFoundResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		404		{object}	apitypes.NotFoundResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/racejp [put]
 func (h *RaceJPHandler) UpdateRaceJP(w http.ResponseWriter, r *http.Request) {
@@ -328,7 +395,7 @@ func (h *RaceJPHandler) UpdateRaceJP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var in UpdateRaceJPInput
+	var in apitypes.FISUpdateRaceJPExample
 	if err := utils.ReadJSON(w, r, &in); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -366,12 +433,12 @@ func (h *RaceJPHandler) UpdateRaceJP(w http.ResponseWriter, r *http.Request) {
 //	@Produce		json
 //	@Param			id	query	int32	true	"Race ID"
 //	@Success		200	"Deleted"
-//	@Failure		400	{object}	swagger.ValidationErrorResponse
-//	@Failure		401	{object}	swagger.UnauthorizedResponse
-//	@Failure		403	{object}	swagger.ForbiddenResponse
-//	@Failure		404	{object}	swagger.NotFoundResponse
-//	@Failure		500	{object}	swagger.InternalServerErrorResponse
-//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400	{object}	apitypes.ValidationErrorResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		403	{object}	apitypes.ForbiddenResponse
+//	@Failure		404	{object}	apitypes.NotFoundResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/racejp [delete]
 func (h *RaceJPHandler) DeleteRaceJP(w http.ResponseWriter, r *http.Request) {
@@ -10,9 +10,12 @@ import (
 	"strings"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/export"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 type RaceNKHandler struct {
@@ -30,12 +33,12 @@ func NewRaceNKHandler(store fis.Racenk, cache *cache.Storage) *RaceNKHandler {
 //	@Tags		FIS - Season Discipline & Category Codes
 //	@Accept		json
 //	@Produce	json
-//	@Success	200	{object}	swagger.FISSeasonsNKResponse
-//	@Failure	400	{object}	swagger.ValidationErrorResponse
-//	@Failure	401	{object}	swagger.UnauthorizedResponse
-//	@Failure	403	{object}	swagger.ForbiddenResponse
-//	@Failure	500	{object}	swagger.InternalServerErrorResponse
-//	@Failure	503	{object}	swagger.ServiceUnavailableResponse
+//	@Success	200	{object}	apitypes.FISSeasonsNKResponse
+//	@Failure	400	{object}	apitypes.ValidationErrorResponse
+//	@Failure	401	{object}	apitypes.UnauthorizedResponse
+//	@Failure	403	{object}	apitypes.ForbiddenResponse
+//	@Failure	500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure	503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security	BearerAuth
 //	@Router		/fis/seasoncodeNK [get]
 func (h *RaceNKHandler) GetSeasonCodesNK(w http.ResponseWriter, r *http.Request) {
@@ -58,7 +61,7 @@ func (h *RaceNKHandler) GetSeasonCodesNK(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	body := map[string]any{"seasons": rows}
+	body := apitypes.FISSeasonsNKResponse{Seasons: rows}
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
 	utils.WriteJSON(w, http.StatusOK, body)
 }
@@ -69,12 +72,12 @@ func (h *RaceNKHandler) GetSeasonCodesNK(w http.ResponseWriter, r *http.Request)
 //	@Tags		FIS - Season Discipline & Category Codes
 //	@Accept		json
 //	@Produce	json
-//	@Success	200	{object}	swagger.FISDisciplinesNKResponse
-//	@Failure	400	{object}	swagger.ValidationErrorResponse
-//	@Failure	401	{object}	swagger.UnauthorizedResponse
-//	@Failure	403	{object}	swagger.ForbiddenResponse
-//	@Failure	500	{object}	swagger.InternalServerErrorResponse
-//	@Failure	503	{object}	swagger.ServiceUnavailableResponse
+//	@Success	200	{object}	apitypes.FISDisciplinesNKResponse
+//	@Failure	400	{object}	apitypes.ValidationErrorResponse
+//	@Failure	401	{object}	apitypes.UnauthorizedResponse
+//	@Failure	403	{object}	apitypes.ForbiddenResponse
+//	@Failure	500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure	503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security	BearerAuth
 //	@Router		/fis/disciplinecodeNK [get]
 func (h *RaceNKHandler) GetDisciplineCodesNK(w http.ResponseWriter, r *http.Request) {
@@ -97,7 +100,7 @@ func (h *RaceNKHandler) GetDisciplineCodesNK(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	body := map[string]any{"disciplines": rows}
+	body := apitypes.FISDisciplinesNKResponse{Disciplines: rows}
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
 	utils.WriteJSON(w, http.StatusOK, body)
 }
@@ -108,12 +111,12 @@ func (h *RaceNKHandler) GetDisciplineCodesNK(w http.ResponseWriter, r *http.Requ
 //	@Tags		FIS - Season Discipline & Category Codes
 //	@Accept		json
 //	@Produce	json
-//	@Success	200	{object}	swagger.FISCategoriesNKResponse
-//	@Failure	400	{object}	swagger.ValidationErrorResponse
-//	@Failure	401	{object}	swagger.UnauthorizedResponse
-//	@Failure	403	{object}	swagger.ForbiddenResponse
-//	@Failure	500	{object}	swagger.InternalServerErrorResponse
-//	@Failure	503	{object}	swagger.ServiceUnavailableResponse
+//	@Success	200	{object}	apitypes.FISCategoriesNKResponse
+//	@Failure	400	{object}	apitypes.ValidationErrorResponse
+//	@Failure	401	{object}	apitypes.UnauthorizedResponse
+//	@Failure	403	{object}	apitypes.ForbiddenResponse
+//	@Failure	500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure	503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security	BearerAuth
 //	@Router		/fis/catcodeNK [get]
 func (h *RaceNKHandler) GetCategoryCodesNK(w http.ResponseWriter, r *http.Request) {
@@ -136,7 +139,7 @@ func (h *RaceNKHandler) GetCategoryCodesNK(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	body := map[string]any{"categories": rows}
+	body := apitypes.FISCategoriesNKResponse{Categories: rows}
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
 	utils.WriteJSON(w, http.StatusOK, body)
 }
@@ -146,16 +149,21 @@ func (h *RaceNKHandler) GetCategoryCodesNK(w http.ResponseWriter, r *http.Reques
 //	@Summary	Get list of Nordic Combined races
 //	@Tags		FIS - Race Data
 //	@Accept		json
-//	@Produce	json
+//	@Produce	json,text/csv,application/x-ndjson,application/vnd.apache.parquet
 //	@Param		seasoncode		query		[]int32		false	"Season code (repeat or comma-separated)"
 //	@Param		disciplinecode	query		[]string	false	"Discipline code (repeat or comma-separated)"
 //	@Param		catcode			query		[]string	false	"Category code (repeat or comma-separated)"
-//	@Success	200				{object}	swagger.FISRacesNKResponse
-//	@Failure	400				{object}	swagger.ValidationErrorResponse
-//	@Failure	401				{object}	swagger.UnauthorizedResponse
-//	@Failure	403				{object}	swagger.ForbiddenResponse
-//	@Failure	500				{object}	swagger.InternalServerErrorResponse
-//	@Failure	503				{object}	swagger.ServiceUnavailableResponse
+//	@Param		limit			query		int			false	"Page size (1-1000); enables cursor pagination"
+//	@Param		cursor			query		string		false	"Opaque cursor from next_cursor of the previous page"
+//	@Param		fields			query		string		false	"Comma-separated columns to return, or a preset: summary, full (default)"
+//	@Param		format			query		string		false	"Response format: json (default), csv, ndjson, parquet; overrides Accept"
+//	@Param		stream			query		bool		false	"Stream the full listing row by row (implied for csv, ndjson and parquet; ignored with limit or cursor)"
+//	@Success	200				{object}	apitypes.FISRacesNKResponse
+//	@Failure	400				{object}	apitypes.ValidationErrorResponse
+//	@Failure	401				{object}	apitypes.UnauthorizedResponse
+//	@Failure	403				{object}	apitypes.ForbiddenResponse
+//	@Failure	500				{object}	apitypes.InternalServerErrorResponse
+//	@Failure	503				{object}	apitypes.ServiceUnavailableResponse
 //	@Security	BearerAuth
 //	@Router		/fis/racenk [get]
 func (h *RaceNKHandler) GetRacesNK(w http.ResponseWriter, r *http.Request) {
@@ -190,30 +198,89 @@ func (h *RaceNKHandler) GetRacesNK(w http.ResponseWriter, r *http.Request) {
 		seasons = append(seasons, int32(n))
 	}
 
-	cacheKey := fmt.Sprintf("%s:sc=%v:dc=%v:cc=%v", fisRaceNKListPrefix, seasons, discs, cats)
-	if h.cache != nil {
+	page, err := utils.ParsePage(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	fields, err := parseFields(r, raceNKFields)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	format, err := export.Negotiate(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	// full listings are streamed straight from the database
+	if !page.Paged() && (format != export.JSON || utils.WantsStream(r)) {
+		h.streamRacesNK(w, r, format, seasons, discs, cats, fields)
+		return
+	}
+
+	cacheKey := fmt.Sprintf("%s:sc=%v:dc=%v:cc=%v%s%s", fisRaceNKListPrefix, seasons, discs, cats, fieldsCacheKey(fields), page.CacheKey())
+	if format == export.JSON && h.cache != nil {
 		if raw, err := h.cache.Get(r.Context(), cacheKey); err == nil && raw != "" {
+			utils.SetNextLink(w, r, utils.CachedNextCursor(raw))
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(raw))
 			return
 		}
 	}
 
-	rows, err := h.store.GetRacesNK(r.Context(), seasons, discs, cats)
+	rows, next, err := h.store.GetRacesNK(r.Context(), seasons, discs, cats, fields, page)
 	if err != nil {
 		utils.InternalServerError(w, r, err)
 		return
 	}
 
-	out := make([]FISRaceNKFullResponse, 0, len(rows))
+	out := make([]apitypes.FISRaceNK, 0, len(rows))
 	for _, row := range rows {
 		out = append(out, FISRaceNKFullFromSqlc(row))
 	}
+	if format != export.JSON {
+		utils.SetNextLink(w, r, next)
+		if err := export.Write(w, format, "races_nk", out, fields); err != nil {
+			utils.InternalServerError(w, r, err)
+		}
+		return
+	}
+
+	races, err := projectFields(out, fields)
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
 
-	body := map[string]any{"races": out}
+	body := map[string]any{"races": races}
+	utils.AddPageInfo(body, page, next)
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
+	utils.SetNextLink(w, r, next)
 	utils.WriteJSON(w, http.StatusOK, body)
 }
 
+func (h *RaceNKHandler) streamRacesNK(w http.ResponseWriter, r *http.Request, format export.Format, seasons []int32, discs, cats, fields []string) {
+	stream, err := export.NewStream[apitypes.FISRaceNK](w, format, "races", "races_nk", fields)
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	err = h.store.StreamRacesNK(r.Context(), seasons, discs, cats, fields, func(row fissqlc.ARacenk) error {
+		return stream.Write(FISRaceNKFullFromSqlc(row))
+	})
+	if err == nil {
+		err = stream.Close()
+	}
+	if err != nil {
+		if stream.Started() {
+			utils.AbortStream(r, err)
+		}
+		utils.InternalServerError(w, r, err)
+	}
+}
+
 // GetLastRowRaceNK godoc
 //
 //	@Summary		Get last Nordic Combined race record
@@ -221,13 +288,13 @@ func (h *RaceNKHandler) GetRacesNK(w http.ResponseWriter, r *http.Request) {
 //	@Tags			FIS - Race Management – Nordic Combined
 //	@Accept			json
 //	@Produce		json
-//	@Success		200	{object}	swagger.FISLastRaceNKResponse
-//	@Failure		400	{object}	swagger.ValidationErrorResponse
-//	@Failure		401	{object}	swagger.UnauthorizedResponse
-//	@Failure		403	{object}	swagger.ForbiddenResponse
-//	@Failure		404	{object}	swagger.NotFoundResponse
-//	@Failure		500	{object}	swagger.InternalServerErrorResponse
-//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Success		200	{object}	apitypes.FISLastRaceNKResponse
+//	@Failure		400	{object}	apitypes.ValidationErrorResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		403	{object}	apitypes.ForbiddenResponse
+//	@Failure		404	{object}	apitypes.NotFoundResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/lastrow/racenk [get]
 func (h *RaceNKHandler) GetLastRowRaceNK(w http.ResponseWriter, r *http.Request) {
@@ -253,7 +320,7 @@ func (h *RaceNKHandler) GetLastRowRaceNK(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	body := map[string]any{"race": FISRaceNKFullFromSqlc(row)}
+	body := apitypes.FISLastRaceNKResponse{Race: FISRaceNKFullFromSqlc(row)}
 	cache.SetCacheJSON(r.Context(), h.cache, fisRaceNKLastRowPrefix, body, FISCacheTTL)
 	utils.WriteJSON(w, http.StatusOK, body)
 }
@@ -265,14 +332,14 @@ func (h *RaceNKHandler) GetLastRowRaceNK(w http.ResponseWriter, r *http.Request)
 //	@Tags			FIS - Race Management – Nordic Combined
 //	@Accept			json
 //	@Produce		json
-//	@Param			racenk	body	swagger.FISInsertRaceNKExample	true	"Race payload"
+//	@Param			racenk	body	apitypes.FISInsertRaceNKExample	true	"Race payload"
 //	@Success		201		"Created"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		409		{object}	swagger.ConflictResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		409		{object}	apitypes.ConflictResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/racenk [post]
 func (h *RaceNKHandler) InsertRaceNK(w http.ResponseWriter, r *http.Request) {
@@ -281,7 +348,7 @@ func (h *RaceNKHandler) InsertRaceNK(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var in InsertRaceNKInput
+	var in apitypes.FISInsertRaceNKExample
 	if err := utils.ReadJSON(w, r, &in); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -313,14 +380,14 @@ func (h *RaceNKHandler) InsertRaceNK(w http.ResponseWriter, r *http.Request) {
 //	@Tags			FIS - Race Management – Nordic Combined
 //	@Accept			json
 //	@Produce		json
-//	@Param			racenk	body	swagger.FISUpdateRaceNKExample	true	"Race payload"
+//	@Param			racenk	body	apitypes.FISUpdateRaceNKExample	true	"Race payload"
 //	@Success		200		"Updated"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		404		{object}	swagger.NotFoundResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		404		{object}	apitypes.NotFoundResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/racenk [put]
 func (h *RaceNKHandler) UpdateRaceNK(w http.ResponseWriter, r *http.Request) {
@@ -329,7 +396,7 @@ func (h *RaceNKHandler) UpdateRaceNK(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var in UpdateRaceNKInput
+	var in apitypes.FISUpdateRaceNKExample
 	if err := utils.ReadJSON(w, r, &in); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -367,12 +434,12 @@ func (h *RaceNKHandler) UpdateRaceNK(w http.ResponseWriter, r *http.Request) {
 //	@Produce		json
 //	@Param			id	query	int32	true	"Race ID"
 //	@Success		200	"Deleted"
-//	@Failure		400	{object}	swagger.ValidationErrorResponse
-//	@Failure		401	{object}	swagger.UnauthorizedResponse
-//	@Failure		403	{object}	swagger.ForbiddenResponse
-//	@Failure		404	{object}	swagger.NotFoundResponse
-//	@Failure		500	{object}	swagger.InternalServerErrorResponse
-//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400	{object}	apitypes.ValidationErrorResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		403	{object}	apitypes.ForbiddenResponse
+//	@Failure		404	{object}	apitypes.NotFoundResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/racenk [delete]
 func (h *RaceNKHandler) DeleteRaceNK(w http.ResponseWriter, r *http.Request) {
@@ -6,6 +6,7 @@ import (
 	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 type AthleteResultsCCQuery struct {
@@ -14,41 +15,7 @@ type AthleteResultsCCQuery struct {
 	CatCode        []string `form:"catcode"`
 }
 
-type InsertResultCCInput struct {
-	Recid          int32   `json:"recid" validate:"required"`
-	Raceid         *int32  `json:"raceid"`
-	Competitorid   *int32  `json:"competitorid"`
-	Status         *string `json:"status"`
-	Reason         *string `json:"reason"`
-	Position       *string `json:"position"`
-	Pf             *int32  `json:"pf"`
-	Status2        *string `json:"status2"`
-	Bib            *string `json:"bib"`
-	Bibcolor       *string `json:"bibcolor"`
-	Fiscode        *int32  `json:"fiscode"`
-	Competitorname *string `json:"competitorname"`
-	Nationcode     *string `json:"nationcode"`
-	Stage          *string `json:"stage"`
-	Level          *string `json:"level"`
-	Heat           *string `json:"heat"`
-	Timer1         *string `json:"timer1"`
-	Timer2         *string `json:"timer2"`
-	Timer3         *string `json:"timer3"`
-	Timetot        *string `json:"timetot"`
-	Valid          *string `json:"valid"`
-	Racepoints     *string `json:"racepoints"`
-	Cuppoints      *string `json:"cuppoints"`
-	Bonustime      *string `json:"bonustime"`
-	Bonuscuppoints *string `json:"bonuscuppoints"`
-	Version        *string `json:"version"`
-	Rg1            *string `json:"rg1"`
-	Rg2            *string `json:"rg2"`
-	Lastupdate     *string `json:"lastupdate"` // RFC3339
-}
-
-type UpdateResultCCInput = InsertResultCCInput
-
-func mapInsertResultCCInput(in InsertResultCCInput) (fis.InsertResultCCClean, error) {
+func mapInsertResultCCInput(in apitypes.FISInsertResultCCExample) (fis.InsertResultCCClean, error) {
 	var lup *time.Time
 	var err error
 
@@ -92,50 +59,18 @@ func mapInsertResultCCInput(in InsertResultCCInput) (fis.InsertResultCCClean, er
 	}, nil
 }
 
-func mapUpdateResultCCInput(in UpdateResultCCInput) (fis.UpdateResultCCClean, error) {
-	clean, err := mapInsertResultCCInput(InsertResultCCInput(in))
+func mapUpdateResultCCInput(in apitypes.FISUpdateResultCCExample) (fis.UpdateResultCCClean, error) {
+	clean, err := mapInsertResultCCInput(apitypes.FISInsertResultCCExample(in))
 	return fis.UpdateResultCCClean(clean), err
 }
 
-type FISResultCCFullResponse struct {
-	Recid          int32   `json:"recid"`
-	Raceid         *int32  `json:"raceid"`
-	Competitorid   *int32  `json:"competitorid"`
-	Status         *string `json:"status"`
-	Reason         *string `json:"reason"`
-	Position       *string `json:"position"`
-	Pf             *int32  `json:"pf"`
-	Status2        *string `json:"status2"`
-	Bib            *string `json:"bib"`
-	Bibcolor       *string `json:"bibcolor"`
-	Fiscode        *int32  `json:"fiscode"`
-	Competitorname *string `json:"competitorname"`
-	Nationcode     *string `json:"nationcode"`
-	Stage          *string `json:"stage"`
-	Level          *string `json:"level"`
-	Heat           *string `json:"heat"`
-	Timer1         *string `json:"timer1"`
-	Timer2         *string `json:"timer2"`
-	Timer3         *string `json:"timer3"`
-	Timetot        *string `json:"timetot"`
-	Valid          *string `json:"valid"`
-	Racepoints     *string `json:"racepoints"`
-	Cuppoints      *string `json:"cuppoints"`
-	Bonustime      *string `json:"bonustime"`
-	Bonuscuppoints *string `json:"bonuscuppoints"`
-	Version        *string `json:"version"`
-	Rg1            *string `json:"rg1"`
-	Rg2            *string `json:"rg2"`
-	Lastupdate     *string `json:"lastupdate"`
-}
-
-func FISResultCCFullFromSqlc(row fissqlc.AResultcc) FISResultCCFullResponse {
+func FISResultCCFullFromSqlc(row fissqlc.AResultcc) apitypes.FISResultCC {
 	var lastUpdateStr *string
 	if row.Lastupdate.Valid {
 		lastUpdateStr = utils.FormatTimestampPtr(row.Lastupdate)
 	}
 
-	return FISResultCCFullResponse{
+	return apitypes.FISResultCC{
 		Recid:          row.Recid,
 		Raceid:         utils.Int32PtrOrNil(row.Raceid),
 		Competitorid:   utils.Int32PtrOrNil(row.Competitorid),
@@ -168,25 +103,12 @@ func FISResultCCFullFromSqlc(row fissqlc.AResultcc) FISResultCCFullResponse {
 	}
 }
 
-type FISAthleteResultCCRow struct {
-	Recid          int32   `json:"recid"`
-	Raceid         *int32  `json:"raceid"`
-	Position       *string `json:"position"`
-	Timetot        *string `json:"timetot"`
-	Competitorid   *int32  `json:"competitorid"`
-	Racedate       *string `json:"racedate"`
-	Seasoncode     *int32  `json:"seasoncode"`
-	Disciplinecode *string `json:"disciplinecode"`
-	Catcode        *string `json:"catcode"`
-	Place          *string `json:"place"`
-}
-
-func FISAthleteResultCCFromSqlc(row fissqlc.GetAthleteResultsCCRow) FISAthleteResultCCRow {
+func FISAthleteResultCCFromSqlc(row fissqlc.GetAthleteResultsCCRow) apitypes.FISAthleteResultCC {
 	var raceDateStr *string
 	if row.Racedate.Valid {
 		raceDateStr = utils.FormatDatePtr(row.Racedate)
 	}
-	return FISAthleteResultCCRow{
+	return apitypes.FISAthleteResultCC{
 		Recid:          row.Recid,
 		Raceid:         utils.Int32PtrOrNil(row.Raceid),
 		Position:       utils.StringPtrOrNil(row.Position),
@@ -206,103 +128,7 @@ type AthleteResultsJPQuery struct {
 	CatCode        []string `form:"catcode"`
 }
 
-type InsertResultJPInput struct {
-	Recid          int32   `json:"recid" validate:"required"`
-	Raceid         *int32  `json:"raceid"`
-	Competitorid   *int32  `json:"competitorid"`
-	Status         *string `json:"status"`
-	Status2        *string `json:"status2"`
-	Position       *int32  `json:"position"`
-	Bib            *int32  `json:"bib"`
-	Fiscode        *int32  `json:"fiscode"`
-	Competitorname *string `json:"competitorname"`
-	Nationcode     *string `json:"nationcode"`
-	Level          *string `json:"level"`
-	Heat           *string `json:"heat"`
-	Stage          *string `json:"stage"`
-
-	J1r1     *string `json:"j1r1"`
-	J2r1     *string `json:"j2r1"`
-	J3r1     *string `json:"j3r1"`
-	J4r1     *string `json:"j4r1"`
-	J5r1     *string `json:"j5r1"`
-	Speedr1  *string `json:"speedr1"`
-	Distr1   *string `json:"distr1"`
-	Disptsr1 *string `json:"disptsr1"`
-	Judptsr1 *string `json:"judptsr1"`
-	Totrun1  *string `json:"totrun1"`
-	Posr1    *string `json:"posr1"`
-	Statusr1 *string `json:"statusr1"`
-
-	J1r2     *string `json:"j1r2"`
-	J2r2     *string `json:"j2r2"`
-	J3r2     *string `json:"j3r2"`
-	J4r2     *string `json:"j4r2"`
-	J5r2     *string `json:"j5r2"`
-	Speedr2  *string `json:"speedr2"`
-	Distr2   *string `json:"distr2"`
-	Disptsr2 *string `json:"disptsr2"`
-	Judptsr2 *string `json:"judptsr2"`
-	Totrun2  *string `json:"totrun2"`
-	Posr2    *string `json:"posr2"`
-	Statusr2 *string `json:"statusr2"`
-
-	J1r3     *string `json:"j1r3"`
-	J2r3     *string `json:"j2r3"`
-	J3r3     *string `json:"j3r3"`
-	J4r3     *string `json:"j4r3"`
-	J5r3     *string `json:"j5r3"`
-	Speedr3  *string `json:"speedr3"`
-	Distr3   *string `json:"distr3"`
-	Disptsr3 *string `json:"disptsr3"`
-	Judptsr3 *string `json:"judptsr3"`
-	Totrun3  *string `json:"totrun3"`
-	Posr3    *string `json:"posr3"`
-	Statusr3 *string `json:"statusr3"`
-
-	J1r4     *string `json:"j1r4"`
-	J2r4     *string `json:"j2r4"`
-	J3r4     *string `json:"j3r4"`
-	J4r4     *string `json:"j4r4"`
-	J5r4     *string `json:"j5r4"`
-	Speedr4  *string `json:"speedr4"`
-	Distr4   *string `json:"distr4"`
-	Disptsr4 *string `json:"disptsr4"`
-	Judptsr4 *string `json:"judptsr4"`
-
-	Gater1    *string `json:"gater1"`
-	Gater2    *string `json:"gater2"`
-	Gater3    *string `json:"gater3"`
-	Gater4    *string `json:"gater4"`
-	Gateptsr1 *string `json:"gateptsr1"`
-	Gateptsr2 *string `json:"gateptsr2"`
-	Gateptsr3 *string `json:"gateptsr3"`
-	Gateptsr4 *string `json:"gateptsr4"`
-
-	Windr1    *string `json:"windr1"`
-	Windr2    *string `json:"windr2"`
-	Windr3    *string `json:"windr3"`
-	Windr4    *string `json:"windr4"`
-	Windptsr1 *string `json:"windptsr1"`
-	Windptsr2 *string `json:"windptsr2"`
-	Windptsr3 *string `json:"windptsr3"`
-	Windptsr4 *string `json:"windptsr4"`
-
-	Reason     *string `json:"reason"`
-	Totrun4    *string `json:"totrun4"`
-	Tot        *string `json:"tot"`
-	Valid      *int32  `json:"valid"`
-	Racepoints *string `json:"racepoints"`
-	Cuppoints  *string `json:"cuppoints"`
-	Version    *string `json:"version"`
-	Lastupdate *string `json:"lastupdate"` // RFC3339
-	Posr4      *string `json:"posr4"`
-	Statusr4   *string `json:"statusr4"`
-}
-
-type UpdateResultJPInput = InsertResultJPInput
-
-func mapInsertResultJPInput(in InsertResultJPInput) (fis.InsertResultJPClean, error) {
+func mapInsertResultJPInput(in apitypes.FISInsertResultJPExample) (fis.InsertResultJPClean, error) {
 	var lup *time.Time
 	var err error
 
@@ -408,112 +234,18 @@ func mapInsertResultJPInput(in InsertResultJPInput) (fis.InsertResultJPClean, er
 	}, nil
 }
 
-func mapUpdateResultJPInput(in UpdateResultJPInput) (fis.UpdateResultJPClean, error) {
-	clean, err := mapInsertResultJPInput(InsertResultJPInput(in))
+func mapUpdateResultJPInput(in apitypes.FISUpdateResultJPExample) (fis.UpdateResultJPClean, error) {
+	clean, err := mapInsertResultJPInput(apitypes.FISInsertResultJPExample(in))
 	return fis.UpdateResultJPClean(clean), err
 }
 
-type FISResultJPFullResponse struct {
-	Recid          int32   `json:"recid"`
-	Raceid         *int32  `json:"raceid"`
-	Competitorid   *int32  `json:"competitorid"`
-	Status         *string `json:"status"`
-	Status2        *string `json:"status2"`
-	Position       *int32  `json:"position"`
-	Bib            *int32  `json:"bib"`
-	Fiscode        *int32  `json:"fiscode"`
-	Competitorname *string `json:"competitorname"`
-	Nationcode     *string `json:"nationcode"`
-	Level          *string `json:"level"`
-	Heat           *string `json:"heat"`
-	Stage          *string `json:"stage"`
-
-	J1r1     *string `json:"j1r1"`
-	J2r1     *string `json:"j2r1"`
-	J3r1     *string `json:"j3r1"`
-	J4r1     *string `json:"j4r1"`
-	J5r1     *string `json:"j5r1"`
-	Speedr1  *string `json:"speedr1"`
-	Distr1   *string `json:"distr1"`
-	Disptsr1 *string `json:"disptsr1"`
-	Judptsr1 *string `json:"judptsr1"`
-	Totrun1  *string `json:"totrun1"`
-	Posr1    *string `json:"posr1"`
-	Statusr1 *string `json:"statusr1"`
-
-	J1r2     *string `json:"j1r2"`
-	J2r2     *string `json:"j2r2"`
-	J3r2     *string `json:"j3r2"`
-	J4r2     *string `json:"j4r2"`
-	J5r2     *string `json:"j5r2"`
-	Speedr2  *string `json:"speedr2"`
-	Distr2   *string `json:"distr2"`
-	Disptsr2 *string `json:"disptsr2"`
-	Judptsr2 *string `json:"judptsr2"`
-	Totrun2  *string `json:"totrun2"`
-	Posr2    *string `json:"posr2"`
-	Statusr2 *string `json:"statusr2"`
-
-	J1r3     *string `json:"j1r3"`
-	J2r3     *string `json:"j2r3"`
-	J3r3     *string `json:"j3r3"`
-	J4r3     *string `json:"j4r3"`
-	J5r3     *string `json:"j5r3"`
-	Speedr3  *string `json:"speedr3"`
-	Distr3   *string `json:"distr3"`
-	Disptsr3 *string `json:"disptsr3"`
-	Judptsr3 *string `json:"judptsr3"`
-	Totrun3  *string `json:"totrun3"`
-	Posr3    *string `json:"posr3"`
-	Statusr3 *string `json:"statusr3"`
-
-	J1r4     *string `json:"j1r4"`
-	J2r4     *string `json:"j2r4"`
-	J3r4     *string `json:"j3r4"`
-	J4r4     *string `json:"j4r4"`
-	J5r4     *string `json:"j5r4"`
-	Speedr4  *string `json:"speedr4"`
-	Distr4   *string `json:"distr4"`
-	Disptsr4 *string `json:"disptsr4"`
-	Judptsr4 *string `json:"judptsr4"`
-
-	Gater1    *string `json:"gater1"`
-	Gater2    *string `json:"gater2"`
-	Gater3    *string `json:"gater3"`
-	Gater4    *string `json:"gater4"`
-	Gateptsr1 *string `json:"gateptsr1"`
-	Gateptsr2 *string `json:"gateptsr2"`
-	Gateptsr3 *string `json:"gateptsr3"`
-	Gateptsr4 *string `json:"gateptsr4"`
-
-	Windr1    *string `json:"windr1"`
-	Windr2    *string `json:"windr2"`
-	Windr3    *string `json:"windr3"`
-	Windr4    *string `json:"windr4"`
-	Windptsr1 *string `json:"windptsr1"`
-	Windptsr2 *string `json:"windptsr2"`
-	Windptsr3 *string `json:"windptsr3"`
-	Windptsr4 *string `json:"windptsr4"`
-
-	Reason     *string `json:"reason"`
-	Totrun4    *string `json:"totrun4"`
-	Tot        *string `json:"tot"`
-	Valid      *int32  `json:"valid"`
-	Racepoints *string `json:"racepoints"`
-	Cuppoints  *string `json:"cuppoints"`
-	Version    *string `json:"version"`
-	Lastupdate *string `json:"lastupdate"`
-	Posr4      *string `json:"posr4"`
-	Statusr4   *string `json:"statusr4"`
-}
-
-func FISResultJPFullFromSqlc(row fissqlc.AResultjp) FISResultJPFullResponse {
+func FISResultJPFullFromSqlc(row fissqlc.AResultjp) apitypes.FISResultJP {
 	var lastUpdateStr *string
 	if row.Lastupdate.Valid {
 		lastUpdateStr = utils.FormatTimestampPtr(row.Lastupdate)
 	}
 
-	return FISResultJPFullResponse{
+	return apitypes.FISResultJP{
 		Recid:          row.Recid,
 		Raceid:         utils.Int32PtrOrNil(row.Raceid),
 		Competitorid:   utils.Int32PtrOrNil(row.Competitorid),
@@ -608,42 +340,13 @@ func FISResultJPFullFromSqlc(row fissqlc.AResultjp) FISResultJPFullResponse {
 	}
 }
 
-type FISAthleteResultJPRow struct {
-	Raceid         *int32  `json:"raceid"`
-	Position       *int32  `json:"position"`
-	Racedate       *string `json:"racedate"`
-	Seasoncode     *int32  `json:"seasoncode"`
-	Disciplinecode *string `json:"disciplinecode"`
-	Catcode        *string `json:"catcode"`
-	Place          *string `json:"place"`
-
-	Posr1     *string `json:"posr1"`
-	Speedr1   *string `json:"speedr1"`
-	Distr1    *string `json:"distr1"`
-	Judptsr1  *string `json:"judptsr1"`
-	Windr1    *string `json:"windr1"`
-	Windptsr1 *string `json:"windptsr1"`
-	Gater1    *string `json:"gater1"`
-
-	Posr2     *string `json:"posr2"`
-	Speedr2   *string `json:"speedr2"`
-	Distr2    *string `json:"distr2"`
-	Judptsr2  *string `json:"judptsr2"`
-	Windr2    *string `json:"windr2"`
-	Windptsr2 *string `json:"windptsr2"`
-	Gater2    *string `json:"gater2"`
-
-	Totrun1 *string `json:"totrun1"`
-	Totrun2 *string `json:"totrun2"`
-}
-
-func FISAthleteResultJPFromSqlc(row fissqlc.GetAthleteResultsJPRow) FISAthleteResultJPRow {
+func FISAthleteResultJPFromSqlc(row fissqlc.GetAthleteResultsJPRow) apitypes.FISAthleteResultJP {
 	var raceDateStr *string
 	if row.Racedate.Valid {
 		raceDateStr = utils.FormatDatePtr(row.Racedate)
 	}
 
-	return FISAthleteResultJPRow{
+	return apitypes.FISAthleteResultJP{
 		Raceid:         utils.Int32PtrOrNil(row.Raceid),
 		Position:       utils.Int32PtrOrNil(row.Position),
 		Racedate:       raceDateStr,
@@ -679,79 +382,7 @@ type AthleteResultsNKQuery struct {
 	CatCode        []string `form:"catcode"`
 }
 
-type InsertResultNKInput struct {
-	Recid          int32   `json:"recid" validate:"required"`
-	Raceid         *int32  `json:"raceid"`
-	Competitorid   *int32  `json:"competitorid"`
-	Status         *string `json:"status"`
-	Status2        *string `json:"status2"`
-	Reason         *string `json:"reason"`
-	Position       *int32  `json:"position"`
-	Pf             *int32  `json:"pf"`
-	Bib            *int32  `json:"bib"`
-	Bibcolor       *string `json:"bibcolor"`
-	Fiscode        *int32  `json:"fiscode"`
-	Competitorname *string `json:"competitorname"`
-	Nationcode     *string `json:"nationcode"`
-	Level          *string `json:"level"`
-	Heat           *string `json:"heat"`
-	Stage          *string `json:"stage"`
-
-	J1r1      *string `json:"j1r1"`
-	J2r1      *string `json:"j2r1"`
-	J3r1      *string `json:"j3r1"`
-	J4r1      *string `json:"j4r1"`
-	J5r1      *string `json:"j5r1"`
-	Speedr1   *string `json:"speedr1"`
-	Distr1    *string `json:"distr1"`
-	Disptsr1  *string `json:"disptsr1"`
-	Judptsr1  *string `json:"judptsr1"`
-	Gater1    *string `json:"gater1"`
-	Gateptsr1 *string `json:"gateptsr1"`
-	Windr1    *string `json:"windr1"`
-	Windptsr1 *string `json:"windptsr1"`
-	Totrun1   *string `json:"totrun1"`
-	Posr1     *string `json:"posr1"`
-	Statusr1  *string `json:"statusr1"`
-
-	J1r2      *string `json:"j1r2"`
-	J2r2      *string `json:"j2r2"`
-	J3r2      *string `json:"j3r2"`
-	J4r2      *string `json:"j4r2"`
-	J5r2      *string `json:"j5r2"`
-	Speedr2   *string `json:"speedr2"`
-	Distr2    *string `json:"distr2"`
-	Disptsr2  *string `json:"disptsr2"`
-	Judptsr2  *string `json:"judptsr2"`
-	Gater2    *string `json:"gater2"`
-	Gateptsr2 *string `json:"gateptsr2"`
-	Windr2    *string `json:"windr2"`
-	Windptsr2 *string `json:"windptsr2"`
-	Totrun2   *string `json:"totrun2"`
-	Posr2     *string `json:"posr2"`
-	Statusr2  *string `json:"statusr2"`
-
-	Pointsjump *string `json:"pointsjump"`
-	Behindjump *string `json:"behindjump"`
-	Posjump    *string `json:"posjump"`
-	Timecc     *string `json:"timecc"`
-	Timeccint  *string `json:"timeccint"`
-	Poscc      *string `json:"poscc"`
-	Starttime  *string `json:"starttime"`
-	Statuscc   *string `json:"statuscc"`
-	Totbehind  *string `json:"totbehind"`
-	Timetot    *string `json:"timetot"`
-	Timetotint *int32  `json:"timetotint"`
-	Valid      *int32  `json:"valid"`
-	Racepoints *string `json:"racepoints"`
-	Cuppoints  *string `json:"cuppoints"`
-	Version    *string `json:"version"`
-	Lastupdate *string `json:"lastupdate"` // RFC3339
-}
-
-type UpdateResultNKInput = InsertResultNKInput
-
-func mapInsertResultNKInput(in InsertResultNKInput) (fis.InsertResultNKClean, error) {
+func mapInsertResultNKInput(in apitypes.FISInsertResultNKExample) (fis.InsertResultNKClean, error) {
 	var lup *time.Time
 	var err error
 
@@ -833,88 +464,18 @@ func mapInsertResultNKInput(in InsertResultNKInput) (fis.InsertResultNKClean, er
 	}, nil
 }
 
-func mapUpdateResultNKInput(in UpdateResultNKInput) (fis.UpdateResultNKClean, error) {
-	clean, err := mapInsertResultNKInput(InsertResultNKInput(in))
+func mapUpdateResultNKInput(in apitypes.FISUpdateResultNKExample) (fis.UpdateResultNKClean, error) {
+	clean, err := mapInsertResultNKInput(apitypes.FISInsertResultNKExample(in))
 	return fis.UpdateResultNKClean(clean), err
 }
 
-type FISResultNKFullResponse struct {
-	Recid          int32   `json:"recid"`
-	Raceid         *int32  `json:"raceid"`
-	Competitorid   *int32  `json:"competitorid"`
-	Status         *string `json:"status"`
-	Status2        *string `json:"status2"`
-	Reason         *string `json:"reason"`
-	Position       *int32  `json:"position"`
-	Pf             *int32  `json:"pf"`
-	Bib            *int32  `json:"bib"`
-	Bibcolor       *string `json:"bibcolor"`
-	Fiscode        *int32  `json:"fiscode"`
-	Competitorname *string `json:"competitorname"`
-	Nationcode     *string `json:"nationcode"`
-	Level          *string `json:"level"`
-	Heat           *string `json:"heat"`
-	Stage          *string `json:"stage"`
-
-	J1r1      *string `json:"j1r1"`
-	J2r1      *string `json:"j2r1"`
-	J3r1      *string `json:"j3r1"`
-	J4r1      *string `json:"j4r1"`
-	J5r1      *string `json:"j5r1"`
-	Speedr1   *string `json:"speedr1"`
-	Distr1    *string `json:"distr1"`
-	Disptsr1  *string `json:"disptsr1"`
-	Judptsr1  *string `json:"judptsr1"`
-	Gater1    *string `json:"gater1"`
-	Gateptsr1 *string `json:"gateptsr1"`
-	Windr1    *string `json:"windr1"`
-	Windptsr1 *string `json:"windptsr1"`
-	Totrun1   *string `json:"totrun1"`
-	Posr1     *string `json:"posr1"`
-	Statusr1  *string `json:"statusr1"`
-
-	J1r2      *string `json:"j1r2"`
-	J2r2      *string `json:"j2r2"`
-	J3r2      *string `json:"j3r2"`
-	J4r2      *string `json:"j4r2"`
-	J5r2      *string `json:"j5r2"`
-	Speedr2   *string `json:"speedr2"`
-	Distr2    *string `json:"distr2"`
-	Disptsr2  *string `json:"disptsr2"`
-	Judptsr2  *string `json:"judptsr2"`
-	Gater2    *string `json:"gater2"`
-	Gateptsr2 *string `json:"gateptsr2"`
-	Windr2    *string `json:"windr2"`
-	Windptsr2 *string `json:"windptsr2"`
-	Totrun2   *string `json:"totrun2"`
-	Posr2     *string `json:"posr2"`
-	Statusr2  *string `json:"statusr2"`
-
-	Pointsjump *string `json:"pointsjump"`
-	Behindjump *string `json:"behindjump"`
-	Posjump    *string `json:"posjump"`
-	Timecc     *string `json:"timecc"`
-	Timeccint  *string `json:"timeccint"`
-	Poscc      *string `json:"poscc"`
-	Starttime  *string `json:"starttime"`
-	Statuscc   *string `json:"statuscc"`
-	Totbehind  *string `json:"totbehind"`
-	Timetot    *string `json:"timetot"`
-	Timetotint *int32  `json:"timetotint"`
-	Valid      *int32  `json:"valid"`
-	Racepoints *string `json:"racepoints"`
-	Cuppoints  *string `json:"cuppoints"`
-	Version    *string `json:"version"`
-	Lastupdate *string `json:"lastupdate"`
-}
-
-func FISResultNKFullFromSqlc(row fissqlc.AResultnk) FISResultNKFullResponse {
+func FISResultNKFullFromSqlc(row fissqlc.AResultnk) apitypes.FISResultNK {
 	var lastUpdateStr *string
 	if row.Lastupdate.Valid {
 		lastUpdateStr = utils.FormatTimestampPtr(row.Lastupdate)
 	}
 
-	return FISResultNKFullResponse{
+	return apitypes.FISResultNK{
 		Recid:          row.Recid,
 		Raceid:         utils.Int32PtrOrNil(row.Raceid),
 		Competitorid:   utils.Int32PtrOrNil(row.Competitorid),
@@ -985,40 +546,13 @@ func FISResultNKFullFromSqlc(row fissqlc.AResultnk) FISResultNKFullResponse {
 	}
 }
 
-type FISAthleteResultNKRow struct {
-	Recid          int32   `json:"recid"`
-	Raceid         *int32  `json:"raceid"`
-	Position       *int32  `json:"position"`
-	Racedate       *string `json:"racedate"`
-	Seasoncode     *int32  `json:"seasoncode"`
-	Distance       *string `json:"distance"`
-	Hill           *int32  `json:"hill"`
-	Disciplinecode *string `json:"disciplinecode"`
-	Catcode        *string `json:"catcode"`
-	Place          *string `json:"place"`
-
-	Posr1     *string `json:"posr1"`
-	Speedr1   *string `json:"speedr1"`
-	Distr1    *string `json:"distr1"`
-	Judptsr1  *string `json:"judptsr1"`
-	Windr1    *string `json:"windr1"`
-	Windptsr1 *string `json:"windptsr1"`
-	Gater1    *string `json:"gater1"`
-	Totrun1   *string `json:"totrun1"`
-
-	Poscc      *string `json:"poscc"`
-	Timetot    *string `json:"timetot"`
-	Timetotint *int32  `json:"timetotint"`
-	Pointsjump *string `json:"pointsjump"`
-}
-
-func FISAthleteResultNKFromSqlc(row fissqlc.GetAthleteResultsNKRow) FISAthleteResultNKRow {
+func FISAthleteResultNKFromSqlc(row fissqlc.GetAthleteResultsNKRow) apitypes.FISAthleteResultNK {
 	var raceDateStr *string
 	if row.Racedate.Valid {
 		raceDateStr = utils.FormatDatePtr(row.Racedate)
 	}
 
-	return FISAthleteResultNKRow{
+	return apitypes.FISAthleteResultNK{
 		Recid:          row.Recid,
 		Raceid:         utils.Int32PtrOrNil(row.Raceid),
 		Position:       utils.Int32PtrOrNil(row.Position),
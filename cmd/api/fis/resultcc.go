@@ -10,9 +10,12 @@ import (
 	"strings"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
+	"github.com/DeRuina/KUHA-REST-API/internal/export"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 type ResultCCHandler struct {
@@ -41,13 +44,13 @@ func parseListParam(r *http.Request, key string) []string {
 //	@Tags			FIS - Result Management – Cross-Country
 //	@Accept			json
 //	@Produce		json
-//	@Success		200	{object}	swagger.FISLastResultCCResponse
-//	@Failure		400	{object}	swagger.ValidationErrorResponse
-//	@Failure		401	{object}	swagger.UnauthorizedResponse
-//	@Failure		403	{object}	swagger.ForbiddenResponse
-//	@Failure		404	{object}	swagger.NotFoundResponse
-//	@Failure		500	{object}	swagger.InternalServerErrorResponse
-//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Success		200	{object}	apitypes.FISLastResultCCResponse
+//	@Failure		400	{object}	apitypes.ValidationErrorResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		403	{object}	apitypes.ForbiddenResponse
+//	@Failure		404	{object}	apitypes.NotFoundResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/lastrow/resultcc [get]
 func (h *ResultCCHandler) GetLastRowResultCC(w http.ResponseWriter, r *http.Request) {
@@ -73,7 +76,7 @@ func (h *ResultCCHandler) GetLastRowResultCC(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	body := map[string]any{"result": FISResultCCFullFromSqlc(row)}
+	body := apitypes.FISLastResultCCResponse{Result: FISResultCCFullFromSqlc(row)}
 	cache.SetCacheJSON(r.Context(), h.cache, fisResultCCLastRowPrefix, body, FISCacheTTL)
 	utils.WriteJSON(w, http.StatusOK, body)
 }
@@ -85,14 +88,14 @@ func (h *ResultCCHandler) GetLastRowResultCC(w http.ResponseWriter, r *http.Requ
 //	@Tags			FIS - Result Management – Cross-Country
 //	@Accept			json
 //	@Produce		json
-//	@Param			resultcc	body	swagger.FISInsertResultCCExample	true	"Result payload"
+//	@Param			resultcc	body	apitypes.FISInsertResultCCExample	true	"Result payload"
 //	@Success		201			"Created"
-//	@Failure		400			{object}	swagger.ValidationErrorResponse
-//	@Failure		401			{object}	swagger.UnauthorizedResponse
-//	@Failure		403			{object}	swagger.ForbiddenResponse
-//	@Failure		409			{object}	swagger.ConflictResponse
-//	@Failure		500			{object}	swagger.InternalServerErrorResponse
-//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400			{object}	apitypes.ValidationErrorResponse
+//	@Failure		401			{object}	apitypes.UnauthorizedResponse
+//	@Failure		403			{object}	apitypes.ForbiddenResponse
+//	@Failure		409			{object}	apitypes.ConflictResponse
+//	@Failure		500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/resultcc [post]
 func (h *ResultCCHandler) InsertResultCC(w http.ResponseWriter, r *http.Request) {
@@ -101,7 +104,7 @@ func (h *ResultCCHandler) InsertResultCC(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	var in InsertResultCCInput
+	var in apitypes.FISInsertResultCCExample
 	if err := utils.ReadJSON(w, r, &in); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -123,6 +126,7 @@ func (h *ResultCCHandler) InsertResultCC(w http.ResponseWriter, r *http.Request)
 	}
 
 	invalidateResultCC(r.Context(), h.cache, clean.Recid)
+	events.Publish(events.FISResultUpdated, events.FISResult{Sector: "cc", RecID: clean.Recid, RaceID: clean.Raceid, CompetitorID: clean.Competitorid})
 	w.WriteHeader(http.StatusCreated)
 }
 
@@ -133,14 +137,14 @@ func (h *ResultCCHandler) InsertResultCC(w http.ResponseWriter, r *http.Request)
 //	@Tags			FIS - Result Management – Cross-Country
 //	@Accept			json
 //	@Produce		json
-//	@Param			resultcc	body	swagger.FISUpdateResultCCExample	true	"Result payload"
+//	@Param			resultcc	body	apitypes.FISUpdateResultCCExample	true	"Result payload"
 //	@Success		200			"Updated"
-//	@Failure		400			{object}	swagger.ValidationErrorResponse
-//	@Failure		401			{object}	swagger.UnauthorizedResponse
-//	@Failure		403			{object}	swagger.ForbiddenResponse
-//	@Failure		404			{object}	swagger.NotFoundResponse
-//	@Failure		500			{object}	swagger.InternalServerErrorResponse
-//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400			{object}	apitypes.ValidationErrorResponse
+//	@Failure		401			{object}	apitypes.UnauthorizedResponse
+//	@Failure		403			{object}	apitypes.ForbiddenResponse
+//	@Failure		404			{object}	apitypes.NotFoundResponse
+//	@Failure		500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/resultcc [put]
 func (h *ResultCCHandler) UpdateResultCC(w http.ResponseWriter, r *http.Request) {
@@ -149,7 +153,7 @@ func (h *ResultCCHandler) UpdateResultCC(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	var in UpdateResultCCInput
+	var in apitypes.FISUpdateResultCCExample
 	if err := utils.ReadJSON(w, r, &in); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -175,6 +179,7 @@ func (h *ResultCCHandler) UpdateResultCC(w http.ResponseWriter, r *http.Request)
 	}
 
 	invalidateResultCC(r.Context(), h.cache, clean.Recid)
+	events.Publish(events.FISResultUpdated, events.FISResult{Sector: "cc", RecID: clean.Recid, RaceID: clean.Raceid, CompetitorID: clean.Competitorid})
 	w.WriteHeader(http.StatusOK)
 }
 
@@ -187,12 +192,12 @@ func (h *ResultCCHandler) UpdateResultCC(w http.ResponseWriter, r *http.Request)
 //	@Produce		json
 //	@Param			id	query	int32	true	"Result RecID"
 //	@Success		200	"Deleted"
-//	@Failure		400	{object}	swagger.ValidationErrorResponse
-//	@Failure		401	{object}	swagger.UnauthorizedResponse
-//	@Failure		403	{object}	swagger.ForbiddenResponse
-//	@Failure		404	{object}	swagger.NotFoundResponse
-//	@Failure		500	{object}	swagger.InternalServerErrorResponse
-//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400	{object}	apitypes.ValidationErrorResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		403	{object}	apitypes.ForbiddenResponse
+//	@Failure		404	{object}	apitypes.NotFoundResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/resultcc [delete]
 func (h *ResultCCHandler) DeleteResultCC(w http.ResponseWriter, r *http.Request) {
@@ -230,14 +235,16 @@ func (h *ResultCCHandler) DeleteResultCC(w http.ResponseWriter, r *http.Request)
 //	@Summary	Get results for a Cross-Country race
 //	@Tags		FIS - Race Results
 //	@Accept		json
-//	@Produce	json
+//	@Produce	json,text/csv,application/x-ndjson,application/vnd.apache.parquet
 //	@Param		raceid	query		int32	true	"Race ID"
-//	@Success	200		{object}	swagger.FISRaceResultsCCResponse
-//	@Failure	400		{object}	swagger.ValidationErrorResponse
-//	@Failure	401		{object}	swagger.UnauthorizedResponse
-//	@Failure	403		{object}	swagger.ForbiddenResponse
-//	@Failure	500		{object}	swagger.InternalServerErrorResponse
-//	@Failure	503		{object}	swagger.ServiceUnavailableResponse
+//	@Param		fields	query		string	false	"Comma-separated columns to return, or a preset: summary, full (default)"
+//	@Param		format	query		string	false	"Response format: json (default), csv, ndjson, parquet; overrides Accept"
+//	@Success	200		{object}	apitypes.FISRaceResultsCCResponse
+//	@Failure	400		{object}	apitypes.ValidationErrorResponse
+//	@Failure	401		{object}	apitypes.UnauthorizedResponse
+//	@Failure	403		{object}	apitypes.ForbiddenResponse
+//	@Failure	500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure	503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security	BearerAuth
 //	@Router		/fis/resultcc [get]
 func (h *ResultCCHandler) GetRaceResultsCC(w http.ResponseWriter, r *http.Request) {
@@ -245,7 +252,7 @@ func (h *ResultCCHandler) GetRaceResultsCC(w http.ResponseWriter, r *http.Reques
 		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
 		return
 	}
-	if err := utils.ValidateParams(r, []string{"raceid"}); err != nil {
+	if err := utils.ValidateParams(r, []string{"raceid", "fields", export.FormatParam}); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
@@ -257,15 +264,26 @@ func (h *ResultCCHandler) GetRaceResultsCC(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	cacheKey := fmt.Sprintf("%s:race=%d", fisResultCCRacePrefix, raceID)
-	if h.cache != nil {
+	fields, err := parseFields(r, resultCCFields)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	format, err := export.Negotiate(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	cacheKey := fmt.Sprintf("%s:race=%d%s", fisResultCCRacePrefix, raceID, fieldsCacheKey(fields))
+	if format == export.JSON && h.cache != nil {
 		if raw, err := h.cache.Get(r.Context(), cacheKey); err == nil && raw != "" {
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(raw))
 			return
 		}
 	}
 
-	rows, err := h.store.GetRaceResultsCCByRaceID(r.Context(), raceID)
+	rows, err := h.store.GetRaceResultsCCByRaceID(r.Context(), raceID, fields)
 	if err != nil {
 		utils.HandleDatabaseError(w, r, err)
 		return
@@ -276,12 +294,24 @@ func (h *ResultCCHandler) GetRaceResultsCC(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	out := make([]FISResultCCFullResponse, 0, len(rows))
+	out := make([]apitypes.FISResultCC, 0, len(rows))
 	for _, row := range rows {
 		out = append(out, FISResultCCFullFromSqlc(row))
 	}
+	if format != export.JSON {
+		if err := export.Write(w, format, "results_cc", out, fields); err != nil {
+			utils.InternalServerError(w, r, err)
+		}
+		return
+	}
 
-	body := map[string]any{"results": out}
+	results, err := projectFields(out, fields)
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	body := map[string]any{"results": results}
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
 	utils.WriteJSON(w, http.StatusOK, body)
 }
@@ -296,13 +326,13 @@ func (h *ResultCCHandler) GetRaceResultsCC(w http.ResponseWriter, r *http.Reques
 //	@Param		seasoncode		query		[]int32		false	"Season code (repeat or comma-separated)"
 //	@Param		disciplinecode	query		[]string	false	"Discipline code (repeat or comma-separated)"
 //	@Param		catcode			query		[]string	false	"Category code (repeat or comma-separated)"
-//	@Success	200				{object}	swagger.FISAthleteResultsCCResponse
-//	@Failure	400				{object}	swagger.ValidationErrorResponse
-//	@Failure	401				{object}	swagger.UnauthorizedResponse
-//	@Failure	403				{object}	swagger.ForbiddenResponse
-//	@Failure	404				{object}	swagger.NotFoundResponse
-//	@Failure	500				{object}	swagger.InternalServerErrorResponse
-//	@Failure	503				{object}	swagger.ServiceUnavailableResponse
+//	@Success	200				{object}	apitypes.FISAthleteResultsCCResponse
+//	@Failure	400				{object}	apitypes.ValidationErrorResponse
+//	@Failure	401				{object}	apitypes.UnauthorizedResponse
+//	@Failure	403				{object}	apitypes.ForbiddenResponse
+//	@Failure	404				{object}	apitypes.NotFoundResponse
+//	@Failure	500				{object}	apitypes.InternalServerErrorResponse
+//	@Failure	503				{object}	apitypes.ServiceUnavailableResponse
 //	@Security	BearerAuth
 //	@Router		/fis/resultathletecc [get]
 func (h *ResultCCHandler) GetAthleteResultsCC(w http.ResponseWriter, r *http.Request) {
@@ -366,7 +396,7 @@ func (h *ResultCCHandler) GetAthleteResultsCC(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	out := make([]FISAthleteResultCCRow, 0, len(rows))
+	out := make([]apitypes.FISAthleteResultCC, 0, len(rows))
 	for _, row := range rows {
 		out = append(out, FISAthleteResultCCFromSqlc(row))
 	}
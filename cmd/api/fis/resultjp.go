@@ -10,9 +10,12 @@ import (
 	"strings"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
+	"github.com/DeRuina/KUHA-REST-API/internal/export"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 type ResultJPHandler struct {
@@ -32,13 +35,13 @@ func NewResultJPHandler(store fis.Resultjp, competitors fis.Competitors, cache *
 //	@Tags			FIS - Result Management – Ski Jumping
 //	@Accept			json
 //	@Produce		json
-//	@Success		200	{object}	swagger.FISLastResultJPResponse
-//	@Failure		400	{object}	swagger.ValidationErrorResponse
-//	@Failure		401	{object}	swagger.UnauthorizedResponse
-//	@Failure		403	{object}	swagger.ForbiddenResponse
-//	@Failure		404	{object}	swagger.NotFoundResponse
-//	@Failure		500	{object}	swagger.InternalServerErrorResponse
-//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Success		200	{object}	apitypes.FISLastResultJPResponse
+//	@Failure		400	{object}	apitypes.ValidationErrorResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		403	{object}	apitypes.ForbiddenResponse
+//	@Failure		404	{object}	apitypes.NotFoundResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/lastrow/resultjp [get]
 func (h *ResultJPHandler) GetLastRowResultJP(w http.ResponseWriter, r *http.Request) {
@@ -64,7 +67,7 @@ func (h *ResultJPHandler) GetLastRowResultJP(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	body := map[string]any{"result": FISResultJPFullFromSqlc(row)}
+	body := apitypes.FISLastResultJPResponse{Result: FISResultJPFullFromSqlc(row)}
 	cache.SetCacheJSON(r.Context(), h.cache, fisResultJPLastRowPrefix, body, FISCacheTTL)
 	utils.WriteJSON(w, http.StatusOK, body)
 }
@@ -76,14 +79,14 @@ func (h *ResultJPHandler) GetLastRowResultJP(w http.ResponseWriter, r *http.Requ
 //	@Tags			FIS - Result Management – Ski Jumping
 //	@Accept			json
 //	@Produce		json
-//	@Param			resultjp	body	swagger.FISInsertResultJPExample	true	"Result payload"
+//	@Param			resultjp	body	apitypes.FISInsertResultJPExample	true	"Result payload"
 //	@Success		201			"Created"
-//	@Failure		400			{object}	swagger.ValidationErrorResponse
-//	@Failure		401			{object}	swagger.UnauthorizedResponse
-//	@Failure		403			{object}	swagger.ForbiddenResponse
-//	@Failure		409			{object}	swagger.ConflictResponse
-//	@Failure		500			{object}	swagger.InternalServerErrorResponse
-//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400			{object}	apitypes.ValidationErrorResponse
+//	@Failure		401			{object}	apitypes.UnauthorizedResponse
+//	@Failure		403			{object}	apitypes.ForbiddenResponse
+//	@Failure		409			{object}	apitypes.ConflictResponse
+//	@Failure		500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/resultjp [post]
 func (h *ResultJPHandler) InsertResultJP(w http.ResponseWriter, r *http.Request) {
@@ -92,7 +95,7 @@ func (h *ResultJPHandler) InsertResultJP(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	var in InsertResultJPInput
+	var in apitypes.FISInsertResultJPExample
 	if err := utils.ReadJSON(w, r, &in); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -114,6 +117,7 @@ func (h *ResultJPHandler) InsertResultJP(w http.ResponseWriter, r *http.Request)
 	}
 
 	invalidateResultJP(r.Context(), h.cache, clean.Recid)
+	events.Publish(events.FISResultUpdated, events.FISResult{Sector: "jp", RecID: clean.Recid, RaceID: clean.Raceid, CompetitorID: clean.Competitorid})
 	w.WriteHeader(http.StatusCreated)
 }
 
@@ -124,14 +128,14 @@ func (h *ResultJPHandler) InsertResultJP(w http.ResponseWriter, r *http.Request)
 //	@Tags			FIS - Result Management – Ski Jumping
 //	@Accept			json
 //	@Produce		json
-//	@Param			resultjp	body	swagger.FISUpdateResultJPExample	true	"Result payload"
+//	@Param			resultjp	body	apitypes.FISUpdateResultJPExample	true	"Result payload"
 //	@Success		200			"Updated"
-//	@Failure		400			{object}	swagger.ValidationErrorResponse
-//	@Failure		401			{object}	swagger.UnauthorizedResponse
-//	@Failure		403			{object}	swagger.ForbiddenResponse
-//	@Failure		404			{object}	swagger.NotFoundResponse
-//	@Failure		500			{object}	swagger.InternalServerErrorResponse
-//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400			{object}	apitypes.ValidationErrorResponse
+//	@Failure		401			{object}	apitypes.UnauthorizedResponse
+//	@Failure		403			{object}	apitypes.ForbiddenResponse
+//	@Failure		404			{object}	apitypes.NotFoundResponse
+//	@Failure		500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/resultjp [put]
 func (h *ResultJPHandler) UpdateResultJP(w http.ResponseWriter, r *http.Request) {
@@ -140,7 +144,7 @@ func (h *ResultJPHandler) UpdateResultJP(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	var in UpdateResultJPInput
+	var in apitypes.FISUpdateResultJPExample
 	if err := utils.ReadJSON(w, r, &in); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -166,6 +170,7 @@ func (h *ResultJPHandler) UpdateResultJP(w http.ResponseWriter, r *http.Request)
 	}
 
 	invalidateResultJP(r.Context(), h.cache, clean.Recid)
+	events.Publish(events.FISResultUpdated, events.FISResult{Sector: "jp", RecID: clean.Recid, RaceID: clean.Raceid, CompetitorID: clean.Competitorid})
 	w.WriteHeader(http.StatusOK)
 }
 
@@ -178,12 +183,12 @@ func (h *ResultJPHandler) UpdateResultJP(w http.ResponseWriter, r *http.Request)
 //	@Produce		json
 //	@Param			id	query	int32	true	"Result RecID"
 //	@Success		200	"Deleted"
-//	@Failure		400	{object}	swagger.ValidationErrorResponse
-//	@Failure		401	{object}	swagger.UnauthorizedResponse
-//	@Failure		403	{object}	swagger.ForbiddenResponse
-//	@Failure		404	{object}	swagger.NotFoundResponse
-//	@Failure		500	{object}	swagger.InternalServerErrorResponse
-//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400	{object}	apitypes.ValidationErrorResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		403	{object}	apitypes.ForbiddenResponse
+//	@Failure		404	{object}	apitypes.NotFoundResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/resultjp [delete]
 func (h *ResultJPHandler) DeleteResultJP(w http.ResponseWriter, r *http.Request) {
@@ -221,14 +226,16 @@ func (h *ResultJPHandler) DeleteResultJP(w http.ResponseWriter, r *http.Request)
 //	@Summary	Get results for a Ski Jumping race
 //	@Tags		FIS - Race Results
 //	@Accept		json
-//	@Produce	json
+//	@Produce	json,text/csv,application/x-ndjson,application/vnd.apache.parquet
 //	@Param		raceid	query		int32	true	"Race ID"
-//	@Success	200		{object}	swagger.FISRaceResultsJPResponse
-//	@Failure	400		{object}	swagger.ValidationErrorResponse
-//	@Failure	401		{object}	swagger.UnauthorizedResponse
-//	@Failure	403		{object}	swagger.ForbiddenResponse
-//	@Failure	500		{object}	swagger.InternalServerErrorResponse
-//	@Failure	503		{object}	swagger.ServiceUnavailableResponse
+//	@Param		fields	query		string	false	"Comma-separated columns to return, or a preset: summary, full (default)"
+//	@Param		format	query		string	false	"Response format: json (default), csv, ndjson, parquet; overrides Accept"
+//	@Success	200		{object}	apitypes.FISRaceResultsJPResponse
+//	@Failure	400		{object}	apitypes.ValidationErrorResponse
+//	@Failure	401		{object}	apitypes.UnauthorizedResponse
+//	@Failure	403		{object}	apitypes.ForbiddenResponse
+//	@Failure	500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure	503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security	BearerAuth
 //	@Router		/fis/resultjp [get]
 func (h *ResultJPHandler) GetRaceResultsJP(w http.ResponseWriter, r *http.Request) {
@@ -236,7 +243,7 @@ func (h *ResultJPHandler) GetRaceResultsJP(w http.ResponseWriter, r *http.Reques
 		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
 		return
 	}
-	if err := utils.ValidateParams(r, []string{"raceid"}); err != nil {
+	if err := utils.ValidateParams(r, []string{"raceid", "fields", export.FormatParam}); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
@@ -248,15 +255,26 @@ func (h *ResultJPHandler) GetRaceResultsJP(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	cacheKey := fmt.Sprintf("%s:race=%d", fisResultJPRacePrefix, raceID)
-	if h.cache != nil {
+	fields, err := parseFields(r, resultJPFields)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	format, err := export.Negotiate(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	cacheKey := fmt.Sprintf("%s:race=%d%s", fisResultJPRacePrefix, raceID, fieldsCacheKey(fields))
+	if format == export.JSON && h.cache != nil {
 		if raw, err := h.cache.Get(r.Context(), cacheKey); err == nil && raw != "" {
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(raw))
 			return
 		}
 	}
 
-	rows, err := h.store.GetRaceResultsJPByRaceID(r.Context(), raceID)
+	rows, err := h.store.GetRaceResultsJPByRaceID(r.Context(), raceID, fields)
 	if err != nil {
 		utils.HandleDatabaseError(w, r, err)
 		return
@@ -267,12 +285,24 @@ func (h *ResultJPHandler) GetRaceResultsJP(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	out := make([]FISResultJPFullResponse, 0, len(rows))
+	out := make([]apitypes.FISResultJP, 0, len(rows))
 	for _, row := range rows {
 		out = append(out, FISResultJPFullFromSqlc(row))
 	}
+	if format != export.JSON {
+		if err := export.Write(w, format, "results_jp", out, fields); err != nil {
+			utils.InternalServerError(w, r, err)
+		}
+		return
+	}
 
-	body := map[string]any{"results": out}
+	results, err := projectFields(out, fields)
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	body := map[string]any{"results": results}
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
 	utils.WriteJSON(w, http.StatusOK, body)
 }
@@ -287,13 +317,13 @@ func (h *ResultJPHandler) GetRaceResultsJP(w http.ResponseWriter, r *http.Reques
 //	@Param		seasoncode		query		[]int32		false	"Season code (repeat or comma-separated)"
 //	@Param		disciplinecode	query		[]string	false	"Discipline code (repeat or comma-separated)"
 //	@Param		catcode			query		[]string	false	"Category code (repeat or comma-separated)"
-//	@Success	200				{object}	swagger.FISAthleteResultsJPResponse
-//	@Failure	400				{object}	swagger.ValidationErrorResponse
-//	@Failure	401				{object}	swagger.UnauthorizedResponse
-//	@Failure	403				{object}	swagger.ForbiddenResponse
-//	@Failure	404				{object}	swagger.NotFoundResponse
-//	@Failure	500				{object}	swagger.InternalServerErrorResponse
-//	@Failure	503				{object}	swagger.ServiceUnavailableResponse
+//	@Success	200				{object}	apitypes.FISAthleteResultsJPResponse
+//	@Failure	400				{object}	apitypes.ValidationErrorResponse
+//	@Failure	401				{object}	apitypes.UnauthorizedResponse
+//	@Failure	403				{object}	apitypes.ForbiddenResponse
+//	@Failure	404				{object}	apitypes.NotFoundResponse
+//	@Failure	500				{object}	apitypes.InternalServerErrorResponse
+//	@Failure	503				{object}	apitypes.ServiceUnavailableResponse
 //	@Security	BearerAuth
 //	@Router		/fis/resultathletejp [get]
 func (h *ResultJPHandler) GetAthleteResultsJP(w http.ResponseWriter, r *http.Request) {
@@ -357,7 +387,7 @@ func (h *ResultJPHandler) GetAthleteResultsJP(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	out := make([]FISAthleteResultJPRow, 0, len(rows))
+	out := make([]apitypes.FISAthleteResultJP, 0, len(rows))
 	for _, row := range rows {
 		out = append(out, FISAthleteResultJPFromSqlc(row))
 	}
@@ -10,9 +10,12 @@ import (
 	"strings"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
+	"github.com/DeRuina/KUHA-REST-API/internal/export"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 type ResultNKHandler struct {
@@ -32,13 +35,13 @@ func NewResultNKHandler(store fis.Resultnk, competitors fis.Competitors, cache *
 //	@Tags			FIS - Result Management – Nordic Combined
 //	@Accept			json
 //	@Produce		json
-//	@Success		200	{object}	swagger.FISLastResultNKResponse
-//	@Failure		400	{object}	swagger.ValidationErrorResponse
-//	@Failure		401	{object}	swagger.UnauthorizedResponse
-//	@Failure		403	{object}	swagger.ForbiddenResponse
-//	@Failure		404	{object}	swagger.NotFoundResponse
-//	@Failure		500	{object}	swagger.InternalServerErrorResponse
-//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Success		200	{object}	apitypes.FISLastResultNKResponse
+//	@Failure		400	{object}	apitypes.ValidationErrorResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		403	{object}	apitypes.ForbiddenResponse
+//	@Failure		404	{object}	apitypes.NotFoundResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/lastrow/resultnk [get]
 func (h *ResultNKHandler) GetLastRowResultNK(w http.ResponseWriter, r *http.Request) {
@@ -64,7 +67,7 @@ func (h *ResultNKHandler) GetLastRowResultNK(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	body := map[string]any{"result": FISResultNKFullFromSqlc(row)}
+	body := apitypes.FISLastResultNKResponse{Result: FISResultNKFullFromSqlc(row)}
 	cache.SetCacheJSON(r.Context(), h.cache, fisResultNKLastRowPrefix, body, FISCacheTTL)
 	utils.WriteJSON(w, http.StatusOK, body)
 }
@@ -76,14 +79,14 @@ func (h *ResultNKHandler) GetLastRowResultNK(w http.ResponseWriter, r *http.Requ
 //	@Tags			FIS - Result Management – Nordic Combined
 //	@Accept			json
 //	@Produce		json
-//	@Param			resultnk	body	swagger.FISInsertResultNKExample	true	"Result payload"
+//	@Param			resultnk	body	apitypes.FISInsertResultNKExample	true	"Result payload"
 //	@Success		201			"Created"
-//	@Failure		400			{object}	swagger.ValidationErrorResponse
-//	@Failure		401			{object}	swagger.UnauthorizedResponse
-//	@Failure		403			{object}	swagger.ForbiddenResponse
-//	@Failure		409			{object}	swagger.ConflictResponse
-//	@Failure		500			{object}	swagger.InternalServerErrorResponse
-//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400			{object}	apitypes.ValidationErrorResponse
+//	@Failure		401			{object}	apitypes.UnauthorizedResponse
+//	@Failure		403			{object}	apitypes.ForbiddenResponse
+//	@Failure		409			{object}	apitypes.ConflictResponse
+//	@Failure		500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/resultnk [post]
 func (h *ResultNKHandler) InsertResultNK(w http.ResponseWriter, r *http.Request) {
@@ -92,7 +95,7 @@ func (h *ResultNKHandler) InsertResultNK(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	var in InsertResultNKInput
+	var in apitypes.FISInsertResultNKExample
 	if err := utils.ReadJSON(w, r, &in); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -114,6 +117,7 @@ func (h *ResultNKHandler) InsertResultNK(w http.ResponseWriter, r *http.Request)
 	}
 
 	invalidateResultNK(r.Context(), h.cache, clean.Recid)
+	events.Publish(events.FISResultUpdated, events.FISResult{Sector: "nk", RecID: clean.Recid, RaceID: clean.Raceid, CompetitorID: clean.Competitorid})
 	w.WriteHeader(http.StatusCreated)
 }
 
@@ -124,14 +128,14 @@ func (h *ResultNKHandler) InsertResultNK(w http.ResponseWriter, r *http.Request)
 //	@Tags			FIS - Result Management – Nordic Combined
 //	@Accept			json
 //	@Produce		json
-//	@Param			resultnk	body	swagger.FISUpdateResultNKExample	true	"Result payload"
+//	@Param			resultnk	body	apitypes.FISUpdateResultNKExample	true	"Result payload"
 //	@Success		200			"Updated"
-//	@Failure		400			{object}	swagger.ValidationErrorResponse
-//	@Failure		401			{object}	swagger.UnauthorizedResponse
-//	@Failure		403			{object}	swagger.ForbiddenResponse
-//	@Failure		404			{object}	swagger.NotFoundResponse
-//	@Failure		500			{object}	swagger.InternalServerErrorResponse
-//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400			{object}	apitypes.ValidationErrorResponse
+//	@Failure		401			{object}	apitypes.UnauthorizedResponse
+//	@Failure		403			{object}	apitypes.ForbiddenResponse
+//	@Failure		404			{object}	apitypes.NotFoundResponse
+//	@Failure		500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/resultnk [put]
 func (h *ResultNKHandler) UpdateResultNK(w http.ResponseWriter, r *http.Request) {
@@ -140,7 +144,7 @@ func (h *ResultNKHandler) UpdateResultNK(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	var in UpdateResultNKInput
+	var in apitypes.FISUpdateResultNKExample
 	if err := utils.ReadJSON(w, r, &in); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -166,6 +170,7 @@ func (h *ResultNKHandler) UpdateResultNK(w http.ResponseWriter, r *http.Request)
 	}
 
 	invalidateResultNK(r.Context(), h.cache, clean.Recid)
+	events.Publish(events.FISResultUpdated, events.FISResult{Sector: "nk", RecID: clean.Recid, RaceID: clean.Raceid, CompetitorID: clean.Competitorid})
 	w.WriteHeader(http.StatusOK)
 }
 
@@ -178,12 +183,12 @@ func (h *ResultNKHandler) UpdateResultNK(w http.ResponseWriter, r *http.Request)
 //	@Produce		json
 //	@Param			id	query	int32	true	"Result RecID"
 //	@Success		200	"Deleted"
-//	@Failure		400	{object}	swagger.ValidationErrorResponse
-//	@Failure		401	{object}	swagger.UnauthorizedResponse
-//	@Failure		403	{object}	swagger.ForbiddenResponse
-//	@Failure		404	{object}	swagger.NotFoundResponse
-//	@Failure		500	{object}	swagger.InternalServerErrorResponse
-//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400	{object}	apitypes.ValidationErrorResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		403	{object}	apitypes.ForbiddenResponse
+//	@Failure		404	{object}	apitypes.NotFoundResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/fis/resultnk [delete]
 func (h *ResultNKHandler) DeleteResultNK(w http.ResponseWriter, r *http.Request) {
@@ -221,14 +226,16 @@ func (h *ResultNKHandler) DeleteResultNK(w http.ResponseWriter, r *http.Request)
 //	@Summary	Get results for a Nordic Combined race
 //	@Tags		FIS - Race Results
 //	@Accept		json
-//	@Produce	json
+//	@Produce	json,text/csv,application/x-ndjson,application/vnd.apache.parquet
 //	@Param		raceid	query		int32	true	"Race ID"
-//	@Success	200		{object}	swagger.FISRaceResultsNKResponse
-//	@Failure	400		{object}	swagger.ValidationErrorResponse
-//	@Failure	401		{object}	swagger.UnauthorizedResponse
-//	@Failure	403		{object}	swagger.ForbiddenResponse
-//	@Failure	500		{object}	swagger.InternalServerErrorResponse
-//	@Failure	503		{object}	swagger.ServiceUnavailableResponse
+//	@Param		fields	query		string	false	"Comma-separated columns to return, or a preset: summary, full (default)"
+//	@Param		format	query		string	false	"Response format: json (default), csv, ndjson, parquet; overrides Accept"
+//	@Success	200		{object}	apitypes.FISRaceResultsNKResponse
+//	@Failure	400		{object}	apitypes.ValidationErrorResponse
+//	@Failure	401		{object}	apitypes.UnauthorizedResponse
+//	@Failure	403		{object}	apitypes.ForbiddenResponse
+//	@Failure	500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure	503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security	BearerAuth
 //	@Router		/fis/resultnk [get]
 func (h *ResultNKHandler) GetRaceResultsNK(w http.ResponseWriter, r *http.Request) {
@@ -236,7 +243,7 @@ func (h *ResultNKHandler) GetRaceResultsNK(w http.ResponseWriter, r *http.Reques
 		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
 		return
 	}
-	if err := utils.ValidateParams(r, []string{"raceid"}); err != nil {
+	if err := utils.ValidateParams(r, []string{"raceid", "fields", export.FormatParam}); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
@@ -248,15 +255,26 @@ func (h *ResultNKHandler) GetRaceResultsNK(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	cacheKey := fmt.Sprintf("%s:race=%d", fisResultNKRacePrefix, raceID)
-	if h.cache != nil {
+	fields, err := parseFields(r, resultNKFields)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	format, err := export.Negotiate(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	cacheKey := fmt.Sprintf("%s:race=%d%s", fisResultNKRacePrefix, raceID, fieldsCacheKey(fields))
+	if format == export.JSON && h.cache != nil {
 		if raw, err := h.cache.Get(r.Context(), cacheKey); err == nil && raw != "" {
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(raw))
 			return
 		}
 	}
 
-	rows, err := h.store.GetRaceResultsNKByRaceID(r.Context(), raceID)
+	rows, err := h.store.GetRaceResultsNKByRaceID(r.Context(), raceID, fields)
 	if err != nil {
 		utils.HandleDatabaseError(w, r, err)
 		return
@@ -267,12 +285,24 @@ func (h *ResultNKHandler) GetRaceResultsNK(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	out := make([]FISResultNKFullResponse, 0, len(rows))
+	out := make([]apitypes.FISResultNK, 0, len(rows))
 	for _, row := range rows {
 		out = append(out, FISResultNKFullFromSqlc(row))
 	}
+	if format != export.JSON {
+		if err := export.Write(w, format, "results_nk", out, fields); err != nil {
+			utils.InternalServerError(w, r, err)
+		}
+		return
+	}
 
-	body := map[string]any{"results": out}
+	results, err := projectFields(out, fields)
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	body := map[string]any{"results": results}
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
 	utils.WriteJSON(w, http.StatusOK, body)
 }
@@ -287,13 +317,13 @@ func (h *ResultNKHandler) GetRaceResultsNK(w http.ResponseWriter, r *http.Reques
 //	@Param		seasoncode		query		[]int32		false	"Season code (repeat or comma-separated)"
 //	@Param		disciplinecode	query		[]string	false	"Discipline code (repeat or comma-separated)"
 //	@Param		catcode			query		[]string	false	"Category code (repeat or comma-separated)"
-//	@Success	200				{object}	swagger.FISAthleteResultsNKResponse
-//	@Failure	400				{object}	swagger.ValidationErrorResponse
-//	@Failure	401				{object}	swagger.UnauthorizedResponse
-//	@Failure	403				{object}	swagger.ForbiddenResponse
-//	@Failure	404				{object}	swagger.NotFoundResponse
-//	@Failure	500				{object}	swagger.InternalServerErrorResponse
-//	@Failure	503				{object}	swagger.ServiceUnavailableResponse
+//	@Success	200				{object}	apitypes.FISAthleteResultsNKResponse
+//	@Failure	400				{object}	apitypes.ValidationErrorResponse
+//	@Failure	401				{object}	apitypes.UnauthorizedResponse
+//	@Failure	403				{object}	apitypes.ForbiddenResponse
+//	@Failure	404				{object}	apitypes.NotFoundResponse
+//	@Failure	500				{object}	apitypes.InternalServerErrorResponse
+//	@Failure	503				{object}	apitypes.ServiceUnavailableResponse
 //	@Security	BearerAuth
 //	@Router		/fis/resultathletenk [get]
 func (h *ResultNKHandler) GetAthleteResultsNK(w http.ResponseWriter, r *http.Request) {
@@ -357,7 +387,7 @@ func (h *ResultNKHandler) GetAthleteResultsNK(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	out := make([]FISAthleteResultNKRow, 0, len(rows))
+	out := make([]apitypes.FISAthleteResultNK, 0, len(rows))
 	for _, row := range rows {
 		out = append(out, FISAthleteResultNKFromSqlc(row))
 	}
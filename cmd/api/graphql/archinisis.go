@@ -0,0 +1,64 @@
+package graphqlapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/store"
+)
+
+type archinisisAthlete struct {
+	SporttiID string
+	store     *store.Storage
+}
+
+func (r *resolver) ArchinisisAthlete(ctx context.Context, args struct{ SporttiID string }) (*archinisisAthlete, error) {
+	if err := authorize(ctx, "/v1/archinisis"); err != nil {
+		return nil, err
+	}
+	if r.store.ARCHINISIS == nil {
+		return nil, unavailable("archinisis")
+	}
+	return &archinisisAthlete{SporttiID: args.SporttiID, store: r.store}, nil
+}
+
+func (a *archinisisAthlete) Data(ctx context.Context) (*JSON, error) {
+	if err := authorize(ctx, "/v1/archinisis/data"); err != nil {
+		return nil, err
+	}
+	data, err := a.store.ARCHINISIS.Data().GetDataBySporttiID(ctx, a.SporttiID)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && data == nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, storeError(ctx, err)
+	}
+	return jsonPtr(data)
+}
+
+func (a *archinisisAthlete) ReportSessions(ctx context.Context) (*[]int32, error) {
+	if err := authorize(ctx, "/v1/archinisis/race-report/sessions"); err != nil {
+		return nil, err
+	}
+	sessions, err := a.store.ARCHINISIS.Data().GetRaceReportSessions(ctx, a.SporttiID)
+	if err != nil {
+		return nil, storeError(ctx, err)
+	}
+	sessions = emptyIfNil(sessions)
+	return &sessions, nil
+}
+
+func (a *archinisisAthlete) RaceReport(ctx context.Context, args struct{ SessionID int32 }) (*string, error) {
+	if err := authorize(ctx, "/v1/archinisis/race-report"); err != nil {
+		return nil, err
+	}
+	html, err := a.store.ARCHINISIS.Data().GetRaceReport(ctx, a.SporttiID, args.SessionID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, storeError(ctx, err)
+	}
+	return &html, nil
+}
@@ -0,0 +1,436 @@
+package graphqlapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	fisapi "github.com/DeRuina/KUHA-REST-API/cmd/api/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/store"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
+)
+
+// most races one races query may ask for
+const maxRaceIDs = 100
+
+type race struct {
+	Sector         string
+	Raceid         int32
+	Seasoncode     *int32
+	Disciplinecode *string
+	Catcode        *string
+	Gender         *string
+	Racedate       *string
+	Description    *string
+	Place          *string
+	Nationcode     *string
+	details        any
+}
+
+type raceResult struct {
+	Recid          int32
+	Raceid         *int32
+	Competitorid   *int32
+	Fiscode        *int32
+	Competitorname *string
+	Nationcode     *string
+	Status         *string
+	Position       *string
+	Bib            *string
+	sector         string
+	details        any
+}
+
+type athleteResult struct {
+	Raceid         *int32
+	Position       *string
+	Racedate       *string
+	Seasoncode     *int32
+	Disciplinecode *string
+	Catcode        *string
+	Place          *string
+	sector         string
+	details        any
+}
+
+type competitor struct {
+	apitypes.FISCompetitorFull
+	store *store.Storage
+}
+
+type competitorPage struct {
+	Items      []*competitor
+	NextCursor *string
+}
+
+type fisAthlete struct {
+	Fiscode   *int32
+	Firstname *string
+	Lastname  *string
+	store     *store.Storage
+	sector    func() (string, error)
+}
+
+type resultFilter struct {
+	Seasons     *[]int32
+	Disciplines *[]string
+	Categories  *[]string
+}
+
+func (r *resolver) Race(ctx context.Context, args struct {
+	Sector string
+	ID     int32
+}) (*race, error) {
+	if err := authorize(ctx, racePath(args.Sector)); err != nil {
+		return nil, err
+	}
+	if r.store.FIS == nil {
+		return nil, unavailable("fis")
+	}
+	rc, err := loadersFrom(ctx).races.Load(ctx, sectorKey{args.Sector, args.ID})()
+	if err != nil {
+		return nil, storeError(ctx, err)
+	}
+	return rc, nil
+}
+
+func (r *resolver) Races(ctx context.Context, args struct {
+	Sector string
+	IDs    []int32
+}) (*[]*race, error) {
+	if err := authorize(ctx, racePath(args.Sector)); err != nil {
+		return nil, err
+	}
+	if r.store.FIS == nil {
+		return nil, unavailable("fis")
+	}
+	if len(args.IDs) > maxRaceIDs {
+		return nil, badRequest(fmt.Errorf("ids must not contain more than %d race IDs", maxRaceIDs))
+	}
+
+	keys := make([]sectorKey, len(args.IDs))
+	for i, id := range args.IDs {
+		keys[i] = sectorKey{args.Sector, id}
+	}
+	races, errs := loadersFrom(ctx).races.LoadMany(ctx, keys)()
+	for _, err := range errs {
+		if err != nil {
+			return nil, storeError(ctx, err)
+		}
+	}
+	return &races, nil
+}
+
+func (r *resolver) Competitors(ctx context.Context, args struct {
+	Sector *string
+	Nation *string
+	Gender *string
+	First  int32
+	After  *string
+}) (*competitorPage, error) {
+	if err := authorize(ctx, "/v1/fis/competitor/search"); err != nil {
+		return nil, err
+	}
+	if r.store.FIS == nil {
+		return nil, unavailable("fis")
+	}
+	p, err := page(args.First, args.After)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, next, err := r.store.FIS.Competitors().SearchCompetitors(ctx, args.Nation, args.Sector, args.Gender, nil, nil, p)
+	if err != nil {
+		return nil, storeError(ctx, err)
+	}
+	out := &competitorPage{Items: make([]*competitor, 0, len(rows)), NextCursor: nextCursor(next)}
+	for _, row := range rows {
+		out.Items = append(out.Items, &competitor{FISCompetitorFull: fisapi.FISCompetitorFullFromSqlc(row), store: r.store})
+	}
+	return out, nil
+}
+
+func (r *resolver) FisAthletes(ctx context.Context, args struct{ SporttiID int32 }) (*[]*fisAthlete, error) {
+	if err := authorize(ctx, "/v1/fis/fiscode"); err != nil {
+		return nil, err
+	}
+	if r.store.FIS == nil {
+		return nil, unavailable("fis")
+	}
+
+	rows, err := r.store.FIS.Athlete().GetAthletesBySporttiID(ctx, args.SporttiID)
+	if err != nil {
+		return nil, storeError(ctx, err)
+	}
+	out := make([]*fisAthlete, 0, len(rows))
+	for _, row := range rows {
+		a := &fisAthlete{Fiscode: row.Fiscode, Firstname: row.Firstname, Lastname: row.Lastname, store: r.store}
+		// sector and results both need it; look it up once
+		a.sector = sync.OnceValues(func() (string, error) {
+			if a.Fiscode == nil {
+				return "", nil
+			}
+			s, err := r.store.FIS.Competitors().GetSectorcodeByFiscode(ctx, *a.Fiscode)
+			if errors.Is(err, sql.ErrNoRows) {
+				return "", nil
+			}
+			return s, err
+		})
+		out = append(out, a)
+	}
+	return &out, nil
+}
+
+func (rc *race) Details() (JSON, error) {
+	return newJSON(rc.details)
+}
+
+func (rc *race) Results(ctx context.Context) (*[]*raceResult, error) {
+	if err := authorize(ctx, resultPath(rc.Sector)); err != nil {
+		return nil, err
+	}
+	results, err := loadersFrom(ctx).results.Load(ctx, sectorKey{rc.Sector, rc.Raceid})()
+	if err != nil {
+		return nil, storeError(ctx, err)
+	}
+	return &results, nil
+}
+
+func (rr *raceResult) Details() (JSON, error) {
+	return newJSON(rr.details)
+}
+
+func (rr *raceResult) Race(ctx context.Context) (*race, error) {
+	return loadRace(ctx, rr.sector, rr.Raceid)
+}
+
+func (ar *athleteResult) Details() (JSON, error) {
+	return newJSON(ar.details)
+}
+
+func (ar *athleteResult) Race(ctx context.Context) (*race, error) {
+	return loadRace(ctx, ar.sector, ar.Raceid)
+}
+
+func (c *competitor) Results(ctx context.Context, args resultFilter) (*[]*athleteResult, error) {
+	if c.Sectorcode == nil {
+		return &[]*athleteResult{}, nil
+	}
+	return athleteResults(ctx, c.store, *c.Sectorcode, c.Competitorid, args)
+}
+
+func (a *fisAthlete) Sector(ctx context.Context) (*string, error) {
+	if err := authorize(ctx, "/v1/fis/competitor/sectorcode"); err != nil {
+		return nil, err
+	}
+	s, err := a.sector()
+	if err != nil {
+		return nil, storeError(ctx, err)
+	}
+	if !isSector(s) {
+		return nil, nil
+	}
+	return &s, nil
+}
+
+func (a *fisAthlete) Results(ctx context.Context, args resultFilter) (*[]*athleteResult, error) {
+	s, err := a.sector()
+	if err != nil {
+		return nil, storeError(ctx, err)
+	}
+	if !isSector(s) {
+		return &[]*athleteResult{}, nil
+	}
+	if err := authorize(ctx, athleteResultPath(s)); err != nil {
+		return nil, err
+	}
+
+	var competitorID int32
+	switch s {
+	case "CC":
+		competitorID, err = a.store.FIS.Competitors().GetCompetitorIDByFiscodeCC(ctx, *a.Fiscode)
+	case "JP":
+		competitorID, err = a.store.FIS.Competitors().GetCompetitorIDByFiscodeJP(ctx, *a.Fiscode)
+	case "NK":
+		competitorID, err = a.store.FIS.Competitors().GetCompetitorIDByFiscodeNK(ctx, *a.Fiscode)
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return &[]*athleteResult{}, nil
+	}
+	if err != nil {
+		return nil, storeError(ctx, err)
+	}
+	return athleteResults(ctx, a.store, s, competitorID, args)
+}
+
+func loadRace(ctx context.Context, sector string, raceID *int32) (*race, error) {
+	if raceID == nil {
+		return nil, nil
+	}
+	if err := authorize(ctx, racePath(sector)); err != nil {
+		return nil, err
+	}
+	rc, err := loadersFrom(ctx).races.Load(ctx, sectorKey{sector, *raceID})()
+	if err != nil {
+		return nil, storeError(ctx, err)
+	}
+	return rc, nil
+}
+
+func athleteResults(ctx context.Context, s *store.Storage, sector string, competitorID int32, f resultFilter) (*[]*athleteResult, error) {
+	if err := authorize(ctx, athleteResultPath(sector)); err != nil {
+		return nil, err
+	}
+	var (
+		seasons     []int32
+		disciplines []string
+		cats        []string
+	)
+	if f.Seasons != nil {
+		seasons = *f.Seasons
+	}
+	if f.Disciplines != nil {
+		disciplines = *f.Disciplines
+	}
+	if f.Categories != nil {
+		cats = *f.Categories
+	}
+
+	out := []*athleteResult{}
+	switch sector {
+	case "CC":
+		rows, err := s.FIS.ResultCC().GetAthleteResultsCC(ctx, competitorID, seasons, disciplines, cats)
+		if err != nil {
+			return nil, storeError(ctx, err)
+		}
+		for _, row := range rows {
+			d := fisapi.FISAthleteResultCCFromSqlc(row)
+			out = append(out, &athleteResult{Raceid: d.Raceid, Position: d.Position, Racedate: d.Racedate, Seasoncode: d.Seasoncode,
+				Disciplinecode: d.Disciplinecode, Catcode: d.Catcode, Place: d.Place, sector: sector, details: d})
+		}
+	case "JP":
+		rows, err := s.FIS.ResultJP().GetAthleteResultsJP(ctx, competitorID, seasons, disciplines, cats)
+		if err != nil {
+			return nil, storeError(ctx, err)
+		}
+		for _, row := range rows {
+			d := fisapi.FISAthleteResultJPFromSqlc(row)
+			out = append(out, &athleteResult{Raceid: d.Raceid, Position: itoa(d.Position), Racedate: d.Racedate, Seasoncode: d.Seasoncode,
+				Disciplinecode: d.Disciplinecode, Catcode: d.Catcode, Place: d.Place, sector: sector, details: d})
+		}
+	case "NK":
+		rows, err := s.FIS.ResultNK().GetAthleteResultsNK(ctx, competitorID, seasons, disciplines, cats)
+		if err != nil {
+			return nil, storeError(ctx, err)
+		}
+		for _, row := range rows {
+			d := fisapi.FISAthleteResultNKFromSqlc(row)
+			out = append(out, &athleteResult{Raceid: d.Raceid, Position: itoa(d.Position), Racedate: d.Racedate, Seasoncode: d.Seasoncode,
+				Disciplinecode: d.Disciplinecode, Catcode: d.Catcode, Place: d.Place, sector: sector, details: d})
+		}
+	}
+	return &out, nil
+}
+
+func racesByIDs(ctx context.Context, s *store.Storage, sector string, ids []int32) ([]*race, error) {
+	var out []*race
+	switch sector {
+	case "CC":
+		rows, err := s.FIS.RaceCC().GetRacesByIDsCC(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			d := fisapi.FISRaceCCFullFromSqlc(row)
+			out = append(out, &race{Sector: sector, Raceid: d.Raceid, Seasoncode: d.Seasoncode, Disciplinecode: d.Disciplinecode, Catcode: d.Catcode,
+				Gender: d.Gender, Racedate: d.Racedate, Description: d.Description, Place: d.Place, Nationcode: d.Nationcode, details: d})
+		}
+	case "JP":
+		rows, err := s.FIS.RaceJP().GetRacesByIDsJP(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			d := fisapi.FISRaceJPFullFromSqlc(row)
+			out = append(out, &race{Sector: sector, Raceid: d.Raceid, Seasoncode: d.Seasoncode, Disciplinecode: d.Disciplinecode, Catcode: d.Catcode,
+				Gender: d.Gender, Racedate: d.Racedate, Description: d.Description, Place: d.Place, Nationcode: d.Nationcode, details: d})
+		}
+	case "NK":
+		rows, err := s.FIS.RaceNK().GetRacesByIDsNK(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			d := fisapi.FISRaceNKFullFromSqlc(row)
+			out = append(out, &race{Sector: sector, Raceid: d.Raceid, Seasoncode: d.Seasoncode, Disciplinecode: d.Disciplinecode, Catcode: d.Catcode,
+				Gender: d.Gender, Racedate: d.Racedate, Description: d.Description, Place: d.Place, Nationcode: d.Nationcode, details: d})
+		}
+	}
+	return out, nil
+}
+
+func resultsByRaceIDs(ctx context.Context, s *store.Storage, sector string, raceIDs []int32) ([]*raceResult, error) {
+	var out []*raceResult
+	switch sector {
+	case "CC":
+		rows, err := s.FIS.ResultCC().GetResultsByRaceIDsCC(ctx, raceIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			d := fisapi.FISResultCCFullFromSqlc(row)
+			out = append(out, &raceResult{Recid: d.Recid, Raceid: d.Raceid, Competitorid: d.Competitorid, Fiscode: d.Fiscode, Competitorname: d.Competitorname,
+				Nationcode: d.Nationcode, Status: d.Status, Position: d.Position, Bib: d.Bib, sector: sector, details: d})
+		}
+	case "JP":
+		rows, err := s.FIS.ResultJP().GetResultsByRaceIDsJP(ctx, raceIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			d := fisapi.FISResultJPFullFromSqlc(row)
+			out = append(out, &raceResult{Recid: d.Recid, Raceid: d.Raceid, Competitorid: d.Competitorid, Fiscode: d.Fiscode, Competitorname: d.Competitorname,
+				Nationcode: d.Nationcode, Status: d.Status, Position: itoa(d.Position), Bib: itoa(d.Bib), sector: sector, details: d})
+		}
+	case "NK":
+		rows, err := s.FIS.ResultNK().GetResultsByRaceIDsNK(ctx, raceIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			d := fisapi.FISResultNKFullFromSqlc(row)
+			out = append(out, &raceResult{Recid: d.Recid, Raceid: d.Raceid, Competitorid: d.Competitorid, Fiscode: d.Fiscode, Competitorname: d.Competitorname,
+				Nationcode: d.Nationcode, Status: d.Status, Position: itoa(d.Position), Bib: itoa(d.Bib), sector: sector, details: d})
+		}
+	}
+	return out, nil
+}
+
+func isSector(s string) bool {
+	return s == "CC" || s == "JP" || s == "NK"
+}
+
+// REST routes whose roles guard the FIS fields of each sector
+func racePath(sector string) string {
+	return "/v1/fis/race" + strings.ToLower(sector)
+}
+
+func resultPath(sector string) string {
+	return "/v1/fis/result" + strings.ToLower(sector)
+}
+
+func athleteResultPath(sector string) string {
+	return "/v1/fis/resultathlete" + strings.ToLower(sector)
+}
+
+func itoa(n *int32) *string {
+	if n == nil {
+		return nil
+	}
+	s := strconv.Itoa(int(*n))
+	return &s
+}
@@ -0,0 +1,110 @@
+package graphqlapi
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/logger"
+	"github.com/DeRuina/KUHA-REST-API/internal/store"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/log"
+)
+
+//go:embed schema.graphql
+var schemaSDL string
+
+const (
+	maxQueryDepth = 10
+	// longest query document accepted, in bytes
+	maxQueryLength = 16 << 10
+	// field resolvers of one query running at the same time
+	maxParallelism = 16
+)
+
+// Handler
+type GraphQLHandler struct {
+	schema *graphql.Schema
+	store  *store.Storage
+}
+
+func NewGraphQLHandler(store *store.Storage) *GraphQLHandler {
+	schema := graphql.MustParseSchema(schemaSDL, &resolver{store: store},
+		graphql.UseFieldResolvers(),
+		graphql.MaxDepth(maxQueryDepth),
+		graphql.MaxQueryLength(maxQueryLength),
+		graphql.MaxParallelism(maxParallelism),
+		graphql.Logger(log.LoggerFunc(func(ctx context.Context, value any) {
+			logger.Logger.Errorw("GraphQL resolver panicked", "panic", value, "request_id", middleware.GetReqID(ctx))
+		})),
+	)
+	return &GraphQLHandler{schema: schema, store: store}
+}
+
+// Query godoc
+//
+//	@Summary		Query the API with GraphQL
+//	@Description	Read-only GraphQL endpoint over the FIS, UTV, Tietoevry, K-LAB, Archinisis and KAMK data; the schema is served by GET /graphql/schema. Each field is authorized with the roles of the REST route that serves the same data: a field the token may not read resolves to null with a forbidden error under errors, and the rest of the query still returns. Errors carry extensions.code with the REST problem codes. Queries may be sent as a JSON body with POST or as query, operationName and variables parameters with GET.
+//	@Tags			GraphQL
+//	@Accept			json
+//	@Produce		json
+//	@Param			request			body		apitypes.GraphQLRequest	false	"Query (POST)"
+//	@Param			query			query		string					false	"Query (GET)"
+//	@Param			operationName	query		string					false	"Operation to run (GET)"
+//	@Param			variables		query		string					false	"Variables as a JSON object (GET)"
+//	@Success		200				{object}	apitypes.GraphQLResponse
+//	@Failure		400				{object}	apitypes.ValidationErrorResponse
+//	@Failure		401				{object}	apitypes.UnauthorizedResponse
+//	@Failure		500				{object}	apitypes.InternalServerErrorResponse
+//	@Security		BearerAuth
+//	@Router			/graphql [post]
+//	@Router			/graphql [get]
+func (h *GraphQLHandler) Query(w http.ResponseWriter, r *http.Request) {
+	var input apitypes.GraphQLRequest
+	if r.Method == http.MethodGet {
+		if err := utils.ValidateParams(r, []string{"query", "operationName", "variables"}); err != nil {
+			utils.BadRequestResponse(w, r, err)
+			return
+		}
+		q := r.URL.Query()
+		input.Query, input.OperationName = q.Get("query"), q.Get("operationName")
+		if v := q.Get("variables"); v != "" {
+			if err := json.Unmarshal([]byte(v), &input.Variables); err != nil {
+				utils.BadRequestResponse(w, r, fmt.Errorf("variables must be a JSON object"))
+				return
+			}
+		}
+	} else if err := utils.ReadJSON(w, r, &input); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	if input.Query == "" {
+		utils.BadRequestResponse(w, r, fmt.Errorf("query is required"))
+		return
+	}
+
+	// loaders live as long as the request so batched reads never mix callers
+	ctx := withLoaders(r.Context(), newLoaders(h.store))
+	resp := h.schema.Exec(ctx, input.Query, input.OperationName, input.Variables)
+	utils.WriteJSON(w, http.StatusOK, resp)
+}
+
+// GetSchema godoc
+//
+//	@Summary	Get the GraphQL schema
+//	@Tags		GraphQL
+//	@Produce	plain
+//	@Success	200	{string}	string	"Schema in GraphQL SDL"
+//	@Failure	401	{object}	apitypes.UnauthorizedResponse
+//	@Security	BearerAuth
+//	@Router		/graphql/schema [get]
+func (h *GraphQLHandler) GetSchema(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(schemaSDL))
+}
@@ -0,0 +1,43 @@
+package graphqlapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/store/kamk"
+)
+
+type injury struct {
+	kamk.Injury
+}
+
+func (r *resolver) KamkInjuries(ctx context.Context, args struct{ UserID int32 }) (*[]*injury, error) {
+	if err := authorize(ctx, "/v1/kamk/injury"); err != nil {
+		return nil, err
+	}
+	if r.store.KAMK == nil {
+		return nil, unavailable("kamk")
+	}
+
+	rows, err := r.store.KAMK.Injuries().GetActiveInjuries(ctx, args.UserID)
+	if err != nil {
+		return nil, storeError(ctx, err)
+	}
+	out := make([]*injury, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &injury{row})
+	}
+	return &out, nil
+}
+
+func (i *injury) DateStart() string {
+	return i.Injury.DateStart.Format(time.RFC3339)
+}
+
+func (i *injury) DateEnd() *string {
+	if i.Injury.DateEnd == nil {
+		return nil
+	}
+	s := i.Injury.DateEnd.Format(time.RFC3339)
+	return &s
+}
@@ -0,0 +1,125 @@
+package graphqlapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	klabsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/klab"
+	"github.com/DeRuina/KUHA-REST-API/internal/store"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/klab"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+type klabCustomer struct {
+	row   klabsqlc.Customer
+	store *store.Storage
+}
+
+type klabData struct {
+	data       *klab.KlabDataNoCustomerResponse
+	NextCursor *string
+}
+
+func (r *resolver) KlabCustomer(ctx context.Context, args struct{ SporttiID string }) (*klabCustomer, error) {
+	if err := authorize(ctx, "/v1/klab/user"); err != nil {
+		return nil, err
+	}
+	if r.store.KLAB == nil {
+		return nil, unavailable("klab")
+	}
+
+	id, err := r.store.KLAB.Users().GetCustomerIDBySporttiID(ctx, args.SporttiID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, storeError(ctx, err)
+	}
+	row, err := r.store.KLAB.Users().GetCustomerByID(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, storeError(ctx, err)
+	}
+	return &klabCustomer{row: row, store: r.store}, nil
+}
+
+func (c *klabCustomer) Idcustomer() int32 {
+	return c.row.Idcustomer
+}
+
+func (c *klabCustomer) SporttiID() *string {
+	return utils.StringPtrOrNil(c.row.SporttiID)
+}
+
+func (c *klabCustomer) Firstname() string {
+	return c.row.Firstname
+}
+
+func (c *klabCustomer) Lastname() string {
+	return c.row.Lastname
+}
+
+func (c *klabCustomer) Dob() *string {
+	return utils.FormatDatePtr(c.row.Dob)
+}
+
+func (c *klabCustomer) Sex() *int32 {
+	return utils.Int32PtrOrNil(c.row.Sex)
+}
+
+func (c *klabCustomer) WeightKg() *float64 {
+	return utils.Float64PtrOrNil(c.row.WeightKg)
+}
+
+func (c *klabCustomer) HeightCm() *float64 {
+	return utils.Float64PtrOrNil(c.row.HeightCm)
+}
+
+func (c *klabCustomer) Data(ctx context.Context, args struct {
+	First int32
+	After *string
+}) (*klabData, error) {
+	if err := authorize(ctx, "/v1/klab/data"); err != nil {
+		return nil, err
+	}
+	p, err := page(args.First, args.After)
+	if err != nil {
+		return nil, err
+	}
+
+	data, next, err := c.store.KLAB.Data().GetDataByCustomerIDNoCustomer(ctx, c.row.Idcustomer, p)
+	if err != nil {
+		return nil, storeError(ctx, err)
+	}
+	if data == nil {
+		data = &klab.KlabDataNoCustomerResponse{CustomerID: c.row.Idcustomer}
+	}
+	return &klabData{data: data, NextCursor: nextCursor(next)}, nil
+}
+
+func (d *klabData) Measurements() (JSON, error) {
+	return newJSON(emptyIfNil(d.data.Measurements))
+}
+
+func (d *klabData) Dirtest() (JSON, error) {
+	return newJSON(emptyIfNil(d.data.DirTests))
+}
+
+func (d *klabData) Dirteststeps() (JSON, error) {
+	return newJSON(emptyIfNil(d.data.DirTestSteps))
+}
+
+func (d *klabData) Dirreport() (JSON, error) {
+	return newJSON(emptyIfNil(d.data.DirReports))
+}
+
+func (d *klabData) Dirrawdata() (JSON, error) {
+	return newJSON(emptyIfNil(d.data.DirRawData))
+}
+
+func (d *klabData) Dirresults() (JSON, error) {
+	return newJSON(emptyIfNil(d.data.DirResults))
+}
@@ -0,0 +1,117 @@
+package graphqlapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/store"
+	"github.com/graph-gophers/dataloader/v7"
+)
+
+const (
+	// how long a loader collects keys before it runs a batch
+	loaderWait = 2 * time.Millisecond
+	// largest ID list sent to one batched store query
+	loaderBatchCapacity = 100
+)
+
+// sectorKey identifies a FIS race or the results of one
+type sectorKey struct {
+	sector string
+	id     int32
+}
+
+// loaders batch and deduplicate the store reads of one request; they are
+// built per request so nothing is cached across callers
+type loaders struct {
+	races   *dataloader.Loader[sectorKey, *race]
+	results *dataloader.Loader[sectorKey, []*raceResult]
+}
+
+type loadersKey struct{}
+
+func newLoaders(s *store.Storage) *loaders {
+	return &loaders{
+		races: dataloader.NewBatchedLoader(racesBatch(s),
+			dataloader.WithWait[sectorKey, *race](loaderWait),
+			dataloader.WithBatchCapacity[sectorKey, *race](loaderBatchCapacity)),
+		results: dataloader.NewBatchedLoader(resultsBatch(s),
+			dataloader.WithWait[sectorKey, []*raceResult](loaderWait),
+			dataloader.WithBatchCapacity[sectorKey, []*raceResult](loaderBatchCapacity)),
+	}
+}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+// racesBatch loads the races of a batch with one query per sector
+func racesBatch(s *store.Storage) dataloader.BatchFunc[sectorKey, *race] {
+	return func(ctx context.Context, keys []sectorKey) []*dataloader.Result[*race] {
+		ids := map[string][]int32{}
+		for _, k := range keys {
+			ids[k.sector] = append(ids[k.sector], k.id)
+		}
+
+		found := map[sectorKey]*race{}
+		failed := map[string]error{}
+		for sector, list := range ids {
+			races, err := racesByIDs(ctx, s, sector, list)
+			if err != nil {
+				failed[sector] = err
+				continue
+			}
+			for _, rc := range races {
+				found[sectorKey{sector, rc.Raceid}] = rc
+			}
+		}
+
+		out := make([]*dataloader.Result[*race], len(keys))
+		for i, k := range keys {
+			out[i] = &dataloader.Result[*race]{Data: found[k], Error: failed[k.sector]}
+		}
+		return out
+	}
+}
+
+// resultsBatch loads the results of a batch of races with one query per sector
+func resultsBatch(s *store.Storage) dataloader.BatchFunc[sectorKey, []*raceResult] {
+	return func(ctx context.Context, keys []sectorKey) []*dataloader.Result[[]*raceResult] {
+		ids := map[string][]int32{}
+		for _, k := range keys {
+			ids[k.sector] = append(ids[k.sector], k.id)
+		}
+
+		found := map[sectorKey][]*raceResult{}
+		failed := map[string]error{}
+		for sector, list := range ids {
+			results, err := resultsByRaceIDs(ctx, s, sector, list)
+			if err != nil {
+				failed[sector] = err
+				continue
+			}
+			for _, res := range results {
+				if res.Raceid == nil {
+					continue
+				}
+				k := sectorKey{sector, *res.Raceid}
+				found[k] = append(found[k], res)
+			}
+		}
+
+		out := make([]*dataloader.Result[[]*raceResult], len(keys))
+		for i, k := range keys {
+			// a race without results is an empty list, not null
+			list := found[k]
+			if list == nil && failed[k.sector] == nil {
+				list = []*raceResult{}
+			}
+			out[i] = &dataloader.Result[[]*raceResult]{Data: list, Error: failed[k.sector]}
+		}
+		return out
+	}
+}
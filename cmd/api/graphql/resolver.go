@@ -0,0 +1,131 @@
+package graphqlapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authn"
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	"github.com/DeRuina/KUHA-REST-API/internal/logger"
+	"github.com/DeRuina/KUHA-REST-API/internal/store"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// resolver is the Query root
+type resolver struct {
+	store *store.Storage
+}
+
+// fieldError is a resolver error; its code shows up under extensions.code
+// and uses the same values as the REST problem codes
+type fieldError struct {
+	code utils.ErrorCode
+	msg  string
+}
+
+func (e *fieldError) Error() string {
+	return e.msg
+}
+
+func (e *fieldError) Extensions() map[string]any {
+	return map[string]any{"code": e.code}
+}
+
+func badRequest(err error) error {
+	return &fieldError{code: utils.CodeBadRequest, msg: err.Error()}
+}
+
+func unavailable(dbName string) error {
+	return &fieldError{code: utils.CodeDatabaseUnavailable, msg: fmt.Sprintf("%s database is unavailable", dbName)}
+}
+
+// storeError hides database errors from the client like the REST handlers do
+func storeError(ctx context.Context, err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return &fieldError{code: utils.CodeQueryTimeout, msg: utils.ErrQueryTimeOut.Error()}
+	}
+	if errors.Is(err, context.Canceled) {
+		return err
+	}
+	logger.Logger.Warnw("GraphQL resolver error",
+		"error", err.Error(),
+		"request_id", middleware.GetReqID(ctx),
+	)
+	return &fieldError{code: utils.CodeInternalError, msg: "the server encountered a problem"}
+}
+
+// authorize checks the caller's roles against the REST route that serves
+// the same data, so GraphQL never exposes more than REST does
+func authorize(ctx context.Context, path string) error {
+	if !authz.Can(authn.GetClientRoles(ctx), http.MethodGet, path) {
+		return &fieldError{code: utils.CodeForbidden, msg: fmt.Sprintf("access denied: requires read access to %s", path)}
+	}
+	return nil
+}
+
+// JSON is the JSON scalar
+type JSON struct {
+	raw json.RawMessage
+}
+
+func newJSON(v any) (JSON, error) {
+	raw, err := json.Marshal(v)
+	return JSON{raw: raw}, err
+}
+
+// jsonPtr is newJSON for nullable fields
+func jsonPtr(v any) (*JSON, error) {
+	j, err := newJSON(v)
+	if err != nil {
+		return nil, err
+	}
+	return &j, nil
+}
+
+func rawJSON(s *string) *JSON {
+	if s == nil {
+		return nil
+	}
+	return &JSON{raw: json.RawMessage(*s)}
+}
+
+func (JSON) ImplementsGraphQLType(name string) bool {
+	return name == "JSON"
+}
+
+func (j *JSON) UnmarshalGraphQL(input any) error {
+	raw, err := json.Marshal(input)
+	j.raw = raw
+	return err
+}
+
+func (j JSON) MarshalJSON() ([]byte, error) {
+	if len(j.raw) == 0 {
+		return []byte("null"), nil
+	}
+	return j.raw, nil
+}
+
+// page turns the first and after arguments into a store page
+func page(first int32, after *string) (utils.Page, error) {
+	var cursor string
+	if after != nil {
+		cursor = *after
+	}
+	p, err := utils.NewPage(first, cursor)
+	if err != nil {
+		return p, badRequest(err)
+	}
+	return p, nil
+}
+
+func nextCursor(next string) *string {
+	if next == "" {
+		return nil
+	}
+	return &next
+}
@@ -0,0 +1,222 @@
+package graphqlapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	tietoevryapi "github.com/DeRuina/KUHA-REST-API/cmd/api/tietoevry"
+	tietoevrysqlc "github.com/DeRuina/KUHA-REST-API/internal/db/tietoevry"
+	"github.com/DeRuina/KUHA-REST-API/internal/store"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/tietoevry"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
+	"github.com/google/uuid"
+	"github.com/graph-gophers/graphql-go"
+)
+
+type tietoevryUser struct {
+	row   tietoevrysqlc.User
+	store *store.Storage
+}
+
+type exercise struct {
+	apitypes.TietoevryExerciseUpsertInput
+	id    uuid.UUID
+	store *store.Storage
+}
+
+type exercisePage struct {
+	Items      []*exercise
+	NextCursor *string
+}
+
+type measurement struct {
+	apitypes.TietoevryMeasurementInput
+}
+
+type measurementPage struct {
+	Items      []*measurement
+	NextCursor *string
+}
+
+type listArgs struct {
+	From  *string
+	To    *string
+	First int32
+	After *string
+}
+
+func (r *resolver) TietoevryUser(ctx context.Context, args struct{ ID graphql.ID }) (*tietoevryUser, error) {
+	if err := authorize(ctx, "/v1/tietoevry/users"); err != nil {
+		return nil, err
+	}
+	if r.store.Tietoevry == nil {
+		return nil, unavailable("Tietoevry")
+	}
+	id, err := utils.ParseUUID(string(args.ID))
+	if err != nil {
+		return nil, badRequest(err)
+	}
+
+	row, err := r.store.Tietoevry.Users().GetUser(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, storeError(ctx, err)
+	}
+	return &tietoevryUser{row: row, store: r.store}, nil
+}
+
+func (u *tietoevryUser) ID() graphql.ID {
+	return graphql.ID(u.row.ID.String())
+}
+
+func (u *tietoevryUser) SporttiID() int32 {
+	return u.row.SporttiID
+}
+
+func (u *tietoevryUser) ProfileGender() *string {
+	return utils.StringPtrOrNil(u.row.ProfileGender)
+}
+
+func (u *tietoevryUser) ProfileBirthdate() *string {
+	return utils.FormatDatePtr(u.row.ProfileBirthdate)
+}
+
+func (u *tietoevryUser) ProfileWeight() *float64 {
+	return utils.Float64PtrOrNil(u.row.ProfileWeight)
+}
+
+func (u *tietoevryUser) ProfileHeight() *float64 {
+	return utils.Float64PtrOrNil(u.row.ProfileHeight)
+}
+
+func (u *tietoevryUser) ProfileRestingHeartRate() *int32 {
+	return utils.Int32PtrOrNil(u.row.ProfileRestingHeartRate)
+}
+
+func (u *tietoevryUser) ProfileMaximumHeartRate() *int32 {
+	return utils.Int32PtrOrNil(u.row.ProfileMaximumHeartRate)
+}
+
+func (u *tietoevryUser) ProfileAerobicThreshold() *int32 {
+	return utils.Int32PtrOrNil(u.row.ProfileAerobicThreshold)
+}
+
+func (u *tietoevryUser) ProfileAnaerobicThreshold() *int32 {
+	return utils.Int32PtrOrNil(u.row.ProfileAnaerobicThreshold)
+}
+
+func (u *tietoevryUser) ProfileVo2max() *int32 {
+	return utils.Int32PtrOrNil(u.row.ProfileVo2max)
+}
+
+func (u *tietoevryUser) Exercises(ctx context.Context, args listArgs) (*exercisePage, error) {
+	if err := authorize(ctx, "/v1/tietoevry/exercises"); err != nil {
+		return nil, err
+	}
+	filter, p, err := listFilter(args)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, next, err := u.store.Tietoevry.Exercises().GetExercisesByUser(ctx, u.row.ID, filter, p)
+	if err != nil {
+		return nil, storeError(ctx, err)
+	}
+	out := &exercisePage{Items: make([]*exercise, 0, len(rows)), NextCursor: nextCursor(next)}
+	for _, row := range rows {
+		out.Items = append(out.Items, &exercise{TietoevryExerciseUpsertInput: tietoevryapi.ExerciseFromRow(row), id: row.ID, store: u.store})
+	}
+	return out, nil
+}
+
+func (u *tietoevryUser) Measurements(ctx context.Context, args listArgs) (*measurementPage, error) {
+	if err := authorize(ctx, "/v1/tietoevry/measurements"); err != nil {
+		return nil, err
+	}
+	filter, p, err := listFilter(args)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, next, err := u.store.Tietoevry.Measurements().GetMeasurementsByUser(ctx, u.row.ID, filter, p)
+	if err != nil {
+		return nil, storeError(ctx, err)
+	}
+	out := &measurementPage{Items: make([]*measurement, 0, len(rows)), NextCursor: nextCursor(next)}
+	for _, row := range rows {
+		out.Items = append(out.Items, &measurement{tietoevryapi.MeasurementFromRow(row)})
+	}
+	return out, nil
+}
+
+func (e *exercise) ID() graphql.ID {
+	return graphql.ID(e.id.String())
+}
+
+func (e *exercise) RawData() *JSON {
+	return rawJSON(e.TietoevryExerciseUpsertInput.RawData)
+}
+
+// HR zones, samples and sections are only read when asked for
+func (e *exercise) HrZones(ctx context.Context) (*JSON, error) {
+	rows, err := e.store.Tietoevry.Exercises().GetExerciseHRZones(ctx, e.id)
+	if err != nil {
+		return nil, storeError(ctx, err)
+	}
+	return jsonPtr(emptyIfNil(tietoevryapi.HRZonesFromRows(rows)))
+}
+
+func (e *exercise) Samples(ctx context.Context) (*JSON, error) {
+	rows, err := e.store.Tietoevry.Exercises().GetExerciseSamples(ctx, e.id)
+	if err != nil {
+		return nil, storeError(ctx, err)
+	}
+	return jsonPtr(emptyIfNil(tietoevryapi.SamplesFromRows(rows)))
+}
+
+func (e *exercise) Sections(ctx context.Context) (*JSON, error) {
+	rows, err := e.store.Tietoevry.Exercises().GetExerciseSections(ctx, e.id)
+	if err != nil {
+		return nil, storeError(ctx, err)
+	}
+	return jsonPtr(emptyIfNil(tietoevryapi.SectionsFromRows(rows)))
+}
+
+func (m *measurement) ID() graphql.ID {
+	return graphql.ID(m.TietoevryMeasurementInput.ID)
+}
+
+func (m *measurement) RawData() *JSON {
+	return rawJSON(m.TietoevryMeasurementInput.RawData)
+}
+
+func (m *measurement) AdditionalInfo() *JSON {
+	return rawJSON(m.TietoevryMeasurementInput.AdditionalInfo)
+}
+
+func listFilter(args listArgs) (tietoevry.ReadFilter, utils.Page, error) {
+	var from, to string
+	if args.From != nil {
+		from = *args.From
+	}
+	if args.To != nil {
+		to = *args.To
+	}
+	filter, err := tietoevryapi.NewReadFilter(from, to, "")
+	if err != nil {
+		return filter, utils.Page{}, badRequest(err)
+	}
+	p, err := page(args.First, args.After)
+	return filter, p, err
+}
+
+func emptyIfNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
@@ -0,0 +1,158 @@
+package graphqlapi
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	utvapi "github.com/DeRuina/KUHA-REST-API/cmd/api/utv"
+	"github.com/DeRuina/KUHA-REST-API/internal/store"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/utv"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/google/uuid"
+	"github.com/graph-gophers/graphql-go"
+)
+
+// devices in the order /utv/latest lists them
+var utvDevices = []string{"GARMIN", "OURA", "POLAR", "SUUNTO"}
+
+type utvUser struct {
+	id    uuid.UUID
+	store *store.Storage
+}
+
+type deviceData struct {
+	Device string
+	Date   string
+	data   JSON
+}
+
+// deviceStore is the part of a device data store the UTV fields read
+type deviceStore interface {
+	GetLatestByType(ctx context.Context, userID uuid.UUID, typ string, limit int32) ([]utv.LatestDataEntry, error)
+	GetAllByType(ctx context.Context, userID uuid.UUID, typ string, after, before *time.Time, limit, offset int32) ([]utv.LatestDataEntry, error)
+}
+
+func (r *resolver) UtvUser(ctx context.Context, args struct{ ID graphql.ID }) (*utvUser, error) {
+	if err := authorize(ctx, "/v1/utv"); err != nil {
+		return nil, err
+	}
+	if r.store.UTV == nil {
+		return nil, unavailable("utv")
+	}
+	id, err := utils.ParseUUID(string(args.ID))
+	if err != nil {
+		return nil, badRequest(err)
+	}
+	return &utvUser{id: id, store: r.store}, nil
+}
+
+func (u *utvUser) ID() graphql.ID {
+	return graphql.ID(u.id.String())
+}
+
+func (u *utvUser) Devices(ctx context.Context) (*JSON, error) {
+	if err := authorize(ctx, "/v1/utv/user-linked-devices"); err != nil {
+		return nil, err
+	}
+	status, err := u.store.UTV.UserData().GetUserDeviceStatus(ctx, u.id)
+	if err != nil {
+		return nil, storeError(ctx, err)
+	}
+	return jsonPtr(status)
+}
+
+func (u *utvUser) Latest(ctx context.Context, args struct {
+	Type   string
+	Device *string
+	Limit  int32
+}) (*[]*deviceData, error) {
+	if err := authorize(ctx, "/v1/utv/latest"); err != nil {
+		return nil, err
+	}
+	in := utvapi.LatestDataInput{UserID: u.id.String(), Type: args.Type, Limit: args.Limit}
+	devices := utvDevices
+	if args.Device != nil {
+		in.Device = strings.ToLower(*args.Device)
+		devices = []string{*args.Device}
+	}
+	// same rules as the query parameters of the REST route
+	if err := utils.GetValidator().Struct(in); err != nil {
+		return nil, badRequest(err)
+	}
+
+	out := []*deviceData{}
+	for _, device := range devices {
+		rows, err := u.device(device).GetLatestByType(ctx, u.id, args.Type, args.Limit)
+		if err != nil {
+			return nil, storeError(ctx, err)
+		}
+		out = appendDeviceData(out, device, rows)
+	}
+	return &out, nil
+}
+
+func (u *utvUser) Data(ctx context.Context, args struct {
+	Type   string
+	Device string
+	After  *string
+	Before *string
+	Limit  int32
+	Offset int32
+}) (*[]*deviceData, error) {
+	if err := authorize(ctx, "/v1/utv/all"); err != nil {
+		return nil, err
+	}
+	in := utvapi.AllByTypeInput{UserID: u.id.String(), Type: args.Type, Limit: args.Limit, Offset: args.Offset}
+	if args.After != nil {
+		in.AfterDate = *args.After
+	}
+	if args.Before != nil {
+		in.BeforeDate = *args.Before
+	}
+	if err := utils.GetValidator().Struct(in); err != nil {
+		return nil, badRequest(err)
+	}
+	after, err := utils.ParseDatePtr(args.After)
+	if err != nil {
+		return nil, badRequest(err)
+	}
+	before, err := utils.ParseDatePtr(args.Before)
+	if err != nil {
+		return nil, badRequest(err)
+	}
+	if after != nil && before != nil && after.After(*before) {
+		return nil, badRequest(utils.ErrInvalidDateRange)
+	}
+
+	rows, err := u.device(args.Device).GetAllByType(ctx, u.id, args.Type, after, before, args.Limit, args.Offset)
+	if err != nil {
+		return nil, storeError(ctx, err)
+	}
+	out := appendDeviceData([]*deviceData{}, args.Device, rows)
+	return &out, nil
+}
+
+func (u *utvUser) device(name string) deviceStore {
+	switch name {
+	case "GARMIN":
+		return u.store.UTV.Garmin()
+	case "OURA":
+		return u.store.UTV.Oura()
+	case "POLAR":
+		return u.store.UTV.Polar()
+	default:
+		return u.store.UTV.Suunto()
+	}
+}
+
+func appendDeviceData(out []*deviceData, device string, rows []utv.LatestDataEntry) []*deviceData {
+	for _, row := range rows {
+		out = append(out, &deviceData{Device: device, Date: row.Date.Format("2006-01-02"), data: JSON{raw: row.Data}})
+	}
+	return out
+}
+
+func (d *deviceData) Data() JSON {
+	return d.data
+}
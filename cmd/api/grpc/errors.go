@@ -0,0 +1,101 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/logger"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/go-chi/chi/v5/middleware"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain names this API in the ErrorInfo detail of every error
+const errorDomain = "kuha-rest-api"
+
+// problem builds a status carrying the REST problem code as ErrorInfo.reason
+func problem(c codes.Code, code utils.ErrorCode, msg string) error {
+	st := status.New(c, msg)
+	if withInfo, err := st.WithDetails(&errdetails.ErrorInfo{Reason: string(code), Domain: errorDomain}); err == nil {
+		st = withInfo
+	}
+	return st.Err()
+}
+
+func unavailable(dbName string) error {
+	return problem(codes.Unavailable, utils.CodeDatabaseUnavailable, fmt.Sprintf("%s database is unavailable", dbName))
+}
+
+func invalidArgument(err error) error {
+	return problem(codes.InvalidArgument, utils.CodeBadRequest, err.Error())
+}
+
+// statusError turns a handler error into a status the way the REST handlers
+// turn it into a problem response: client errors are passed on, database
+// errors are classified, and anything else is logged and hidden
+func statusError(ctx context.Context, err error) error {
+	// failed sends and receives already carry a status
+	if st, ok := status.FromError(err); ok {
+		return st.Err()
+	}
+
+	var inputErr *utils.InvalidInputError
+	var rejected *utils.ItemRejection
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return problem(codes.DeadlineExceeded, utils.CodeQueryTimeout, utils.ErrQueryTimeOut.Error())
+	case errors.As(err, &inputErr):
+		code := utils.CodeValidationFailed
+		if errors.As(inputErr.Err, &rejected) {
+			code = rejected.Code
+		}
+		return problem(codes.InvalidArgument, code, inputErr.Error())
+	case errors.As(err, &rejected):
+		return problem(grpcCode(rejected.Code.Status()), rejected.Code, err.Error())
+	}
+
+	code, detail, _ := utils.ClassifyDatabaseError(err)
+	switch code {
+	case utils.CodeInternalError:
+		logger.Logger.Errorw("gRPC handler error",
+			"error", err.Error(),
+			"request_id", middleware.GetReqID(ctx),
+		)
+		return problem(codes.Internal, code, "the server encountered a problem")
+	case utils.CodeQueryTimeout:
+		return problem(codes.DeadlineExceeded, code, utils.ErrQueryTimeOut.Error())
+	}
+	return problem(grpcCode(code.Status()), code, detail)
+}
+
+// grpcCode maps the HTTP status of a problem code to the closest gRPC code
+func grpcCode(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusRequestEntityTooLarge, http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	}
+	if httpStatus >= 500 {
+		return codes.Internal
+	}
+	return codes.FailedPrecondition
+}
@@ -0,0 +1,172 @@
+package grpcapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authn"
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	"github.com/DeRuina/KUHA-REST-API/internal/logger"
+	ingestv1 "github.com/DeRuina/KUHA-REST-API/proto/kuha/ingest/v1"
+	"github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// route is the REST route whose roles an RPC needs
+type route struct {
+	method string
+	path   string
+}
+
+// routes lists every RPC; IngestDeviceData has no fixed route because each
+// message is authorized for its own device
+var routes = map[string]route{
+	ingestv1.IngestService_IngestExercises_FullMethodName:    {http.MethodPost, "/v1/tietoevry/exercises"},
+	ingestv1.IngestService_IngestMeasurements_FullMethodName: {http.MethodPost, "/v1/tietoevry/measurements"},
+	ingestv1.IngestService_IngestDeviceData_FullMethodName:   {},
+	ingestv1.IngestService_StreamExercises_FullMethodName:    {http.MethodGet, "/v1/tietoevry/exercises"},
+	ingestv1.IngestService_StreamMeasurements_FullMethodName: {http.MethodGet, "/v1/tietoevry/measurements"},
+	ingestv1.IngestService_StreamDeviceData_FullMethodName:   {http.MethodGet, "/v1/utv/all"},
+}
+
+// serverStream swaps the context of a stream
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// authStream checks the bearer token like the JWT middleware of the REST API
+// and the roles like authz.Authorize
+func authStream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx := ss.Context()
+	md, _ := metadata.FromIncomingContext(ctx)
+	auth := md.Get("authorization")
+	if len(auth) == 0 || !strings.HasPrefix(auth[0], "Bearer ") {
+		return status.Error(codes.Unauthenticated, "missing or malformed authorization metadata")
+	}
+	_, claims, err := authn.ValidateJWT(strings.TrimPrefix(auth[0], "Bearer "))
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	clientName, _ := claims["sub"].(string)
+	roles := authn.RolesFromClaims(claims)
+
+	rt, ok := routes[info.FullMethod]
+	if !ok {
+		return status.Error(codes.Unimplemented, "unknown method")
+	}
+	if rt.path != "" && !authz.Can(roles, rt.method, rt.path) {
+		return status.Error(codes.PermissionDenied, "access denied")
+	}
+
+	if ci, ok := ctx.Value(callInfoKey{}).(*callInfo); ok {
+		ci.client, ci.roles = clientName, roles
+	}
+	ctx = authn.WithClientMetadata(ctx, clientName, roles)
+	return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+}
+
+// recoverStream turns a panic into an Internal error instead of taking the
+// whole process down
+func recoverStream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			logger.Logger.Errorw("gRPC handler panicked",
+				"method", info.FullMethod,
+				"panic", p,
+				"request_id", middleware.GetReqID(ss.Context()),
+			)
+			err = status.Error(codes.Internal, "the server encountered a problem")
+		}
+	}()
+	return handler(srv, ss)
+}
+
+// logStream gives every call a request ID, sent back as x-request-id, and logs
+// it with the fields of the REST request log
+func logStream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	requestID := fmt.Sprintf("grpc-%06d", middleware.NextRequestID())
+	// the auth interceptor runs later and fills in the client
+	ci := &callInfo{}
+	ctx := context.WithValue(ss.Context(), middleware.RequestIDKey, requestID)
+	ctx = context.WithValue(ctx, callInfoKey{}, ci)
+	_ = ss.SetHeader(metadata.Pairs("x-request-id", requestID))
+
+	counted := &countingStream{serverStream: serverStream{ServerStream: ss, ctx: ctx}}
+	err := handler(srv, counted)
+
+	clientID := ci.client
+	if clientID == "" {
+		clientID = "anonymous"
+	}
+	var ip string
+	if p, ok := peer.FromContext(ctx); ok {
+		ip = p.Addr.String()
+	}
+	code := status.Code(err)
+
+	logFields := []zap.Field{
+		zap.String("method", info.FullMethod),
+		zap.String("client_id", clientID),
+		zap.Strings("roles", ci.roles),
+		zap.String("request_id", requestID),
+		zap.String("ip", ip),
+		zap.String("code", code.String()),
+		zap.Int64("messages_in", counted.received),
+		zap.Int64("messages_out", counted.sent),
+		zap.Duration("response_time", time.Since(start)),
+	}
+	switch code {
+	case codes.OK:
+		logger.Logger.Desugar().With(logFields...).Info("gRPC call completed")
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable:
+		logger.Logger.Desugar().With(logFields...).Error("gRPC call failed")
+	default:
+		logger.Logger.Desugar().With(logFields...).Warn("gRPC error response")
+	}
+	return err
+}
+
+// callInfo carries what the auth interceptor learns back to the call log
+type callInfo struct {
+	client string
+	roles  []string
+}
+
+type callInfoKey struct{}
+
+// countingStream counts the messages of a call for the call log
+type countingStream struct {
+	serverStream
+	received int64
+	sent     int64
+}
+
+func (s *countingStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.received++
+	}
+	return err
+}
+
+func (s *countingStream) SendMsg(m any) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.sent++
+	}
+	return err
+}
@@ -0,0 +1,82 @@
+package grpcapi
+
+import (
+	"context"
+	"net"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/store"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
+	ingestv1 "github.com/DeRuina/KUHA-REST-API/proto/kuha/ingest/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// largest single message accepted; an exercise bundle with its samples is the
+// biggest one
+const maxMessageSize = 16 << 20
+
+// Config of the gRPC listener. Without a certificate the server speaks
+// HTTP/2 over cleartext (h2c), for use behind a TLS-terminating proxy.
+type Config struct {
+	Enabled  bool
+	Addr     string
+	CertFile string
+	KeyFile  string
+}
+
+// Server serves the ingestion service on its own port
+type Server struct {
+	addr string
+	srv  *grpc.Server
+	lis  net.Listener
+}
+
+func NewServer(cfg Config, store *store.Storage, cache *cache.Storage) (*Server, error) {
+	opts := []grpc.ServerOption{
+		grpc.ChainStreamInterceptor(logStream, recoverStream, authStream),
+		grpc.MaxRecvMsgSize(maxMessageSize),
+	}
+	if cfg.CertFile != "" {
+		creds, err := credentials.NewServerTLSFromFile(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+
+	srv := grpc.NewServer(opts...)
+	ingestv1.RegisterIngestServiceServer(srv, &ingestService{store: store, cache: cache})
+	return &Server{addr: cfg.Addr, srv: srv}, nil
+}
+
+// Listen opens the port, so a taken port fails startup instead of Serve
+func (s *Server) Listen() error {
+	lis, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return err
+	}
+	s.lis = lis
+	return nil
+}
+
+// Serve blocks until the server is shut down
+func (s *Server) Serve() error {
+	return s.srv.Serve(s.lis)
+}
+
+// Shutdown lets running calls finish until ctx is done, then cuts them off
+func (s *Server) Shutdown(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.srv.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		s.srv.Stop()
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,296 @@
+package grpcapi
+
+import (
+	"errors"
+	"io"
+
+	tietoevryapi "github.com/DeRuina/KUHA-REST-API/cmd/api/tietoevry"
+	"github.com/DeRuina/KUHA-REST-API/internal/store"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/tietoevry"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
+	ingestv1 "github.com/DeRuina/KUHA-REST-API/proto/kuha/ingest/v1"
+	"github.com/google/uuid"
+)
+
+// ingestService implements ingestv1.IngestService on the REST handlers, so
+// validation, storage and cache invalidation are the same as over HTTP
+type ingestService struct {
+	ingestv1.UnimplementedIngestServiceServer
+	store *store.Storage
+	cache *cache.Storage
+}
+
+func (s *ingestService) IngestExercises(stream ingestv1.IngestService_IngestExercisesServer) error {
+	ctx := stream.Context()
+	if s.store.Tietoevry == nil {
+		return unavailable("Tietoevry")
+	}
+
+	h := tietoevryapi.NewTietoevryExerciseHandler(s.store.Tietoevry.Exercises(), s.cache)
+	res, err := h.IngestExercises(ctx, receive(stream.Recv, exerciseInput))
+	if err != nil {
+		return statusError(ctx, err)
+	}
+	return stream.SendAndClose(&ingestv1.IngestSummary{Received: int64(res.Items), Users: int64(res.Users)})
+}
+
+func (s *ingestService) IngestMeasurements(stream ingestv1.IngestService_IngestMeasurementsServer) error {
+	ctx := stream.Context()
+	if s.store.Tietoevry == nil {
+		return unavailable("Tietoevry")
+	}
+
+	h := tietoevryapi.NewTietoevryMeasurementHandler(s.store.Tietoevry.Measurements(), s.cache)
+	res, err := h.IngestMeasurements(ctx, receive(stream.Recv, measurementInput))
+	if err != nil {
+		return statusError(ctx, err)
+	}
+	return stream.SendAndClose(&ingestv1.IngestSummary{Received: int64(res.Items), Users: int64(res.Users)})
+}
+
+func (s *ingestService) StreamExercises(req *ingestv1.StreamRequest, stream ingestv1.IngestService_StreamExercisesServer) error {
+	ctx := stream.Context()
+	if s.store.Tietoevry == nil {
+		return unavailable("Tietoevry")
+	}
+	userID, filter, err := readFilter(req)
+	if err != nil {
+		return invalidArgument(err)
+	}
+
+	h := tietoevryapi.NewTietoevryExerciseHandler(s.store.Tietoevry.Exercises(), s.cache)
+	err = h.StreamExercises(ctx, userID, filter, func(ex apitypes.TietoevryExerciseUpsertInput) error {
+		return stream.Send(exerciseMessage(ex))
+	})
+	if err != nil {
+		return statusError(ctx, err)
+	}
+	return nil
+}
+
+func (s *ingestService) StreamMeasurements(req *ingestv1.StreamRequest, stream ingestv1.IngestService_StreamMeasurementsServer) error {
+	ctx := stream.Context()
+	if s.store.Tietoevry == nil {
+		return unavailable("Tietoevry")
+	}
+	userID, filter, err := readFilter(req)
+	if err != nil {
+		return invalidArgument(err)
+	}
+
+	h := tietoevryapi.NewTietoevryMeasurementHandler(s.store.Tietoevry.Measurements(), s.cache)
+	err = h.StreamMeasurements(ctx, userID, filter, func(m apitypes.TietoevryMeasurementInput) error {
+		return stream.Send(measurementMessage(m))
+	})
+	if err != nil {
+		return statusError(ctx, err)
+	}
+	return nil
+}
+
+// receive adapts a client stream to the item sources of the REST bulk inserts
+func receive[M, T any](recv func() (M, error), convert func(M) T) func(fn func(i int, item T, itemErr error) error) error {
+	return func(fn func(i int, item T, itemErr error) error) error {
+		for i := 0; ; i++ {
+			m, err := recv()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if err := fn(i, convert(m), nil); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func readFilter(req *ingestv1.StreamRequest) (uuid.UUID, tietoevry.ReadFilter, error) {
+	userID, err := utils.ParseUUID(req.GetUserId())
+	if err != nil {
+		return uuid.Nil, tietoevry.ReadFilter{}, err
+	}
+	filter, err := tietoevryapi.NewReadFilter(req.GetFrom(), req.GetTo(), req.GetUpdatedSince())
+	return userID, filter, err
+}
+
+func exerciseInput(m *ingestv1.Exercise) apitypes.TietoevryExerciseUpsertInput {
+	in := apitypes.TietoevryExerciseUpsertInput{
+		ID:                m.GetId(),
+		CreatedAt:         m.GetCreatedAt(),
+		UpdatedAt:         m.GetUpdatedAt(),
+		UserID:            m.GetUserId(),
+		StartTime:         m.GetStartTime(),
+		Duration:          m.GetDuration(),
+		Comment:           m.Comment,
+		SportType:         m.SportType,
+		DetailedSportType: m.DetailedSportType,
+		Distance:          m.Distance,
+		AvgHeartRate:      m.AvgHeartRate,
+		MaxHeartRate:      m.MaxHeartRate,
+		Trimp:             m.Trimp,
+		SprintCount:       m.SprintCount,
+		AvgSpeed:          m.AvgSpeed,
+		MaxSpeed:          m.MaxSpeed,
+		Source:            m.GetSource(),
+		Status:            m.Status,
+		Calories:          m.Calories,
+		TrainingLoad:      m.TrainingLoad,
+		RawID:             m.RawId,
+		Feeling:           m.Feeling,
+		Recovery:          m.Recovery,
+		RPE:               m.Rpe,
+		RawData:           m.RawData,
+	}
+	for _, z := range m.GetHrZones() {
+		in.HRZones = append(in.HRZones, apitypes.HRZone{
+			ExerciseID:    z.GetExerciseId(),
+			ZoneIndex:     z.GetZoneIndex(),
+			SecondsInZone: z.GetSecondsInZone(),
+			LowerLimit:    z.GetLowerLimit(),
+			UpperLimit:    z.GetUpperLimit(),
+			CreatedAt:     z.GetCreatedAt(),
+			UpdatedAt:     z.GetUpdatedAt(),
+		})
+	}
+	for _, s := range m.GetSamples() {
+		in.Samples = append(in.Samples, apitypes.Sample{
+			ID:            s.GetId(),
+			UserID:        s.GetUserId(),
+			ExerciseID:    s.GetExerciseId(),
+			SampleType:    s.GetSampleType(),
+			RecordingRate: s.GetRecordingRate(),
+			Samples:       s.GetSamples(),
+			Source:        s.GetSource(),
+		})
+	}
+	for _, sec := range m.GetSections() {
+		in.Sections = append(in.Sections, apitypes.Section{
+			ID:          sec.GetId(),
+			UserID:      sec.GetUserId(),
+			ExerciseID:  sec.GetExerciseId(),
+			CreatedAt:   sec.GetCreatedAt(),
+			UpdatedAt:   sec.GetUpdatedAt(),
+			StartTime:   sec.GetStartTime(),
+			EndTime:     sec.GetEndTime(),
+			SectionType: sec.SectionType,
+			Name:        sec.Name,
+			Comment:     sec.Comment,
+			Source:      sec.GetSource(),
+			RawID:       sec.RawId,
+			RawData:     sec.RawData,
+		})
+	}
+	return in
+}
+
+func exerciseMessage(ex apitypes.TietoevryExerciseUpsertInput) *ingestv1.Exercise {
+	m := &ingestv1.Exercise{
+		Id:                ex.ID,
+		CreatedAt:         ex.CreatedAt,
+		UpdatedAt:         ex.UpdatedAt,
+		UserId:            ex.UserID,
+		StartTime:         ex.StartTime,
+		Duration:          ex.Duration,
+		Comment:           ex.Comment,
+		SportType:         ex.SportType,
+		DetailedSportType: ex.DetailedSportType,
+		Distance:          ex.Distance,
+		AvgHeartRate:      ex.AvgHeartRate,
+		MaxHeartRate:      ex.MaxHeartRate,
+		Trimp:             ex.Trimp,
+		SprintCount:       ex.SprintCount,
+		AvgSpeed:          ex.AvgSpeed,
+		MaxSpeed:          ex.MaxSpeed,
+		Source:            ex.Source,
+		Status:            ex.Status,
+		Calories:          ex.Calories,
+		TrainingLoad:      ex.TrainingLoad,
+		RawId:             ex.RawID,
+		Feeling:           ex.Feeling,
+		Recovery:          ex.Recovery,
+		Rpe:               ex.RPE,
+		RawData:           ex.RawData,
+	}
+	for _, z := range ex.HRZones {
+		m.HrZones = append(m.HrZones, &ingestv1.HRZone{
+			ExerciseId:    z.ExerciseID,
+			ZoneIndex:     z.ZoneIndex,
+			SecondsInZone: z.SecondsInZone,
+			LowerLimit:    z.LowerLimit,
+			UpperLimit:    z.UpperLimit,
+			CreatedAt:     z.CreatedAt,
+			UpdatedAt:     z.UpdatedAt,
+		})
+	}
+	for _, s := range ex.Samples {
+		m.Samples = append(m.Samples, &ingestv1.Sample{
+			Id:            s.ID,
+			UserId:        s.UserID,
+			ExerciseId:    s.ExerciseID,
+			SampleType:    s.SampleType,
+			RecordingRate: s.RecordingRate,
+			Samples:       s.Samples,
+			Source:        s.Source,
+		})
+	}
+	for _, sec := range ex.Sections {
+		m.Sections = append(m.Sections, &ingestv1.Section{
+			Id:          sec.ID,
+			UserId:      sec.UserID,
+			ExerciseId:  sec.ExerciseID,
+			CreatedAt:   sec.CreatedAt,
+			UpdatedAt:   sec.UpdatedAt,
+			StartTime:   sec.StartTime,
+			EndTime:     sec.EndTime,
+			SectionType: sec.SectionType,
+			Name:        sec.Name,
+			Comment:     sec.Comment,
+			Source:      sec.Source,
+			RawId:       sec.RawID,
+			RawData:     sec.RawData,
+		})
+	}
+	return m
+}
+
+func measurementInput(m *ingestv1.Measurement) apitypes.TietoevryMeasurementInput {
+	return apitypes.TietoevryMeasurementInput{
+		ID:             m.GetId(),
+		CreatedAt:      m.GetCreatedAt(),
+		UpdatedAt:      m.GetUpdatedAt(),
+		UserID:         m.GetUserId(),
+		Date:           m.GetDate(),
+		Name:           m.GetName(),
+		NameType:       m.GetNameType(),
+		Source:         m.GetSource(),
+		Value:          m.GetValue(),
+		ValueNumeric:   m.ValueNumeric,
+		Comment:        m.Comment,
+		RawID:          m.RawId,
+		RawData:        m.RawData,
+		AdditionalInfo: m.AdditionalInfo,
+	}
+}
+
+func measurementMessage(m apitypes.TietoevryMeasurementInput) *ingestv1.Measurement {
+	return &ingestv1.Measurement{
+		Id:             m.ID,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+		UserId:         m.UserID,
+		Date:           m.Date,
+		Name:           m.Name,
+		NameType:       m.NameType,
+		Source:         m.Source,
+		Value:          m.Value,
+		ValueNumeric:   m.ValueNumeric,
+		Comment:        m.Comment,
+		RawId:          m.RawID,
+		RawData:        m.RawData,
+		AdditionalInfo: m.AdditionalInfo,
+	}
+}
@@ -0,0 +1,102 @@
+package grpcapi
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	utvapi "github.com/DeRuina/KUHA-REST-API/cmd/api/utv"
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authn"
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/utv"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	ingestv1 "github.com/DeRuina/KUHA-REST-API/proto/kuha/ingest/v1"
+	"google.golang.org/grpc/codes"
+)
+
+var deviceNames = map[ingestv1.Device]string{
+	ingestv1.Device_DEVICE_GARMIN: "garmin",
+	ingestv1.Device_DEVICE_OURA:   "oura",
+	ingestv1.Device_DEVICE_POLAR:  "polar",
+	ingestv1.Device_DEVICE_SUUNTO: "suunto",
+}
+
+var devices = map[string]ingestv1.Device{
+	"garmin": ingestv1.Device_DEVICE_GARMIN,
+	"oura":   ingestv1.Device_DEVICE_OURA,
+	"polar":  ingestv1.Device_DEVICE_POLAR,
+	"suunto": ingestv1.Device_DEVICE_SUUNTO,
+}
+
+func (s *ingestService) deviceHandler() *utvapi.DeviceDataHandler {
+	u := s.store.UTV
+	return utvapi.NewDeviceDataHandler(u.Oura(), u.Polar(), u.Suunto(), u.Garmin(), s.cache)
+}
+
+func (s *ingestService) IngestDeviceData(stream ingestv1.IngestService_IngestDeviceDataServer) error {
+	ctx := stream.Context()
+	if s.store.UTV == nil {
+		return unavailable("utv")
+	}
+
+	h := s.deviceHandler()
+	roles := authn.GetClientRoles(ctx)
+	users := map[string]struct{}{}
+	var n int64
+	for ; ; n++ {
+		m, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		device := deviceNames[m.GetDevice()]
+		// each device has its own REST route and roles
+		if device != "" && !authz.Can(roles, http.MethodPost, "/v1/utv/"+device+"/data") {
+			return problem(codes.PermissionDenied, utils.CodeForbidden, "access denied to "+device+" data")
+		}
+		err = h.InsertDeviceData(ctx, utvapi.DeviceDataInput{UserID: m.GetUserId(), Device: device, Date: m.GetDate(), Data: m.GetData()})
+		var rejected *utils.ItemRejection
+		if errors.As(err, &rejected) {
+			err = &utils.InvalidInputError{Index: int(n), Err: err}
+		}
+		if err != nil {
+			return statusError(ctx, err)
+		}
+		users[m.GetUserId()] = struct{}{}
+	}
+
+	if n == 0 {
+		return invalidArgument(utils.ErrMissingData)
+	}
+	return stream.SendAndClose(&ingestv1.IngestSummary{Received: n, Users: int64(len(users))})
+}
+
+func (s *ingestService) StreamDeviceData(req *ingestv1.DeviceDataRequest, stream ingestv1.IngestService_StreamDeviceDataServer) error {
+	ctx := stream.Context()
+	if s.store.UTV == nil {
+		return unavailable("utv")
+	}
+
+	q := utvapi.DeviceDataQuery{
+		UserID:     req.GetUserId(),
+		Device:     deviceNames[req.GetDevice()],
+		Type:       req.GetType(),
+		AfterDate:  req.GetAfterDate(),
+		BeforeDate: req.GetBeforeDate(),
+	}
+	err := s.deviceHandler().StreamDeviceData(ctx, q, func(e utv.LatestDataEntry) error {
+		return stream.Send(&ingestv1.DeviceData{
+			UserId: q.UserID,
+			Device: devices[e.Device],
+			Date:   e.Date.Format("2006-01-02"),
+			Data:   e.Data,
+		})
+	})
+	if err != nil {
+		return statusError(ctx, err)
+	}
+	return nil
+}
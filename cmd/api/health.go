@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 // Store app start time for uptime calculation
@@ -17,20 +18,37 @@ var startTime = time.Now()
 //	@Description	Healthcheck endpoint
 //	@Tags			Health
 //	@Produce		json
-//	@Success		200	{object}	swagger.HealthStatusResponse	"Health status"
-//	@Failure		500	{object}	swagger.InternalServerErrorResponse
+//	@Success		200	{object}	apitypes.HealthStatusResponse	"Health status"
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
 //	@Router			/health [get]
 func (app *api) healthCheckHandler(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(r.Context(), 1*time.Second)
 	defer cancel()
 
-	data := map[string]any{
-		"env":     app.config.env,
-		"version": version,
+	deps := app.dependencyStatus(ctx)
+	data := apitypes.HealthStatusResponse{
+		API:           "ok",
+		DBArchinisis:  deps["db_archinisis"],
+		DBAuth:        deps["db_auth"],
+		DBFIS:         deps["db_fis"],
+		DBKAMK:        deps["db_kamk"],
+		DBKlab:        deps["db_klab"],
+		DBTietoevry:   deps["db_tietoevry"],
+		DBUTV:         deps["db_utv"],
+		Env:           app.config.env,
+		Redis:         deps["redis"],
+		UptimeSeconds: int64(time.Since(startTime).Seconds()),
+		Version:       version,
 	}
 
-	status := "ok"
-	statusCode := http.StatusOK
+	if err := utils.WriteJSON(w, http.StatusOK, data); err != nil {
+		utils.InternalServerError(w, r, err)
+	}
+}
+
+// dependencyStatus pings Redis and every configured database
+func (app *api) dependencyStatus(ctx context.Context) map[string]string {
+	data := make(map[string]string)
 
 	// Redis check
 	if app.config.redisCfg.enabled {
@@ -65,13 +83,5 @@ func (app *api) healthCheckHandler(w http.ResponseWriter, r *http.Request) {
 	checkDB("db_klab", app.store.KLAB)
 	checkDB("db_archinisis", app.store.ARCHINISIS)
 
-	// Add uptime and goroutine info
-	data["uptime_seconds"] = int64(time.Since(startTime).Seconds())
-	data["api"] = status
-
-	statusCode = http.StatusOK
-
-	if err := utils.WriteJSON(w, statusCode, data); err != nil {
-		utils.InternalServerError(w, r, err)
-	}
+	return data
 }
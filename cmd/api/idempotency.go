@@ -0,0 +1,200 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authn"
+	"github.com/DeRuina/KUHA-REST-API/internal/idempotency"
+	"github.com/DeRuina/KUHA-REST-API/internal/logger"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+type idempotencyConfig struct {
+	// how long a finished response is replayed
+	ttl time.Duration
+	// how long a key stays claimed by a request that never finishes (crash, timeout)
+	lockTTL time.Duration
+}
+
+const (
+	// bodies up to this size are fingerprinted in memory, larger ones are spooled to disk
+	idempotencyMemBody = 8 << 20
+	// same cap as a decompressed upload
+	idempotencyMaxBody = 1 << 30
+	// responses larger than this are not stored, so a retry runs the request again
+	idempotencyMaxResponse = 1 << 20
+)
+
+// IdempotencyMiddleware makes POSTs that carry an Idempotency-Key safe to retry.
+// The first request with a key runs normally and its response is stored;
+// a retry with the same body gets that response back, a retry with a different
+// body is rejected, and a retry while the first is still running gets 409.
+// Keys are scoped to the client and route. 5xx responses are not stored.
+func (app *api) IdempotencyMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(idempotency.Header)
+		if key == "" || app.idempotency == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if len(key) > idempotency.MaxKeyLength {
+			utils.BadRequestResponse(w, r, fmt.Errorf("%s must be at most %d characters", idempotency.Header, idempotency.MaxKeyLength))
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, idempotencyMaxBody)
+		body, fingerprint, err := spoolBody(r)
+		if err != nil {
+			utils.BadRequestResponse(w, r, err)
+			return
+		}
+		defer body.Close()
+		r.Body = body
+
+		scoped := fmt.Sprintf("%s:%s:%s:%s", authn.GetClientName(r.Context()), r.Method, r.URL.Path, key)
+		ctx := r.Context()
+
+		rec, started, err := app.idempotency.Begin(ctx, scoped, fingerprint, app.config.idempotency.lockTTL)
+		if err != nil {
+			utils.InternalServerError(w, r, err)
+			return
+		}
+		if !started {
+			switch err := idempotency.Check(rec, fingerprint); {
+			case errors.Is(err, idempotency.ErrFingerprintMismatch):
+				utils.IdempotencyKeyReusedResponse(w, r, err)
+			case errors.Is(err, idempotency.ErrInProgress):
+				utils.IdempotencyInProgressResponse(w, r, err)
+			default:
+				replayResponse(w, rec)
+			}
+			return
+		}
+
+		rw := &idempotencyRecorder{ResponseWriter: w, status: http.StatusOK}
+		stored := false
+		defer func() {
+			if stored {
+				return
+			}
+			// never leave the key claimed after a failure or panic; the client may retry
+			if err := app.idempotency.Release(context.WithoutCancel(ctx), scoped); err != nil {
+				logger.Logger.Warnw("failed to release idempotency key", "error", err)
+			}
+		}()
+
+		next.ServeHTTP(rw, r)
+
+		if rw.status >= 500 || rw.overflow {
+			return
+		}
+		err = app.idempotency.Complete(context.WithoutCancel(ctx), scoped, idempotency.Record{
+			Fingerprint: fingerprint,
+			Status:      rw.status,
+			Header:      rw.header,
+			Body:        rw.body.Bytes(),
+		}, app.config.idempotency.ttl)
+		if err != nil {
+			logger.Logger.Warnw("failed to store idempotent response", "error", err)
+			return
+		}
+		stored = true
+	})
+}
+
+func replayResponse(w http.ResponseWriter, rec *idempotency.Record) {
+	for k, v := range rec.Header {
+		w.Header()[k] = v
+	}
+	w.Header().Set(idempotency.ReplayedHeader, "true")
+	w.WriteHeader(rec.Status)
+	_, _ = w.Write(rec.Body)
+}
+
+// spoolBody reads the whole body once to fingerprint it and hands back a
+// replacement reader; large bodies go to a temporary file instead of memory
+func spoolBody(r *http.Request) (io.ReadCloser, string, error) {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s %s?%s\n", r.Method, r.URL.Path, r.URL.RawQuery)
+
+	var buf bytes.Buffer
+	n, err := io.Copy(io.MultiWriter(h, &buf), io.LimitReader(r.Body, idempotencyMemBody+1))
+	if err != nil {
+		return nil, "", err
+	}
+	if n <= idempotencyMemBody {
+		return io.NopCloser(&buf), hex.EncodeToString(h.Sum(nil)), nil
+	}
+
+	f, err := os.CreateTemp("", "kuha-idempotency-*")
+	if err != nil {
+		return nil, "", err
+	}
+	spool := &tempFileBody{File: f}
+	if _, err := io.Copy(io.MultiWriter(h, f), io.MultiReader(&buf, r.Body)); err != nil {
+		spool.Close()
+		return nil, "", err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		spool.Close()
+		return nil, "", err
+	}
+	return spool, hex.EncodeToString(h.Sum(nil)), nil
+}
+
+type tempFileBody struct {
+	*os.File
+}
+
+func (b *tempFileBody) Close() error {
+	err := b.File.Close()
+	os.Remove(b.File.Name())
+	return err
+}
+
+// idempotencyRecorder passes the response through while keeping a copy to store
+type idempotencyRecorder struct {
+	http.ResponseWriter
+	status   int
+	header   http.Header
+	body     bytes.Buffer
+	overflow bool
+	wrote    bool
+}
+
+func (rw *idempotencyRecorder) WriteHeader(code int) {
+	if !rw.wrote {
+		rw.wrote = true
+		rw.status = code
+		rw.header = rw.ResponseWriter.Header().Clone()
+	}
+	rw.ResponseWriter.WriteHeader(code)
+}
+
+func (rw *idempotencyRecorder) Write(b []byte) (int, error) {
+	if !rw.wrote {
+		rw.WriteHeader(http.StatusOK)
+	}
+	if !rw.overflow {
+		if rw.body.Len()+len(b) > idempotencyMaxResponse {
+			rw.overflow = true
+			rw.body = bytes.Buffer{}
+		} else {
+			rw.body.Write(b)
+		}
+	}
+	return rw.ResponseWriter.Write(b)
+}
+
+func (rw *idempotencyRecorder) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
@@ -0,0 +1,606 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authn"
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	authsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/openapi"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+var (
+	errAthleteNotFound   = errors.New("athlete not found")
+	errInvalidAthleteRef = errors.New("invalid athlete reference")
+)
+
+// the registry id of the athlete itself, as in /identities/{id}
+const identityAthlete = "athlete"
+
+// identifierKind is one of the ids an athlete has in the source systems,
+// named after its column in athlete_identities
+type identifierKind struct {
+	name string
+	// set checks a value of this kind and puts it in a lookup
+	set func(p *authsqlc.FindAthleteIdentitiesParams, v string) error
+	// get is the athlete's value of this kind, if linked
+	get func(a authsqlc.AthleteIdentity) (string, bool)
+}
+
+var identifierKinds = []identifierKind{
+	{
+		name: "sportti_id",
+		set: func(p *authsqlc.FindAthleteIdentitiesParams, v string) error {
+			if _, err := strconv.ParseUint(v, 10, 64); err != nil {
+				return fmt.Errorf("sportti_id must be numeric")
+			}
+			p.SporttiID = sql.NullString{String: v, Valid: true}
+			return nil
+		},
+		get: func(a authsqlc.AthleteIdentity) (string, bool) { return a.SporttiID.String, a.SporttiID.Valid },
+	},
+	{
+		name: "utv_user_id",
+		set: func(p *authsqlc.FindAthleteIdentitiesParams, v string) (err error) {
+			p.UtvUserID, err = parseIdentityUUID("utv_user_id", v)
+			return err
+		},
+		get: func(a authsqlc.AthleteIdentity) (string, bool) { return a.UtvUserID.UUID.String(), a.UtvUserID.Valid },
+	},
+	{
+		name: "tietoevry_user_id",
+		set: func(p *authsqlc.FindAthleteIdentitiesParams, v string) (err error) {
+			p.TietoevryUserID, err = parseIdentityUUID("tietoevry_user_id", v)
+			return err
+		},
+		get: func(a authsqlc.AthleteIdentity) (string, bool) {
+			return a.TietoevryUserID.UUID.String(), a.TietoevryUserID.Valid
+		},
+	},
+	{
+		name: "fiscode",
+		set: func(p *authsqlc.FindAthleteIdentitiesParams, v string) (err error) {
+			p.Fiscode, err = parseIdentityInt32("fiscode", v)
+			return err
+		},
+		get: func(a authsqlc.AthleteIdentity) (string, bool) { return formatNullInt32(a.Fiscode) },
+	},
+	{
+		name: "fis_competitorid",
+		set: func(p *authsqlc.FindAthleteIdentitiesParams, v string) (err error) {
+			p.FisCompetitorid, err = parseIdentityInt32("fis_competitorid", v)
+			return err
+		},
+		get: func(a authsqlc.AthleteIdentity) (string, bool) { return formatNullInt32(a.FisCompetitorid) },
+	},
+	{
+		name: "kamk_user_id",
+		set: func(p *authsqlc.FindAthleteIdentitiesParams, v string) (err error) {
+			p.KamkUserID, err = parseIdentityInt32("kamk_user_id", v)
+			return err
+		},
+		get: func(a authsqlc.AthleteIdentity) (string, bool) { return formatNullInt32(a.KamkUserID) },
+	},
+}
+
+func findIdentifierKind(name string) (identifierKind, bool) {
+	for _, k := range identifierKinds {
+		if k.name == name {
+			return k, true
+		}
+	}
+	return identifierKind{}, false
+}
+
+func parseIdentityUUID(kind, v string) (uuid.NullUUID, error) {
+	id, err := uuid.Parse(v)
+	if err != nil {
+		return uuid.NullUUID{}, fmt.Errorf("%s must be a UUID", kind)
+	}
+	return uuid.NullUUID{UUID: id, Valid: true}, nil
+}
+
+func parseIdentityInt32(kind, v string) (sql.NullInt32, error) {
+	n, err := utils.ParsePositiveInt32(v)
+	if err != nil {
+		return sql.NullInt32{}, fmt.Errorf("%s must be a positive integer", kind)
+	}
+	return sql.NullInt32{Int32: n, Valid: true}, nil
+}
+
+func formatNullInt32(n sql.NullInt32) (string, bool) {
+	return strconv.FormatInt(int64(n.Int32), 10), n.Valid
+}
+
+// identifiers turns the input into a lookup of the given identifiers, with
+// their values in the form the registry returns them
+func identifiers(in apitypes.IdentitiesInput) (authsqlc.FindAthleteIdentitiesParams, map[string]string, error) {
+	values := make(map[string]string)
+	if in.SporttiID != nil {
+		values["sportti_id"] = *in.SporttiID
+	}
+	if in.UTVUserID != nil {
+		values["utv_user_id"] = *in.UTVUserID
+	}
+	if in.TietoevryUserID != nil {
+		values["tietoevry_user_id"] = *in.TietoevryUserID
+	}
+	if in.Fiscode != nil {
+		values["fiscode"] = strconv.FormatInt(int64(*in.Fiscode), 10)
+	}
+	if in.FISCompetitorID != nil {
+		values["fis_competitorid"] = strconv.FormatInt(int64(*in.FISCompetitorID), 10)
+	}
+	if in.KAMKUserID != nil {
+		values["kamk_user_id"] = strconv.FormatInt(int64(*in.KAMKUserID), 10)
+	}
+
+	var p authsqlc.FindAthleteIdentitiesParams
+	for _, k := range identifierKinds {
+		v, ok := values[k.name]
+		if !ok {
+			continue
+		}
+		if err := k.set(&p, v); err != nil {
+			return p, nil, err
+		}
+		// UUIDs are compared in their canonical form
+		values[k.name], _ = k.get(identityFromParams(0, p))
+	}
+	return p, values, nil
+}
+
+func identityFromParams(id int32, p authsqlc.FindAthleteIdentitiesParams) authsqlc.AthleteIdentity {
+	return authsqlc.AthleteIdentity{
+		ID:              id,
+		SporttiID:       p.SporttiID,
+		UtvUserID:       p.UtvUserID,
+		TietoevryUserID: p.TietoevryUserID,
+		Fiscode:         p.Fiscode,
+		FisCompetitorid: p.FisCompetitorid,
+		KamkUserID:      p.KamkUserID,
+	}
+}
+
+func identityFromRow(a authsqlc.AthleteIdentity) apitypes.IdentityResponse {
+	return apitypes.IdentityResponse{
+		ID:              a.ID,
+		SporttiID:       utils.StringPtrOrNil(a.SporttiID),
+		UTVUserID:       utils.UUIDPtrToStringPtr(a.UtvUserID),
+		TietoevryUserID: utils.UUIDPtrToStringPtr(a.TietoevryUserID),
+		Fiscode:         utils.Int32PtrOrNil(a.Fiscode),
+		FISCompetitorID: utils.Int32PtrOrNil(a.FisCompetitorid),
+		KAMKUserID:      utils.Int32PtrOrNil(a.KamkUserID),
+		CreatedAt:       a.CreatedAt,
+		UpdatedAt:       a.UpdatedAt,
+	}
+}
+
+// identityConflicts lists the identifiers that cannot be linked to target:
+// those another athlete holds and those target already has a different
+// value for
+func identityConflicts(target authsqlc.AthleteIdentity, matches []authsqlc.AthleteIdentity, values map[string]string) []utils.FieldError {
+	var conflicts []utils.FieldError
+	for _, k := range identifierKinds {
+		want, ok := values[k.name]
+		if !ok {
+			continue
+		}
+		if have, linked := k.get(target); linked && have != want {
+			conflicts = append(conflicts, utils.FieldError{
+				Field:   k.name,
+				Message: fmt.Sprintf("athlete %d already has %s %s", target.ID, k.name, have),
+			})
+			continue
+		}
+		for _, m := range matches {
+			if have, linked := k.get(m); m.ID != target.ID && linked && have == want {
+				conflicts = append(conflicts, utils.FieldError{
+					Field:   k.name,
+					Message: fmt.Sprintf("linked to athlete %d", m.ID),
+				})
+			}
+		}
+	}
+	return conflicts
+}
+
+// athleteFromURL loads the athlete in the {id} URL parameter
+func (app *api) athleteFromURL(w http.ResponseWriter, r *http.Request) (authsqlc.AthleteIdentity, bool) {
+	id, err := utils.ParsePositiveInt32(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return authsqlc.AthleteIdentity{}, false
+	}
+
+	a, err := app.store.Auth.Identities().GetIdentity(r.Context(), id)
+	if errors.Is(err, sql.ErrNoRows) {
+		utils.NotFoundResponse(w, r, errAthleteNotFound)
+		return a, false
+	}
+	if err != nil {
+		utils.HandleDatabaseError(w, r, err)
+		return a, false
+	}
+	return a, true
+}
+
+// LinkIdentities godoc
+//
+//	@Summary		Link athlete identifiers
+//	@Description	Records that the given identifiers belong to the same athlete. When none of them is known yet a new athlete is created (201); otherwise they are added to the athlete the known ones point to, or to athlete_id when given (200).
+//	@Description	Nothing is changed when an identifier is linked to another athlete, or the athlete already has a different value of that kind: the 409 response lists each conflict under errors. Unlink the old value first to replace it.
+//	@Tags			Identities
+//	@Accept			json
+//	@Produce		json
+//	@Param			input	body		apitypes.IdentitiesInput	true	"Identifiers"
+//	@Success		200		{object}	apitypes.IdentityResponse
+//	@Success		201		{object}	apitypes.IdentityResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		404		{object}	apitypes.NotFoundResponse
+//	@Failure		409		{object}	apitypes.ConflictResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/identities [post]
+func (app *api) linkIdentitiesHandler(w http.ResponseWriter, r *http.Request) {
+	if !authz.Authorize(r) {
+		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+		return
+	}
+
+	var in apitypes.IdentitiesInput
+	if err := utils.ReadJSON(w, r, &in); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	if err := utils.GetValidator().Struct(in); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	lookup, values, err := identifiers(in)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	if len(values) == 0 {
+		utils.BadRequestResponse(w, r, fmt.Errorf("at least one identifier is required"))
+		return
+	}
+
+	store := app.store.Auth.Identities()
+	matches, err := store.FindIdentities(r.Context(), lookup)
+	if err != nil {
+		utils.HandleDatabaseError(w, r, err)
+		return
+	}
+
+	var target *authsqlc.AthleteIdentity
+	if in.AthleteID != nil {
+		a, err := store.GetIdentity(r.Context(), *in.AthleteID)
+		if errors.Is(err, sql.ErrNoRows) {
+			utils.NotFoundResponse(w, r, errAthleteNotFound)
+			return
+		}
+		if err != nil {
+			utils.HandleDatabaseError(w, r, err)
+			return
+		}
+		target = &a
+	} else if len(matches) > 0 {
+		target = &matches[0]
+	}
+
+	if target == nil {
+		a, err := store.CreateIdentity(r.Context(), authsqlc.CreateAthleteIdentityParams(lookup))
+		if err != nil {
+			utils.HandleDatabaseError(w, r, err)
+			return
+		}
+		utils.WriteJSON(w, http.StatusCreated, identityFromRow(a))
+		return
+	}
+
+	if conflicts := identityConflicts(*target, matches, values); len(conflicts) > 0 {
+		p := utils.NewProblem(r, utils.CodeConflict, "identifiers are linked to another athlete or replace a linked one")
+		p.Errors = conflicts
+		utils.WriteProblem(w, p)
+		return
+	}
+
+	a, err := store.LinkIdentifiers(r.Context(), authsqlc.LinkAthleteIdentifiersParams{
+		SporttiID:       lookup.SporttiID,
+		UtvUserID:       lookup.UtvUserID,
+		TietoevryUserID: lookup.TietoevryUserID,
+		Fiscode:         lookup.Fiscode,
+		FisCompetitorid: lookup.FisCompetitorid,
+		KamkUserID:      lookup.KamkUserID,
+		ID:              target.ID,
+	})
+	if err != nil {
+		utils.HandleDatabaseError(w, r, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, identityFromRow(a))
+}
+
+// GetIdentity godoc
+//
+//	@Summary		Get athlete identifiers
+//	@Description	All ids the athlete has in the source systems. {id} is the registry id or any athlete reference, e.g. /identities/sportti_id:27353728.
+//	@Tags			Identities
+//	@Produce		json
+//	@Param			id	path		string	true	"Athlete id or reference"
+//	@Success		200	{object}	apitypes.IdentityResponse
+//	@Failure		400	{object}	apitypes.ValidationErrorResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		403	{object}	apitypes.ForbiddenResponse
+//	@Failure		404	{object}	apitypes.NotFoundResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503	{object}	apitypes.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/identities/{id} [get]
+func (app *api) getIdentityHandler(w http.ResponseWriter, r *http.Request) {
+	if !authz.Authorize(r) {
+		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+		return
+	}
+	a, ok := app.athleteFromURL(w, r)
+	if !ok {
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, identityFromRow(a))
+}
+
+// UnlinkIdentifier godoc
+//
+//	@Summary		Unlink an athlete identifier
+//	@Description	Removes one id from the athlete, e.g. one that was linked by mistake; the others stay linked
+//	@Tags			Identities
+//	@Produce		json
+//	@Param			id		path		string	true	"Athlete id or reference"
+//	@Param			kind	path		string	true	"Identifier kind"	Enums(sportti_id, utv_user_id, tietoevry_user_id, fiscode, fis_competitorid, kamk_user_id)
+//	@Success		200		{object}	apitypes.IdentityResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		404		{object}	apitypes.NotFoundResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/identities/{id}/identifiers/{kind} [delete]
+func (app *api) unlinkIdentifierHandler(w http.ResponseWriter, r *http.Request) {
+	if !authz.Authorize(r) {
+		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+		return
+	}
+	kind, ok := findIdentifierKind(chi.URLParam(r, "kind"))
+	if !ok {
+		utils.BadRequestResponse(w, r, fmt.Errorf("unknown identifier kind %q", chi.URLParam(r, "kind")))
+		return
+	}
+	a, ok := app.athleteFromURL(w, r)
+	if !ok {
+		return
+	}
+	if _, linked := kind.get(a); !linked {
+		utils.NotFoundResponse(w, r, fmt.Errorf("athlete %d has no %s", a.ID, kind.name))
+		return
+	}
+
+	a, err := app.store.Auth.Identities().UnlinkIdentifier(r.Context(), a.ID, kind.name)
+	if err != nil {
+		utils.HandleDatabaseError(w, r, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, identityFromRow(a))
+}
+
+// DeleteIdentity godoc
+//
+//	@Summary		Delete athlete
+//	@Description	Removes the athlete from the registry with all its links. The data in the source systems is not touched.
+//	@Tags			Identities
+//	@Param			id	path	string	true	"Athlete id or reference"
+//	@Success		204	"Deleted"
+//	@Failure		400	{object}	apitypes.ValidationErrorResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		403	{object}	apitypes.ForbiddenResponse
+//	@Failure		404	{object}	apitypes.NotFoundResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503	{object}	apitypes.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/identities/{id} [delete]
+func (app *api) deleteIdentityHandler(w http.ResponseWriter, r *http.Request) {
+	if !authz.Authorize(r) {
+		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+		return
+	}
+	id, err := utils.ParsePositiveInt32(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	deleted, err := app.store.Auth.Identities().DeleteIdentity(r.Context(), id)
+	if err != nil {
+		utils.HandleDatabaseError(w, r, err)
+		return
+	}
+	if !deleted {
+		utils.NotFoundResponse(w, r, errAthleteNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// athleteRef is an athlete reference, <kind>:<value>, e.g. sportti_id:27353728
+// or athlete:12 for the registry id
+type athleteRef struct {
+	kind  string
+	value string
+}
+
+// parseAthleteRef reads v as an athlete reference; false when it is a plain
+// value, which is passed on as is
+func parseAthleteRef(v string) (athleteRef, bool) {
+	kind, value, ok := strings.Cut(v, ":")
+	if !ok {
+		return athleteRef{}, false
+	}
+	if _, known := findIdentifierKind(kind); !known && kind != identityAthlete {
+		return athleteRef{}, false
+	}
+	return athleteRef{kind: kind, value: value}, true
+}
+
+// resolveAthleteRef looks up the athlete ref points to and returns its id of kind want
+func (app *api) resolveAthleteRef(r *http.Request, ref athleteRef, want string) (string, error) {
+	store := app.store.Auth.Identities()
+
+	var a authsqlc.AthleteIdentity
+	var err error
+	if ref.kind == identityAthlete {
+		id, perr := utils.ParsePositiveInt32(ref.value)
+		if perr != nil {
+			return "", fmt.Errorf("%w: athlete must be a positive integer", errInvalidAthleteRef)
+		}
+		a, err = store.GetIdentity(r.Context(), id)
+	} else {
+		kind, _ := findIdentifierKind(ref.kind)
+		var lookup authsqlc.FindAthleteIdentitiesParams
+		if perr := kind.set(&lookup, ref.value); perr != nil {
+			return "", fmt.Errorf("%w: %v", errInvalidAthleteRef, perr)
+		}
+		var matches []authsqlc.AthleteIdentity
+		matches, err = store.FindIdentities(r.Context(), lookup)
+		if err == nil && len(matches) == 0 {
+			err = sql.ErrNoRows
+		}
+		if err == nil {
+			a = matches[0]
+		}
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("%w: no athlete has %s %s", errAthleteNotFound, ref.kind, ref.value)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if want == identityAthlete {
+		return strconv.FormatInt(int64(a.ID), 10), nil
+	}
+	kind, _ := findIdentifierKind(want)
+	v, linked := kind.get(a)
+	if !linked {
+		return "", fmt.Errorf("%w: athlete %d has no %s", errAthleteNotFound, a.ID, want)
+	}
+	return v, nil
+}
+
+// IdentityResolutionMiddleware lets the parameters the OpenAPI document marks
+// with x-identity take an athlete reference (<kind>:<value>) in place of the
+// id they expect: the reference is looked up in the athlete registry and the
+// request goes on with the athlete's id of the parameter's kind, so handlers,
+// validation and role checks only ever see native ids. Plain values and
+// requests without a valid token pass through untouched; references need the
+// identities read role besides the route's own.
+func (app *api) IdentityResolutionMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if app.openapi == nil || r.Method == http.MethodOptions {
+			next.ServeHTTP(w, r)
+			return
+		}
+		op, pathParams := app.openapi.Find(r.Method, r.URL.Path)
+		if op == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		query := r.URL.Query()
+		var refs []openapi.Parameter
+		for _, p := range op.Params {
+			if p.Identity == "" {
+				continue
+			}
+			if _, ok := parseAthleteRef(identityParamValue(p, pathParams, query)); ok {
+				refs = append(refs, p)
+			}
+		}
+		// the JWT middleware answers unauthenticated requests further in
+		if len(refs) == 0 || authn.GetClientName(r.Context()) == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		// a lookup tells whether an athlete and its links exist, so it is only
+		// made for clients that may read the registry and call the route
+		roles := authn.GetClientRoles(r.Context())
+		method, path, found := app.v1Route(r)
+		if !authz.Can(roles, http.MethodGet, "/v1/identities") || !found || !authz.Can(roles, method, path) {
+			utils.ForbiddenResponse(w, r, fmt.Errorf("athlete references need access to /identities and %s %s", r.Method, r.URL.Path))
+			return
+		}
+		if app.store.Auth == nil {
+			utils.ServiceUnavailableDBResponse(w, r, "Auth")
+			return
+		}
+
+		segments := strings.Split(r.URL.Path, "/")
+		template := strings.Split(op.Path, "/")
+		for _, p := range refs {
+			ref, _ := parseAthleteRef(identityParamValue(p, pathParams, query))
+			id, err := app.resolveAthleteRef(r, ref, p.Identity)
+			switch {
+			case errors.Is(err, errInvalidAthleteRef):
+				utils.BadRequestResponse(w, r, fmt.Errorf("%s: %w", p.Name, err))
+				return
+			case errors.Is(err, errAthleteNotFound):
+				// the same answer for an unknown athlete and a missing link
+				utils.NotFoundResponse(w, r, fmt.Errorf("%s: %w", p.Name, err))
+				return
+			case err != nil:
+				utils.HandleDatabaseError(w, r, err)
+				return
+			}
+
+			if p.In == "query" {
+				query.Set(p.Name, id)
+				continue
+			}
+			for i := range template {
+				if template[i] == "{"+p.Name+"}" && i < len(segments) {
+					segments[i] = id
+				}
+			}
+		}
+
+		r = r.Clone(r.Context())
+		r.URL.Path = strings.Join(segments, "/")
+		r.URL.RawPath = ""
+		r.URL.RawQuery = query.Encode()
+		next.ServeHTTP(w, r)
+	})
+}
+
+func identityParamValue(p openapi.Parameter, pathParams map[string]string, query map[string][]string) string {
+	switch p.In {
+	case "path":
+		return pathParams[p.Name]
+	case "query":
+		if v := query[p.Name]; len(v) > 0 {
+			return v[0]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authn"
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	"github.com/DeRuina/KUHA-REST-API/internal/jobs"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+// AsyncBulkMiddleware takes over bulk uploads sent with ?mode=async: the body is
+// spooled to disk, a job of kind is queued to run process in the background
+// and the client gets 202 with the job to poll at /jobs/{id}. Any other
+// mode goes on to the handler. Async uploads are exempt from the request
+// timeout; the spool only stops when the client stops sending.
+func (app *api) AsyncBulkMiddleware(kind string, process jobs.Processor) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !asyncUpload(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if !authz.Authorize(r) {
+				utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+				return
+			}
+
+			utils.LimitBody(w, r)
+			body := utils.ExtendReads(http.NewResponseController(w), r.Body)
+			job, err := app.jobs.Submit(r.Context(), kind, authn.GetClientName(r.Context()), body, process)
+			switch {
+			case errors.Is(err, jobs.ErrQueueFull):
+				utils.JobQueueFullResponse(w, r, err)
+				return
+			case err != nil:
+				var maxErr *http.MaxBytesError
+				if errors.As(err, &maxErr) {
+					utils.BadRequestResponse(w, r, err)
+					return
+				}
+				utils.InternalServerError(w, r, err)
+				return
+			}
+
+			w.Header().Set("Location", apiPrefix(r)+"/jobs/"+job.ID)
+			utils.WriteJSON(w, http.StatusAccepted, job)
+		})
+	}
+}
+
+// asyncUpload reports whether a bulk upload is sent with ?mode=async
+func asyncUpload(r *http.Request) bool {
+	mode, err := utils.ParseBulkMode(r)
+	return err == nil && mode == utils.BulkModeAsync
+}
+
+// GetJob godoc
+//
+//	@Summary		Get import job
+//	@Description	Status, progress, per-item errors and counts of an upload sent with mode=async. Jobs are only visible to the client that created them and expire JOBS_TTL_HOURS (default 24) after their last update.
+//	@Tags			Jobs
+//	@Produce		json
+//	@Param			id	path		string	true	"Job ID"
+//	@Success		200	{object}	apitypes.JobResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		404	{object}	apitypes.NotFoundResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Security		BearerAuth
+//	@Router			/jobs/{id} [get]
+func (app *api) getJobHandler(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	job, err := app.jobs.Store().Get(r.Context(), id)
+	if errors.Is(err, jobs.ErrNotFound) {
+		utils.NotFoundResponse(w, r, err)
+		return
+	}
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	// other clients' jobs look like they do not exist
+	isAdmin := slices.Contains(authn.GetClientRoles(r.Context()), "admin")
+	if job.Client != authn.GetClientName(r.Context()) && !isAdmin {
+		utils.NotFoundResponse(w, r, jobs.ErrNotFound)
+		return
+	}
+
+	if !job.Done() {
+		w.Header().Set("Retry-After", "5")
+	}
+	utils.WriteJSON(w, http.StatusOK, job)
+}
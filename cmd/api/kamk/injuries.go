@@ -6,9 +6,12 @@ import (
 	"net/http"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
+	"github.com/DeRuina/KUHA-REST-API/internal/export"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/kamk"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 // Handler
@@ -22,21 +25,6 @@ func NewInjuriesHandler(store kamk.Injuries, cache *cache.Storage) *InjuriesHand
 }
 
 // Validation structs
-type KamkAddInjuryInput struct {
-	UserID      int32  `json:"user_id" validate:"required,gt=0"`
-	InjuryType  int32  `json:"injury_type" validate:"required"`
-	Severity    int32  `json:"severity" validate:"required"`
-	PainLevel   int32  `json:"pain_level" validate:"required"`
-	Description string `json:"description" validate:"required"`
-	InjuryID    int32  `json:"injury_id" validate:"required"`
-	Meta        string `json:"meta" validate:"required"`
-}
-
-type KamkMarkRecoveredInput struct {
-	UserID   int32 `json:"user_id" validate:"required,gt=0"`
-	InjuryID int32 `json:"injury_id" validate:"required"`
-}
-
 type KamkGetInjuriesParams struct {
 	UserID int32 `form:"user_id" validate:"required,gt=0"`
 }
@@ -57,13 +45,13 @@ type KamkDeleteInjuryParams struct {
 //	@Tags			KAMK - Injuries
 //	@Accept			json
 //	@Produce		json
-//	@Param			body	body	swagger.KamkAddInjuryRequest	true	"Injury payload"
+//	@Param			body	body	apitypes.KamkAddInjuryRequest	true	"Injury payload"
 //	@Success		201		"Created: Injury stored (no content in response body)"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/kamk/injury [post]
 func (h *InjuriesHandler) AddInjury(w http.ResponseWriter, r *http.Request) {
@@ -72,7 +60,7 @@ func (h *InjuriesHandler) AddInjury(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var input KamkAddInjuryInput
+	var input apitypes.KamkAddInjuryRequest
 	if err := utils.ReadJSON(w, r, &input); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -96,6 +84,7 @@ func (h *InjuriesHandler) AddInjury(w http.ResponseWriter, r *http.Request) {
 	}
 
 	invalidateKamkInjuries(r.Context(), h.cache, input.UserID)
+	events.Publish(events.KAMKInjuryAdded, events.KAMKInjury{UserID: input.UserID, InjuryID: input.InjuryID})
 
 	w.WriteHeader(http.StatusCreated)
 }
@@ -107,13 +96,13 @@ func (h *InjuriesHandler) AddInjury(w http.ResponseWriter, r *http.Request) {
 //	@Tags			KAMK - Injuries
 //	@Accept			json
 //	@Produce		json
-//	@Param			body	body	swagger.KamkMarkRecoveredRequest	true	"Recovery payload"
+//	@Param			body	body	apitypes.KamkMarkRecoveredRequest	true	"Recovery payload"
 //	@Success		200		"OK: Marked recovered"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/kamk/injury-recovered [post]
 func (h *InjuriesHandler) MarkRecovered(w http.ResponseWriter, r *http.Request) {
@@ -122,7 +111,7 @@ func (h *InjuriesHandler) MarkRecovered(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	var input KamkMarkRecoveredInput
+	var input apitypes.KamkMarkRecoveredRequest
 	if err := utils.ReadJSON(w, r, &input); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -138,6 +127,7 @@ func (h *InjuriesHandler) MarkRecovered(w http.ResponseWriter, r *http.Request)
 	}
 
 	invalidateKamkInjuries(r.Context(), h.cache, input.UserID)
+	events.Publish(events.KAMKInjuryUpdated, events.KAMKInjury{UserID: input.UserID, InjuryID: input.InjuryID})
 
 	w.WriteHeader(http.StatusOK)
 }
@@ -148,15 +138,16 @@ func (h *InjuriesHandler) MarkRecovered(w http.ResponseWriter, r *http.Request)
 //	@Description	Returns active injuries (status=0) for a competitor
 //	@Tags			KAMK - Injuries
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,text/csv,application/x-ndjson,application/vnd.apache.parquet
 //	@Param			user_id	query		integer	true	"Competitor sportti_id"
-//	@Success		200		{object}	swagger.KamkInjuriesListResponse
+//	@Param			format	query		string	false	"Response format: json (default), csv, ndjson, parquet; overrides Accept"
+//	@Success		200		{object}	apitypes.KamkInjuriesListResponse
 //	@Success		204		"No Content: no injuries"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/kamk/injury [get]
 func (h *InjuriesHandler) GetActive(w http.ResponseWriter, r *http.Request) {
@@ -165,7 +156,7 @@ func (h *InjuriesHandler) GetActive(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := utils.ValidateParams(r, []string{"user_id"}); err != nil {
+	if err := utils.ValidateParams(r, []string{"user_id", export.FormatParam}); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
@@ -185,8 +176,14 @@ func (h *InjuriesHandler) GetActive(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	format, err := export.Negotiate(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
 	cacheKey := fmt.Sprintf("kamk:injury:list:%d", uid)
-	if h.cache != nil {
+	if format == export.JSON && h.cache != nil {
 		if cached, err := h.cache.Get(r.Context(), cacheKey); err == nil && cached != "" {
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(cached))
 			return
@@ -204,6 +201,13 @@ func (h *InjuriesHandler) GetActive(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if format != export.JSON {
+		if err := export.Write(w, format, "injuries", items, nil); err != nil {
+			utils.InternalServerError(w, r, err)
+		}
+		return
+	}
+
 	resp := map[string]any{"injuries": items}
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, resp, KAMKCacheTTL)
 	utils.WriteJSON(w, http.StatusOK, resp)
@@ -217,12 +221,12 @@ func (h *InjuriesHandler) GetActive(w http.ResponseWriter, r *http.Request) {
 //	@Accept			json
 //	@Produce		json
 //	@Param			user_id	query		integer	true	"Competitor sportti_id"
-//	@Success		200		{object}	swagger.KamkMaxInjuryIDResponse
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Success		200		{object}	apitypes.KamkMaxInjuryIDResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/kamk/injury-id [get]
 func (h *InjuriesHandler) GetMaxID(w http.ResponseWriter, r *http.Request) {
@@ -257,8 +261,7 @@ func (h *InjuriesHandler) GetMaxID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	resp := map[string]int32{"id": id}
-	utils.WriteJSON(w, http.StatusOK, resp)
+	utils.WriteJSON(w, http.StatusOK, apitypes.KamkMaxInjuryIDResponse{ID: id})
 }
 
 // DeleteInjury godoc
@@ -271,12 +274,12 @@ func (h *InjuriesHandler) GetMaxID(w http.ResponseWriter, r *http.Request) {
 //	@Param			user_id		query	integer	true	"sportti_id"
 //	@Param			injury_id	query	integer	true	"Injury ID"
 //	@Success		200			"OK: deleted"
-//	@Failure		400			{object}	swagger.ValidationErrorResponse
-//	@Failure		401			{object}	swagger.UnauthorizedResponse
-//	@Failure		403			{object}	swagger.ForbiddenResponse
-//	@Failure		404			{object}	swagger.NotFoundResponse
-//	@Failure		500			{object}	swagger.InternalServerErrorResponse
-//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400			{object}	apitypes.ValidationErrorResponse
+//	@Failure		401			{object}	apitypes.UnauthorizedResponse
+//	@Failure		403			{object}	apitypes.ForbiddenResponse
+//	@Failure		404			{object}	apitypes.NotFoundResponse
+//	@Failure		500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/kamk/injury [delete]
 func (h *InjuriesHandler) DeleteInjury(w http.ResponseWriter, r *http.Request) {
@@ -318,5 +321,6 @@ func (h *InjuriesHandler) DeleteInjury(w http.ResponseWriter, r *http.Request) {
 	}
 
 	invalidateKamkInjuries(r.Context(), h.cache, p.UserID)
+	events.Publish(events.KAMKInjuryUpdated, events.KAMKInjury{UserID: p.UserID, InjuryID: p.InjuryID})
 	w.WriteHeader(http.StatusOK)
 }
@@ -6,9 +6,12 @@ import (
 	"net/http"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
+	"github.com/DeRuina/KUHA-REST-API/internal/export"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/kamk"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 // Handler
@@ -22,14 +25,6 @@ func NewQueriesHandler(store kamk.Queries, cache *cache.Storage) *QueriesHandler
 }
 
 // Validation structs
-type KamkAddQuestionnaireInput struct {
-	UserID    int32  `json:"user_id" validate:"required,gt=0"`
-	QueryType int32  `json:"query_type" validate:"gte=0"`
-	Answers   string `json:"answers" validate:"required"`
-	Comment   string `json:"comment" validate:"required"`
-	Meta      string `json:"meta" validate:"required"`
-}
-
 type KamkGetQuestionnairesParams struct {
 	UserID int32 `json:"user_id" validate:"required,gt=0"`
 }
@@ -44,11 +39,6 @@ type KamkUpdateQuestionnaireQuery struct {
 	ID     int64 `form:"id" validate:"required,gt=0"`
 }
 
-type KamkUpdateQuestionnaireBody struct {
-	Answers string `json:"answers" validate:"required"`
-	Comment string `json:"comment" validate:"required"`
-}
-
 // AddQuestionnaire godoc
 //
 //	@Summary		Create questionnaire entry
@@ -56,13 +46,13 @@ type KamkUpdateQuestionnaireBody struct {
 //	@Tags			KAMK - Queries
 //	@Accept			json
 //	@Produce		json
-//	@Param			body	body		swagger.KamkAddQuestionnaireRequest	true	"Questionnaire payload"
-//	@Success		201		{object}	swagger.KamkCreateQuestionnaireResponse
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Param			body	body		apitypes.KamkAddQuestionnaireRequest	true	"Questionnaire payload"
+//	@Success		201		{object}	apitypes.KamkCreateQuestionnaireResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/kamk/questionnaire [post]
 func (h *QueriesHandler) AddQuestionnaire(w http.ResponseWriter, r *http.Request) {
@@ -71,7 +61,7 @@ func (h *QueriesHandler) AddQuestionnaire(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	var input KamkAddQuestionnaireInput
+	var input apitypes.KamkAddQuestionnaireRequest
 	if err := utils.ReadJSON(w, r, &input); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -93,7 +83,8 @@ func (h *QueriesHandler) AddQuestionnaire(w http.ResponseWriter, r *http.Request
 	}
 
 	invalidateKamkQueries(r.Context(), h.cache, input.UserID)
-	utils.WriteJSON(w, http.StatusCreated, map[string]int64{"id": id})
+	events.Publish(events.KAMKQuestionnaireUpdated, events.KAMKQuestionnaire{UserID: input.UserID, ID: id})
+	utils.WriteJSON(w, http.StatusCreated, apitypes.KamkCreateQuestionnaireResponse{ID: id})
 }
 
 // GetQuestionnaires godoc
@@ -102,15 +93,18 @@ func (h *QueriesHandler) AddQuestionnaire(w http.ResponseWriter, r *http.Request
 //	@Description	Returns questionnaires for a competitor ordered by timestamp DESC
 //	@Tags			KAMK - Queries
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,text/csv,application/x-ndjson,application/vnd.apache.parquet
 //	@Param			user_id	query		integer	true	"sportti_id"
-//	@Success		200		{object}	swagger.KamkQuestionnairesListResponse
+//	@Param			limit	query		integer	false	"Page size (1-1000); enables cursor pagination"
+//	@Param			cursor	query		string	false	"Opaque cursor from next_cursor of the previous page"
+//	@Param			format	query		string	false	"Response format: json (default), csv, ndjson, parquet; overrides Accept"
+//	@Success		200		{object}	apitypes.KamkQuestionnairesListResponse
 //	@Success		204		"No Content: no rows"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/kamk/questionnaire [get]
 func (h *QueriesHandler) GetQuestionnaires(w http.ResponseWriter, r *http.Request) {
@@ -119,7 +113,7 @@ func (h *QueriesHandler) GetQuestionnaires(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	if err := utils.ValidateParams(r, []string{"user_id"}); err != nil {
+	if err := utils.ValidateParams(r, append([]string{"user_id", export.FormatParam}, utils.PageParams...)); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
@@ -139,15 +133,28 @@ func (h *QueriesHandler) GetQuestionnaires(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	cacheKey := fmt.Sprintf("kamk:queries:list:%d", uid)
-	if h.cache != nil {
+	page, err := utils.ParsePage(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	format, err := export.Negotiate(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	cacheKey := fmt.Sprintf("kamk:queries:list:%d%s", uid, page.CacheKey())
+	if format == export.JSON && h.cache != nil {
 		if cached, err := h.cache.Get(r.Context(), cacheKey); err == nil && cached != "" {
+			utils.SetNextLink(w, r, utils.CachedNextCursor(cached))
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(cached))
 			return
 		}
 	}
 
-	items, err := h.store.GetQuestionnaires(r.Context(), uid)
+	items, next, err := h.store.GetQuestionnaires(r.Context(), uid, page)
 	if err != nil {
 		utils.InternalServerError(w, r, err)
 		return
@@ -158,8 +165,18 @@ func (h *QueriesHandler) GetQuestionnaires(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	if format != export.JSON {
+		utils.SetNextLink(w, r, next)
+		if err := export.Write(w, format, "questionnaires", items, nil); err != nil {
+			utils.InternalServerError(w, r, err)
+		}
+		return
+	}
+
 	resp := map[string]any{"questionnaires": items}
+	utils.AddPageInfo(resp, page, next)
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, resp, KAMKCacheTTL)
+	utils.SetNextLink(w, r, next)
 	utils.WriteJSON(w, http.StatusOK, resp)
 }
 
@@ -172,13 +189,13 @@ func (h *QueriesHandler) GetQuestionnaires(w http.ResponseWriter, r *http.Reques
 //	@Produce		json
 //	@Param			user_id		query		integer	true	"sportti_id"
 //	@Param			quiz_type	query		integer	true	"Quiz type"
-//	@Success		200			{object}	swagger.KamkQuestionnairesListResponse
+//	@Success		200			{object}	apitypes.KamkQuestionnairesListResponse
 //	@Success		204			"No Content: no rows today"
-//	@Failure		400			{object}	swagger.ValidationErrorResponse
-//	@Failure		401			{object}	swagger.UnauthorizedResponse
-//	@Failure		403			{object}	swagger.ForbiddenResponse
-//	@Failure		500			{object}	swagger.InternalServerErrorResponse
-//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400			{object}	apitypes.ValidationErrorResponse
+//	@Failure		401			{object}	apitypes.UnauthorizedResponse
+//	@Failure		403			{object}	apitypes.ForbiddenResponse
+//	@Failure		500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/kamk/is-quiz-done [get]
 func (h *QueriesHandler) IsQuizDoneToday(w http.ResponseWriter, r *http.Request) {
@@ -237,16 +254,16 @@ func (h *QueriesHandler) IsQuizDoneToday(w http.ResponseWriter, r *http.Request)
 //	@Tags			KAMK - Queries
 //	@Accept			json
 //	@Produce		json
-//	@Param			user_id	query	integer								true	"sportti_id"
-//	@Param			id		query	integer								true	"Questionnaire ID"
-//	@Param			body	body	swagger.KamkUpdateQuestionnaireBody	true	"Update payload"
+//	@Param			user_id	query	integer									true	"sportti_id"
+//	@Param			id		query	integer									true	"Questionnaire ID"
+//	@Param			body	body	apitypes.KamkUpdateQuestionnaireBody	true	"Update payload"
 //	@Success		200		"OK: updated"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		404		{object}	swagger.NotFoundResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		404		{object}	apitypes.NotFoundResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/kamk/update-quiz [post]
 func (h *QueriesHandler) UpdateQuestionnaireByID(w http.ResponseWriter, r *http.Request) {
@@ -282,7 +299,7 @@ func (h *QueriesHandler) UpdateQuestionnaireByID(w http.ResponseWriter, r *http.
 		return
 	}
 
-	var body KamkUpdateQuestionnaireBody
+	var body apitypes.KamkUpdateQuestionnaireBody
 	if err := utils.ReadJSON(w, r, &body); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -304,6 +321,7 @@ func (h *QueriesHandler) UpdateQuestionnaireByID(w http.ResponseWriter, r *http.
 	}
 
 	invalidateKamkQueries(r.Context(), h.cache, uid)
+	events.Publish(events.KAMKQuestionnaireUpdated, events.KAMKQuestionnaire{UserID: uid, ID: qid})
 
 	w.WriteHeader(http.StatusOK)
 }
@@ -318,12 +336,12 @@ func (h *QueriesHandler) UpdateQuestionnaireByID(w http.ResponseWriter, r *http.
 //	@Param			user_id	query	integer	true	"sportti_id"
 //	@Param			id		query	integer	true	"Questionnaire ID"
 //	@Success		200		"OK: deleted"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		404		{object}	swagger.NotFoundResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		404		{object}	apitypes.NotFoundResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/kamk/delete-quiz [delete]
 func (h *QueriesHandler) DeleteQuestionnaire(w http.ResponseWriter, r *http.Request) {
@@ -369,5 +387,6 @@ func (h *QueriesHandler) DeleteQuestionnaire(w http.ResponseWriter, r *http.Requ
 	}
 
 	invalidateKamkQueries(r.Context(), h.cache, q.UserID)
+	events.Publish(events.KAMKQuestionnaireUpdated, events.KAMKQuestionnaire{UserID: q.UserID, ID: q.ID})
 	w.WriteHeader(http.StatusOK)
 }
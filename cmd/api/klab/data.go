@@ -3,14 +3,19 @@ package klabapi
 import (
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	klabsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/klab"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/klab"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 type KlabDataHandler struct {
@@ -22,18 +27,6 @@ func NewKlabDataHandler(store klab.Data, cache *cache.Storage) *KlabDataHandler
 	return &KlabDataHandler{store: store, cache: cache}
 }
 
-type KlabDataBulkInput map[string]KlabDataBundleInput
-
-type KlabDataBundleInput struct {
-	Customer        []KlabCustomerInput    `json:"customer"         validate:"omitempty,dive"`
-	MeasurementList []KlabMeasurementInput `json:"measurement_list" validate:"omitempty,dive"`
-	DirTest         []KlabDirTestInput     `json:"dirtest"          validate:"omitempty,dive"`
-	DirTestSteps    []KlabDirTestStepInput `json:"dirteststeps"     validate:"omitempty,dive"`
-	DirReport       []KlabDirReportInput   `json:"dirreport"        validate:"omitempty,dive"`
-	DirRawData      []KlabDirRawDataInput  `json:"dirrawdata"       validate:"omitempty,dive"`
-	DirResults      []KlabDirResultsInput  `json:"dirresults"       validate:"omitempty,dive"`
-}
-
 // UpsertKlabDataBulk godoc
 //
 //	@Summary		Upsert k-Lab data (one customer per request)
@@ -41,13 +34,18 @@ type KlabDataBundleInput struct {
 //	@Tags			KLAB - Data
 //	@Accept			json
 //	@Produce		json
-//	@Param			data	body	swagger.KlabDataBulkDoc	true	"klab data"
-//	@Success		201		"Data processed successfully"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Param			data			body	apitypes.KlabDataBulkDoc	true	"klab data"
+//	@Param			Idempotency-Key	header	string						false	"Client-chosen key; a retry with the same key and body replays the first response"
+//	@Param			mode			query	string						false	"atomic (default): all or nothing; partial: commit valid items and report each one"
+//	@Success		201				"Data processed successfully"
+//	@Success		207				{object}	apitypes.BulkResultResponse	"Partial mode: some items failed; see items"
+//	@Failure		400				{object}	apitypes.ValidationErrorResponse
+//	@Failure		401				{object}	apitypes.UnauthorizedResponse
+//	@Failure		403				{object}	apitypes.ForbiddenResponse
+//	@Failure		409				{object}	apitypes.ConflictResponse
+//	@Failure		422				{object}	apitypes.IdempotencyKeyReusedResponse
+//	@Failure		500				{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503				{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/klab/data [post]
 func (h *KlabDataHandler) InsertKlabDataBulk(w http.ResponseWriter, r *http.Request) {
@@ -56,144 +54,516 @@ func (h *KlabDataHandler) InsertKlabDataBulk(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	var input KlabDataBulkInput
-	if err := utils.ReadJSON(w, r, &input); err != nil {
+	mode, err := utils.ParseBulkMode(r)
+	if err == nil && mode == utils.BulkModeAsync {
+		// one customer per request keeps these bundles small enough to answer inline
+		err = fmt.Errorf("invalid mode: async is not supported for k-Lab data")
+	}
+	if err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
 
-	if len(input) != 1 {
-		utils.BadRequestResponse(w, r, fmt.Errorf("payload must contain exactly one customer key at the top level"))
+	if mode == utils.BulkModePartial {
+		h.insertKlabDataPartialBody(w, r)
 		return
 	}
 
-	var (
-		keyStr string
-		bundle KlabDataBundleInput
-	)
-	for k, v := range input {
-		keyStr, bundle = k, v
-		break
+	sporttiID, ok := h.insertKlabStreamed(w, r)
+	if !ok {
+		return
 	}
 
-	sporttiID, err := utils.ParseSporttiID(keyStr)
+	invalidateKlabAll(r.Context(), h.cache, sporttiID)
+	events.Publish(events.KLABDataInserted, events.KLABData{SporttiID: sporttiID})
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+// klabChunk is how many rows an atomic upload hands to the store at a time
+const klabChunk = 500
+
+// insertKlabStreamed runs an atomic upload while its body is being read, see
+// klabStream. On failure it answers the request itself; otherwise it returns
+// the Sportti ID of the customer.
+func (h *KlabDataHandler) insertKlabStreamed(w http.ResponseWriter, r *http.Request) (string, bool) {
+	utils.LimitBody(w, r)
+
+	st := &klabStream{written: map[int32]bool{}}
+	err := h.store.InsertKlabDataStream(r.Context(), func(write func(klab.KlabDataPayload) error) error {
+		st.write = write
+		err := st.read(utils.NewJSONReader(r.Body))
+		if err != nil && st.writeErr == nil {
+			return &utils.InvalidInputError{Index: -1, Err: err}
+		}
+		return err
+	})
 	if err != nil {
-		utils.BadRequestResponse(w, r, err)
-		return
+		var inputErr *utils.InvalidInputError
+		if errors.As(err, &inputErr) {
+			utils.BadRequestResponse(w, r, inputErr.Err)
+		} else {
+			utils.HandleDatabaseError(w, r, err)
+		}
+		return "", false
 	}
+	return st.sporttiID, true
+}
 
-	if err := utils.GetValidator().Struct(bundle); err != nil {
-		utils.BadRequestResponse(w, r, err)
-		return
+// klabStream validates the rows of an atomic upload as they arrive and hands
+// them to the store every klabChunk rows. A row is written once what it
+// references is: measurements after the customer rows, test rows after their
+// measurement. Rows that come before those are held until the body is read.
+type klabStream struct {
+	write    func(klab.KlabDataPayload) error
+	writeErr error
+
+	sporttiID string
+	custID    int32
+	// measurements that came before the customer rows, by position
+	early   []earlyMeasurement
+	written map[int32]bool
+	chunk   klab.KlabDataPayload
+	rows    int
+	held    klab.KlabDataPayload
+}
+
+type earlyMeasurement struct {
+	index int
+	m     apitypes.KlabMeasurement
+}
+
+func (st *klabStream) read(s *utils.JSONReader) error {
+	n := 0
+	err := s.Object(func(k string) error {
+		if n++; n > 1 {
+			return fmt.Errorf("payload must contain exactly one customer key at the top level")
+		}
+		sporttiID, err := utils.ParseSporttiID(k)
+		if err != nil {
+			return err
+		}
+		st.sporttiID = sporttiID
+
+		return s.Object(func(field string) error {
+			switch field {
+			case "customer":
+				var rows []apitypes.KlabCustomer
+				if err := decodeRows(s, &rows, true); err != nil {
+					return err
+				}
+				return st.customers(rows)
+			case "measurement_list":
+				return s.Array(func(i int) error {
+					var m apitypes.KlabMeasurement
+					if err := s.Decode(&m); err != nil {
+						return err
+					}
+					if err := utils.GetValidator().Struct(m); err != nil {
+						return err
+					}
+					return st.measurement(i, m)
+				})
+			case "dirtest":
+				return testRows(st, s, mapDirTestToParams, func(x klabsqlc.InsertDirTestParams) int32 { return x.Idmeasurement },
+					func(p *klab.KlabDataPayload) *[]klabsqlc.InsertDirTestParams { return &p.DirTests })
+			case "dirteststeps":
+				return testRows(st, s, mapDirTestStepToParams, func(x klabsqlc.InsertDirTestStepParams) int32 { return x.Idmeasurement },
+					func(p *klab.KlabDataPayload) *[]klabsqlc.InsertDirTestStepParams { return &p.DirTestSteps })
+			case "dirreport":
+				return testRows(st, s, mapDirReportToParams, func(x klabsqlc.InsertDirReportParams) int32 { return x.Idmeasurement },
+					func(p *klab.KlabDataPayload) *[]klabsqlc.InsertDirReportParams { return &p.DirReports })
+			case "dirrawdata":
+				return testRows(st, s, mapDirRawDataToParams, func(x klabsqlc.InsertDirRawDataParams) int32 { return x.Idmeasurement },
+					func(p *klab.KlabDataPayload) *[]klabsqlc.InsertDirRawDataParams { return &p.DirRawData })
+			case "dirresults":
+				return testRows(st, s, mapDirResultsToParams, func(x klabsqlc.InsertDirResultsParams) int32 { return x.Idmeasurement },
+					func(p *klab.KlabDataPayload) *[]klabsqlc.InsertDirResultsParams { return &p.DirResults })
+			default:
+				return utils.UnknownField(field)
+			}
+		})
+	})
+	if err != nil {
+		return err
+	}
+	if n != 1 {
+		return fmt.Errorf("payload must contain exactly one customer key at the top level")
+	}
+	if st.custID == 0 {
+		return fmt.Errorf("customer array must contain at least one row")
 	}
 
-	if len(bundle.Customer) == 0 {
-		utils.BadRequestResponse(w, r, fmt.Errorf("customer array must contain at least one row"))
-		return
+	// every measurement is written now, so the held test rows can follow
+	held := st.held
+	st.held = klab.KlabDataPayload{}
+	for _, err := range []error{
+		requeue(st, held.DirTests, func(p *klab.KlabDataPayload) *[]klabsqlc.InsertDirTestParams { return &p.DirTests }),
+		requeue(st, held.DirTestSteps, func(p *klab.KlabDataPayload) *[]klabsqlc.InsertDirTestStepParams { return &p.DirTestSteps }),
+		requeue(st, held.DirReports, func(p *klab.KlabDataPayload) *[]klabsqlc.InsertDirReportParams { return &p.DirReports }),
+		requeue(st, held.DirRawData, func(p *klab.KlabDataPayload) *[]klabsqlc.InsertDirRawDataParams { return &p.DirRawData }),
+		requeue(st, held.DirResults, func(p *klab.KlabDataPayload) *[]klabsqlc.InsertDirResultsParams { return &p.DirResults }),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+	return st.flush()
+}
+
+// customers checks the customer rows against the root key and queues them;
+// measurements that came before them are queued after them
+func (st *klabStream) customers(rows []apitypes.KlabCustomer) error {
+	if st.custID != 0 {
+		return fmt.Errorf("customer must appear only once")
+	}
+	custID, params, err := customerParams(st.sporttiID, rows)
+	if err != nil {
+		return err
+	}
+	st.custID = custID
+	st.chunk.Customers = params
+	st.rows += len(params)
+
+	early := st.early
+	st.early = nil
+	for _, e := range early {
+		if err := st.measurement(e.index, e.m); err != nil {
+			return err
+		}
 	}
+	return nil
+}
+
+// measurement queues measurement_list[i], or holds it until the customer rows are read
+func (st *klabStream) measurement(i int, m apitypes.KlabMeasurement) error {
+	if st.custID == 0 {
+		st.early = append(st.early, earlyMeasurement{index: i, m: m})
+		return nil
+	}
+	if m.IdCustomer == nil {
+		m.IdCustomer = &st.custID
+	} else if *m.IdCustomer != st.custID {
+		return fmt.Errorf("measurement_list[%d].idCustomer must equal customer[0].idCustomer (%d)", i, st.custID)
+	}
+
+	arg, err := mapMeasurementToParams(m)
+	if err != nil {
+		return err
+	}
+	st.chunk.Measurements = append(st.chunk.Measurements, arg)
+	st.written[arg.Idmeasurement] = true
+	return st.added()
+}
+
+// added counts a queued row and writes the chunk once it is full
+func (st *klabStream) added() error {
+	if st.rows++; st.rows < klabChunk {
+		return nil
+	}
+	return st.flush()
+}
 
-	for i, c := range bundle.Customer {
+func (st *klabStream) flush() error {
+	if st.rows == 0 {
+		return nil
+	}
+	if st.writeErr = st.write(st.chunk); st.writeErr != nil {
+		return st.writeErr
+	}
+	st.chunk, st.rows = klab.KlabDataPayload{}, 0
+	return nil
+}
+
+// testRows reads the rows of a test table: each is validated and mapped on
+// arrival and queued if its measurement is written, otherwise held
+func testRows[I, P any](st *klabStream, s *utils.JSONReader, mapFn func(I) (P, error), measurement func(P) int32, rows func(*klab.KlabDataPayload) *[]P) error {
+	return s.Array(func(int) error {
+		var row I
+		if err := s.Decode(&row); err != nil {
+			return err
+		}
+		if err := utils.GetValidator().Struct(row); err != nil {
+			return err
+		}
+		arg, err := mapFn(row)
+		if err != nil {
+			return err
+		}
+		if !st.written[measurement(arg)] {
+			*rows(&st.held) = append(*rows(&st.held), arg)
+			return nil
+		}
+		*rows(&st.chunk) = append(*rows(&st.chunk), arg)
+		return st.added()
+	})
+}
+
+// requeue queues held test rows
+func requeue[P any](st *klabStream, held []P, rows func(*klab.KlabDataPayload) *[]P) error {
+	for _, arg := range held {
+		*rows(&st.chunk) = append(*rows(&st.chunk), arg)
+		if err := st.added(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// customerParams checks the customer rows of a bundle against the Sportti ID
+// of its root key and maps them; all rows must be of one positive idCustomer
+func customerParams(sporttiID string, rows []apitypes.KlabCustomer) (int32, []klabsqlc.UpsertCustomerParams, error) {
+	if len(rows) == 0 {
+		return 0, nil, fmt.Errorf("customer array must contain at least one row")
+	}
+
+	for i, c := range rows {
 		if c.SporttiID == nil {
-			utils.BadRequestResponse(w, r, fmt.Errorf("customer[%d].sportti_id is required", i))
-			return
+			return 0, nil, fmt.Errorf("customer[%d].sportti_id is required", i)
 		}
 		if strings.TrimSpace(*c.SporttiID) != sporttiID {
-			utils.BadRequestResponse(w, r, fmt.Errorf("customer[%d].sportti_id (%s) does not match root key (%s)", i, *c.SporttiID, sporttiID))
-			return
+			return 0, nil, fmt.Errorf("customer[%d].sportti_id (%s) does not match root key (%s)", i, *c.SporttiID, sporttiID)
 		}
 	}
 
-	custID := utils.DerefInt32(bundle.Customer[0].IdCustomer)
+	custID := utils.DerefInt32(rows[0].IdCustomer)
 	if custID <= 0 {
-		utils.BadRequestResponse(w, r, fmt.Errorf("idCustomer must be a positive integer"))
-		return
+		return 0, nil, fmt.Errorf("idCustomer must be a positive integer")
 	}
-	for i, c := range bundle.Customer {
+	for i, c := range rows {
 		if c.IdCustomer == nil || *c.IdCustomer != custID {
-			utils.BadRequestResponse(w, r, fmt.Errorf("customer[%d].idCustomer must equal customer[0].idCustomer (%d)", i, custID))
-			return
+			return 0, nil, fmt.Errorf("customer[%d].idCustomer must equal customer[0].idCustomer (%d)", i, custID)
 		}
 	}
 
-	var p klab.KlabDataPayload
-
-	// 1) customer rows
-	for _, c := range bundle.Customer {
+	params := make([]klabsqlc.UpsertCustomerParams, 0, len(rows))
+	for _, c := range rows {
 		arg, err := mapCustomerToParams(c, sporttiID)
 		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
+			return 0, nil, err
 		}
-		p.Customers = append(p.Customers, arg)
+		params = append(params, arg)
+	}
+	return custID, params, nil
+}
+
+// insertKlabDataPartialBody reads a partial upload. Its outcomes are reported
+// per measurement, so the whole bundle is read before anything is stored.
+func (h *KlabDataHandler) insertKlabDataPartialBody(w http.ResponseWriter, r *http.Request) {
+	keyStr, bundle, err := decodeKlabBundle(w, r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
 	}
 
-	// 2) measurement_list
+	sporttiID, err := utils.ParseSporttiID(keyStr)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	custID, customers, err := customerParams(sporttiID, bundle.Customer)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	h.insertKlabDataPartial(w, r, sporttiID, custID, customers, bundle)
+}
+
+// decodeKlabBundle reads the single-customer payload of a partial upload row
+// by row. Customer rows are checked as they arrive; the other rows are checked
+// one by one later, so a bad one only fails itself.
+func decodeKlabBundle(w http.ResponseWriter, r *http.Request) (string, apitypes.KlabDataBundle, error) {
+	utils.LimitBody(w, r)
+
+	var (
+		key    string
+		bundle apitypes.KlabDataBundle
+		n      int
+	)
+	s := utils.NewJSONReader(r.Body)
+	err := s.Object(func(k string) error {
+		if n++; n > 1 {
+			return fmt.Errorf("payload must contain exactly one customer key at the top level")
+		}
+		key = k
+		return s.Object(func(field string) error {
+			switch field {
+			case "customer":
+				return decodeRows(s, &bundle.Customer, true)
+			case "measurement_list":
+				return decodeRows(s, &bundle.MeasurementList, false)
+			case "dirtest":
+				return decodeRows(s, &bundle.DirTest, false)
+			case "dirteststeps":
+				return decodeRows(s, &bundle.DirTestSteps, false)
+			case "dirreport":
+				return decodeRows(s, &bundle.DirReport, false)
+			case "dirrawdata":
+				return decodeRows(s, &bundle.DirRawData, false)
+			case "dirresults":
+				return decodeRows(s, &bundle.DirResults, false)
+			default:
+				return utils.UnknownField(field)
+			}
+		})
+	})
+	if err == nil && n != 1 {
+		err = fmt.Errorf("payload must contain exactly one customer key at the top level")
+	}
+	return key, bundle, err
+}
+
+// decodeRows appends each element of the next array to rows
+func decodeRows[T any](s *utils.JSONReader, rows *[]T, validate bool) error {
+	return s.Array(func(int) error {
+		var row T
+		if err := s.Decode(&row); err != nil {
+			return err
+		}
+		if validate {
+			if err := utils.GetValidator().Struct(row); err != nil {
+				return err
+			}
+		}
+		*rows = append(*rows, row)
+		return nil
+	})
+}
+
+// insertKlabDataPartial commits the customer and then every measurement that
+// is valid, together with its test rows; a bad row only fails its measurement.
+// Items are keyed by idMeasurement; index is the position in measurement_list,
+// or -1 for test rows that belong to a measurement uploaded earlier.
+func (h *KlabDataHandler) insertKlabDataPartial(w http.ResponseWriter, r *http.Request, sporttiID string, custID int32, customers []klabsqlc.UpsertCustomerParams, bundle apitypes.KlabDataBundle) {
+	p := klab.KlabDataPayload{Customers: customers}
+	failed := map[int32]error{}
+	position := map[int32]int{}
+
 	for i, m := range bundle.MeasurementList {
+		if m.IdMeasurement == nil {
+			utils.BadRequestResponse(w, r, fmt.Errorf("measurement_list[%d].idMeasurement is required", i))
+			return
+		}
+		id := *m.IdMeasurement
+		position[id] = i
 		if m.IdCustomer == nil {
 			m.IdCustomer = &custID
 		} else if *m.IdCustomer != custID {
-			utils.BadRequestResponse(w, r, fmt.Errorf("measurement_list[%d].idCustomer must equal customer[0].idCustomer (%d)", i, custID))
-			return
+			failed[id] = utils.RejectItem(utils.CodeValidationFailed, fmt.Errorf("measurement_list[%d].idCustomer must equal customer[0].idCustomer (%d)", i, custID))
+			continue
+		}
+		if err := utils.GetValidator().Struct(m); err != nil {
+			failed[id] = err
+			continue
 		}
-
 		arg, err := mapMeasurementToParams(m)
 		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
+			failed[id] = utils.RejectItem(utils.CodeValidationFailed, err)
+			continue
 		}
 		p.Measurements = append(p.Measurements, arg)
 	}
 
-	// 3) child tables
-	for _, t := range bundle.DirTest {
-		arg, err := mapDirTestToParams(t)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-		p.DirTests = append(p.DirTests, arg)
+	var err error
+	if p.DirTests, err = partialRows(bundle.DirTest, "dirtest", func(t apitypes.KlabDirTest) *int32 { return t.IdMeasurement }, mapDirTestToParams, failed); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
 	}
-	for _, st := range bundle.DirTestSteps {
-		arg, err := mapDirTestStepToParams(st)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-		p.DirTestSteps = append(p.DirTestSteps, arg)
+	if p.DirTestSteps, err = partialRows(bundle.DirTestSteps, "dirteststeps", func(t apitypes.KlabDirTestStep) *int32 { return t.Idmeasurement }, mapDirTestStepToParams, failed); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
 	}
-	for _, rp := range bundle.DirReport {
-		arg, err := mapDirReportToParams(rp)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-		p.DirReports = append(p.DirReports, arg)
+	if p.DirReports, err = partialRows(bundle.DirReport, "dirreport", func(t apitypes.KlabDirReport) *int32 { return t.Idmeasurement }, mapDirReportToParams, failed); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
 	}
-	for _, rd := range bundle.DirRawData {
-		arg, err := mapDirRawDataToParams(rd)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-		p.DirRawData = append(p.DirRawData, arg)
+	if p.DirRawData, err = partialRows(bundle.DirRawData, "dirrawdata", func(t apitypes.KlabDirRawData) *int32 { return t.IdMeasurement }, mapDirRawDataToParams, failed); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
 	}
-	for _, rs := range bundle.DirResults {
-		arg, err := mapDirResultsToParams(rs)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
+	if p.DirResults, err = partialRows(bundle.DirResults, "dirresults", func(t apitypes.KlabDirResults) *int32 { return t.Idmeasurement }, mapDirResultsToParams, failed); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	// drop every row of a measurement that already failed validation
+	p.Measurements = keepRows(p.Measurements, func(x klabsqlc.InsertMeasurementParams) int32 { return x.Idmeasurement }, failed)
+	p.DirTests = keepRows(p.DirTests, func(x klabsqlc.InsertDirTestParams) int32 { return x.Idmeasurement }, failed)
+	p.DirTestSteps = keepRows(p.DirTestSteps, func(x klabsqlc.InsertDirTestStepParams) int32 { return x.Idmeasurement }, failed)
+	p.DirReports = keepRows(p.DirReports, func(x klabsqlc.InsertDirReportParams) int32 { return x.Idmeasurement }, failed)
+	p.DirRawData = keepRows(p.DirRawData, func(x klabsqlc.InsertDirRawDataParams) int32 { return x.Idmeasurement }, failed)
+	p.DirResults = keepRows(p.DirResults, func(x klabsqlc.InsertDirResultsParams) int32 { return x.Idmeasurement }, failed)
+
+	index := func(id int32) int {
+		if i, ok := position[id]; ok {
+			return i
 		}
-		p.DirResults = append(p.DirResults, arg)
+		return -1
 	}
 
-	if err := h.store.InsertKlabDataBulk(r.Context(), []klab.KlabDataPayload{p}); err != nil {
+	var res utils.BulkResult
+	for id, err := range failed {
+		res.Add(utils.ItemFailed(index(id), strconv.Itoa(int(id)), err))
+	}
+
+	ids, outcomes, err := h.store.InsertKlabDataPartial(r.Context(), p)
+	if err != nil {
 		utils.HandleDatabaseError(w, r, err)
 		return
 	}
+	for k, o := range outcomes {
+		id := strconv.Itoa(int(ids[k]))
+		if o.Status == utils.ItemError {
+			res.Add(utils.ItemFailed(index(ids[k]), id, o.Err))
+			continue
+		}
+		res.Add(utils.ItemResult{Index: index(ids[k]), ID: id, Status: o.Status})
+	}
 
 	invalidateKlabAll(r.Context(), h.cache, sporttiID)
+	events.Publish(events.KLABDataInserted, events.KLABData{SporttiID: sporttiID})
 
-	w.WriteHeader(http.StatusCreated)
+	if err := utils.WriteBulkResult(w, res); err != nil {
+		utils.InternalServerError(w, r, err)
+	}
+}
+
+// partialRows validates and maps test rows, marking the measurement of a bad
+// row as failed. A row without idmeasurement cannot be attributed and fails the batch.
+func partialRows[I any, P any](rows []I, table string, id func(I) *int32, mapFn func(I) (P, error), failed map[int32]error) ([]P, error) {
+	out := make([]P, 0, len(rows))
+	for i, row := range rows {
+		mid := id(row)
+		if mid == nil {
+			return nil, fmt.Errorf("%s[%d].idmeasurement is required", table, i)
+		}
+		if _, ok := failed[*mid]; ok {
+			continue
+		}
+		if err := utils.GetValidator().Struct(row); err != nil {
+			failed[*mid] = err
+			continue
+		}
+		arg, err := mapFn(row)
+		if err != nil {
+			failed[*mid] = utils.RejectItem(utils.CodeValidationFailed, fmt.Errorf("%s[%d]: %w", table, i, err))
+			continue
+		}
+		out = append(out, arg)
+	}
+	return out, nil
+}
+
+func keepRows[P any](rows []P, id func(P) int32, failed map[int32]error) []P {
+	out := rows[:0]
+	for _, row := range rows {
+		if _, ok := failed[id(row)]; !ok {
+			out = append(out, row)
+		}
+	}
+	return out
 }
 
 // GetKlabData godoc
@@ -203,14 +573,16 @@ func (h *KlabDataHandler) InsertKlabDataBulk(w http.ResponseWriter, r *http.Requ
 //	@Tags			KLAB - Data
 //	@Accept			json
 //	@Produce		json
-//	@Param			id	query		string	true	"Sportti ID"
-//	@Success		200	{object}	swagger.KlabDataResponse
-//	@Failure		400	{object}	swagger.ValidationErrorResponse
-//	@Failure		401	{object}	swagger.UnauthorizedResponse
-//	@Failure		403	{object}	swagger.ForbiddenResponse
-//	@Failure		404	{object}	swagger.NotFoundResponse
-//	@Failure		500	{object}	swagger.InternalServerErrorResponse
-//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Param			id		query		string	true	"Sportti ID"
+//	@Param			limit	query		integer	false	"Page size (1-1000) over measurements; enables cursor pagination"
+//	@Param			cursor	query		string	false	"Opaque cursor from next_cursor of the previous page"
+//	@Success		200		{object}	apitypes.KlabDataResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		404		{object}	apitypes.NotFoundResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/klab/data [get]
 func (h *KlabDataHandler) GetKlabData(w http.ResponseWriter, r *http.Request) {
@@ -219,7 +591,7 @@ func (h *KlabDataHandler) GetKlabData(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := utils.ValidateParams(r, []string{"id"}); err != nil {
+	if err := utils.ValidateParams(r, append([]string{"id"}, utils.PageParams...)); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
@@ -239,9 +611,16 @@ func (h *KlabDataHandler) GetKlabData(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	page, err := utils.ParsePage(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	cacheKey := fmt.Sprintf("%s:%s%s", klabDataPrefix, sporttiID, page.CacheKey())
 	if h.cache != nil {
-		cacheKey := fmt.Sprintf("%s:%s", klabDataPrefix, sporttiID)
 		if cached, err := h.cache.Get(r.Context(), cacheKey); err == nil && cached != "" {
+			utils.SetNextLink(w, r, utils.CachedNextCursor(cached))
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(cached))
 			return
 		}
@@ -257,7 +636,7 @@ func (h *KlabDataHandler) GetKlabData(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res, err := h.store.GetDataByCustomerIDNoCustomer(r.Context(), idcustomer)
+	res, next, err := h.store.GetDataByCustomerIDNoCustomer(r.Context(), idcustomer, page)
 	if err == sql.ErrNoRows {
 		utils.NotFoundResponse(w, r, err)
 		return
@@ -276,7 +655,9 @@ func (h *KlabDataHandler) GetKlabData(w http.ResponseWriter, r *http.Request) {
 		"dirrawdata":   res.DirRawData,
 		"dirresults":   res.DirResults,
 	}
+	utils.AddPageInfo(body, page, next)
 
-	cache.SetCacheJSON(r.Context(), h.cache, fmt.Sprintf("%s:%s", klabDataPrefix, sporttiID), body, KLABCacheTTL)
+	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, KLABCacheTTL)
+	utils.SetNextLink(w, r, next)
 	utils.WriteJSON(w, http.StatusOK, body)
 }
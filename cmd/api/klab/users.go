@@ -7,11 +7,11 @@ import (
 	"fmt"
 	"net/http"
 
-	"github.com/DeRuina/KUHA-REST-API/docs/swagger"
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/klab"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 type UserDataHandler struct {
@@ -35,13 +35,13 @@ type KlabUserParams struct {
 //	@Accept			json
 //	@Produce		json
 //	@Param			id	query		integer	true	"Sportti ID"
-//	@Success		200	{object}	swagger.UserKlabResponse
-//	@Failure		400	{object}	swagger.ValidationErrorResponse
-//	@Failure		401	{object}	swagger.UnauthorizedResponse
-//	@Failure		403	{object}	swagger.ForbiddenResponse
-//	@Failure		404	{object}	swagger.NotFoundResponse
-//	@Failure		500	{object}	swagger.InternalServerErrorResponse
-//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Success		200	{object}	apitypes.UserKlabResponse
+//	@Failure		400	{object}	apitypes.ValidationErrorResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		403	{object}	apitypes.ForbiddenResponse
+//	@Failure		404	{object}	apitypes.NotFoundResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/klab/user [get]
 func (h *UserDataHandler) GetUser(w http.ResponseWriter, r *http.Request) {
@@ -98,7 +98,7 @@ func (h *UserDataHandler) GetUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	resp := swagger.KlabCustomerResponse{
+	resp := apitypes.KlabCustomerResponse{
 		Idcustomer:         row.Idcustomer,
 		Firstname:          row.Firstname,
 		Lastname:           row.Lastname,
@@ -152,7 +152,7 @@ func (h *UserDataHandler) GetUser(w http.ResponseWriter, r *http.Request) {
 		SporttiID:          utils.StringPtrOrNil(row.SporttiID),
 	}
 
-	body := map[string]any{"customer": resp}
+	body := apitypes.UserKlabResponse{Customer: resp}
 
 	cache.SetCacheJSON(r.Context(), h.cache, fmt.Sprintf("%s:%s", klabUserPrefix, sporttiID), body, KLABCacheTTL)
 	utils.WriteJSON(w, http.StatusOK, body)
@@ -171,12 +171,12 @@ type SporttiIDParam struct {
 //	@Produce		json
 //	@Param			sportti_id	query	string	true	"Sportti ID"
 //	@Success		200
-//	@Failure		400	{object}	swagger.ValidationErrorResponse
-//	@Failure		401	{object}	swagger.UnauthorizedResponse
-//	@Failure		403	{object}	swagger.ForbiddenResponse
-//	@Failure		404	{object}	swagger.NotFoundResponse
-//	@Failure		500	{object}	swagger.InternalServerErrorResponse
-//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400	{object}	apitypes.ValidationErrorResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		403	{object}	apitypes.ForbiddenResponse
+//	@Failure		404	{object}	apitypes.NotFoundResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/klab/user [delete]
 func (h *UserDataHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
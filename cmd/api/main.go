@@ -4,21 +4,30 @@ import (
 	"context"
 	"expvar"
 	"runtime"
+	"strings"
 	"time"
 
+	grpcapi "github.com/DeRuina/KUHA-REST-API/cmd/api/grpc"
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authn"
 	"github.com/DeRuina/KUHA-REST-API/internal/db"
 	"github.com/DeRuina/KUHA-REST-API/internal/env"
+	"github.com/DeRuina/KUHA-REST-API/internal/idempotency"
+	"github.com/DeRuina/KUHA-REST-API/internal/jobs"
 	"github.com/DeRuina/KUHA-REST-API/internal/logger"
+	"github.com/DeRuina/KUHA-REST-API/internal/openapi"
 	"github.com/DeRuina/KUHA-REST-API/internal/ratelimiter"
 	"github.com/DeRuina/KUHA-REST-API/internal/store"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
+	"github.com/DeRuina/KUHA-REST-API/internal/stream"
+	"github.com/DeRuina/KUHA-REST-API/internal/usage"
+	"github.com/DeRuina/KUHA-REST-API/internal/webhooks"
 )
 
 const version = "1.3.1"
 
 //	@title			KUHA REST API
 //	@description	API for integrating, analyzing, and visualizing sports and health data
+//	@description	Errors are RFC 7807 problem details (application/problem+json) with a stable `code`, the `request_id` and, for validation failures, per-field `errors`.
 //	@termsOfService	https://csc.fi/en/security-privacy-data-policy-and-open-source-policy/privacy/
 
 //	@BasePath	/v1
@@ -43,6 +52,11 @@ func main() {
 			maxOpenConns:   env.GetInt("DB_MAX_OPEN_CONNS", 30),
 			maxIdleConns:   env.GetInt("DB_MAX_IDLE_CONNS", 30),
 			maxIdleTime:    env.GetString("DB_MAX_IDLE_TIME", "15m"),
+			queryLog: db.QueryLogConfig{
+				Enabled:       env.GetBool("DB_QUERY_LOG_ENABLED", true),
+				SlowThreshold: time.Duration(env.GetInt("DB_SLOW_QUERY_MS", 500)) * time.Millisecond,
+				AppName:       env.GetString("DB_APPLICATION_NAME", "kuha-rest-api"),
+			},
 		},
 		redisCfg: redisConfig{
 			addr:    env.GetString("REDIS_ADDR", "localhost:6379"),
@@ -67,6 +81,52 @@ func main() {
 			TimeFrame:            time.Second * 5,
 			Enabled:              env.GetBool("RATE_LIMITER_ENABLED", true),
 		},
+		usage: usageConfig{
+			enabled:       env.GetBool("USAGE_ANALYTICS_ENABLED", true),
+			flushInterval: time.Duration(env.GetInt("USAGE_FLUSH_INTERVAL_SECONDS", 60)) * time.Second,
+		},
+		compression: compressionConfig{
+			enabled: env.GetBool("COMPRESSION_ENABLED", true),
+			minSize: env.GetInt("COMPRESSION_MIN_SIZE", 1024),
+		},
+		idempotency: idempotencyConfig{
+			ttl:     time.Duration(env.GetInt("IDEMPOTENCY_TTL_HOURS", 24)) * time.Hour,
+			lockTTL: time.Duration(env.GetInt("IDEMPOTENCY_LOCK_SECONDS", 120)) * time.Second,
+		},
+		jobs: jobs.Config{
+			Workers:   env.GetInt("JOBS_WORKERS", 2),
+			QueueSize: env.GetInt("JOBS_QUEUE_SIZE", 16),
+			Dir:       env.GetString("JOBS_DIR", ""),
+			TTL:       time.Duration(env.GetInt("JOBS_TTL_HOURS", 24)) * time.Hour,
+		},
+		grpc: grpcapi.Config{
+			Enabled:  env.GetBool("GRPC_ENABLED", false),
+			Addr:     env.GetString("GRPC_ADDR", ":9090"),
+			CertFile: env.GetString("GRPC_TLS_CERT", ""),
+			KeyFile:  env.GetString("GRPC_TLS_KEY", ""),
+		},
+		webhooks: webhooks.Config{
+			Enabled:      env.GetBool("WEBHOOKS_ENABLED", true),
+			Workers:      env.GetInt("WEBHOOK_WORKERS", 4),
+			QueueSize:    env.GetInt("WEBHOOK_QUEUE_SIZE", 1024),
+			MaxAttempts:  env.GetInt("WEBHOOK_MAX_ATTEMPTS", 8),
+			BaseBackoff:  time.Duration(env.GetInt("WEBHOOK_BACKOFF_SECONDS", 30)) * time.Second,
+			MaxBackoff:   time.Duration(env.GetInt("WEBHOOK_MAX_BACKOFF_MINUTES", 60)) * time.Minute,
+			Timeout:      time.Duration(env.GetInt("WEBHOOK_TIMEOUT_SECONDS", 10)) * time.Second,
+			PollInterval: time.Duration(env.GetInt("WEBHOOK_POLL_SECONDS", 10)) * time.Second,
+			Retention:    time.Duration(env.GetInt("WEBHOOK_RETENTION_DAYS", 30)) * 24 * time.Hour,
+		},
+		stream: stream.Config{
+			Enabled:    env.GetBool("STREAM_ENABLED", true),
+			History:    env.GetInt("STREAM_HISTORY", 100),
+			HistoryTTL: time.Duration(env.GetInt("STREAM_HISTORY_TTL_HOURS", 24)) * time.Hour,
+			QueueSize:  env.GetInt("STREAM_QUEUE_SIZE", 1024),
+			Heartbeat:  time.Duration(env.GetInt("STREAM_HEARTBEAT_SECONDS", 25)) * time.Second,
+		},
+		openapi: openAPIConfig{
+			mode:    env.GetString("OPENAPI_VALIDATION", openAPIEnforce),
+			maxBody: int64(env.GetInt("OPENAPI_VALIDATION_MAX_BODY_KB", 1024)) << 10,
+		},
 	}
 
 	// Rate limiter
@@ -78,8 +138,40 @@ func main() {
 	logger.Init(logDir)
 	defer logger.Cleanup()
 
+	if err := logger.SetLevel(env.GetString("LOG_LEVEL", "info")); err != nil {
+		logger.Logger.Warnw("invalid LOG_LEVEL, using info", "error", err)
+	}
+
+	var redactParams []string
+	if v := env.GetString("LOG_REDACT_PARAMS", ""); v != "" {
+		redactParams = strings.Split(v, ",")
+	}
+	err := logger.LoadRedactionConfig(logger.RedactionConfig{
+		Params: redactParams,
+		Mode:   env.GetString("LOG_REDACT_MODE", logger.RedactModeRedact),
+		Salt:   []byte(env.GetString("LOG_REDACT_SALT", "")),
+	})
+	if err != nil {
+		logger.Logger.Fatalw("invalid log redaction config, set LOG_REDACT_SALT", "error", err)
+	}
+
+	// v1 deprecation (dates as YYYY-MM-DD; an empty sunset leaves it unannounced)
+	since, err := time.Parse(time.DateOnly, env.GetString("V1_DEPRECATION_DATE", "2026-11-01"))
+	if err != nil {
+		logger.Logger.Fatalw("invalid V1_DEPRECATION_DATE", "error", err)
+	}
+	cfg.v1Deprecation.since = since
+	if v := env.GetString("V1_SUNSET_DATE", "2027-11-01"); v != "" {
+		if cfg.v1Deprecation.sunset, err = time.Parse(time.DateOnly, v); err != nil {
+			logger.Logger.Fatalw("invalid V1_SUNSET_DATE", "error", err)
+		}
+	}
+
 	// Cache
 	var cacheStorage *cache.Storage
+	var idempotencyStore idempotency.Store
+	var jobStore jobs.Store
+	var streamBackend stream.Backend
 	if cfg.redisCfg.enabled {
 		rdb := cache.NewRedisClient(cfg.redisCfg.addr, cfg.redisCfg.pw, cfg.redisCfg.db)
 		defer rdb.Close()
@@ -89,6 +181,9 @@ func main() {
 		} else {
 			cacheStorage = cache.NewRedisStorage(rdb)
 			redisLimiter = ratelimiter.NewRedisSlidingLimiter(rdb)
+			idempotencyStore = idempotency.NewRedisStore(rdb)
+			jobStore = jobs.NewRedisStore(rdb)
+			streamBackend = stream.NewRedisBackend(rdb, cfg.stream)
 			logger.Logger.Info("Redis cache connection established")
 		}
 	} else {
@@ -99,7 +194,21 @@ func main() {
 		)
 	}
 
+	if idempotencyStore == nil {
+		// keys only hold within this instance; replays need Redis when running several
+		idempotencyStore = idempotency.NewMemoryStore()
+	}
+	if jobStore == nil {
+		// job status is only visible on the instance that runs the job
+		jobStore = jobs.NewMemoryStore()
+	}
+	if streamBackend == nil {
+		// athlete streams only see writes made through this instance
+		streamBackend = stream.NewMemoryBackend(cfg.stream)
+	}
+
 	// Database - Connect with graceful failure handling
+	db.LoadQueryLogConfig(cfg.db.queryLog)
 	databases, dbErrors := db.NewWithGracefulFailure(
 		cfg.db.fisAddr,
 		cfg.db.utvAddr,
@@ -156,6 +265,18 @@ func main() {
 		Audience: cfg.auth.jwt.audience,
 	})
 
+	// OpenAPI document (requests are validated against it)
+	spec, err := openapi.Load(version)
+	if err != nil {
+		logger.Logger.Fatalw("invalid OpenAPI document", "error", err)
+	}
+	switch cfg.openapi.mode {
+	case openAPIEnforce, openAPIReport, openAPIOff:
+	default:
+		logger.Logger.Warnw("invalid OPENAPI_VALIDATION, using enforce", "value", cfg.openapi.mode)
+		cfg.openapi.mode = openAPIEnforce
+	}
+
 	// Storage
 	store := store.NewStorage(databases)
 
@@ -165,6 +286,28 @@ func main() {
 		cacheStorage:     cacheStorage,
 		redisRateLimiter: redisLimiter,
 		localRateLimiter: localLimiter,
+		idempotency:      idempotencyStore,
+		jobs:             jobs.NewRunner(jobStore, cfg.jobs),
+		openapi:          spec,
+	}
+	app.jobs.Start()
+
+	// Usage analytics (stored in the auth database)
+	if cfg.usage.enabled && app.store.Auth != nil {
+		app.usage = usage.NewCollector(app.store.Auth.Usage(), cfg.usage.flushInterval)
+		app.usage.Start()
+	}
+
+	// Webhooks (subscriptions and deliveries are stored in the auth database)
+	if cfg.webhooks.Enabled && app.store.Auth != nil {
+		app.webhooks = webhooks.NewDispatcher(app.store.Auth.Webhooks(), cfg.webhooks)
+		app.webhooks.Start()
+	}
+
+	// Athlete event streams (fanned out over Redis pub/sub when available)
+	if cfg.stream.Enabled {
+		app.stream = stream.NewHub(streamBackend, cfg.stream)
+		app.stream.Start()
 	}
 
 	// metrics
@@ -4,12 +4,21 @@ import (
 	"compress/gzip"
 	"encoding/base64"
 	"fmt"
+	"io"
 	"net/http"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authn"
+	"github.com/DeRuina/KUHA-REST-API/internal/export"
 	"github.com/DeRuina/KUHA-REST-API/internal/ratelimiter"
+	"github.com/DeRuina/KUHA-REST-API/internal/usage"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/andybalholm/brotli"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/klauspost/compress/zstd"
 )
 
 func (app *api) BasicAuthMiddleware() func(http.Handler) http.Handler {
@@ -51,6 +60,86 @@ func (app *api) BasicAuthMiddleware() func(http.Handler) http.Handler {
 	}
 }
 
+// untimedRoutes are the v1 routes that may outlast the request timeout, by
+// method and route pattern, with the requests on them that do
+var untimedRoutes = map[string]func(*http.Request) bool{
+	"GET /fis/racecc":              export.Streamed,
+	"GET /fis/racejp":              export.Streamed,
+	"GET /fis/racenk":              export.Streamed,
+	"GET /tietoevry/exercises":     export.Streamed,
+	"GET /tietoevry/measurements":  export.Streamed,
+	"POST /tietoevry/exercises":    asyncUpload,
+	"POST /tietoevry/measurements": asyncUpload,
+}
+
+// TimeoutMiddleware cancels the request context after d, except for event
+// streams, which stay open as long as the client listens, and the requests
+// untimedRoutes lists: streamed exports, which take as long as the listing
+// does, and async uploads, which take as long as the client sends. These
+// bound idle clients with a read or write deadline instead, and stream
+// queries keep a deadline of their own.
+func (app *api) TimeoutMiddleware(d time.Duration) func(http.Handler) http.Handler {
+	timeout := middleware.Timeout(d)
+	return func(next http.Handler) http.Handler {
+		limited := timeout(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isEventStream(r) || app.untimed(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			limited.ServeHTTP(w, r)
+		})
+	}
+}
+
+// untimed reports whether the request is one untimedRoutes exempts from the
+// request timeout. The route is found on v1, which v2 requests are served as.
+func (app *api) untimed(r *http.Request) bool {
+	if app.v1 == nil {
+		return false
+	}
+	method, path, ok := app.v1Route(r)
+	if !ok || !strings.HasPrefix(path, "/v1/") {
+		return false
+	}
+	pattern := app.v1.Find(chi.NewRouteContext(), method, strings.TrimPrefix(path, "/v1"))
+	exempt := untimedRoutes[method+" "+pattern]
+	return exempt != nil && exempt(r)
+}
+
+// AdminAuthMiddleware accepts either the Basic Auth operator credentials or a JWT carrying the admin role
+func (app *api) AdminAuthMiddleware() func(http.Handler) http.Handler {
+	basicAuth := app.BasicAuthMiddleware()
+
+	return func(next http.Handler) http.Handler {
+		basicNext := basicAuth(next)
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authHeader := r.Header.Get("Authorization")
+			if !strings.HasPrefix(authHeader, "Bearer ") {
+				basicNext.ServeHTTP(w, r)
+				return
+			}
+
+			_, claims, err := authn.ValidateJWT(strings.TrimPrefix(authHeader, "Bearer "))
+			if err != nil {
+				utils.UnauthorizedResponse(w, r, err)
+				return
+			}
+
+			clientName, _ := claims["sub"].(string)
+			roles := authn.RolesFromClaims(claims)
+			if !slices.Contains(roles, "admin") {
+				utils.ForbiddenResponse(w, r, fmt.Errorf("admin role required"))
+				return
+			}
+
+			ctx := authn.WithClientMetadata(r.Context(), clientName, roles)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
 func JWTMiddleware() func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -68,14 +157,7 @@ func JWTMiddleware() func(http.Handler) http.Handler {
 			}
 
 			clientName, _ := claims["sub"].(string)
-			rawRoles, _ := claims["roles"].([]interface{})
-
-			var roles []string
-			for _, r := range rawRoles {
-				if s, ok := r.(string); ok {
-					roles = append(roles, s)
-				}
-			}
+			roles := authn.RolesFromClaims(claims)
 
 			ctx := authn.WithClientMetadata(r.Context(), clientName, roles)
 			next.ServeHTTP(w, r.WithContext(ctx))
@@ -100,7 +182,7 @@ func ExtractClientIDMiddleware() func(http.Handler) http.Handler {
 			}
 
 			clientName, _ := claims["sub"].(string)
-			ctx := authn.WithClientMetadata(r.Context(), clientName, nil)
+			ctx := authn.WithClientMetadata(r.Context(), clientName, authn.RolesFromClaims(claims))
 
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
@@ -115,8 +197,11 @@ func (app *api) RateLimiterMiddleware(next http.Handler) http.Handler {
 		}
 
 		clientID := authn.GetClientName(r.Context())
+		// anonymous callers are limited per address but counted together, as
+		// the address is theirs to pick
+		rejectionKey := clientID
 		if clientID == "" {
-			clientID = r.RemoteAddr
+			clientID, rejectionKey = r.RemoteAddr, "anonymous"
 		}
 
 		limit, window := ratelimiter.GetLimitForRole(clientID)
@@ -128,13 +213,15 @@ func (app *api) RateLimiterMiddleware(next http.Handler) http.Handler {
 				return
 			}
 			if !allowed {
-				utils.RateLimitExceededResponse(w, r, retryAfter.String())
+				ratelimiter.RecordRejection(rejectionKey)
+				utils.RateLimitExceededResponse(w, r, retryAfter)
 				return
 			}
 		} else if app.localRateLimiter != nil {
 			allowed, retryAfter := app.localRateLimiter.Allow(clientID)
 			if !allowed {
-				utils.RateLimitExceededResponse(w, r, retryAfter.String())
+				ratelimiter.RecordRejection(rejectionKey)
+				utils.RateLimitExceededResponse(w, r, retryAfter)
 				return
 			}
 		}
@@ -143,10 +230,14 @@ func (app *api) RateLimiterMiddleware(next http.Handler) http.Handler {
 	})
 }
 
-func GzipDecompressionMiddleware() func(http.Handler) http.Handler {
+// DecompressionMiddleware decodes request bodies sent with Content-Encoding
+// gzip, zstd or br. The compressed body is capped at 200 MB and the decoded
+// one at 1 GB; other encodings pass through untouched.
+func DecompressionMiddleware() func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if !strings.EqualFold(r.Header.Get("Content-Encoding"), "gzip") {
+			encoding := strings.ToLower(strings.TrimSpace(r.Header.Get("Content-Encoding")))
+			if encoding != encodingGzip && encoding != encodingZstd && encoding != encodingBrotli {
 				next.ServeHTTP(w, r)
 				return
 			}
@@ -156,21 +247,98 @@ func GzipDecompressionMiddleware() func(http.Handler) http.Handler {
 
 			r.Body = http.MaxBytesReader(w, r.Body, maxCompressed)
 
-			gz, err := gzip.NewReader(r.Body)
-			if err != nil {
-				utils.BadRequestResponse(w, r, fmt.Errorf("invalid gzip payload: %w", err))
-				return
+			var body io.Reader
+			switch encoding {
+			case encodingGzip:
+				gz, err := gzip.NewReader(r.Body)
+				if err != nil {
+					utils.BadRequestResponse(w, r, fmt.Errorf("invalid gzip payload: %w", err))
+					return
+				}
+				defer gz.Close()
+				body = gz
+			case encodingZstd:
+				zr, err := zstd.NewReader(r.Body, zstd.WithDecoderConcurrency(1))
+				if err != nil {
+					utils.BadRequestResponse(w, r, fmt.Errorf("invalid zstd payload: %w", err))
+					return
+				}
+				defer zr.Close()
+				body = zr
+			case encodingBrotli:
+				body = brotli.NewReader(r.Body)
 			}
-			defer gz.Close()
-
-			rc := http.MaxBytesReader(w, gz, maxDecompressed)
-
-			r.Body = rc
 
+			r.Body = http.MaxBytesReader(w, io.NopCloser(body), maxDecompressed)
 			r.Header.Del("Content-Encoding")
-			r.Header.Set("X-Was-Gzipped", "true")
 
-			next.ServeHTTP(w, r)
+			next.ServeHTTP(w, utils.MarkDecompressed(r))
 		})
 	}
 }
+
+type usageRecorder struct {
+	http.ResponseWriter
+	status   int
+	bytesOut int64
+}
+
+func (rw *usageRecorder) WriteHeader(code int) {
+	rw.status = code
+	rw.ResponseWriter.WriteHeader(code)
+}
+
+func (rw *usageRecorder) Write(b []byte) (int, error) {
+	n, err := rw.ResponseWriter.Write(b)
+	rw.bytesOut += int64(n)
+	return n, err
+}
+
+func (rw *usageRecorder) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
+type usageBody struct {
+	io.ReadCloser
+	n int64
+}
+
+func (b *usageBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.n += int64(n)
+	return n, err
+}
+
+// UsageMiddleware feeds the per-client usage rollups; it relies on ExtractClientIDMiddleware for the client name
+func (app *api) UsageMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if app.usage == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		start := time.Now()
+		rw := &usageRecorder{ResponseWriter: w, status: http.StatusOK}
+
+		var body *usageBody
+		if r.Body != nil && r.Body != http.NoBody {
+			body = &usageBody{ReadCloser: r.Body}
+			r.Body = body
+		}
+
+		next.ServeHTTP(rw, r)
+
+		clientName := authn.GetClientName(r.Context())
+		if clientName == "" {
+			clientName = "anonymous"
+		}
+
+		var bytesIn int64
+		if body != nil {
+			bytesIn = body.n
+		}
+
+		// the pattern is filled in by the routers the request went through
+		app.usage.Record(clientName, usage.RouteGroup(chi.RouteContext(r.Context()).RoutePattern()), rw.status, time.Since(start), bytesIn, rw.bytesOut)
+	})
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/logger"
+	"github.com/DeRuina/KUHA-REST-API/internal/openapi"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+const (
+	// requests breaking the spec are rejected with 400
+	openAPIEnforce = "enforce"
+	// violations are only logged, the request goes through
+	openAPIReport = "report"
+	// no validation
+	openAPIOff = "off"
+)
+
+type openAPIConfig struct {
+	mode string
+	// larger JSON bodies are left to the handler
+	maxBody int64
+}
+
+// OpenAPIValidationMiddleware checks every request against the OpenAPI document
+// before it reaches a handler. Requests the spec does not declare pass through,
+// so the router answers them with 404 or 405.
+func (app *api) OpenAPIValidationMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if app.openapi == nil || app.config.openapi.mode == openAPIOff || r.Method == http.MethodOptions {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		op, pathParams := app.openapi.Find(r.Method, r.URL.Path)
+		if op == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		violations := op.ValidateRequest(r, pathParams, app.config.openapi.maxBody)
+		if len(violations) == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		err := contractError(violations)
+		if app.config.openapi.mode == openAPIReport {
+			logger.Logger.Warnw("request breaks the OpenAPI contract",
+				"operation", op.ID,
+				"error", err.Error(),
+				"fields", err.Fields,
+				"request_id", middleware.GetReqID(r.Context()),
+			)
+			next.ServeHTTP(w, r)
+			return
+		}
+		utils.BadRequestResponse(w, r, err)
+	})
+}
+
+func contractError(violations []openapi.Violation) *utils.ContractError {
+	err := &utils.ContractError{Query: true}
+	for _, v := range violations {
+		if v.In != "query" {
+			err.Query = false
+		}
+		field := v.Field
+		if field == "" {
+			field = v.In
+		}
+		err.Fields = append(err.Fields, utils.FieldError{Field: field, Message: v.Message})
+	}
+	return err
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"database/sql"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/db"
+	"github.com/DeRuina/KUHA-REST-API/internal/logger"
+	"github.com/DeRuina/KUHA-REST-API/internal/openapi"
+	"github.com/DeRuina/KUHA-REST-API/internal/store"
+	"github.com/DeRuina/KUHA-REST-API/internal/stream"
+	"github.com/DeRuina/KUHA-REST-API/internal/webhooks"
+	"github.com/go-chi/chi/v5"
+)
+
+// mountedRoutes mounts the API with every database configured (connections
+// are never opened) and returns the chi patterns by method
+func mountedRoutes(t *testing.T, spec *openapi.Spec) map[string][]string {
+	t.Helper()
+	logger.Init(t.TempDir())
+
+	conn, err := sql.Open("postgres", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	st := store.NewStorage(&db.Database{FIS: conn, UTV: conn, Auth: conn, Tietoevry: conn, KAMK: conn, KLAB: conn, ARCHINISIS: conn})
+
+	app := &api{store: *st, openapi: spec}
+	app.webhooks = webhooks.NewDispatcher(st.Auth.Webhooks(), webhooks.Config{})
+	app.stream = stream.NewHub(stream.NewMemoryBackend(stream.Config{}), stream.Config{})
+
+	routes := make(map[string][]string)
+	err = chi.Walk(app.mount().(chi.Routes), func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		// the Swagger UI serves its own assets under this wildcard
+		if strings.HasSuffix(route, "/*") {
+			return nil
+		}
+		if len(route) > 1 {
+			route = strings.TrimSuffix(route, "/")
+		}
+		routes[method] = append(routes[method], route)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return routes
+}
+
+func TestMountedRoutesAreInOpenAPISpec(t *testing.T) {
+	spec, err := openapi.Load("")
+	if err != nil {
+		t.Fatalf("load spec: %v", err)
+	}
+
+	mounted := make(map[string]bool)
+	for method, routes := range mountedRoutes(t, spec) {
+		for _, route := range routes {
+			mounted[method+" "+route] = true
+			if !spec.HasOperation(method, route) {
+				t.Errorf("%s %s is mounted but missing from internal/openapi/openapi.yaml", method, route)
+			}
+		}
+	}
+
+	for _, op := range spec.Operations() {
+		found := false
+		for key := range mounted {
+			method, route, _ := strings.Cut(key, " ")
+			if method == op.Method && sameRoute(route, op.Path) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("%s %s is in the spec but not mounted", op.Method, op.Path)
+		}
+	}
+}
+
+// sameRoute compares two path templates ignoring parameter names
+func sameRoute(a, b string) bool {
+	as, bs := strings.Split(a, "/"), strings.Split(b, "/")
+	if len(as) != len(bs) {
+		return false
+	}
+	for i := range as {
+		pa, pb := strings.HasPrefix(as[i], "{"), strings.HasPrefix(bs[i], "{")
+		if pa != pb || (!pa && as[i] != bs[i]) {
+			return false
+		}
+	}
+	return true
+}
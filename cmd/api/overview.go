@@ -0,0 +1,331 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+
+	fisapi "github.com/DeRuina/KUHA-REST-API/cmd/api/fis"
+	tietoevryapi "github.com/DeRuina/KUHA-REST-API/cmd/api/tietoevry"
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authn"
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	authsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/logger"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/kamk"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/klab"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/tietoevry"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/utv"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+)
+
+const (
+	overviewDefaultLimit   int32 = 5
+	overviewDefaultUTVType       = "sleep"
+)
+
+// Section status values of the athlete overview
+const (
+	sectionOK        = "ok"
+	sectionNotLinked = "not_linked"
+	sectionError     = "error"
+)
+
+type overviewInput struct {
+	UTVType string `validate:"required,key"`
+	Limit   int32  `validate:"min=1,max=50"`
+}
+
+// overviewSource is one section of the overview: the REST route whose read
+// access it requires, the database it reads and how to load it
+type overviewSource struct {
+	name  string
+	route string
+	db    string
+	// available reports whether the section's database is connected
+	available func() bool
+	// load returns the section data, or ok=false when the athlete has no id
+	// in the domain
+	load func(ctx context.Context, a authsqlc.AthleteIdentity, in overviewInput) (data any, ok bool, err error)
+}
+
+func (app *api) overviewSources() []overviewSource {
+	return []overviewSource{
+		{name: "fis", route: "/v1/fis/competitor/latest-results", db: "FIS",
+			available: func() bool { return app.store.FIS != nil }, load: app.overviewFIS},
+		{name: "utv", route: "/v1/utv/latest", db: "UTV",
+			available: func() bool { return app.store.UTV != nil }, load: app.overviewUTV},
+		{name: "tietoevry", route: "/v1/tietoevry/exercises", db: "Tietoevry",
+			available: func() bool { return app.store.Tietoevry != nil }, load: app.overviewTietoevry},
+		{name: "klab", route: "/v1/klab/data", db: "KLAB",
+			available: func() bool { return app.store.KLAB != nil }, load: app.overviewKLAB},
+		{name: "archinisis", route: "/v1/archinisis/race-report/sessions", db: "ARCHINISIS",
+			available: func() bool { return app.store.ARCHINISIS != nil }, load: app.overviewArchinisis},
+		{name: "kamk", route: "/v1/kamk/injury", db: "KAMK",
+			available: func() bool { return app.store.KAMK != nil }, load: app.overviewKAMK},
+	}
+}
+
+// AthleteOverview godoc
+//
+//	@Summary		Get athlete overview
+//	@Description	Returns the latest data of one athlete from every domain in one response: FIS latest results (per sector), UTV latest entries of utv_type, recent Tietoevry exercises, the latest KLAB test, Archinisis race-report sessions and active KAMK injuries. The domains are queried concurrently.
+//	@Description	Each section has its own status. A section the client has no read access to, whose database is unavailable or whose query failed has status error and the problem code (forbidden, database_unavailable, query_timeout, internal_error); the other sections are still returned. A section whose id the athlete has no link for has status not_linked.
+//	@Tags			Identities
+//	@Produce		json
+//	@Param			id			path		string	true	"Athlete id or reference"
+//	@Param			limit		query		int		false	"Maximum entries per section (1-50, default 5)"
+//	@Param			utv_type	query		string	false	"UTV data type (default sleep)"
+//	@Success		200			{object}	apitypes.AthleteOverviewResponse
+//	@Failure		400			{object}	apitypes.ValidationErrorResponse
+//	@Failure		401			{object}	apitypes.UnauthorizedResponse
+//	@Failure		403			{object}	apitypes.ForbiddenResponse
+//	@Failure		404			{object}	apitypes.NotFoundResponse
+//	@Failure		500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503			{object}	apitypes.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/athletes/{id}/overview [get]
+func (app *api) athleteOverviewHandler(w http.ResponseWriter, r *http.Request) {
+	if err := utils.ValidateParams(r, []string{"limit", "utv_type"}); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	in := overviewInput{UTVType: overviewDefaultUTVType, Limit: overviewDefaultLimit}
+	if v := r.URL.Query().Get("utv_type"); v != "" {
+		in.UTVType = v
+	}
+	if v := r.URL.Query().Get("limit"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil {
+			utils.BadRequestResponse(w, r, fmt.Errorf("limit must be a number"))
+			return
+		}
+		in.Limit = int32(parsed)
+	}
+	if err := utils.GetValidator().Struct(in); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	sources := app.overviewSources()
+	roles := authn.GetClientRoles(r.Context())
+	allowed := make(map[string]bool, len(sources))
+	for _, s := range sources {
+		allowed[s.name] = authz.Can(roles, http.MethodGet, s.route)
+	}
+	if !anyAllowed(allowed) {
+		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+		return
+	}
+
+	a, ok := app.athleteFromURL(w, r)
+	if !ok {
+		return
+	}
+
+	resp := apitypes.AthleteOverviewResponse{AthleteID: a.ID, Sections: make(map[string]*apitypes.AthleteOverviewSection, len(sources))}
+	for _, s := range sources {
+		resp.Sections[s.name] = &apitypes.AthleteOverviewSection{}
+	}
+
+	var wg sync.WaitGroup
+	for _, s := range sources {
+		section := resp.Sections[s.name]
+		switch {
+		case !allowed[s.name]:
+			failSection(section, utils.CodeForbidden, fmt.Sprintf("access denied: requires read access to %s", s.route))
+			continue
+		case !s.available():
+			failSection(section, utils.CodeDatabaseUnavailable, fmt.Sprintf("%s database is unavailable", s.db))
+			continue
+		}
+
+		wg.Add(1)
+		go func(s overviewSource) {
+			defer wg.Done()
+			data, linked, err := s.load(r.Context(), a, in)
+			switch {
+			case err != nil:
+				sectionStoreError(r, section, s.name, err)
+			case !linked:
+				section.Status = sectionNotLinked
+			default:
+				section.Status = sectionOK
+				section.Data = data
+			}
+		}(s)
+	}
+	wg.Wait()
+
+	utils.WriteJSON(w, http.StatusOK, resp)
+}
+
+func anyAllowed(allowed map[string]bool) bool {
+	for _, ok := range allowed {
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+func failSection(s *apitypes.AthleteOverviewSection, code utils.ErrorCode, msg string) {
+	s.Status = sectionError
+	s.Error = &apitypes.AthleteOverviewSectionError{Code: string(code), Message: msg}
+}
+
+// sectionStoreError hides database errors from the client like the REST handlers do
+func sectionStoreError(r *http.Request, s *apitypes.AthleteOverviewSection, name string, err error) {
+	if errors.Is(err, context.DeadlineExceeded) {
+		failSection(s, utils.CodeQueryTimeout, utils.ErrQueryTimeOut.Error())
+		return
+	}
+	logger.Logger.Warnw("athlete overview section error",
+		"section", name,
+		"error", err.Error(),
+		"request_id", middleware.GetReqID(r.Context()),
+	)
+	failSection(s, utils.CodeInternalError, "the server encountered a problem")
+}
+
+func (app *api) overviewFIS(ctx context.Context, a authsqlc.AthleteIdentity, in overviewInput) (any, bool, error) {
+	if !a.Fiscode.Valid {
+		return nil, false, nil
+	}
+	h := fisapi.NewResultKAMKHandler(app.store.FIS.ResultCC(), app.store.FIS.ResultJP(), app.store.FIS.ResultNK(), nil)
+
+	data := map[string]any{"fiscode": a.Fiscode.Int32}
+	for _, sector := range []string{"CC", "JP", "NK"} {
+		results, err := h.LatestResults(ctx, sector, a.Fiscode.Int32, nil, nil, in.Limit)
+		if err != nil {
+			return nil, true, err
+		}
+		data[sector] = results
+	}
+	return data, true, nil
+}
+
+func (app *api) overviewUTV(ctx context.Context, a authsqlc.AthleteIdentity, in overviewInput) (any, bool, error) {
+	if !a.UtvUserID.Valid {
+		return nil, false, nil
+	}
+	userID := a.UtvUserID.UUID
+
+	devices := []struct {
+		name  string
+		store interface {
+			GetLatestByType(ctx context.Context, userID uuid.UUID, typ string, limit int32) ([]utv.LatestDataEntry, error)
+		}
+	}{
+		{"garmin", app.store.UTV.Garmin()},
+		{"oura", app.store.UTV.Oura()},
+		{"polar", app.store.UTV.Polar()},
+		{"suunto", app.store.UTV.Suunto()},
+	}
+
+	entries := make([]utv.LatestDataEntry, 0)
+	for _, d := range devices {
+		rows, err := d.store.GetLatestByType(ctx, userID, in.UTVType, in.Limit)
+		if err != nil {
+			return nil, true, err
+		}
+		for _, row := range rows {
+			row.Device = d.name
+			entries = append(entries, row)
+		}
+	}
+
+	// newest first across devices
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Date.After(entries[j].Date) })
+	if len(entries) > int(in.Limit) {
+		entries = entries[:in.Limit]
+	}
+
+	latest := make([]apitypes.LatestDataResponse, 0, len(entries))
+	for _, e := range entries {
+		latest = append(latest, apitypes.LatestDataResponse{
+			Device: e.Device,
+			Date:   e.Date.Format("2006-01-02"),
+			Data:   e.Data,
+		})
+	}
+	return map[string]any{"type": in.UTVType, "latest": latest}, true, nil
+}
+
+func (app *api) overviewTietoevry(ctx context.Context, a authsqlc.AthleteIdentity, in overviewInput) (any, bool, error) {
+	if !a.TietoevryUserID.Valid {
+		return nil, false, nil
+	}
+	page, err := utils.NewPage(in.Limit, "")
+	if err != nil {
+		return nil, true, err
+	}
+
+	rows, _, err := app.store.Tietoevry.Exercises().GetExercisesByUser(ctx, a.TietoevryUserID.UUID, tietoevry.ReadFilter{}, page)
+	if err != nil {
+		return nil, true, err
+	}
+
+	exercises := make([]apitypes.TietoevryExerciseUpsertInput, 0, len(rows))
+	for _, ex := range rows {
+		exercises = append(exercises, tietoevryapi.ExerciseFromRow(ex))
+	}
+	return map[string]any{"exercises": exercises}, true, nil
+}
+
+func (app *api) overviewKLAB(ctx context.Context, a authsqlc.AthleteIdentity, in overviewInput) (any, bool, error) {
+	if !a.SporttiID.Valid {
+		return nil, false, nil
+	}
+	store := app.store.KLAB.Data()
+
+	// an athlete without a KLAB customer or measurements has no latest test
+	var latest *klab.KlabDataNoCustomerResponse
+	customerID, err := store.GetCustomerIDBySporttiID(ctx, a.SporttiID.String)
+	if err == nil {
+		latest, err = store.GetLatestDataByCustomerID(ctx, customerID)
+	}
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, true, err
+	}
+	return map[string]any{"latest_test": latest}, true, nil
+}
+
+func (app *api) overviewArchinisis(ctx context.Context, a authsqlc.AthleteIdentity, in overviewInput) (any, bool, error) {
+	if !a.SporttiID.Valid {
+		return nil, false, nil
+	}
+	sessions, err := app.store.ARCHINISIS.Data().GetRaceReportSessions(ctx, a.SporttiID.String)
+	if err != nil {
+		return nil, true, err
+	}
+	if sessions == nil {
+		sessions = []int32{}
+	}
+	return map[string]any{"race_report_sessions": sessions}, true, nil
+}
+
+func (app *api) overviewKAMK(ctx context.Context, a authsqlc.AthleteIdentity, in overviewInput) (any, bool, error) {
+	if !a.KamkUserID.Valid {
+		return nil, false, nil
+	}
+	injuries, err := app.store.KAMK.Injuries().GetActiveInjuries(ctx, a.KamkUserID.Int32)
+	if err != nil {
+		return nil, true, err
+	}
+	if injuries == nil {
+		injuries = []kamk.Injury{}
+	}
+	if len(injuries) > int(in.Limit) {
+		injuries = injuries[:in.Limit]
+	}
+	return map[string]any{"active_injuries": injuries}, true, nil
+}
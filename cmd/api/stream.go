@@ -0,0 +1,187 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authn"
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	authsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
+	"github.com/DeRuina/KUHA-REST-API/internal/stream"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+// how long EventSource clients wait before reconnecting
+const streamRetry = 3 * time.Second
+
+// isEventStream reports whether r opens an athlete stream, which stays open
+// past the usual request timeout
+func isEventStream(r *http.Request) bool {
+	_, rest, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	return strings.HasPrefix(rest, "stream/")
+}
+
+// StreamAthlete godoc
+//
+//	@Summary		Stream athlete updates
+//	@Description	Server-Sent Events feed of the writes about one athlete, on any instance. {id} is <kind>:<value> with the id the writing domain uses: utv_user_id:<uuid>, tietoevry_user_id:<uuid>, kamk_user_id:<n>, fis_competitorid:<n> or sportti_id:<n> (KLAB, Archinisis), e.g. kamk_user_id:12345.
+//	@Description	Each event has the SSE id of its position in the athlete's stream, the event type as SSE event name and the event JSON ({id, type, time, data}, as sent to webhooks) as data. Only events of domains the client may read are sent. A comment line is sent every STREAM_HEARTBEAT_SECONDS.
+//	@Description	On reconnect, send the last received id in the Last-Event-ID header (EventSource does this itself) or the last_event_id parameter to get the events missed in between, as far as they are still kept (STREAM_HISTORY per athlete, for STREAM_HISTORY_TTL_HOURS).
+//	@Tags			Stream
+//	@Produce		text/event-stream
+//	@Param			id				path		string	true	"Athlete id, <kind>:<value>"
+//	@Param			Last-Event-ID	header		string	false	"Resume after this event id"
+//	@Param			last_event_id	query		string	false	"Resume after this event id, for clients that cannot set headers"
+//	@Success		200				{object}	apitypes.Event
+//	@Failure		400				{object}	apitypes.ValidationErrorResponse
+//	@Failure		401				{object}	apitypes.UnauthorizedResponse
+//	@Failure		403				{object}	apitypes.ForbiddenResponse
+//	@Failure		500				{object}	apitypes.InternalServerErrorResponse
+//	@Security		BearerAuth
+//	@Router			/stream/athletes/{id} [get]
+func (app *api) streamAthleteHandler(w http.ResponseWriter, r *http.Request) {
+	athlete, err := streamAthleteKey(strings.TrimSpace(chi.URLParam(r, "id")))
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	lastID := r.Header.Get("Last-Event-ID")
+	if lastID == "" {
+		lastID = r.URL.Query().Get("last_event_id")
+	}
+	if lastID != "" && !stream.ValidID(lastID) {
+		utils.BadRequestResponse(w, r, fmt.Errorf("invalid last event id"))
+		return
+	}
+
+	roles := authn.GetClientRoles(r.Context())
+	allowed := make(map[string]bool)
+	for typ, route := range eventReadRoutes {
+		if authz.Can(roles, http.MethodGet, route) {
+			allowed[typ] = true
+		}
+	}
+	if len(allowed) == 0 {
+		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+		return
+	}
+
+	// subscribe before reading the history, so nothing falls in between
+	sub := app.stream.Subscribe(athlete)
+	defer sub.Close()
+
+	var missed []stream.Message
+	if lastID != "" {
+		if missed, err = app.stream.Since(r.Context(), athlete, lastID); err != nil {
+			utils.InternalServerError(w, r, err)
+			return
+		}
+	}
+
+	h := w.Header()
+	h.Set("Content-Type", "text/event-stream")
+	h.Set("Cache-Control", "no-cache")
+	h.Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	sw := newSSEWriter(w, 2*app.config.stream.Heartbeat)
+	sw.retry(streamRetry)
+
+	// live messages up to here came with the history
+	seen := lastID
+	for _, m := range missed {
+		if allowed[m.Type] {
+			sw.event(m)
+		}
+		seen = m.ID
+	}
+	if sw.flush() != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(app.config.stream.Heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case m, ok := <-sub.C():
+			if !ok {
+				// too far behind or shutting down; the client resumes from its last id
+				return
+			}
+			if (seen != "" && !stream.After(m.ID, seen)) || !allowed[m.Type] {
+				continue
+			}
+			sw.event(m)
+		case <-heartbeat.C:
+			sw.comment("ping")
+		case <-r.Context().Done():
+			return
+		}
+		if sw.flush() != nil {
+			return
+		}
+	}
+}
+
+// streamKinds are the athlete id kinds events are keyed by
+var streamKinds = []string{events.AthleteUTV, events.AthleteTietoevry, events.AthleteKAMK, events.AthleteFIS, events.AthleteSportti}
+
+// streamAthleteKey checks a stream id, <kind>:<value>, and returns it in the
+// form events are published under. The kind is required: the numeric ids of
+// KAMK, FIS and sportti_id overlap.
+func streamAthleteKey(id string) (string, error) {
+	kindName, value, _ := strings.Cut(id, ":")
+	if !slices.Contains(streamKinds, kindName) {
+		return "", fmt.Errorf("id must be <kind>:<value> with kind one of %s", strings.Join(streamKinds, ", "))
+	}
+	kind, _ := findIdentifierKind(kindName)
+	var lookup authsqlc.FindAthleteIdentitiesParams
+	if err := kind.set(&lookup, value); err != nil {
+		return "", err
+	}
+	value, _ = kind.get(identityFromParams(0, lookup))
+	return events.AthleteKey(kindName, value), nil
+}
+
+// sseWriter writes the text/event-stream format. Every flush gets a fresh
+// write deadline, so an open stream outlives the server's WriteTimeout but a
+// stalled client is still noticed.
+type sseWriter struct {
+	bw      *bufio.Writer
+	rc      *http.ResponseController
+	timeout time.Duration
+}
+
+func newSSEWriter(w http.ResponseWriter, timeout time.Duration) *sseWriter {
+	return &sseWriter{bw: bufio.NewWriter(w), rc: http.NewResponseController(w), timeout: timeout}
+}
+
+func (s *sseWriter) retry(d time.Duration) {
+	fmt.Fprintf(s.bw, "retry: %d\n\n", d.Milliseconds())
+}
+
+func (s *sseWriter) event(m stream.Message) {
+	fmt.Fprintf(s.bw, "id: %s\nevent: %s\n", m.ID, m.Type)
+	// a data line may not contain a newline; encoded JSON has none
+	fmt.Fprintf(s.bw, "data: %s\n\n", m.Data)
+}
+
+func (s *sseWriter) comment(text string) {
+	fmt.Fprintf(s.bw, ": %s\n\n", text)
+}
+
+func (s *sseWriter) flush() error {
+	_ = s.rc.SetWriteDeadline(time.Now().Add(s.timeout))
+	if err := s.bw.Flush(); err != nil {
+		return err
+	}
+	return s.rc.Flush()
+}
@@ -6,12 +6,13 @@ import (
 	"net/http"
 	"time"
 
-	"github.com/DeRuina/KUHA-REST-API/docs/swagger"
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
 	tietoevrysqlc "github.com/DeRuina/KUHA-REST-API/internal/db/tietoevry"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/tietoevry"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 	"github.com/google/uuid"
 )
 
@@ -24,25 +25,6 @@ func NewTietoevryActivityZoneHandler(store tietoevry.ActivityZones, cache *cache
 	return &TietoevryActivityZoneHandler{store: store, cache: cache}
 }
 
-type TietoevryActivityZoneInput struct {
-	UserID         string   `json:"user_id" validate:"required,uuid4"`
-	Date           string   `json:"date" validate:"required"`
-	CreatedAt      string   `json:"created_at" validate:"required"`
-	UpdatedAt      string   `json:"updated_at" validate:"required"`
-	SecondsInZone0 *float64 `json:"seconds_in_zone_0"`
-	SecondsInZone1 *float64 `json:"seconds_in_zone_1"`
-	SecondsInZone2 *float64 `json:"seconds_in_zone_2"`
-	SecondsInZone3 *float64 `json:"seconds_in_zone_3"`
-	SecondsInZone4 *float64 `json:"seconds_in_zone_4"`
-	SecondsInZone5 *float64 `json:"seconds_in_zone_5"`
-	Source         string   `json:"source" validate:"required"`
-	RawData        *string  `json:"raw_data"`
-}
-
-type TietoevryActivityZonesBulkInput struct {
-	ActivityZones []TietoevryActivityZoneInput `json:"activity_zones" validate:"required,dive"`
-}
-
 // InsertActivityZonesBulk godoc
 //
 //	@Summary		Insert activity zones (bulk)
@@ -50,14 +32,14 @@ type TietoevryActivityZonesBulkInput struct {
 //	@Tags			Tietoevry - Activity_Zones
 //	@Accept			json
 //	@Produce		json
-//	@Param			activity_zones	body	swagger.TietoevryActivityZonesBulkInput	true	"Activity zone summaries"
+//	@Param			activity_zones	body	apitypes.TietoevryActivityZonesBulkInput	true	"Activity zone summaries"
 //	@Success		201				"Activity zones processed successfully (idempotent operation)"
-//	@Failure		400				{object}	swagger.ValidationErrorResponse
-//	@Failure		401				{object}	swagger.UnauthorizedResponse
-//	@Failure		403				{object}	swagger.ForbiddenResponse
-//	@Failure		409				{object}	swagger.ConflictResponse
-//	@Failure		500				{object}	swagger.InternalServerErrorResponse
-//	@Failure		503				{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400				{object}	apitypes.ValidationErrorResponse
+//	@Failure		401				{object}	apitypes.UnauthorizedResponse
+//	@Failure		403				{object}	apitypes.ForbiddenResponse
+//	@Failure		409				{object}	apitypes.ConflictResponse
+//	@Failure		500				{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503				{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/tietoevry/activity-zones [post]
 func (h *TietoevryActivityZoneHandler) InsertActivityZonesBulk(w http.ResponseWriter, r *http.Request) {
@@ -66,95 +48,58 @@ func (h *TietoevryActivityZoneHandler) InsertActivityZonesBulk(w http.ResponseWr
 		return
 	}
 
-	var input TietoevryActivityZonesBulkInput
-	if err := utils.ReadJSON(w, r, &input); err != nil {
-		utils.BadRequestResponse(w, r, err)
-		return
-	}
-	if err := utils.GetValidator().Struct(input); err != nil {
-		utils.BadRequestResponse(w, r, err)
+	users, ok := insertStreamed(w, r, "activity_zones", h.store, activityZoneParams,
+		func(p tietoevrysqlc.InsertActivityZoneParams) uuid.UUID { return p.UserID }, h.store.InsertActivityZonesStream)
+	if !ok {
 		return
 	}
 
-	// PreValidation
-	userIDs := make([]uuid.UUID, len(input.ActivityZones))
-	for i, a := range input.ActivityZones {
-		uid, err := utils.ParseUUID(a.UserID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-		userIDs[i] = uid
-	}
-
-	if err := h.store.ValidateUsersExist(r.Context(), userIDs); err != nil {
-		utils.BadRequestResponse(w, r, err)
-		return
+	for uid := range users {
+		invalidateTietoevry(r.Context(), h.cache, uid, tzPrefix)
+		events.Publish(events.TietoevryDataCreated, events.TietoevryData{UserID: uid.String(), Resource: "activity-zones"})
 	}
 
-	activityZones := make([]tietoevrysqlc.InsertActivityZoneParams, len(input.ActivityZones))
-	for i, activityZone := range input.ActivityZones {
-		// Parse and convert values
-		userID, err := utils.ParseUUID(activityZone.UserID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		date, err := utils.ParseDate(activityZone.Date)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		createdAt, err := utils.ParseTimestamp(activityZone.CreatedAt)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		updatedAt, err := utils.ParseTimestamp(activityZone.UpdatedAt)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
+	w.WriteHeader(http.StatusCreated)
+}
 
-		rawData := utils.ParseRawJSON(activityZone.RawData)
-
-		activityZones[i] = tietoevrysqlc.InsertActivityZoneParams{
-			UserID:         userID,
-			Date:           date,
-			CreatedAt:      createdAt,
-			UpdatedAt:      updatedAt,
-			SecondsInZone0: utils.NullFloat64Ptr(activityZone.SecondsInZone0),
-			SecondsInZone1: utils.NullFloat64Ptr(activityZone.SecondsInZone1),
-			SecondsInZone2: utils.NullFloat64Ptr(activityZone.SecondsInZone2),
-			SecondsInZone3: utils.NullFloat64Ptr(activityZone.SecondsInZone3),
-			SecondsInZone4: utils.NullFloat64Ptr(activityZone.SecondsInZone4),
-			SecondsInZone5: utils.NullFloat64Ptr(activityZone.SecondsInZone5),
-			Source:         activityZone.Source,
-			RawData:        rawData,
-		}
+// activityZoneParams converts one input activity zone summary to insert params
+func activityZoneParams(activityZone apitypes.TietoevryActivityZoneInput) (tietoevrysqlc.InsertActivityZoneParams, error) {
+	userID, err := utils.ParseUUID(activityZone.UserID)
+	if err != nil {
+		return tietoevrysqlc.InsertActivityZoneParams{}, err
 	}
 
-	if err := h.store.InsertActivityZonesBulk(r.Context(), activityZones); err != nil {
-		utils.HandleDatabaseError(w, r, err)
-		return
+	date, err := utils.ParseDate(activityZone.Date)
+	if err != nil {
+		return tietoevrysqlc.InsertActivityZoneParams{}, err
 	}
 
-	if h.cache != nil {
-		seen := map[uuid.UUID]struct{}{}
-		for _, p := range activityZones {
-			uid := p.UserID
-			if _, ok := seen[uid]; ok {
-				continue
-			}
-			seen[uid] = struct{}{}
-			invalidateTietoevry(r.Context(), h.cache, uid, tzPrefix)
-		}
+	createdAt, err := utils.ParseTimestamp(activityZone.CreatedAt)
+	if err != nil {
+		return tietoevrysqlc.InsertActivityZoneParams{}, err
 	}
 
-	w.WriteHeader(http.StatusCreated)
+	updatedAt, err := utils.ParseTimestamp(activityZone.UpdatedAt)
+	if err != nil {
+		return tietoevrysqlc.InsertActivityZoneParams{}, err
+	}
+
+	rawData := utils.ParseRawJSON(activityZone.RawData)
+
+	return tietoevrysqlc.InsertActivityZoneParams{
+		UserID:         userID,
+		Date:           date,
+		CreatedAt:      createdAt,
+		UpdatedAt:      updatedAt,
+		SecondsInZone0: utils.NullFloat64Ptr(activityZone.SecondsInZone0),
+		SecondsInZone1: utils.NullFloat64Ptr(activityZone.SecondsInZone1),
+		SecondsInZone2: utils.NullFloat64Ptr(activityZone.SecondsInZone2),
+		SecondsInZone3: utils.NullFloat64Ptr(activityZone.SecondsInZone3),
+		SecondsInZone4: utils.NullFloat64Ptr(activityZone.SecondsInZone4),
+		SecondsInZone5: utils.NullFloat64Ptr(activityZone.SecondsInZone5),
+		Source:         activityZone.Source,
+		RawData:        rawData,
+	}, nil
 }
 
 type TietoevryActivityZoneParams struct {
@@ -168,13 +113,18 @@ type TietoevryActivityZoneParams struct {
 //	@Tags			Tietoevry - Activity_Zones
 //	@Accept			json
 //	@Produce		json
-//	@Param			user_id	query		string	true	"User ID (UUID)"
-//	@Success		200		{object}	swagger.TietoevryActivityZoneResponse
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Param			user_id			query		string	true	"User ID (UUID)"
+//	@Param			from			query		string	false	"From date, inclusive (YYYY-MM-DD or RFC3339)"
+//	@Param			to				query		string	false	"To date, inclusive (YYYY-MM-DD or RFC3339)"
+//	@Param			updated_since	query		string	false	"Only rows updated at or after this time (RFC3339 or YYYY-MM-DD)"
+//	@Param			limit			query		integer	false	"Page size (1-1000); enables cursor pagination"
+//	@Param			cursor			query		string	false	"Opaque cursor from next_cursor of the previous page"
+//	@Success		200				{object}	apitypes.TietoevryActivityZoneResponse
+//	@Failure		400				{object}	apitypes.ValidationErrorResponse
+//	@Failure		401				{object}	apitypes.UnauthorizedResponse
+//	@Failure		403				{object}	apitypes.ForbiddenResponse
+//	@Failure		500				{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503				{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/tietoevry/activity-zones [get]
 func (h *TietoevryActivityZoneHandler) GetActivityZones(w http.ResponseWriter, r *http.Request) {
@@ -183,7 +133,7 @@ func (h *TietoevryActivityZoneHandler) GetActivityZones(w http.ResponseWriter, r
 		return
 	}
 
-	if err := utils.ValidateParams(r, []string{"user_id"}); err != nil {
+	if err := utils.ValidateParams(r, listParams); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
@@ -197,9 +147,22 @@ func (h *TietoevryActivityZoneHandler) GetActivityZones(w http.ResponseWriter, r
 		return
 	}
 
-	cacheKey := fmt.Sprintf("tietoevry:activity-zones:%s", params.UserID)
+	filter, err := parseReadFilter(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	page, err := utils.ParsePage(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	cacheKey := fmt.Sprintf("tietoevry:activity-zones:%s%s%s", params.UserID, readFilterCacheKey(filter), page.CacheKey())
 	if h.cache != nil {
 		if cached, err := h.cache.Get(r.Context(), cacheKey); err == nil && cached != "" {
+			utils.SetNextLink(w, r, utils.CachedNextCursor(cached))
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(cached))
 			return
 		}
@@ -211,22 +174,24 @@ func (h *TietoevryActivityZoneHandler) GetActivityZones(w http.ResponseWriter, r
 		return
 	}
 
-	activityZones, err := h.store.GetActivityZonesByUser(r.Context(), userID)
+	activityZones, next, err := h.store.GetActivityZonesByUser(r.Context(), userID, filter, page)
 	if err != nil {
 		utils.InternalServerError(w, r, err)
 		return
 	}
 
 	if len(activityZones) == 0 {
-		utils.WriteJSON(w, http.StatusOK, map[string]any{
-			"activity_zones": []swagger.TietoevryActivityZoneInput{},
-		})
+		resp := map[string]any{
+			"activity_zones": []apitypes.TietoevryActivityZoneInput{},
+		}
+		utils.AddPageInfo(resp, page, "")
+		utils.WriteJSON(w, http.StatusOK, resp)
 		return
 	}
 
-	var output []swagger.TietoevryActivityZoneInput
+	var output []apitypes.TietoevryActivityZoneInput
 	for _, activityZone := range activityZones {
-		out := swagger.TietoevryActivityZoneInput{
+		out := apitypes.TietoevryActivityZoneInput{
 			UserID:         activityZone.UserID.String(),
 			Date:           activityZone.Date.Format("2006-01-02"),
 			CreatedAt:      activityZone.CreatedAt.Format(time.RFC3339),
@@ -244,6 +209,8 @@ func (h *TietoevryActivityZoneHandler) GetActivityZones(w http.ResponseWriter, r
 	}
 
 	resp := map[string]any{"activity_zones": output}
+	utils.AddPageInfo(resp, page, next)
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, resp, TietoevryCacheTTL)
+	utils.SetNextLink(w, r, next)
 	utils.WriteJSON(w, http.StatusOK, resp)
 }
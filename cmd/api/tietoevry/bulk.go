@@ -0,0 +1,226 @@
+package tietoevryapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/jobs"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/tietoevry"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/google/uuid"
+)
+
+// streamChunk is how many items an upload hands to the store at a time: per
+// insert while an atomic upload is still being read, per transaction in an
+// async import
+const streamChunk = 500
+
+type userValidator interface {
+	ValidateUsersExist(ctx context.Context, userIDs []uuid.UUID) error
+}
+
+// itemSource pushes the items of an upload to fn in order; itemErr is set for
+// an item that could not be decoded
+type itemSource[T any] func(fn func(i int, item T, itemErr error) error) error
+
+// streamInsert runs an atomic bulk insert while its items are still arriving.
+// Each item is validated and converted on arrival, and every streamChunk items
+// are checked against the users table and handed to insert, which keeps them
+// all in one transaction. It returns the users whose data changed and the
+// number of items stored; errors caused by the items are *utils.InvalidInputError.
+func streamInsert[T, P any](ctx context.Context, source itemSource[T], users userValidator,
+	convert func(T) (P, error), userID func(P) uuid.UUID,
+	insert func(ctx context.Context, produce tietoevry.Produce[P]) error,
+) (map[uuid.UUID]struct{}, int, error) {
+	touched := map[uuid.UUID]struct{}{}
+	n := 0
+	// the items are at fault for anything but a failed insert
+	var inputErr *utils.InvalidInputError
+	var writeErr error
+	produce := func(write func([]P) error) error {
+		chunk := make([]P, 0, streamChunk)
+		flush := func() error {
+			ids := make([]uuid.UUID, len(chunk))
+			for i, p := range chunk {
+				ids[i] = userID(p)
+				touched[ids[i]] = struct{}{}
+			}
+			if err := users.ValidateUsersExist(ctx, ids); err != nil {
+				inputErr = &utils.InvalidInputError{Index: -1, Err: err}
+				return err
+			}
+			writeErr = write(chunk)
+			chunk = chunk[:0]
+			return writeErr
+		}
+
+		err := source(func(i int, item T, itemErr error) error {
+			if itemErr == nil {
+				itemErr = utils.GetValidator().Struct(item)
+			}
+			var p P
+			if itemErr == nil {
+				p, itemErr = convert(item)
+			}
+			if itemErr != nil {
+				inputErr = &utils.InvalidInputError{Index: i, Err: itemErr}
+				return itemErr
+			}
+			chunk = append(chunk, p)
+			n++
+			if len(chunk) < streamChunk {
+				return nil
+			}
+			return flush()
+		})
+		if err == nil && n == 0 {
+			err = utils.ErrMissingData
+		}
+		if err == nil && len(chunk) > 0 {
+			err = flush()
+		}
+		if err != nil && inputErr == nil && writeErr == nil {
+			inputErr = &utils.InvalidInputError{Index: -1, Err: err}
+		}
+		return err
+	}
+
+	if err := insert(ctx, produce); err != nil {
+		if inputErr != nil {
+			return nil, 0, inputErr
+		}
+		return nil, 0, err
+	}
+	return touched, n, nil
+}
+
+// insertStreamed runs an atomic bulk upload while its body is being read, with
+// the items under field. On failure it answers the request itself; otherwise
+// it returns the users whose data changed.
+func insertStreamed[T, P any](w http.ResponseWriter, r *http.Request, field string, users userValidator,
+	convert func(T) (P, error), userID func(P) uuid.UUID,
+	insert func(ctx context.Context, produce tietoevry.Produce[P]) error,
+) (map[uuid.UUID]struct{}, bool) {
+	utils.LimitBody(w, r)
+
+	source := func(fn func(i int, item T, itemErr error) error) error {
+		return utils.StreamJSONArray(r.Body, field, fn)
+	}
+	touched, _, err := streamInsert(r.Context(), source, users, convert, userID, insert)
+	if err != nil {
+		var inputErr *utils.InvalidInputError
+		if errors.As(err, &inputErr) {
+			utils.BadRequestResponse(w, r, inputErr.Err)
+		} else {
+			utils.HandleDatabaseError(w, r, err)
+		}
+		return nil, false
+	}
+	return touched, true
+}
+
+// decodePartial reads the items under field into a batch for a partial upload;
+// items that do not decode are rejected like invalid ones
+func decodePartial[T, P any](w http.ResponseWriter, r *http.Request, field string, prepare func(batch *partialBatch[P], i int, item T)) (*partialBatch[P], error) {
+	utils.LimitBody(w, r)
+
+	batch := &partialBatch[P]{}
+	err := utils.StreamJSONArray(r.Body, field, func(i int, item T, itemErr error) error {
+		addItem(batch, i, item, itemErr, prepare)
+		return nil
+	})
+	if err == nil && batch.size() == 0 {
+		err = utils.ErrMissingData
+	}
+	return batch, err
+}
+
+// partialBatch collects the items of a partial bulk upload: the ones rejected
+// while parsing go straight into the result, the rest are handed to the store
+type partialBatch[P any] struct {
+	result utils.BulkResult
+	params []P
+	index  []int
+	ids    []string
+}
+
+// addItem queues or rejects one decoded item of a partial upload
+func addItem[T, P any](b *partialBatch[P], i int, item T, itemErr error, prepare func(batch *partialBatch[P], i int, item T)) {
+	if itemErr != nil {
+		b.reject(i, "", itemErr)
+		return
+	}
+	prepare(b, i, item)
+}
+
+func (b *partialBatch[P]) size() int {
+	return len(b.params) + len(b.result.Items)
+}
+
+func (b *partialBatch[P]) reject(i int, id string, err error) {
+	b.result.Add(utils.ItemFailed(i, id, err))
+}
+
+func (b *partialBatch[P]) accept(i int, id string, p P) {
+	b.params = append(b.params, p)
+	b.index = append(b.index, i)
+	b.ids = append(b.ids, id)
+}
+
+// apply records the store outcomes, calling stored for every committed item
+func (b *partialBatch[P]) apply(outcomes []utils.ItemOutcome, stored func(P)) {
+	for k, o := range outcomes {
+		i, id := b.index[k], b.ids[k]
+		switch {
+		case o.Status == utils.ItemError:
+			b.result.Add(utils.ItemFailed(i, id, o.Err))
+		case o.Status == utils.ItemSkipped:
+			b.result.Add(utils.ItemResult{Index: i, ID: id, Status: o.Status, Reason: o.Err.Error()})
+		default:
+			b.result.Add(utils.ItemResult{Index: i, ID: id, Status: o.Status})
+			stored(b.params[k])
+		}
+	}
+}
+
+// importJob streams the items under field from body, prepares each one and
+// commits them every streamChunk items, recording each chunk on the job.
+// Items that do not decode are rejected without stopping the import.
+func importJob[T, P any](ctx context.Context, body io.Reader, field string, t *jobs.Tracker,
+	prepare func(batch *partialBatch[P], i int, item T),
+	commit func(ctx context.Context, batch *partialBatch[P]) error,
+) error {
+	batch := &partialBatch[P]{}
+	flush := func() error {
+		if err := commit(ctx, batch); err != nil {
+			return err
+		}
+		if err := t.Record(ctx, batch.result); err != nil {
+			return err
+		}
+		batch = &partialBatch[P]{}
+		return nil
+	}
+
+	err := utils.StreamJSONArray(body, field, func(i int, item T, itemErr error) error {
+		addItem(batch, i, item, itemErr, prepare)
+		if batch.size() < streamChunk {
+			return nil
+		}
+		return flush()
+	})
+	if err != nil {
+		return err
+	}
+	if batch.size() > 0 {
+		if err := flush(); err != nil {
+			return err
+		}
+	}
+	if t.Items() == 0 {
+		return utils.ErrMissingData
+	}
+	return nil
+}
@@ -1,17 +1,22 @@
 package tietoevryapi
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
-	"github.com/DeRuina/KUHA-REST-API/docs/swagger"
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
 	tietoevrysqlc "github.com/DeRuina/KUHA-REST-API/internal/db/tietoevry"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
+	"github.com/DeRuina/KUHA-REST-API/internal/export"
+	"github.com/DeRuina/KUHA-REST-API/internal/jobs"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/tietoevry"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 	"github.com/google/uuid"
 )
 
@@ -24,80 +29,6 @@ func NewTietoevryExerciseHandler(store tietoevry.Exercises, cache *cache.Storage
 	return &TietoevryExerciseHandler{store: store, cache: cache}
 }
 
-// request model
-
-type HRZoneInput struct {
-	ExerciseID    string `json:"exercise_id"`
-	ZoneIndex     int32  `json:"zone_index"`
-	SecondsInZone int32  `json:"seconds_in_zone"`
-	LowerLimit    int32  `json:"lower_limit"`
-	UpperLimit    int32  `json:"upper_limit"`
-	CreatedAt     string `json:"created_at"`
-	UpdatedAt     string `json:"updated_at"`
-}
-
-type SampleInput struct {
-	ID            string    `json:"id"`
-	UserID        string    `json:"user_id"`
-	ExerciseID    string    `json:"exercise_id"`
-	SampleType    string    `json:"sample_type"`
-	RecordingRate int32     `json:"recording_rate"`
-	Samples       []float64 `json:"samples"`
-	Source        string    `json:"source"`
-}
-
-type SectionInput struct {
-	ID          string  `json:"id"`
-	UserID      string  `json:"user_id"`
-	ExerciseID  string  `json:"exercise_id"`
-	CreatedAt   string  `json:"created_at"`
-	UpdatedAt   string  `json:"updated_at"`
-	StartTime   string  `json:"start_time"`
-	EndTime     string  `json:"end_time"`
-	SectionType *string `json:"section_type"`
-	Name        *string `json:"name"`
-	Comment     *string `json:"comment"`
-	Source      string  `json:"source"`
-	RawID       *string `json:"raw_id"`
-	RawData     *string `json:"raw_data"`
-}
-
-type TietoevryExerciseUpsertInput struct {
-	ID                string   `json:"id" validate:"required,uuid4"`
-	CreatedAt         string   `json:"created_at" validate:"required"`
-	UpdatedAt         string   `json:"updated_at" validate:"required"`
-	UserID            string   `json:"user_id" validate:"required,uuid4"`
-	StartTime         string   `json:"start_time" validate:"required"`
-	Duration          string   `json:"duration" validate:"required"`
-	Comment           *string  `json:"comment"`
-	SportType         *string  `json:"sport_type"`
-	DetailedSportType *string  `json:"detailed_sport_type"`
-	Distance          *float64 `json:"distance"`
-	AvgHeartRate      *float64 `json:"avg_heart_rate"`
-	MaxHeartRate      *float64 `json:"max_heart_rate"`
-	Trimp             *float64 `json:"trimp"`
-	SprintCount       *int32   `json:"sprint_count"`
-	AvgSpeed          *float64 `json:"avg_speed"`
-	MaxSpeed          *float64 `json:"max_speed"`
-	Source            string   `json:"source" validate:"required"`
-	Status            *string  `json:"status"`
-	Calories          *int32   `json:"calories"`
-	TrainingLoad      *int32   `json:"training_load"`
-	RawID             *string  `json:"raw_id"`
-	Feeling           *int32   `json:"feeling"`
-	Recovery          *int32   `json:"recovery"`
-	RPE               *int32   `json:"rpe"`
-	RawData           *string  `json:"raw_data"`
-
-	HRZones  []HRZoneInput  `json:"hr_zones"`
-	Samples  []SampleInput  `json:"samples"`
-	Sections []SectionInput `json:"sections"`
-}
-
-type TietoevryExercisesBulkInput struct {
-	Exercises []TietoevryExerciseUpsertInput `json:"exercises" validate:"required,dive"`
-}
-
 type TietoevryExerciseParams struct {
 	UserID string `form:"user_id" validate:"required,uuid4"`
 }
@@ -109,14 +40,19 @@ type TietoevryExerciseParams struct {
 //	@Tags			Tietoevry - Exercise
 //	@Accept			json
 //	@Produce		json
-//	@Param			exercise	body	swagger.TietoevryExercisesBulkInput	true	"Exercise data"
-//	@Success		201			"Exercises processed successfully (idempotent operation)"
-//	@Failure		400			{object}	swagger.ValidationErrorResponse
-//	@Failure		401			{object}	swagger.UnauthorizedResponse
-//	@Failure		403			{object}	swagger.ForbiddenResponse
-//	@Failure		409			{object}	swagger.ConflictResponse
-//	@Failure		500			{object}	swagger.InternalServerErrorResponse
-//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Param			exercise		body	apitypes.TietoevryExercisesBulkInput	true	"Exercise data"
+//	@Param			Idempotency-Key	header	string									false	"Client-chosen key; a retry with the same key and body replays the first response"
+//	@Param			mode			query	string									false	"atomic (default): all or nothing; partial: commit valid items and report each one; async: like partial, run as a job polled at /jobs/{id}"
+//	@Success		201				"Exercises processed successfully (idempotent operation)"
+//	@Success		202				{object}	apitypes.JobResponse		"Async mode: job queued; poll the Location header"
+//	@Success		207				{object}	apitypes.BulkResultResponse	"Partial mode: some items failed; see items"
+//	@Failure		400				{object}	apitypes.ValidationErrorResponse
+//	@Failure		401				{object}	apitypes.UnauthorizedResponse
+//	@Failure		403				{object}	apitypes.ForbiddenResponse
+//	@Failure		409				{object}	apitypes.ConflictResponse
+//	@Failure		422				{object}	apitypes.IdempotencyKeyReusedResponse
+//	@Failure		500				{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503				{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/tietoevry/exercises [post]
 func (h *TietoevryExerciseHandler) InsertExercisesBulk(w http.ResponseWriter, r *http.Request) {
@@ -125,182 +61,211 @@ func (h *TietoevryExerciseHandler) InsertExercisesBulk(w http.ResponseWriter, r
 		return
 	}
 
-	var input TietoevryExercisesBulkInput
-	if err := utils.ReadJSON(w, r, &input); err != nil {
+	mode, err := utils.ParseBulkMode(r)
+	if err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
-	if err := utils.GetValidator().Struct(input); err != nil {
-		utils.BadRequestResponse(w, r, err)
+
+	if mode == utils.BulkModePartial {
+		h.insertExercisesPartial(w, r)
 		return
 	}
 
-	// Prevalidation
-	userIDs := make([]uuid.UUID, len(input.Exercises))
-	for i, exercise := range input.Exercises {
-		userID, err := utils.ParseUUID(exercise.UserID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-		userIDs[i] = userID
+	users, ok := insertStreamed(w, r, "exercises", h.store, exercisePayload,
+		func(p tietoevry.ExercisePayload) uuid.UUID { return p.Exercise.UserID }, h.store.InsertExercisesStream)
+	if !ok {
+		return
+	}
+
+	for uid := range users {
+		invalidateTietoevry(r.Context(), h.cache, uid, exPrefix)
+		events.Publish(events.TietoevryExerciseCreated, events.TietoevryExercises{UserID: uid.String()})
 	}
 
-	if err := h.store.ValidateUsersExist(r.Context(), userIDs); err != nil {
+	w.WriteHeader(http.StatusCreated)
+}
+
+// insertExercisesPartial commits every valid exercise and reports the outcome of each
+func (h *TietoevryExerciseHandler) insertExercisesPartial(w http.ResponseWriter, r *http.Request) {
+	batch, err := decodePartial(w, r, "exercises", prepareExercise)
+	if err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
+	if err := h.commitExercises(r.Context(), batch); err != nil {
+		utils.HandleDatabaseError(w, r, err)
+		return
+	}
 
-	// Convert all exercises to ExercisePayload
-	exercises := make([]tietoevry.ExercisePayload, len(input.Exercises))
+	if err := utils.WriteBulkResult(w, batch.result); err != nil {
+		utils.InternalServerError(w, r, err)
+	}
+}
 
-	for i, exercise := range input.Exercises {
-		// Parse UUIDs and timestamps
-		exerciseID, err := utils.ParseUUID(exercise.ID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-		userID, err := utils.ParseUUID(exercise.UserID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
+// ImportExercises is the job behind ?mode=async: the same per-item handling as
+// mode=partial, committed in chunks while the payload streams from disk
+func (h *TietoevryExerciseHandler) ImportExercises(ctx context.Context, body io.Reader, t *jobs.Tracker) error {
+	return importJob(ctx, body, "exercises", t, prepareExercise, h.commitExercises)
+}
 
-		createdAt, err := utils.ParseTimestamp(exercise.CreatedAt)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-		updatedAt, err := utils.ParseTimestamp(exercise.UpdatedAt)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-		startTime, err := utils.ParseTimestamp(exercise.StartTime)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
+// prepareExercise validates one exercise and queues it on the batch or rejects it
+func prepareExercise(batch *partialBatch[tietoevry.ExercisePayload], i int, item apitypes.TietoevryExerciseUpsertInput) {
+	if err := utils.GetValidator().Struct(item); err != nil {
+		batch.reject(i, item.ID, err)
+		return
+	}
+	payload, err := exercisePayload(item)
+	if err != nil {
+		batch.reject(i, item.ID, utils.RejectItem(utils.CodeValidationFailed, err))
+		return
+	}
+	batch.accept(i, item.ID, payload)
+}
 
-		rawData := utils.ParseRawJSON(exercise.RawData)
-
-		arg := tietoevrysqlc.InsertExerciseParams{
-			ID:                exerciseID,
-			CreatedAt:         createdAt,
-			UpdatedAt:         updatedAt,
-			UserID:            userID,
-			StartTime:         startTime,
-			Duration:          exercise.Duration,
-			Comment:           utils.NullStringPtr(exercise.Comment),
-			SportType:         utils.NullStringPtr(exercise.SportType),
-			DetailedSportType: utils.NullStringPtr(exercise.DetailedSportType),
-			Distance:          utils.NullFloat64Ptr(exercise.Distance),
-			AvgHeartRate:      utils.NullFloat64Ptr(exercise.AvgHeartRate),
-			MaxHeartRate:      utils.NullFloat64Ptr(exercise.MaxHeartRate),
-			Trimp:             utils.NullFloat64Ptr(exercise.Trimp),
-			SprintCount:       utils.NullInt32Ptr(exercise.SprintCount),
-			AvgSpeed:          utils.NullFloat64Ptr(exercise.AvgSpeed),
-			MaxSpeed:          utils.NullFloat64Ptr(exercise.MaxSpeed),
-			Source:            exercise.Source,
-			Status:            utils.NullStringPtr(exercise.Status),
-			Calories:          utils.NullInt32Ptr(exercise.Calories),
-			TrainingLoad:      utils.NullInt32Ptr(exercise.TrainingLoad),
-			RawID:             utils.NullStringPtr(exercise.RawID),
-			Feeling:           utils.NullInt32Ptr(exercise.Feeling),
-			Recovery:          utils.NullInt32Ptr(exercise.Recovery),
-			Rpe:               utils.NullInt32Ptr(exercise.RPE),
-			RawData:           rawData,
+// commitExercises stores the queued exercises and records the outcome of each
+func (h *TietoevryExerciseHandler) commitExercises(ctx context.Context, batch *partialBatch[tietoevry.ExercisePayload]) error {
+	if len(batch.params) == 0 {
+		return nil
+	}
+	outcomes, err := h.store.InsertExercisesPartial(ctx, batch.params)
+	if err != nil {
+		return err
+	}
+	seen := map[uuid.UUID]struct{}{}
+	batch.apply(outcomes, func(ex tietoevry.ExercisePayload) {
+		if _, ok := seen[ex.Exercise.UserID]; !ok {
+			seen[ex.Exercise.UserID] = struct{}{}
+			invalidateTietoevry(ctx, h.cache, ex.Exercise.UserID, exPrefix)
+			events.Publish(events.TietoevryExerciseCreated, events.TietoevryExercises{UserID: ex.Exercise.UserID.String()})
 		}
+	})
+	return nil
+}
 
-		var hrZones []tietoevrysqlc.InsertExerciseHRZoneParams
-		for _, z := range exercise.HRZones {
-			exerciseID, _ := utils.ParseUUID(z.ExerciseID)
-			createdAt, _ := utils.ParseTimestamp(z.CreatedAt)
-			updatedAt, _ := utils.ParseTimestamp(z.UpdatedAt)
-
-			hrZones = append(hrZones, tietoevrysqlc.InsertExerciseHRZoneParams{
-				ExerciseID:    exerciseID,
-				ZoneIndex:     z.ZoneIndex,
-				SecondsInZone: z.SecondsInZone,
-				LowerLimit:    z.LowerLimit,
-				UpperLimit:    z.UpperLimit,
-				CreatedAt:     createdAt,
-				UpdatedAt:     updatedAt,
-			})
-		}
+// exercisePayload converts one input bundle to insert params
+func exercisePayload(exercise apitypes.TietoevryExerciseUpsertInput) (tietoevry.ExercisePayload, error) {
+	// Parse UUIDs and timestamps
+	exerciseID, err := utils.ParseUUID(exercise.ID)
+	if err != nil {
+		return tietoevry.ExercisePayload{}, err
+	}
+	userID, err := utils.ParseUUID(exercise.UserID)
+	if err != nil {
+		return tietoevry.ExercisePayload{}, err
+	}
 
-		var samples []tietoevrysqlc.InsertExerciseSampleParams
-		for _, s := range exercise.Samples {
-			id, _ := utils.ParseUUID(s.ID)
-			userID, _ := utils.ParseUUID(s.UserID)
-			exerciseID, _ := utils.ParseUUID(s.ExerciseID)
-
-			samples = append(samples, tietoevrysqlc.InsertExerciseSampleParams{
-				ID:            id,
-				UserID:        userID,
-				ExerciseID:    exerciseID,
-				SampleType:    s.SampleType,
-				RecordingRate: s.RecordingRate,
-				Samples:       s.Samples,
-				Source:        s.Source,
-			})
-		}
+	createdAt, err := utils.ParseTimestamp(exercise.CreatedAt)
+	if err != nil {
+		return tietoevry.ExercisePayload{}, err
+	}
+	updatedAt, err := utils.ParseTimestamp(exercise.UpdatedAt)
+	if err != nil {
+		return tietoevry.ExercisePayload{}, err
+	}
+	startTime, err := utils.ParseTimestamp(exercise.StartTime)
+	if err != nil {
+		return tietoevry.ExercisePayload{}, err
+	}
 
-		var sections []tietoevrysqlc.InsertExerciseSectionParams
-		for _, sec := range exercise.Sections {
-			id, _ := utils.ParseUUID(sec.ID)
-			userID, _ := utils.ParseUUID(sec.UserID)
-			exerciseID, _ := utils.ParseUUID(sec.ExerciseID)
-			createdAt, _ := utils.ParseTimestamp(sec.CreatedAt)
-			updatedAt, _ := utils.ParseTimestamp(sec.UpdatedAt)
-			startTime, _ := utils.ParseTimestamp(sec.StartTime)
-			endTime, _ := utils.ParseTimestamp(sec.EndTime)
-			rawData := utils.ParseRawJSON(sec.RawData)
-
-			sections = append(sections, tietoevrysqlc.InsertExerciseSectionParams{
-				ID:          id,
-				UserID:      userID,
-				ExerciseID:  exerciseID,
-				CreatedAt:   createdAt,
-				UpdatedAt:   updatedAt,
-				StartTime:   startTime,
-				EndTime:     endTime,
-				SectionType: utils.NullStringPtr(sec.SectionType),
-				Name:        utils.NullStringPtr(sec.Name),
-				Comment:     utils.NullStringPtr(sec.Comment),
-				Source:      sec.Source,
-				RawID:       utils.NullStringPtr(sec.RawID),
-				RawData:     rawData,
-			})
-		}
+	rawData := utils.ParseRawJSON(exercise.RawData)
+
+	arg := tietoevrysqlc.InsertExerciseParams{
+		ID:                exerciseID,
+		CreatedAt:         createdAt,
+		UpdatedAt:         updatedAt,
+		UserID:            userID,
+		StartTime:         startTime,
+		Duration:          exercise.Duration,
+		Comment:           utils.NullStringPtr(exercise.Comment),
+		SportType:         utils.NullStringPtr(exercise.SportType),
+		DetailedSportType: utils.NullStringPtr(exercise.DetailedSportType),
+		Distance:          utils.NullFloat64Ptr(exercise.Distance),
+		AvgHeartRate:      utils.NullFloat64Ptr(exercise.AvgHeartRate),
+		MaxHeartRate:      utils.NullFloat64Ptr(exercise.MaxHeartRate),
+		Trimp:             utils.NullFloat64Ptr(exercise.Trimp),
+		SprintCount:       utils.NullInt32Ptr(exercise.SprintCount),
+		AvgSpeed:          utils.NullFloat64Ptr(exercise.AvgSpeed),
+		MaxSpeed:          utils.NullFloat64Ptr(exercise.MaxSpeed),
+		Source:            exercise.Source,
+		Status:            utils.NullStringPtr(exercise.Status),
+		Calories:          utils.NullInt32Ptr(exercise.Calories),
+		TrainingLoad:      utils.NullInt32Ptr(exercise.TrainingLoad),
+		RawID:             utils.NullStringPtr(exercise.RawID),
+		Feeling:           utils.NullInt32Ptr(exercise.Feeling),
+		Recovery:          utils.NullInt32Ptr(exercise.Recovery),
+		Rpe:               utils.NullInt32Ptr(exercise.RPE),
+		RawData:           rawData,
+	}
 
-		exercises[i] = tietoevry.ExercisePayload{
-			Exercise: arg,
-			HRZones:  hrZones,
-			Samples:  samples,
-			Sections: sections,
-		}
+	var hrZones []tietoevrysqlc.InsertExerciseHRZoneParams
+	for _, z := range exercise.HRZones {
+		exerciseID, _ := utils.ParseUUID(z.ExerciseID)
+		createdAt, _ := utils.ParseTimestamp(z.CreatedAt)
+		updatedAt, _ := utils.ParseTimestamp(z.UpdatedAt)
+
+		hrZones = append(hrZones, tietoevrysqlc.InsertExerciseHRZoneParams{
+			ExerciseID:    exerciseID,
+			ZoneIndex:     z.ZoneIndex,
+			SecondsInZone: z.SecondsInZone,
+			LowerLimit:    z.LowerLimit,
+			UpperLimit:    z.UpperLimit,
+			CreatedAt:     createdAt,
+			UpdatedAt:     updatedAt,
+		})
 	}
 
-	if err := h.store.InsertExercisesBulk(r.Context(), exercises); err != nil {
-		utils.HandleDatabaseError(w, r, err)
-		return
+	var samples []tietoevrysqlc.InsertExerciseSampleParams
+	for _, s := range exercise.Samples {
+		id, _ := utils.ParseUUID(s.ID)
+		userID, _ := utils.ParseUUID(s.UserID)
+		exerciseID, _ := utils.ParseUUID(s.ExerciseID)
+
+		samples = append(samples, tietoevrysqlc.InsertExerciseSampleParams{
+			ID:            id,
+			UserID:        userID,
+			ExerciseID:    exerciseID,
+			SampleType:    s.SampleType,
+			RecordingRate: s.RecordingRate,
+			Samples:       s.Samples,
+			Source:        s.Source,
+		})
 	}
 
-	if h.cache != nil {
-		seen := map[uuid.UUID]struct{}{}
-		for _, ex := range exercises {
-			uid := ex.Exercise.UserID
-			if _, ok := seen[uid]; ok {
-				continue
-			}
-			seen[uid] = struct{}{}
-			invalidateTietoevry(r.Context(), h.cache, uid, exPrefix)
-		}
+	var sections []tietoevrysqlc.InsertExerciseSectionParams
+	for _, sec := range exercise.Sections {
+		id, _ := utils.ParseUUID(sec.ID)
+		userID, _ := utils.ParseUUID(sec.UserID)
+		exerciseID, _ := utils.ParseUUID(sec.ExerciseID)
+		createdAt, _ := utils.ParseTimestamp(sec.CreatedAt)
+		updatedAt, _ := utils.ParseTimestamp(sec.UpdatedAt)
+		startTime, _ := utils.ParseTimestamp(sec.StartTime)
+		endTime, _ := utils.ParseTimestamp(sec.EndTime)
+		rawData := utils.ParseRawJSON(sec.RawData)
+
+		sections = append(sections, tietoevrysqlc.InsertExerciseSectionParams{
+			ID:          id,
+			UserID:      userID,
+			ExerciseID:  exerciseID,
+			CreatedAt:   createdAt,
+			UpdatedAt:   updatedAt,
+			StartTime:   startTime,
+			EndTime:     endTime,
+			SectionType: utils.NullStringPtr(sec.SectionType),
+			Name:        utils.NullStringPtr(sec.Name),
+			Comment:     utils.NullStringPtr(sec.Comment),
+			Source:      sec.Source,
+			RawID:       utils.NullStringPtr(sec.RawID),
+			RawData:     rawData,
+		})
 	}
 
-	w.WriteHeader(http.StatusCreated)
+	return tietoevry.ExercisePayload{
+		Exercise: arg,
+		HRZones:  hrZones,
+		Samples:  samples,
+		Sections: sections,
+	}, nil
 }
 
 // GetExercises godoc
@@ -309,14 +274,21 @@ func (h *TietoevryExerciseHandler) InsertExercisesBulk(w http.ResponseWriter, r
 //	@Description	Get all exercises (HR_Zones, Samples, Sections) for a specific user
 //	@Tags			Tietoevry - Exercise
 //	@Accept			json
-//	@Produce		json
-//	@Param			user_id	query		string	true	"User ID (UUID)"
-//	@Success		200		{object}	swagger.TietoevryExerciseResponse
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Produce		json,text/csv,application/x-ndjson,application/vnd.apache.parquet
+//	@Param			user_id			query		string	true	"User ID (UUID)"
+//	@Param			from			query		string	false	"From date, inclusive (YYYY-MM-DD or RFC3339)"
+//	@Param			to				query		string	false	"To date, inclusive (YYYY-MM-DD or RFC3339)"
+//	@Param			updated_since	query		string	false	"Only rows updated at or after this time (RFC3339 or YYYY-MM-DD)"
+//	@Param			limit			query		integer	false	"Page size (1-1000); enables cursor pagination"
+//	@Param			cursor			query		string	false	"Opaque cursor from next_cursor of the previous page"
+//	@Param			format			query		string	false	"Response format: json (default), csv, ndjson, parquet; overrides Accept"
+//	@Param			stream			query		bool	false	"Stream the full listing row by row (implied for csv, ndjson and parquet; ignored with limit or cursor)"
+//	@Success		200				{object}	apitypes.TietoevryExerciseResponse
+//	@Failure		400				{object}	apitypes.ValidationErrorResponse
+//	@Failure		401				{object}	apitypes.UnauthorizedResponse
+//	@Failure		403				{object}	apitypes.ForbiddenResponse
+//	@Failure		500				{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503				{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/tietoevry/exercises [get]
 func (h *TietoevryExerciseHandler) GetExercises(w http.ResponseWriter, r *http.Request) {
@@ -325,7 +297,7 @@ func (h *TietoevryExerciseHandler) GetExercises(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	if err := utils.ValidateParams(r, []string{"user_id"}); err != nil {
+	if err := utils.ValidateParams(r, exportListParams); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
@@ -339,9 +311,31 @@ func (h *TietoevryExerciseHandler) GetExercises(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	cacheKey := fmt.Sprintf("tietoevry:exercises:%s", params.UserID)
-	if h.cache != nil {
+	filter, err := parseReadFilter(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	page, err := utils.ParsePage(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	format, err := export.Negotiate(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	// full listings are streamed straight from the database
+	streamed := !page.Paged() && (format != export.JSON || utils.WantsStream(r))
+
+	cacheKey := fmt.Sprintf("tietoevry:exercises:%s%s%s", params.UserID, readFilterCacheKey(filter), page.CacheKey())
+	if format == export.JSON && !streamed && h.cache != nil {
 		if cached, err := h.cache.Get(r.Context(), cacheKey); err == nil && cached != "" {
+			utils.SetNextLink(w, r, utils.CachedNextCursor(cached))
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(cached))
 			return
 		}
@@ -353,102 +347,215 @@ func (h *TietoevryExerciseHandler) GetExercises(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	exercises, err := h.store.GetExercisesByUser(r.Context(), userID)
+	if streamed {
+		h.streamExercises(w, r, format, userID, filter)
+		return
+	}
+
+	exercises, next, err := h.store.GetExercisesByUser(r.Context(), userID, filter, page)
 	if err != nil {
-		utils.InternalServerError(w, r, err)
+		utils.HandleDatabaseError(w, r, err)
 		return
 	}
 
-	if len(exercises) == 0 {
-		utils.WriteJSON(w, http.StatusOK, map[string]any{
-			"exercises": []swagger.TietoevryExerciseUpsertInput{},
-		})
+	if len(exercises) == 0 && format == export.JSON {
+		resp := map[string]any{
+			"exercises": []apitypes.TietoevryExerciseUpsertInput{},
+		}
+		utils.AddPageInfo(resp, page, "")
+		utils.WriteJSON(w, http.StatusOK, resp)
 		return
 	}
 
-	var output []swagger.TietoevryExerciseUpsertInput
+	var output []apitypes.TietoevryExerciseUpsertInput
 	for _, ex := range exercises {
-		hrZones, _ := h.store.GetExerciseHRZones(r.Context(), ex.ID)
-		samples, _ := h.store.GetExerciseSamples(r.Context(), ex.ID)
-		sections, _ := h.store.GetExerciseSections(r.Context(), ex.ID)
-
-		out := swagger.TietoevryExerciseUpsertInput{
-			ID:                ex.ID.String(),
-			CreatedAt:         ex.CreatedAt.Format(time.RFC3339),
-			UpdatedAt:         ex.UpdatedAt.Format(time.RFC3339),
-			UserID:            ex.UserID.String(),
-			StartTime:         ex.StartTime.Format(time.RFC3339),
-			Duration:          ex.Duration,
-			Comment:           utils.StringPtrOrNil(ex.Comment),
-			SportType:         utils.StringPtrOrNil(ex.SportType),
-			DetailedSportType: utils.StringPtrOrNil(ex.DetailedSportType),
-			Distance:          utils.Float64PtrOrNil(ex.Distance),
-			AvgHeartRate:      utils.Float64PtrOrNil(ex.AvgHeartRate),
-			MaxHeartRate:      utils.Float64PtrOrNil(ex.MaxHeartRate),
-			Trimp:             utils.Float64PtrOrNil(ex.Trimp),
-			SprintCount:       utils.Int32PtrOrNil(ex.SprintCount),
-			AvgSpeed:          utils.Float64PtrOrNil(ex.AvgSpeed),
-			MaxSpeed:          utils.Float64PtrOrNil(ex.MaxSpeed),
-			Source:            ex.Source,
-			Status:            utils.StringPtrOrNil(ex.Status),
-			Calories:          utils.Int32PtrOrNil(ex.Calories),
-			TrainingLoad:      utils.Int32PtrOrNil(ex.TrainingLoad),
-			RawID:             utils.StringPtrOrNil(ex.RawID),
-			Feeling:           utils.Int32PtrOrNil(ex.Feeling),
-			Recovery:          utils.Int32PtrOrNil(ex.Recovery),
-			RPE:               utils.Int32PtrOrNil(ex.Rpe),
-			RawData:           utils.RawMessagePtrOrNil(ex.RawData),
+		output = append(output, ExerciseFromRow(ex))
+	}
+	// nested rows are not part of the tabular formats
+	if format == export.JSON {
+		if err := h.addExerciseDetails(r.Context(), output, exercises); err != nil {
+			utils.InternalServerError(w, r, err)
+			return
 		}
+	}
 
-		// HR Zones
-		for _, z := range hrZones {
-			out.HRZones = append(out.HRZones, swagger.HRZone{
-				ExerciseID:    z.ExerciseID.String(),
-				ZoneIndex:     z.ZoneIndex,
-				SecondsInZone: z.SecondsInZone,
-				LowerLimit:    z.LowerLimit,
-				UpperLimit:    z.UpperLimit,
-				CreatedAt:     z.CreatedAt.Format(time.RFC3339),
-				UpdatedAt:     z.UpdatedAt.Format(time.RFC3339),
-			})
+	if format != export.JSON {
+		utils.SetNextLink(w, r, next)
+		if err := export.Write(w, format, "exercises", output, nil); err != nil {
+			utils.InternalServerError(w, r, err)
 		}
+		return
+	}
 
-		// Samples
-		for _, s := range samples {
-			out.Samples = append(out.Samples, swagger.Sample{
-				ID:            s.ID.String(),
-				UserID:        s.UserID.String(),
-				ExerciseID:    s.ExerciseID.String(),
-				SampleType:    s.SampleType,
-				RecordingRate: s.RecordingRate,
-				Samples:       s.Samples,
-				Source:        s.Source,
-			})
-		}
+	resp := map[string]any{"exercises": output}
+	utils.AddPageInfo(resp, page, next)
+	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, resp, TietoevryCacheTTL)
+	utils.SetNextLink(w, r, next)
+	utils.WriteJSON(w, http.StatusOK, resp)
+}
+
+func ExerciseFromRow(ex tietoevrysqlc.Exercise) apitypes.TietoevryExerciseUpsertInput {
+	return apitypes.TietoevryExerciseUpsertInput{
+		ID:                ex.ID.String(),
+		CreatedAt:         ex.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:         ex.UpdatedAt.Format(time.RFC3339),
+		UserID:            ex.UserID.String(),
+		StartTime:         ex.StartTime.Format(time.RFC3339),
+		Duration:          ex.Duration,
+		Comment:           utils.StringPtrOrNil(ex.Comment),
+		SportType:         utils.StringPtrOrNil(ex.SportType),
+		DetailedSportType: utils.StringPtrOrNil(ex.DetailedSportType),
+		Distance:          utils.Float64PtrOrNil(ex.Distance),
+		AvgHeartRate:      utils.Float64PtrOrNil(ex.AvgHeartRate),
+		MaxHeartRate:      utils.Float64PtrOrNil(ex.MaxHeartRate),
+		Trimp:             utils.Float64PtrOrNil(ex.Trimp),
+		SprintCount:       utils.Int32PtrOrNil(ex.SprintCount),
+		AvgSpeed:          utils.Float64PtrOrNil(ex.AvgSpeed),
+		MaxSpeed:          utils.Float64PtrOrNil(ex.MaxSpeed),
+		Source:            ex.Source,
+		Status:            utils.StringPtrOrNil(ex.Status),
+		Calories:          utils.Int32PtrOrNil(ex.Calories),
+		TrainingLoad:      utils.Int32PtrOrNil(ex.TrainingLoad),
+		RawID:             utils.StringPtrOrNil(ex.RawID),
+		Feeling:           utils.Int32PtrOrNil(ex.Feeling),
+		Recovery:          utils.Int32PtrOrNil(ex.Recovery),
+		RPE:               utils.Int32PtrOrNil(ex.Rpe),
+		RawData:           utils.RawMessagePtrOrNil(ex.RawData),
+	}
+}
+
+// exercises per detail batch when streaming exercises with their nested rows
+const exerciseDetailBatch int32 = 100
 
-		// Sections
-		for _, sec := range sections {
-			out.Sections = append(out.Sections, swagger.Section{
-				ID:          sec.ID.String(),
-				UserID:      sec.UserID.String(),
-				ExerciseID:  sec.ExerciseID.String(),
-				CreatedAt:   sec.CreatedAt.Format(time.RFC3339),
-				UpdatedAt:   sec.UpdatedAt.Format(time.RFC3339),
-				StartTime:   sec.StartTime.Format(time.RFC3339),
-				EndTime:     sec.EndTime.Format(time.RFC3339),
-				SectionType: utils.StringPtrOrNil(sec.SectionType),
-				Name:        utils.StringPtrOrNil(sec.Name),
-				Comment:     utils.StringPtrOrNil(sec.Comment),
-				Source:      sec.Source,
-				RawID:       utils.StringPtrOrNil(sec.RawID),
-				RawData:     utils.RawMessagePtrOrNil(sec.RawData),
-			})
+// addExerciseDetails fills in the HR zones, samples and sections of the
+// exercises, out[i] being rows[i]
+func (h *TietoevryExerciseHandler) addExerciseDetails(ctx context.Context, out []apitypes.TietoevryExerciseUpsertInput, rows []tietoevrysqlc.Exercise) error {
+	ids := make([]uuid.UUID, len(rows))
+	for i, ex := range rows {
+		ids[i] = ex.ID
+	}
+	details, err := h.store.GetExerciseDetails(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for i, id := range ids {
+		d := details[id]
+		out[i].HRZones = HRZonesFromRows(d.HRZones)
+		out[i].Samples = SamplesFromRows(d.Samples)
+		out[i].Sections = SectionsFromRows(d.Sections)
+	}
+	return nil
+}
+
+// eachExerciseWithDetails calls fn with every exercise of the user matching
+// filter, nested rows included. It pages through the exercises rather than
+// holding a cursor open, so loading a page's details never needs a second
+// connection.
+func (h *TietoevryExerciseHandler) eachExerciseWithDetails(ctx context.Context, userID uuid.UUID, filter tietoevry.ReadFilter, fn func(apitypes.TietoevryExerciseUpsertInput) error) error {
+	cursor := ""
+	for {
+		page, err := utils.NewPage(exerciseDetailBatch, cursor)
+		if err != nil {
+			return err
+		}
+		rows, next, err := h.store.GetExercisesByUser(ctx, userID, filter, page)
+		if err != nil {
+			return err
+		}
+		out := make([]apitypes.TietoevryExerciseUpsertInput, len(rows))
+		for i, ex := range rows {
+			out[i] = ExerciseFromRow(ex)
+		}
+		if err := h.addExerciseDetails(ctx, out, rows); err != nil {
+			return err
+		}
+		for _, ex := range out {
+			if err := fn(ex); err != nil {
+				return err
+			}
+		}
+		if next == "" {
+			return nil
 		}
+		cursor = next
+	}
+}
 
-		output = append(output, out)
+func HRZonesFromRows(rows []tietoevrysqlc.ExerciseHrZone) []apitypes.HRZone {
+	var out []apitypes.HRZone
+	for _, z := range rows {
+		out = append(out, apitypes.HRZone{
+			ExerciseID:    z.ExerciseID.String(),
+			ZoneIndex:     z.ZoneIndex,
+			SecondsInZone: z.SecondsInZone,
+			LowerLimit:    z.LowerLimit,
+			UpperLimit:    z.UpperLimit,
+			CreatedAt:     z.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:     z.UpdatedAt.Format(time.RFC3339),
+		})
 	}
+	return out
+}
 
-	resp := map[string]any{"exercises": output}
-	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, resp, TietoevryCacheTTL)
-	utils.WriteJSON(w, http.StatusOK, resp)
+func SamplesFromRows(rows []tietoevrysqlc.ExerciseSample) []apitypes.Sample {
+	var out []apitypes.Sample
+	for _, s := range rows {
+		out = append(out, apitypes.Sample{
+			ID:            s.ID.String(),
+			UserID:        s.UserID.String(),
+			ExerciseID:    s.ExerciseID.String(),
+			SampleType:    s.SampleType,
+			RecordingRate: s.RecordingRate,
+			Samples:       s.Samples,
+			Source:        s.Source,
+		})
+	}
+	return out
+}
+
+func SectionsFromRows(rows []tietoevrysqlc.ExerciseSection) []apitypes.Section {
+	var out []apitypes.Section
+	for _, sec := range rows {
+		out = append(out, apitypes.Section{
+			ID:          sec.ID.String(),
+			UserID:      sec.UserID.String(),
+			ExerciseID:  sec.ExerciseID.String(),
+			CreatedAt:   sec.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:   sec.UpdatedAt.Format(time.RFC3339),
+			StartTime:   sec.StartTime.Format(time.RFC3339),
+			EndTime:     sec.EndTime.Format(time.RFC3339),
+			SectionType: utils.StringPtrOrNil(sec.SectionType),
+			Name:        utils.StringPtrOrNil(sec.Name),
+			Comment:     utils.StringPtrOrNil(sec.Comment),
+			Source:      sec.Source,
+			RawID:       utils.StringPtrOrNil(sec.RawID),
+			RawData:     utils.RawMessagePtrOrNil(sec.RawData),
+		})
+	}
+	return out
+}
+
+func (h *TietoevryExerciseHandler) streamExercises(w http.ResponseWriter, r *http.Request, format export.Format, userID uuid.UUID, filter tietoevry.ReadFilter) {
+	stream, err := export.NewStream[apitypes.TietoevryExerciseUpsertInput](w, format, "exercises", "exercises", nil)
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	if format == export.JSON {
+		err = h.eachExerciseWithDetails(r.Context(), userID, filter, stream.Write)
+	} else {
+		err = h.store.StreamExercisesByUser(r.Context(), userID, filter, func(ex tietoevrysqlc.Exercise) error {
+			return stream.Write(ExerciseFromRow(ex))
+		})
+	}
+	if err == nil {
+		err = stream.Close()
+	}
+	if err != nil {
+		if stream.Started() {
+			utils.AbortStream(r, err)
+		}
+		utils.InternalServerError(w, r, err)
+	}
 }
@@ -0,0 +1,87 @@
+package tietoevryapi
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/export"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/tietoevry"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+// Query parameters accepted by the per-user list endpoints
+var listParams = append([]string{"user_id", "from", "to", "updated_since"}, utils.PageParams...)
+
+// exportListParams is listParams for the endpoints that also serve tabular formats
+var exportListParams = append(listParams[:len(listParams):len(listParams)], export.FormatParam, utils.StreamParam)
+
+// parseReadFilter reads the from, to and updated_since query parameters
+func parseReadFilter(r *http.Request) (tietoevry.ReadFilter, error) {
+	q := r.URL.Query()
+	return NewReadFilter(q.Get("from"), q.Get("to"), q.Get("updated_since"))
+}
+
+// NewReadFilter builds a read filter from its raw values ("" for unset).
+// Each accepts a date (YYYY-MM-DD) or an RFC3339 timestamp; a date-only "to" covers the whole day.
+func NewReadFilter(fromStr, toStr, updatedSinceStr string) (tietoevry.ReadFilter, error) {
+	var f tietoevry.ReadFilter
+
+	from, _, err := parseDateOrTimestamp(fromStr)
+	if err != nil {
+		return f, fmt.Errorf("invalid from: %w", err)
+	}
+	to, dateOnly, err := parseDateOrTimestamp(toStr)
+	if err != nil {
+		return f, fmt.Errorf("invalid to: %w", err)
+	}
+	if to != nil && dateOnly {
+		end := to.Add(24*time.Hour - time.Microsecond)
+		to = &end
+	}
+	if from != nil && to != nil && from.After(*to) {
+		return f, fmt.Errorf("from must not be after to")
+	}
+	updatedSince, _, err := parseDateOrTimestamp(updatedSinceStr)
+	if err != nil {
+		return f, fmt.Errorf("invalid updated_since: %w", err)
+	}
+
+	f.From, f.To, f.UpdatedSince = from, to, updatedSince
+	return f, nil
+}
+
+func parseDateOrTimestamp(s string) (*time.Time, bool, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, false, nil
+	}
+	if len(s) == len("2006-01-02") {
+		t, err := utils.ParseDate(s)
+		if err != nil {
+			return nil, false, err
+		}
+		return &t, true, nil
+	}
+	t, err := utils.ParseTimestamp(s)
+	if err != nil {
+		return nil, false, err
+	}
+	t = t.UTC()
+	return &t, false, nil
+}
+
+// readFilterCacheKey returns the cache key suffix for a filtered request
+func readFilterCacheKey(f tietoevry.ReadFilter) string {
+	var b strings.Builder
+	for _, p := range []struct {
+		name string
+		t    *time.Time
+	}{{"from", f.From}, {"to", f.To}, {"upd", f.UpdatedSince}} {
+		if p.t != nil {
+			fmt.Fprintf(&b, ":%s=%d", p.name, p.t.UnixMicro())
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,63 @@
+package tietoevryapi
+
+import (
+	"context"
+
+	tietoevrysqlc "github.com/DeRuina/KUHA-REST-API/internal/db/tietoevry"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/tietoevry"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
+	"github.com/google/uuid"
+)
+
+// Entry points for transports other than HTTP (the gRPC ingestion service).
+// They validate, store and invalidate the cache exactly like the REST routes.
+
+// IngestResult is the outcome of a streamed ingest
+type IngestResult struct {
+	Items int
+	Users int
+}
+
+// IngestExercises stores the exercises pushed by source in one transaction,
+// like an atomic POST /tietoevry/exercises
+func (h *TietoevryExerciseHandler) IngestExercises(ctx context.Context, source func(fn func(i int, item apitypes.TietoevryExerciseUpsertInput, itemErr error) error) error) (IngestResult, error) {
+	users, n, err := streamInsert(ctx, source, h.store, exercisePayload,
+		func(p tietoevry.ExercisePayload) uuid.UUID { return p.Exercise.UserID }, h.store.InsertExercisesStream)
+	if err != nil {
+		return IngestResult{}, err
+	}
+	for uid := range users {
+		invalidateTietoevry(ctx, h.cache, uid, exPrefix)
+		events.Publish(events.TietoevryExerciseCreated, events.TietoevryExercises{UserID: uid.String()})
+	}
+	return IngestResult{Items: n, Users: len(users)}, nil
+}
+
+// IngestMeasurements stores the measurements pushed by source in one
+// transaction, like an atomic POST /tietoevry/measurements
+func (h *TietoevryMeasurementHandler) IngestMeasurements(ctx context.Context, source func(fn func(i int, item apitypes.TietoevryMeasurementInput, itemErr error) error) error) (IngestResult, error) {
+	users, n, err := streamInsert(ctx, source, h.store, measurementParams,
+		func(p tietoevrysqlc.InsertMeasurementParams) uuid.UUID { return p.UserID }, h.store.InsertMeasurementsStream)
+	if err != nil {
+		return IngestResult{}, err
+	}
+	for uid := range users {
+		invalidateTietoevry(ctx, h.cache, uid, msPrefix)
+		events.Publish(events.TietoevryDataCreated, events.TietoevryData{UserID: uid.String(), Resource: "measurements"})
+	}
+	return IngestResult{Items: n, Users: len(users)}, nil
+}
+
+// StreamExercises calls fn with every exercise of the user matching filter,
+// HR zones, samples and sections included
+func (h *TietoevryExerciseHandler) StreamExercises(ctx context.Context, userID uuid.UUID, filter tietoevry.ReadFilter, fn func(apitypes.TietoevryExerciseUpsertInput) error) error {
+	return h.eachExerciseWithDetails(ctx, userID, filter, fn)
+}
+
+// StreamMeasurements calls fn with every measurement of the user matching filter
+func (h *TietoevryMeasurementHandler) StreamMeasurements(ctx context.Context, userID uuid.UUID, filter tietoevry.ReadFilter, fn func(apitypes.TietoevryMeasurementInput) error) error {
+	return h.store.StreamMeasurementsByUser(ctx, userID, filter, func(m tietoevrysqlc.Measurement) error {
+		return fn(MeasurementFromRow(m))
+	})
+}
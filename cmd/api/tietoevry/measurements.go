@@ -1,17 +1,22 @@
 package tietoevryapi
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
-	"github.com/DeRuina/KUHA-REST-API/docs/swagger"
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
 	tietoevrysqlc "github.com/DeRuina/KUHA-REST-API/internal/db/tietoevry"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
+	"github.com/DeRuina/KUHA-REST-API/internal/export"
+	"github.com/DeRuina/KUHA-REST-API/internal/jobs"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/tietoevry"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 	"github.com/google/uuid"
 )
 
@@ -25,28 +30,6 @@ func NewTietoevryMeasurementHandler(store tietoevry.Measurements, cache *cache.S
 	return &TietoevryMeasurementHandler{store: store, cache: cache}
 }
 
-// Input struct for validation
-type TietoevryMeasurementInput struct {
-	ID             string   `json:"id" validate:"required,uuid4"`
-	CreatedAt      string   `json:"created_at" validate:"required"`
-	UpdatedAt      string   `json:"updated_at" validate:"required"`
-	UserID         string   `json:"user_id" validate:"required,uuid4"`
-	Date           string   `json:"date" validate:"required"`
-	Name           string   `json:"name" validate:"required"`
-	NameType       string   `json:"name_type" validate:"required"`
-	Source         string   `json:"source" validate:"required"`
-	Value          string   `json:"value" validate:"required"`
-	ValueNumeric   *float64 `json:"value_numeric"`
-	Comment        *string  `json:"comment"`
-	RawID          *string  `json:"raw_id"`
-	RawData        *string  `json:"raw_data"`
-	AdditionalInfo *string  `json:"additional_info"`
-}
-
-type TietoevryMeasurementsBulkInput struct {
-	Measurements []TietoevryMeasurementInput `json:"measurements" validate:"required,dive"`
-}
-
 // InsertMeasurements godoc
 //
 //	@Summary		Insert measurements (bulk)
@@ -54,14 +37,17 @@ type TietoevryMeasurementsBulkInput struct {
 //	@Tags			Tietoevry - Measurements
 //	@Accept			json
 //	@Produce		json
-//	@Param			measurements	body	swagger.TietoevryMeasurementsBulkInput	true	"Measurement data"
+//	@Param			measurements	body	apitypes.TietoevryMeasurementsBulkInput	true	"Measurement data"
+//	@Param			mode			query	string									false	"atomic (default): all or nothing; partial: commit valid items and report each one; async: like partial, run as a job polled at /jobs/{id}"
 //	@Success		201				"Measurements processed successfully"
-//	@Failure		400				{object}	swagger.ValidationErrorResponse
-//	@Failure		401				{object}	swagger.UnauthorizedResponse
-//	@Failure		403				{object}	swagger.ForbiddenResponse
-//	@Failure		409				{object}	swagger.ConflictResponse
-//	@Failure		500				{object}	swagger.InternalServerErrorResponse
-//	@Failure		503				{object}	swagger.ServiceUnavailableResponse
+//	@Success		202				{object}	apitypes.JobResponse		"Async mode: job queued; poll the Location header"
+//	@Success		207				{object}	apitypes.BulkResultResponse	"Partial mode: some items failed; see items"
+//	@Failure		400				{object}	apitypes.ValidationErrorResponse
+//	@Failure		401				{object}	apitypes.UnauthorizedResponse
+//	@Failure		403				{object}	apitypes.ForbiddenResponse
+//	@Failure		409				{object}	apitypes.ConflictResponse
+//	@Failure		500				{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503				{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/tietoevry/measurements [post]
 func (h *TietoevryMeasurementHandler) InsertMeasurementsBulk(w http.ResponseWriter, r *http.Request) {
@@ -70,98 +56,128 @@ func (h *TietoevryMeasurementHandler) InsertMeasurementsBulk(w http.ResponseWrit
 		return
 	}
 
-	var input TietoevryMeasurementsBulkInput
-	if err := utils.ReadJSON(w, r, &input); err != nil {
+	mode, err := utils.ParseBulkMode(r)
+	if err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
-	if err := utils.GetValidator().Struct(input); err != nil {
-		utils.BadRequestResponse(w, r, err)
+
+	if mode == utils.BulkModePartial {
+		h.insertMeasurementsPartial(w, r)
 		return
 	}
 
-	// PreValidation
-	userIDs := make([]uuid.UUID, len(input.Measurements))
-	for i, m := range input.Measurements {
-		userID, err := utils.ParseUUID(m.UserID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-		userIDs[i] = userID
+	users, ok := insertStreamed(w, r, "measurements", h.store, measurementParams,
+		func(p tietoevrysqlc.InsertMeasurementParams) uuid.UUID { return p.UserID }, h.store.InsertMeasurementsStream)
+	if !ok {
+		return
 	}
 
-	if err := h.store.ValidateUsersExist(r.Context(), userIDs); err != nil {
+	for uid := range users {
+		invalidateTietoevry(r.Context(), h.cache, uid, msPrefix)
+		events.Publish(events.TietoevryDataCreated, events.TietoevryData{UserID: uid.String(), Resource: "measurements"})
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+// insertMeasurementsPartial commits every valid measurement and reports the outcome of each
+func (h *TietoevryMeasurementHandler) insertMeasurementsPartial(w http.ResponseWriter, r *http.Request) {
+	batch, err := decodePartial(w, r, "measurements", prepareMeasurement)
+	if err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
+	if err := h.commitMeasurements(r.Context(), batch); err != nil {
+		utils.HandleDatabaseError(w, r, err)
+		return
+	}
 
-	params := make([]tietoevrysqlc.InsertMeasurementParams, len(input.Measurements))
-	for i, m := range input.Measurements {
-		id, err := utils.ParseUUID(m.ID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-		userID, err := utils.ParseUUID(m.UserID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-		createdAt, err := utils.ParseTimestamp(m.CreatedAt)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-		updatedAt, err := utils.ParseTimestamp(m.UpdatedAt)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-		date, err := utils.ParseDate(m.Date)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-		rawData := utils.ParseRawJSON(m.RawData)
-		additionalInfo := utils.ParseRawJSON(m.AdditionalInfo)
-
-		params[i] = tietoevrysqlc.InsertMeasurementParams{
-			ID:             id,
-			CreatedAt:      createdAt,
-			UpdatedAt:      updatedAt,
-			UserID:         userID,
-			Date:           date,
-			Name:           m.Name,
-			NameType:       m.NameType,
-			Source:         m.Source,
-			Value:          m.Value,
-			ValueNumeric:   utils.NullFloat64Ptr(m.ValueNumeric),
-			Comment:        utils.NullStringPtr(m.Comment),
-			RawID:          utils.NullStringPtr(m.RawID),
-			RawData:        rawData,
-			AdditionalInfo: additionalInfo,
-		}
+	if err := utils.WriteBulkResult(w, batch.result); err != nil {
+		utils.InternalServerError(w, r, err)
 	}
+}
 
-	if err := h.store.InsertMeasurementsBulk(r.Context(), params); err != nil {
-		utils.HandleDatabaseError(w, r, err)
+// ImportMeasurements is the job behind ?mode=async, see ImportExercises
+func (h *TietoevryMeasurementHandler) ImportMeasurements(ctx context.Context, body io.Reader, t *jobs.Tracker) error {
+	return importJob(ctx, body, "measurements", t, prepareMeasurement, h.commitMeasurements)
+}
+
+// prepareMeasurement validates one measurement and queues it on the batch or rejects it
+func prepareMeasurement(batch *partialBatch[tietoevrysqlc.InsertMeasurementParams], i int, item apitypes.TietoevryMeasurementInput) {
+	if err := utils.GetValidator().Struct(item); err != nil {
+		batch.reject(i, item.ID, err)
+		return
+	}
+	p, err := measurementParams(item)
+	if err != nil {
+		batch.reject(i, item.ID, utils.RejectItem(utils.CodeValidationFailed, err))
 		return
 	}
+	batch.accept(i, item.ID, p)
+}
 
-	if h.cache != nil {
-		seen := map[uuid.UUID]struct{}{}
-		for _, p := range params {
-			uid := p.UserID
-			if _, ok := seen[uid]; ok {
-				continue
-			}
-			seen[uid] = struct{}{}
-			invalidateTietoevry(r.Context(), h.cache, uid, msPrefix)
+// commitMeasurements stores the queued measurements and records the outcome of each
+func (h *TietoevryMeasurementHandler) commitMeasurements(ctx context.Context, batch *partialBatch[tietoevrysqlc.InsertMeasurementParams]) error {
+	if len(batch.params) == 0 {
+		return nil
+	}
+	outcomes, err := h.store.InsertMeasurementsPartial(ctx, batch.params)
+	if err != nil {
+		return err
+	}
+	seen := map[uuid.UUID]struct{}{}
+	batch.apply(outcomes, func(m tietoevrysqlc.InsertMeasurementParams) {
+		if _, ok := seen[m.UserID]; !ok {
+			seen[m.UserID] = struct{}{}
+			invalidateTietoevry(ctx, h.cache, m.UserID, msPrefix)
+			events.Publish(events.TietoevryDataCreated, events.TietoevryData{UserID: m.UserID.String(), Resource: "measurements"})
 		}
+	})
+	return nil
+}
+
+// measurementParams converts one input measurement to insert params
+func measurementParams(m apitypes.TietoevryMeasurementInput) (tietoevrysqlc.InsertMeasurementParams, error) {
+	id, err := utils.ParseUUID(m.ID)
+	if err != nil {
+		return tietoevrysqlc.InsertMeasurementParams{}, err
+	}
+	userID, err := utils.ParseUUID(m.UserID)
+	if err != nil {
+		return tietoevrysqlc.InsertMeasurementParams{}, err
+	}
+	createdAt, err := utils.ParseTimestamp(m.CreatedAt)
+	if err != nil {
+		return tietoevrysqlc.InsertMeasurementParams{}, err
 	}
+	updatedAt, err := utils.ParseTimestamp(m.UpdatedAt)
+	if err != nil {
+		return tietoevrysqlc.InsertMeasurementParams{}, err
+	}
+	date, err := utils.ParseDate(m.Date)
+	if err != nil {
+		return tietoevrysqlc.InsertMeasurementParams{}, err
+	}
+	rawData := utils.ParseRawJSON(m.RawData)
+	additionalInfo := utils.ParseRawJSON(m.AdditionalInfo)
 
-	w.WriteHeader(http.StatusCreated)
+	return tietoevrysqlc.InsertMeasurementParams{
+		ID:             id,
+		CreatedAt:      createdAt,
+		UpdatedAt:      updatedAt,
+		UserID:         userID,
+		Date:           date,
+		Name:           m.Name,
+		NameType:       m.NameType,
+		Source:         m.Source,
+		Value:          m.Value,
+		ValueNumeric:   utils.NullFloat64Ptr(m.ValueNumeric),
+		Comment:        utils.NullStringPtr(m.Comment),
+		RawID:          utils.NullStringPtr(m.RawID),
+		RawData:        rawData,
+		AdditionalInfo: additionalInfo,
+	}, nil
 }
 
 type TietoevryMeasurementParams struct {
@@ -174,14 +190,21 @@ type TietoevryMeasurementParams struct {
 //	@Description	Get all measurements for a specific user
 //	@Tags			Tietoevry - Measurements
 //	@Accept			json
-//	@Produce		json
-//	@Param			user_id	query		string	true	"User ID (UUID)"
-//	@Success		200		{object}	swagger.TietoevryMeasurementResponse
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Produce		json,text/csv,application/x-ndjson,application/vnd.apache.parquet
+//	@Param			user_id			query		string	true	"User ID (UUID)"
+//	@Param			from			query		string	false	"From date, inclusive (YYYY-MM-DD or RFC3339)"
+//	@Param			to				query		string	false	"To date, inclusive (YYYY-MM-DD or RFC3339)"
+//	@Param			updated_since	query		string	false	"Only rows updated at or after this time (RFC3339 or YYYY-MM-DD)"
+//	@Param			limit			query		integer	false	"Page size (1-1000); enables cursor pagination"
+//	@Param			cursor			query		string	false	"Opaque cursor from next_cursor of the previous page"
+//	@Param			format			query		string	false	"Response format: json (default), csv, ndjson, parquet; overrides Accept"
+//	@Param			stream			query		bool	false	"Stream the full listing row by row (implied for csv, ndjson and parquet; ignored with limit or cursor)"
+//	@Success		200				{object}	apitypes.TietoevryMeasurementResponse
+//	@Failure		400				{object}	apitypes.ValidationErrorResponse
+//	@Failure		401				{object}	apitypes.UnauthorizedResponse
+//	@Failure		403				{object}	apitypes.ForbiddenResponse
+//	@Failure		500				{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503				{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/tietoevry/measurements [get]
 func (h *TietoevryMeasurementHandler) GetMeasurements(w http.ResponseWriter, r *http.Request) {
@@ -190,7 +213,7 @@ func (h *TietoevryMeasurementHandler) GetMeasurements(w http.ResponseWriter, r *
 		return
 	}
 
-	if err := utils.ValidateParams(r, []string{"user_id"}); err != nil {
+	if err := utils.ValidateParams(r, exportListParams); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
@@ -204,9 +227,31 @@ func (h *TietoevryMeasurementHandler) GetMeasurements(w http.ResponseWriter, r *
 		return
 	}
 
-	cacheKey := fmt.Sprintf("tietoevry:measurements:%s", params.UserID)
-	if h.cache != nil {
+	filter, err := parseReadFilter(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	page, err := utils.ParsePage(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	format, err := export.Negotiate(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	// full listings are streamed straight from the database
+	streamed := !page.Paged() && (format != export.JSON || utils.WantsStream(r))
+
+	cacheKey := fmt.Sprintf("tietoevry:measurements:%s%s%s", params.UserID, readFilterCacheKey(filter), page.CacheKey())
+	if format == export.JSON && !streamed && h.cache != nil {
 		if cached, err := h.cache.Get(r.Context(), cacheKey); err == nil && cached != "" {
+			utils.SetNextLink(w, r, utils.CachedNextCursor(cached))
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(cached))
 			return
 		}
@@ -218,41 +263,83 @@ func (h *TietoevryMeasurementHandler) GetMeasurements(w http.ResponseWriter, r *
 		return
 	}
 
-	measurements, err := h.store.GetMeasurementsByUser(r.Context(), userID)
+	if streamed {
+		h.streamMeasurements(w, r, format, userID, filter)
+		return
+	}
+
+	measurements, next, err := h.store.GetMeasurementsByUser(r.Context(), userID, filter, page)
 	if err != nil {
-		utils.InternalServerError(w, r, err)
+		utils.HandleDatabaseError(w, r, err)
 		return
 	}
 
-	if len(measurements) == 0 {
-		utils.WriteJSON(w, http.StatusOK, map[string]any{
-			"measurements": []swagger.TietoevryMeasurementInput{},
-		})
+	if len(measurements) == 0 && format == export.JSON {
+		resp := map[string]any{
+			"measurements": []apitypes.TietoevryMeasurementInput{},
+		}
+		utils.AddPageInfo(resp, page, "")
+		utils.WriteJSON(w, http.StatusOK, resp)
 		return
 	}
 
-	var output []swagger.TietoevryMeasurementInput
+	var output []apitypes.TietoevryMeasurementInput
 	for _, measurement := range measurements {
-		out := swagger.TietoevryMeasurementInput{
-			ID:             measurement.ID.String(),
-			CreatedAt:      measurement.CreatedAt.Format(time.RFC3339),
-			UpdatedAt:      measurement.UpdatedAt.Format(time.RFC3339),
-			UserID:         measurement.UserID.String(),
-			Date:           measurement.Date.Format("2006-01-02"),
-			Name:           measurement.Name,
-			NameType:       measurement.NameType,
-			Source:         measurement.Source,
-			Value:          measurement.Value,
-			ValueNumeric:   utils.Float64PtrOrNil(measurement.ValueNumeric),
-			Comment:        utils.StringPtrOrNil(measurement.Comment),
-			RawID:          utils.StringPtrOrNil(measurement.RawID),
-			RawData:        utils.RawMessagePtrOrNil(measurement.RawData),
-			AdditionalInfo: utils.RawMessagePtrOrNil(measurement.AdditionalInfo),
-		}
+		out := MeasurementFromRow(measurement)
 		output = append(output, out)
 	}
 
+	if format != export.JSON {
+		utils.SetNextLink(w, r, next)
+		if err := export.Write(w, format, "measurements", output, nil); err != nil {
+			utils.InternalServerError(w, r, err)
+		}
+		return
+	}
+
 	resp := map[string]any{"measurements": output}
+	utils.AddPageInfo(resp, page, next)
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, resp, TietoevryCacheTTL)
+	utils.SetNextLink(w, r, next)
 	utils.WriteJSON(w, http.StatusOK, resp)
 }
+
+func MeasurementFromRow(m tietoevrysqlc.Measurement) apitypes.TietoevryMeasurementInput {
+	return apitypes.TietoevryMeasurementInput{
+		ID:             m.ID.String(),
+		CreatedAt:      m.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      m.UpdatedAt.Format(time.RFC3339),
+		UserID:         m.UserID.String(),
+		Date:           m.Date.Format("2006-01-02"),
+		Name:           m.Name,
+		NameType:       m.NameType,
+		Source:         m.Source,
+		Value:          m.Value,
+		ValueNumeric:   utils.Float64PtrOrNil(m.ValueNumeric),
+		Comment:        utils.StringPtrOrNil(m.Comment),
+		RawID:          utils.StringPtrOrNil(m.RawID),
+		RawData:        utils.RawMessagePtrOrNil(m.RawData),
+		AdditionalInfo: utils.RawMessagePtrOrNil(m.AdditionalInfo),
+	}
+}
+
+func (h *TietoevryMeasurementHandler) streamMeasurements(w http.ResponseWriter, r *http.Request, format export.Format, userID uuid.UUID, filter tietoevry.ReadFilter) {
+	stream, err := export.NewStream[apitypes.TietoevryMeasurementInput](w, format, "measurements", "measurements", nil)
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	err = h.store.StreamMeasurementsByUser(r.Context(), userID, filter, func(m tietoevrysqlc.Measurement) error {
+		return stream.Write(MeasurementFromRow(m))
+	})
+	if err == nil {
+		err = stream.Close()
+	}
+	if err != nil {
+		if stream.Started() {
+			utils.AbortStream(r, err)
+		}
+		utils.InternalServerError(w, r, err)
+	}
+}
@@ -6,12 +6,13 @@ import (
 	"net/http"
 	"time"
 
-	"github.com/DeRuina/KUHA-REST-API/docs/swagger"
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
 	tietoevrysqlc "github.com/DeRuina/KUHA-REST-API/internal/db/tietoevry"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/tietoevry"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 	"github.com/google/uuid"
 )
 
@@ -24,30 +25,6 @@ func NewTietoevryQuestionnaireHandler(store tietoevry.Questionnaires, cache *cac
 	return &TietoevryQuestionnaireHandler{store: store, cache: cache}
 }
 
-type TietoevryQuestionnaireAnswerInput struct {
-	UserID                  string  `json:"user_id" validate:"required,uuid4"`
-	QuestionnaireInstanceID string  `json:"questionnaire_instance_id" validate:"required,uuid4"`
-	QuestionnaireNameFi     *string `json:"questionnaire_name_fi"`
-	QuestionnaireNameEn     *string `json:"questionnaire_name_en"`
-	QuestionnaireKey        string  `json:"questionnaire_key" validate:"required"`
-	QuestionID              string  `json:"question_id" validate:"required,uuid4"`
-	QuestionLabelFi         *string `json:"question_label_fi"`
-	QuestionLabelEn         *string `json:"question_label_en"`
-	QuestionType            string  `json:"question_type" validate:"required"`
-	OptionID                *string `json:"option_id"`
-	OptionValue             *int32  `json:"option_value"`
-	OptionLabelFi           *string `json:"option_label_fi"`
-	OptionLabelEn           *string `json:"option_label_en"`
-	FreeText                *string `json:"free_text"`
-	CreatedAt               string  `json:"created_at" validate:"required"`
-	UpdatedAt               string  `json:"updated_at" validate:"required"`
-	Value                   *string `json:"value"`
-}
-
-type TietoevryQuestionnaireAnswersBulkInput struct {
-	Questionnaires []TietoevryQuestionnaireAnswerInput `json:"questionnaires" validate:"required,dive"`
-}
-
 // InsertQuestionnaireAnswers godoc
 //
 //	@Summary		Insert questionnaire answers (bulk)
@@ -55,14 +32,14 @@ type TietoevryQuestionnaireAnswersBulkInput struct {
 //	@Tags			Tietoevry - Questionnaires
 //	@Accept			json
 //	@Produce		json
-//	@Param			questionnaires	body	swagger.TietoevryQuestionnaireAnswersBulkInput	true	"Questionnaire answers"
+//	@Param			questionnaires	body	apitypes.TietoevryQuestionnaireAnswersBulkInput	true	"Questionnaire answers"
 //	@Success		201				"Questionnaire answers processed successfully"
-//	@Failure		400				{object}	swagger.ValidationErrorResponse
-//	@Failure		401				{object}	swagger.UnauthorizedResponse
-//	@Failure		403				{object}	swagger.ForbiddenResponse
-//	@Failure		409				{object}	swagger.ConflictResponse
-//	@Failure		500				{object}	swagger.InternalServerErrorResponse
-//	@Failure		503				{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400				{object}	apitypes.ValidationErrorResponse
+//	@Failure		401				{object}	apitypes.UnauthorizedResponse
+//	@Failure		403				{object}	apitypes.ForbiddenResponse
+//	@Failure		409				{object}	apitypes.ConflictResponse
+//	@Failure		500				{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503				{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/tietoevry/questionnaires [post]
 func (h *TietoevryQuestionnaireHandler) InsertQuestionnaireAnswersBulk(w http.ResponseWriter, r *http.Request) {
@@ -71,112 +48,73 @@ func (h *TietoevryQuestionnaireHandler) InsertQuestionnaireAnswersBulk(w http.Re
 		return
 	}
 
-	var input TietoevryQuestionnaireAnswersBulkInput
-	if err := utils.ReadJSON(w, r, &input); err != nil {
-		utils.BadRequestResponse(w, r, err)
-		return
-	}
-	if err := utils.GetValidator().Struct(input); err != nil {
-		utils.BadRequestResponse(w, r, err)
+	users, ok := insertStreamed(w, r, "questionnaires", h.store, questionnaireAnswerParams,
+		func(p tietoevrysqlc.InsertQuestionnaireAnswerParams) uuid.UUID { return p.UserID }, h.store.InsertQuestionnaireAnswersStream)
+	if !ok {
 		return
 	}
 
-	// PreValidation
-	userIDs := make([]uuid.UUID, len(input.Questionnaires))
-	for i, a := range input.Questionnaires {
-		id, err := utils.ParseUUID(a.UserID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-		userIDs[i] = id
+	for uid := range users {
+		invalidateTietoevry(r.Context(), h.cache, uid, qnPrefix)
+		events.Publish(events.TietoevryDataCreated, events.TietoevryData{UserID: uid.String(), Resource: "questionnaires"})
 	}
 
-	if err := h.store.ValidateUsersExist(r.Context(), userIDs); err != nil {
-		utils.BadRequestResponse(w, r, err)
-		return
-	}
-
-	questionnaires := make([]tietoevrysqlc.InsertQuestionnaireAnswerParams, len(input.Questionnaires))
-	for i, questionnaire := range input.Questionnaires {
-		// Parse and convert values
-		userID, err := utils.ParseUUID(questionnaire.UserID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		questionnaireInstanceID, err := utils.ParseUUID(questionnaire.QuestionnaireInstanceID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		questionID, err := utils.ParseUUID(questionnaire.QuestionID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		createdAt, err := utils.ParseTimestamp(questionnaire.CreatedAt)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		updatedAt, err := utils.ParseTimestamp(questionnaire.UpdatedAt)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
+	w.WriteHeader(http.StatusCreated)
+}
 
-		optionID, err := utils.ParseUUIDPtr(questionnaire.OptionID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
+// questionnaireAnswerParams converts one input questionnaire answer to insert params
+func questionnaireAnswerParams(questionnaire apitypes.TietoevryQuestionnaireAnswerInput) (tietoevrysqlc.InsertQuestionnaireAnswerParams, error) {
+	userID, err := utils.ParseUUID(questionnaire.UserID)
+	if err != nil {
+		return tietoevrysqlc.InsertQuestionnaireAnswerParams{}, err
+	}
 
-		valueJSON := utils.ParseRawJSON(questionnaire.Value)
+	questionnaireInstanceID, err := utils.ParseUUID(questionnaire.QuestionnaireInstanceID)
+	if err != nil {
+		return tietoevrysqlc.InsertQuestionnaireAnswerParams{}, err
+	}
 
-		questionnaires[i] = tietoevrysqlc.InsertQuestionnaireAnswerParams{
-			UserID:                  userID,
-			QuestionnaireInstanceID: questionnaireInstanceID,
-			QuestionnaireNameFi:     utils.NullStringPtr(questionnaire.QuestionnaireNameFi),
-			QuestionnaireNameEn:     utils.NullStringPtr(questionnaire.QuestionnaireNameEn),
-			QuestionnaireKey:        questionnaire.QuestionnaireKey,
-			QuestionID:              questionID,
-			QuestionLabelFi:         utils.NullStringPtr(questionnaire.QuestionLabelFi),
-			QuestionLabelEn:         utils.NullStringPtr(questionnaire.QuestionLabelEn),
-			QuestionType:            questionnaire.QuestionType,
-			OptionID:                optionID,
-			OptionValue:             utils.NullInt32Ptr(questionnaire.OptionValue),
-			OptionLabelFi:           utils.NullStringPtr(questionnaire.OptionLabelFi),
-			OptionLabelEn:           utils.NullStringPtr(questionnaire.OptionLabelEn),
-			FreeText:                utils.NullStringPtr(questionnaire.FreeText),
-			CreatedAt:               createdAt,
-			UpdatedAt:               updatedAt,
-			Value:                   valueJSON,
-		}
+	questionID, err := utils.ParseUUID(questionnaire.QuestionID)
+	if err != nil {
+		return tietoevrysqlc.InsertQuestionnaireAnswerParams{}, err
 	}
 
-	if err := h.store.InsertQuestionnaireAnswersBulk(r.Context(), questionnaires); err != nil {
-		utils.HandleDatabaseError(w, r, err)
-		return
+	createdAt, err := utils.ParseTimestamp(questionnaire.CreatedAt)
+	if err != nil {
+		return tietoevrysqlc.InsertQuestionnaireAnswerParams{}, err
 	}
 
-	if h.cache != nil {
-		seen := map[uuid.UUID]struct{}{}
-		for _, q := range questionnaires {
-			uid := q.UserID
-			if _, ok := seen[uid]; ok {
-				continue
-			}
-			seen[uid] = struct{}{}
-			invalidateTietoevry(r.Context(), h.cache, uid, qnPrefix)
-		}
+	updatedAt, err := utils.ParseTimestamp(questionnaire.UpdatedAt)
+	if err != nil {
+		return tietoevrysqlc.InsertQuestionnaireAnswerParams{}, err
 	}
 
-	w.WriteHeader(http.StatusCreated)
+	optionID, err := utils.ParseUUIDPtr(questionnaire.OptionID)
+	if err != nil {
+		return tietoevrysqlc.InsertQuestionnaireAnswerParams{}, err
+	}
+
+	valueJSON := utils.ParseRawJSON(questionnaire.Value)
+
+	return tietoevrysqlc.InsertQuestionnaireAnswerParams{
+		UserID:                  userID,
+		QuestionnaireInstanceID: questionnaireInstanceID,
+		QuestionnaireNameFi:     utils.NullStringPtr(questionnaire.QuestionnaireNameFi),
+		QuestionnaireNameEn:     utils.NullStringPtr(questionnaire.QuestionnaireNameEn),
+		QuestionnaireKey:        questionnaire.QuestionnaireKey,
+		QuestionID:              questionID,
+		QuestionLabelFi:         utils.NullStringPtr(questionnaire.QuestionLabelFi),
+		QuestionLabelEn:         utils.NullStringPtr(questionnaire.QuestionLabelEn),
+		QuestionType:            questionnaire.QuestionType,
+		OptionID:                optionID,
+		OptionValue:             utils.NullInt32Ptr(questionnaire.OptionValue),
+		OptionLabelFi:           utils.NullStringPtr(questionnaire.OptionLabelFi),
+		OptionLabelEn:           utils.NullStringPtr(questionnaire.OptionLabelEn),
+		FreeText:                utils.NullStringPtr(questionnaire.FreeText),
+		CreatedAt:               createdAt,
+		UpdatedAt:               updatedAt,
+		Value:                   valueJSON,
+	}, nil
 }
 
 type TietoevryQuestionnaireParams struct {
@@ -190,13 +128,18 @@ type TietoevryQuestionnaireParams struct {
 //	@Tags			Tietoevry - Questionnaires
 //	@Accept			json
 //	@Produce		json
-//	@Param			user_id	query		string	true	"User ID (UUID)"
-//	@Success		200		{object}	swagger.TietoevryQuestionnaireAnswerResponse
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Param			user_id			query		string	true	"User ID (UUID)"
+//	@Param			from			query		string	false	"From date, inclusive (YYYY-MM-DD or RFC3339)"
+//	@Param			to				query		string	false	"To date, inclusive (YYYY-MM-DD or RFC3339)"
+//	@Param			updated_since	query		string	false	"Only rows updated at or after this time (RFC3339 or YYYY-MM-DD)"
+//	@Param			limit			query		integer	false	"Page size (1-1000); enables cursor pagination"
+//	@Param			cursor			query		string	false	"Opaque cursor from next_cursor of the previous page"
+//	@Success		200				{object}	apitypes.TietoevryQuestionnaireAnswerResponse
+//	@Failure		400				{object}	apitypes.ValidationErrorResponse
+//	@Failure		401				{object}	apitypes.UnauthorizedResponse
+//	@Failure		403				{object}	apitypes.ForbiddenResponse
+//	@Failure		500				{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503				{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/tietoevry/questionnaires [get]
 func (h *TietoevryQuestionnaireHandler) GetQuestionnaires(w http.ResponseWriter, r *http.Request) {
@@ -205,7 +148,7 @@ func (h *TietoevryQuestionnaireHandler) GetQuestionnaires(w http.ResponseWriter,
 		return
 	}
 
-	if err := utils.ValidateParams(r, []string{"user_id"}); err != nil {
+	if err := utils.ValidateParams(r, listParams); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
@@ -219,9 +162,22 @@ func (h *TietoevryQuestionnaireHandler) GetQuestionnaires(w http.ResponseWriter,
 		return
 	}
 
-	cacheKey := fmt.Sprintf("tietoevry:questionnaires:%s", params.UserID)
+	filter, err := parseReadFilter(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	page, err := utils.ParsePage(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	cacheKey := fmt.Sprintf("tietoevry:questionnaires:%s%s%s", params.UserID, readFilterCacheKey(filter), page.CacheKey())
 	if h.cache != nil {
 		if cached, err := h.cache.Get(r.Context(), cacheKey); err == nil && cached != "" {
+			utils.SetNextLink(w, r, utils.CachedNextCursor(cached))
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(cached))
 			return
 		}
@@ -233,22 +189,24 @@ func (h *TietoevryQuestionnaireHandler) GetQuestionnaires(w http.ResponseWriter,
 		return
 	}
 
-	questionnaires, err := h.store.GetQuestionnairesByUser(r.Context(), userID)
+	questionnaires, next, err := h.store.GetQuestionnairesByUser(r.Context(), userID, filter, page)
 	if err != nil {
-		utils.InternalServerError(w, r, err)
+		utils.HandleDatabaseError(w, r, err)
 		return
 	}
 
 	if len(questionnaires) == 0 {
-		utils.WriteJSON(w, http.StatusOK, map[string]any{
-			"questionnaires": []swagger.TietoevryQuestionnaireAnswerInput{},
-		})
+		resp := map[string]any{
+			"questionnaires": []apitypes.TietoevryQuestionnaireAnswerInput{},
+		}
+		utils.AddPageInfo(resp, page, "")
+		utils.WriteJSON(w, http.StatusOK, resp)
 		return
 	}
 
-	var output []swagger.TietoevryQuestionnaireAnswerInput
+	var output []apitypes.TietoevryQuestionnaireAnswerInput
 	for _, questionnaire := range questionnaires {
-		out := swagger.TietoevryQuestionnaireAnswerInput{
+		out := apitypes.TietoevryQuestionnaireAnswerInput{
 			UserID:                  questionnaire.UserID.String(),
 			QuestionnaireInstanceID: questionnaire.QuestionnaireInstanceID.String(),
 			QuestionnaireNameFi:     utils.StringPtrOrNil(questionnaire.QuestionnaireNameFi),
@@ -271,6 +229,8 @@ func (h *TietoevryQuestionnaireHandler) GetQuestionnaires(w http.ResponseWriter,
 	}
 
 	resp := map[string]any{"questionnaires": output}
+	utils.AddPageInfo(resp, page, next)
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, resp, TietoevryCacheTTL)
+	utils.SetNextLink(w, r, next)
 	utils.WriteJSON(w, http.StatusOK, resp)
 }
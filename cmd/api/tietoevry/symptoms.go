@@ -6,12 +6,13 @@ import (
 	"net/http"
 	"time"
 
-	"github.com/DeRuina/KUHA-REST-API/docs/swagger"
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
 	tietoevrysqlc "github.com/DeRuina/KUHA-REST-API/internal/db/tietoevry"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/tietoevry"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 	"github.com/google/uuid"
 )
 
@@ -25,30 +26,6 @@ func NewTietoevrySymptomHandler(store tietoevry.Symptoms, cache *cache.Storage)
 	return &TietoevrySymptomHandler{store: store, cache: cache}
 }
 
-// Input struct for validation
-type TietoevrySymptomInput struct {
-	ID             string  `json:"id" validate:"required,uuid4"`
-	UserID         string  `json:"user_id" validate:"required,uuid4"`
-	Date           string  `json:"date" validate:"required"`
-	Symptom        string  `json:"symptom" validate:"required"`
-	Severity       int32   `json:"severity" validate:"required"`
-	Comment        *string `json:"comment"`
-	Source         string  `json:"source" validate:"required"`
-	CreatedAt      string  `json:"created_at" validate:"required"`
-	UpdatedAt      string  `json:"updated_at" validate:"required"`
-	RawID          *string `json:"raw_id"`
-	OriginalID     *string `json:"original_id"`
-	Recovered      *bool   `json:"recovered"`
-	PainIndex      *int32  `json:"pain_index"`
-	Side           *string `json:"side"`
-	Category       *string `json:"category"`
-	AdditionalData *string `json:"additional_data"`
-}
-
-type TietoevrySymptomsBulkInput struct {
-	Symptoms []TietoevrySymptomInput `json:"symptoms" validate:"required,dive"`
-}
-
 // InsertSymptoms godoc
 //
 //	@Summary		Insert symptoms (bulk)
@@ -56,14 +33,14 @@ type TietoevrySymptomsBulkInput struct {
 //	@Tags			Tietoevry - Symptoms
 //	@Accept			json
 //	@Produce		json
-//	@Param			symptoms	body	swagger.TietoevrySymptomsBulkInput	true	"Symptom data"
+//	@Param			symptoms	body	apitypes.TietoevrySymptomsBulkInput	true	"Symptom data"
 //	@Success		201			"Symptoms processed successfully (idempotent operation)"
-//	@Failure		400			{object}	swagger.ValidationErrorResponse
-//	@Failure		401			{object}	swagger.UnauthorizedResponse
-//	@Failure		403			{object}	swagger.ForbiddenResponse
-//	@Failure		409			{object}	swagger.ConflictResponse
-//	@Failure		500			{object}	swagger.InternalServerErrorResponse
-//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400			{object}	apitypes.ValidationErrorResponse
+//	@Failure		401			{object}	apitypes.UnauthorizedResponse
+//	@Failure		403			{object}	apitypes.ForbiddenResponse
+//	@Failure		409			{object}	apitypes.ConflictResponse
+//	@Failure		500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/tietoevry/symptoms [post]
 func (h *TietoevrySymptomHandler) InsertSymptomsBulk(w http.ResponseWriter, r *http.Request) {
@@ -72,112 +49,72 @@ func (h *TietoevrySymptomHandler) InsertSymptomsBulk(w http.ResponseWriter, r *h
 		return
 	}
 
-	var input TietoevrySymptomsBulkInput
-	if err := utils.ReadJSON(w, r, &input); err != nil {
-		utils.BadRequestResponse(w, r, err)
-		return
-	}
-	if err := utils.GetValidator().Struct(input); err != nil {
-		utils.BadRequestResponse(w, r, err)
+	users, ok := insertStreamed(w, r, "symptoms", h.store, symptomParams,
+		func(p tietoevrysqlc.InsertSymptomParams) uuid.UUID { return p.UserID }, h.store.InsertSymptomsStream)
+	if !ok {
 		return
 	}
 
-	// PreValidation
-	userIDs := make([]uuid.UUID, len(input.Symptoms))
-	for i, symptom := range input.Symptoms {
-		userID, err := utils.ParseUUID(symptom.UserID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-		userIDs[i] = userID
-	}
-
-	if err := h.store.ValidateUsersExist(r.Context(), userIDs); err != nil {
-		utils.BadRequestResponse(w, r, err)
-		return
+	for uid := range users {
+		invalidateTietoevry(r.Context(), h.cache, uid, syPrefix)
+		events.Publish(events.TietoevryDataCreated, events.TietoevryData{UserID: uid.String(), Resource: "symptoms"})
 	}
 
-	// Convert to database parameters
-	symptoms := make([]tietoevrysqlc.InsertSymptomParams, len(input.Symptoms))
-	for i, symptom := range input.Symptoms {
-		// Parse and convert values
-		id, err := utils.ParseUUID(symptom.ID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		userID, err := utils.ParseUUID(symptom.UserID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		date, err := utils.ParseDate(symptom.Date)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		createdAt, err := utils.ParseTimestamp(symptom.CreatedAt)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		updatedAt, err := utils.ParseTimestamp(symptom.UpdatedAt)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
+	w.WriteHeader(http.StatusCreated)
+}
 
-		originalID, err := utils.ParseUUIDPtr(symptom.OriginalID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
+// symptomParams converts one input symptom to insert params
+func symptomParams(symptom apitypes.TietoevrySymptomInput) (tietoevrysqlc.InsertSymptomParams, error) {
+	id, err := utils.ParseUUID(symptom.ID)
+	if err != nil {
+		return tietoevrysqlc.InsertSymptomParams{}, err
+	}
 
-		rawData := utils.ParseRawJSON(symptom.AdditionalData)
+	userID, err := utils.ParseUUID(symptom.UserID)
+	if err != nil {
+		return tietoevrysqlc.InsertSymptomParams{}, err
+	}
 
-		symptoms[i] = tietoevrysqlc.InsertSymptomParams{
-			ID:             id,
-			UserID:         userID,
-			Date:           date,
-			Symptom:        symptom.Symptom,
-			Severity:       symptom.Severity,
-			Comment:        utils.NullStringPtr(symptom.Comment),
-			Source:         symptom.Source,
-			CreatedAt:      createdAt,
-			UpdatedAt:      updatedAt,
-			RawID:          utils.NullStringPtr(symptom.RawID),
-			OriginalID:     originalID,
-			Recovered:      utils.NullBoolPtr(symptom.Recovered),
-			PainIndex:      utils.NullInt32Ptr(symptom.PainIndex),
-			Side:           utils.NullStringPtr(symptom.Side),
-			Category:       utils.NullStringPtr(symptom.Category),
-			AdditionalData: rawData,
-		}
+	date, err := utils.ParseDate(symptom.Date)
+	if err != nil {
+		return tietoevrysqlc.InsertSymptomParams{}, err
 	}
 
-	if err := h.store.InsertSymptomsBulk(r.Context(), symptoms); err != nil {
-		utils.HandleDatabaseError(w, r, err)
-		return
+	createdAt, err := utils.ParseTimestamp(symptom.CreatedAt)
+	if err != nil {
+		return tietoevrysqlc.InsertSymptomParams{}, err
 	}
 
-	if h.cache != nil {
-		seen := map[uuid.UUID]struct{}{}
-		for _, s := range symptoms {
-			uid := s.UserID
-			if _, ok := seen[uid]; ok {
-				continue
-			}
-			seen[uid] = struct{}{}
-			invalidateTietoevry(r.Context(), h.cache, uid, syPrefix)
-		}
+	updatedAt, err := utils.ParseTimestamp(symptom.UpdatedAt)
+	if err != nil {
+		return tietoevrysqlc.InsertSymptomParams{}, err
 	}
 
-	w.WriteHeader(http.StatusCreated)
+	originalID, err := utils.ParseUUIDPtr(symptom.OriginalID)
+	if err != nil {
+		return tietoevrysqlc.InsertSymptomParams{}, err
+	}
+
+	rawData := utils.ParseRawJSON(symptom.AdditionalData)
+
+	return tietoevrysqlc.InsertSymptomParams{
+		ID:             id,
+		UserID:         userID,
+		Date:           date,
+		Symptom:        symptom.Symptom,
+		Severity:       symptom.Severity,
+		Comment:        utils.NullStringPtr(symptom.Comment),
+		Source:         symptom.Source,
+		CreatedAt:      createdAt,
+		UpdatedAt:      updatedAt,
+		RawID:          utils.NullStringPtr(symptom.RawID),
+		OriginalID:     originalID,
+		Recovered:      utils.NullBoolPtr(symptom.Recovered),
+		PainIndex:      utils.NullInt32Ptr(symptom.PainIndex),
+		Side:           utils.NullStringPtr(symptom.Side),
+		Category:       utils.NullStringPtr(symptom.Category),
+		AdditionalData: rawData,
+	}, nil
 }
 
 type TietoevrySymptomParams struct {
@@ -191,13 +128,18 @@ type TietoevrySymptomParams struct {
 //	@Tags			Tietoevry - Symptoms
 //	@Accept			json
 //	@Produce		json
-//	@Param			user_id	query		string	true	"User ID (UUID)"
-//	@Success		200		{object}	swagger.TietoevrySymptomResponse
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Param			user_id			query		string	true	"User ID (UUID)"
+//	@Param			from			query		string	false	"From date, inclusive (YYYY-MM-DD or RFC3339)"
+//	@Param			to				query		string	false	"To date, inclusive (YYYY-MM-DD or RFC3339)"
+//	@Param			updated_since	query		string	false	"Only rows updated at or after this time (RFC3339 or YYYY-MM-DD)"
+//	@Param			limit			query		integer	false	"Page size (1-1000); enables cursor pagination"
+//	@Param			cursor			query		string	false	"Opaque cursor from next_cursor of the previous page"
+//	@Success		200				{object}	apitypes.TietoevrySymptomResponse
+//	@Failure		400				{object}	apitypes.ValidationErrorResponse
+//	@Failure		401				{object}	apitypes.UnauthorizedResponse
+//	@Failure		403				{object}	apitypes.ForbiddenResponse
+//	@Failure		500				{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503				{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/tietoevry/symptoms [get]
 func (h *TietoevrySymptomHandler) GetSymptoms(w http.ResponseWriter, r *http.Request) {
@@ -206,7 +148,7 @@ func (h *TietoevrySymptomHandler) GetSymptoms(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	if err := utils.ValidateParams(r, []string{"user_id"}); err != nil {
+	if err := utils.ValidateParams(r, listParams); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
@@ -220,9 +162,22 @@ func (h *TietoevrySymptomHandler) GetSymptoms(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	cacheKey := fmt.Sprintf("tietoevry:symptoms:%s", params.UserID)
+	filter, err := parseReadFilter(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	page, err := utils.ParsePage(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	cacheKey := fmt.Sprintf("tietoevry:symptoms:%s%s%s", params.UserID, readFilterCacheKey(filter), page.CacheKey())
 	if h.cache != nil {
 		if cached, err := h.cache.Get(r.Context(), cacheKey); err == nil && cached != "" {
+			utils.SetNextLink(w, r, utils.CachedNextCursor(cached))
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(cached))
 			return
 		}
@@ -234,22 +189,24 @@ func (h *TietoevrySymptomHandler) GetSymptoms(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	symptoms, err := h.store.GetSymptomsByUser(r.Context(), userID)
+	symptoms, next, err := h.store.GetSymptomsByUser(r.Context(), userID, filter, page)
 	if err != nil {
-		utils.InternalServerError(w, r, err)
+		utils.HandleDatabaseError(w, r, err)
 		return
 	}
 
 	if len(symptoms) == 0 {
-		utils.WriteJSON(w, http.StatusOK, map[string]any{
-			"symptoms": []swagger.TietoevrySymptomInput{},
-		})
+		resp := map[string]any{
+			"symptoms": []apitypes.TietoevrySymptomInput{},
+		}
+		utils.AddPageInfo(resp, page, "")
+		utils.WriteJSON(w, http.StatusOK, resp)
 		return
 	}
 
-	var output []swagger.TietoevrySymptomInput
+	var output []apitypes.TietoevrySymptomInput
 	for _, symptom := range symptoms {
-		out := swagger.TietoevrySymptomInput{
+		out := apitypes.TietoevrySymptomInput{
 			ID:             symptom.ID.String(),
 			UserID:         symptom.UserID.String(),
 			Date:           symptom.Date.Format("2006-01-02"),
@@ -271,6 +228,8 @@ func (h *TietoevrySymptomHandler) GetSymptoms(w http.ResponseWriter, r *http.Req
 	}
 
 	resp := map[string]any{"symptoms": output}
+	utils.AddPageInfo(resp, page, next)
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, resp, TietoevryCacheTTL)
+	utils.SetNextLink(w, r, next)
 	utils.WriteJSON(w, http.StatusOK, resp)
 }
@@ -6,12 +6,13 @@ import (
 	"net/http"
 	"time"
 
-	"github.com/DeRuina/KUHA-REST-API/docs/swagger"
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
 	tietoevrysqlc "github.com/DeRuina/KUHA-REST-API/internal/db/tietoevry"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/tietoevry"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 	"github.com/google/uuid"
 )
 
@@ -24,31 +25,6 @@ func NewTietoevryTestResultHandler(store tietoevry.TestResults, cache *cache.Sto
 	return &TietoevryTestResultHandler{store: store, cache: cache}
 }
 
-type TietoevryTestResultInput struct {
-	ID                          string  `json:"id" validate:"required,uuid4"`
-	UserID                      string  `json:"user_id" validate:"required,uuid4"`
-	TypeID                      string  `json:"type_id" validate:"required,uuid4"`
-	TypeType                    *string `json:"type_type"`
-	TypeResultType              string  `json:"type_result_type" validate:"required"`
-	TypeName                    *string `json:"type_name"`
-	Timestamp                   string  `json:"timestamp" validate:"required"`
-	Name                        *string `json:"name"`
-	Comment                     *string `json:"comment"`
-	Data                        string  `json:"data" validate:"required"`
-	CreatedAt                   string  `json:"created_at" validate:"required"`
-	UpdatedAt                   string  `json:"updated_at" validate:"required"`
-	TestEventID                 *string `json:"test_event_id"`
-	TestEventName               *string `json:"test_event_name"`
-	TestEventDate               *string `json:"test_event_date"`
-	TestEventTemplateTestID     *string `json:"test_event_template_test_id"`
-	TestEventTemplateTestName   *string `json:"test_event_template_test_name"`
-	TestEventTemplateTestLimits *string `json:"test_event_template_test_limits"`
-}
-
-type TietoevryTestResultsBulkInput struct {
-	TestResults []TietoevryTestResultInput `json:"test_results" validate:"required,dive"`
-}
-
 // InsertTestResultsBulk godoc
 //
 //	@Summary		Insert test results (bulk)
@@ -56,14 +32,14 @@ type TietoevryTestResultsBulkInput struct {
 //	@Tags			Tietoevry - Test_Results
 //	@Accept			json
 //	@Produce		json
-//	@Param			test_results	body	swagger.TietoevryTestResultsBulkInput	true	"Test result data"
+//	@Param			test_results	body	apitypes.TietoevryTestResultsBulkInput	true	"Test result data"
 //	@Success		201				"Test results processed successfully"
-//	@Failure		400				{object}	swagger.ValidationErrorResponse
-//	@Failure		401				{object}	swagger.UnauthorizedResponse
-//	@Failure		403				{object}	swagger.ForbiddenResponse
-//	@Failure		409				{object}	swagger.ConflictResponse
-//	@Failure		500				{object}	swagger.InternalServerErrorResponse
-//	@Failure		503				{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400				{object}	apitypes.ValidationErrorResponse
+//	@Failure		401				{object}	apitypes.UnauthorizedResponse
+//	@Failure		403				{object}	apitypes.ForbiddenResponse
+//	@Failure		409				{object}	apitypes.ConflictResponse
+//	@Failure		500				{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503				{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/tietoevry/test-results [post]
 func (h *TietoevryTestResultHandler) InsertTestResultsBulk(w http.ResponseWriter, r *http.Request) {
@@ -72,132 +48,90 @@ func (h *TietoevryTestResultHandler) InsertTestResultsBulk(w http.ResponseWriter
 		return
 	}
 
-	var input TietoevryTestResultsBulkInput
-	if err := utils.ReadJSON(w, r, &input); err != nil {
-		utils.BadRequestResponse(w, r, err)
+	users, ok := insertStreamed(w, r, "test_results", h.store, testResultParams,
+		func(p tietoevrysqlc.InsertTestResultParams) uuid.UUID { return p.UserID }, h.store.InsertTestResultsStream)
+	if !ok {
 		return
 	}
-	if err := utils.GetValidator().Struct(input); err != nil {
-		utils.BadRequestResponse(w, r, err)
-		return
-	}
-
-	// PreValidation
-	userIDs := make([]uuid.UUID, len(input.TestResults))
-	for i, t := range input.TestResults {
-		userID, err := utils.ParseUUID(t.UserID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-		userIDs[i] = userID
-	}
 
-	if err := h.store.ValidateUsersExist(r.Context(), userIDs); err != nil {
-		utils.BadRequestResponse(w, r, err)
-		return
+	for uid := range users {
+		invalidateTietoevry(r.Context(), h.cache, uid, trPrefix)
+		events.Publish(events.TietoevryDataCreated, events.TietoevryData{UserID: uid.String(), Resource: "test-results"})
 	}
 
-	testResults := make([]tietoevrysqlc.InsertTestResultParams, len(input.TestResults))
-	for i, testResult := range input.TestResults {
-		// Parse and convert values
-		id, err := utils.ParseUUID(testResult.ID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		userID, err := utils.ParseUUID(testResult.UserID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		typeID, err := utils.ParseUUID(testResult.TypeID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		timestamp, err := utils.ParseTimestamp(testResult.Timestamp)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		createdAt, err := utils.ParseTimestamp(testResult.CreatedAt)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
+	w.WriteHeader(http.StatusCreated)
+}
 
-		updatedAt, err := utils.ParseTimestamp(testResult.UpdatedAt)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
+// testResultParams converts one input test result to insert params
+func testResultParams(testResult apitypes.TietoevryTestResultInput) (tietoevrysqlc.InsertTestResultParams, error) {
+	id, err := utils.ParseUUID(testResult.ID)
+	if err != nil {
+		return tietoevrysqlc.InsertTestResultParams{}, err
+	}
 
-		testEventID, err := utils.ParseUUIDPtr(testResult.TestEventID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
+	userID, err := utils.ParseUUID(testResult.UserID)
+	if err != nil {
+		return tietoevrysqlc.InsertTestResultParams{}, err
+	}
 
-		testEventDate, err := utils.ParseDatePtr(testResult.TestEventDate)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
+	typeID, err := utils.ParseUUID(testResult.TypeID)
+	if err != nil {
+		return tietoevrysqlc.InsertTestResultParams{}, err
+	}
 
-		testEventTemplateTestID, err := utils.ParseUUIDPtr(testResult.TestEventTemplateTestID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
+	timestamp, err := utils.ParseTimestamp(testResult.Timestamp)
+	if err != nil {
+		return tietoevrysqlc.InsertTestResultParams{}, err
+	}
 
-		dataJSON := utils.ParseRequiredJSON(testResult.Data)
-		templateLimitsJSON := utils.ParseRawJSON(testResult.TestEventTemplateTestLimits)
+	createdAt, err := utils.ParseTimestamp(testResult.CreatedAt)
+	if err != nil {
+		return tietoevrysqlc.InsertTestResultParams{}, err
+	}
 
-		testResults[i] = tietoevrysqlc.InsertTestResultParams{
-			ID:                          id,
-			UserID:                      userID,
-			TypeID:                      typeID,
-			TypeType:                    utils.NullStringPtr(testResult.TypeType),
-			TypeResultType:              testResult.TypeResultType,
-			TypeName:                    utils.NullStringPtr(testResult.TypeName),
-			Timestamp:                   timestamp,
-			Name:                        utils.NullStringPtr(testResult.Name),
-			Comment:                     utils.NullStringPtr(testResult.Comment),
-			Data:                        dataJSON,
-			CreatedAt:                   createdAt,
-			UpdatedAt:                   updatedAt,
-			TestEventID:                 testEventID,
-			TestEventName:               utils.NullStringPtr(testResult.TestEventName),
-			TestEventDate:               utils.NullTimePtr(testEventDate),
-			TestEventTemplateTestID:     testEventTemplateTestID,
-			TestEventTemplateTestName:   utils.NullStringPtr(testResult.TestEventTemplateTestName),
-			TestEventTemplateTestLimits: templateLimitsJSON,
-		}
+	updatedAt, err := utils.ParseTimestamp(testResult.UpdatedAt)
+	if err != nil {
+		return tietoevrysqlc.InsertTestResultParams{}, err
 	}
 
-	if err := h.store.InsertTestResultsBulk(r.Context(), testResults); err != nil {
-		utils.HandleDatabaseError(w, r, err)
-		return
+	testEventID, err := utils.ParseUUIDPtr(testResult.TestEventID)
+	if err != nil {
+		return tietoevrysqlc.InsertTestResultParams{}, err
 	}
 
-	if h.cache != nil {
-		seen := map[uuid.UUID]struct{}{}
-		for _, t := range testResults {
-			uid := t.UserID
-			if _, ok := seen[uid]; ok {
-				continue
-			}
-			seen[uid] = struct{}{}
-			invalidateTietoevry(r.Context(), h.cache, uid, trPrefix)
-		}
+	testEventDate, err := utils.ParseDatePtr(testResult.TestEventDate)
+	if err != nil {
+		return tietoevrysqlc.InsertTestResultParams{}, err
 	}
 
-	w.WriteHeader(http.StatusCreated)
+	testEventTemplateTestID, err := utils.ParseUUIDPtr(testResult.TestEventTemplateTestID)
+	if err != nil {
+		return tietoevrysqlc.InsertTestResultParams{}, err
+	}
+
+	dataJSON := utils.ParseRequiredJSON(testResult.Data)
+	templateLimitsJSON := utils.ParseRawJSON(testResult.TestEventTemplateTestLimits)
+
+	return tietoevrysqlc.InsertTestResultParams{
+		ID:                          id,
+		UserID:                      userID,
+		TypeID:                      typeID,
+		TypeType:                    utils.NullStringPtr(testResult.TypeType),
+		TypeResultType:              testResult.TypeResultType,
+		TypeName:                    utils.NullStringPtr(testResult.TypeName),
+		Timestamp:                   timestamp,
+		Name:                        utils.NullStringPtr(testResult.Name),
+		Comment:                     utils.NullStringPtr(testResult.Comment),
+		Data:                        dataJSON,
+		CreatedAt:                   createdAt,
+		UpdatedAt:                   updatedAt,
+		TestEventID:                 testEventID,
+		TestEventName:               utils.NullStringPtr(testResult.TestEventName),
+		TestEventDate:               utils.NullTimePtr(testEventDate),
+		TestEventTemplateTestID:     testEventTemplateTestID,
+		TestEventTemplateTestName:   utils.NullStringPtr(testResult.TestEventTemplateTestName),
+		TestEventTemplateTestLimits: templateLimitsJSON,
+	}, nil
 }
 
 type TietoevryTestResultParams struct {
@@ -211,13 +145,18 @@ type TietoevryTestResultParams struct {
 //	@Tags			Tietoevry - Test_Results
 //	@Accept			json
 //	@Produce		json
-//	@Param			user_id	query		string	true	"User ID (UUID)"
-//	@Success		200		{object}	swagger.TietoevryTestResultResponse
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Param			user_id			query		string	true	"User ID (UUID)"
+//	@Param			from			query		string	false	"From date, inclusive (YYYY-MM-DD or RFC3339)"
+//	@Param			to				query		string	false	"To date, inclusive (YYYY-MM-DD or RFC3339)"
+//	@Param			updated_since	query		string	false	"Only rows updated at or after this time (RFC3339 or YYYY-MM-DD)"
+//	@Param			limit			query		integer	false	"Page size (1-1000); enables cursor pagination"
+//	@Param			cursor			query		string	false	"Opaque cursor from next_cursor of the previous page"
+//	@Success		200				{object}	apitypes.TietoevryTestResultResponse
+//	@Failure		400				{object}	apitypes.ValidationErrorResponse
+//	@Failure		401				{object}	apitypes.UnauthorizedResponse
+//	@Failure		403				{object}	apitypes.ForbiddenResponse
+//	@Failure		500				{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503				{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/tietoevry/test-results [get]
 func (h *TietoevryTestResultHandler) GetTestResults(w http.ResponseWriter, r *http.Request) {
@@ -226,7 +165,7 @@ func (h *TietoevryTestResultHandler) GetTestResults(w http.ResponseWriter, r *ht
 		return
 	}
 
-	if err := utils.ValidateParams(r, []string{"user_id"}); err != nil {
+	if err := utils.ValidateParams(r, listParams); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
@@ -240,9 +179,22 @@ func (h *TietoevryTestResultHandler) GetTestResults(w http.ResponseWriter, r *ht
 		return
 	}
 
-	cacheKey := fmt.Sprintf("tietoevry:test-results:%s", params.UserID)
+	filter, err := parseReadFilter(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	page, err := utils.ParsePage(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	cacheKey := fmt.Sprintf("tietoevry:test-results:%s%s%s", params.UserID, readFilterCacheKey(filter), page.CacheKey())
 	if h.cache != nil {
 		if cached, err := h.cache.Get(r.Context(), cacheKey); err == nil && cached != "" {
+			utils.SetNextLink(w, r, utils.CachedNextCursor(cached))
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(cached))
 			return
 		}
@@ -254,22 +206,24 @@ func (h *TietoevryTestResultHandler) GetTestResults(w http.ResponseWriter, r *ht
 		return
 	}
 
-	testResults, err := h.store.GetTestResultsByUser(r.Context(), userID)
+	testResults, next, err := h.store.GetTestResultsByUser(r.Context(), userID, filter, page)
 	if err != nil {
-		utils.InternalServerError(w, r, err)
+		utils.HandleDatabaseError(w, r, err)
 		return
 	}
 
 	if len(testResults) == 0 {
-		utils.WriteJSON(w, http.StatusOK, map[string]any{
-			"test_results": []swagger.TietoevryTestResultInput{},
-		})
+		resp := map[string]any{
+			"test_results": []apitypes.TietoevryTestResultInput{},
+		}
+		utils.AddPageInfo(resp, page, "")
+		utils.WriteJSON(w, http.StatusOK, resp)
 		return
 	}
 
-	var output []swagger.TietoevryTestResultInput
+	var output []apitypes.TietoevryTestResultInput
 	for _, testResult := range testResults {
-		out := swagger.TietoevryTestResultInput{
+		out := apitypes.TietoevryTestResultInput{
 			ID:                          testResult.ID.String(),
 			UserID:                      testResult.UserID.String(),
 			TypeID:                      testResult.TypeID.String(),
@@ -293,6 +247,8 @@ func (h *TietoevryTestResultHandler) GetTestResults(w http.ResponseWriter, r *ht
 	}
 
 	resp := map[string]any{"test_results": output}
+	utils.AddPageInfo(resp, page, next)
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, resp, TietoevryCacheTTL)
+	utils.SetNextLink(w, r, next)
 	utils.WriteJSON(w, http.StatusOK, resp)
 }
@@ -6,12 +6,12 @@ import (
 	"net/http"
 	"time"
 
-	"github.com/DeRuina/KUHA-REST-API/docs/swagger"
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
 	tietoevrysqlc "github.com/DeRuina/KUHA-REST-API/internal/db/tietoevry"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/tietoevry"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 // handler struct
@@ -24,21 +24,6 @@ func NewTietoevryUserHandler(store tietoevry.Users, cache *cache.Storage) *Tieto
 	return &TietoevryUserHandler{store: store, cache: cache}
 }
 
-// request model
-type TietoevryUserUpsertInput struct {
-	ID                        string   `json:"id" validate:"required,uuid4"`
-	SporttiID                 int32    `json:"sportti_id" validate:"required"`
-	ProfileGender             *string  `json:"profile_gender"`
-	ProfileBirthdate          *string  `json:"profile_birthdate"`
-	ProfileWeight             *float64 `json:"profile_weight"`
-	ProfileHeight             *float64 `json:"profile_height"`
-	ProfileRestingHeartRate   *int32   `json:"profile_resting_heart_rate"`
-	ProfileMaximumHeartRate   *int32   `json:"profile_maximum_heart_rate"`
-	ProfileAerobicThreshold   *int32   `json:"profile_aerobic_threshold"`
-	ProfileAnaerobicThreshold *int32   `json:"profile_anaerobic_threshold"`
-	ProfileVo2max             *int32   `json:"profile_vo2max"`
-}
-
 type TietoevryUserDeleteParams struct {
 	ID string `form:"id" validate:"required,uuid4"`
 }
@@ -50,13 +35,13 @@ type TietoevryUserDeleteParams struct {
 //	@Tags			Tietoevry - User
 //	@Accept			json
 //	@Produce		json
-//	@Param			user	body	swagger.TietoevryUserUpsertInput	true	"User data"
+//	@Param			user	body	apitypes.TietoevryUserUpsertInput	true	"User data"
 //	@Success		201		"created"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/tietoevry/users [post]
 func (h *TietoevryUserHandler) UpsertUser(w http.ResponseWriter, r *http.Request) {
@@ -65,7 +50,7 @@ func (h *TietoevryUserHandler) UpsertUser(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	var input TietoevryUserUpsertInput
+	var input apitypes.TietoevryUserUpsertInput
 	if err := utils.ReadJSON(w, r, &input); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -118,13 +103,13 @@ func (h *TietoevryUserHandler) UpsertUser(w http.ResponseWriter, r *http.Request
 //	@Accept			json
 //	@Produce		json
 //	@Param			id	query	string	true	"User ID (UUID)"
-//	@Success		200 "User deleted successfully"
-//	@Success		204 "No content, user not found"
-//	@Failure		400	{object}	swagger.ValidationErrorResponse
-//	@Failure		401	{object}	swagger.UnauthorizedResponse
-//	@Failure		403	{object}	swagger.ForbiddenResponse
-//	@Failure		500	{object}	swagger.InternalServerErrorResponse
-//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Success		200	"User deleted successfully"
+//	@Success		204	"No content, user not found"
+//	@Failure		400	{object}	apitypes.ValidationErrorResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		403	{object}	apitypes.ForbiddenResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/tietoevry/users [delete]
 func (h *TietoevryUserHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
@@ -176,13 +161,13 @@ func (h *TietoevryUserHandler) DeleteUser(w http.ResponseWriter, r *http.Request
 //	@Accept			json
 //	@Produce		json
 //	@Param			id	query		string	true	"User ID (UUID)"
-//	@Success		200	{object}	swagger.TietoevryUserResponse
-//	@Failure		400	{object}	swagger.ValidationErrorResponse
-//	@Failure		401	{object}	swagger.UnauthorizedResponse
-//	@Failure		403	{object}	swagger.ForbiddenResponse
-//	@Failure		404	{object}	swagger.NotFoundResponse
-//	@Failure		500	{object}	swagger.InternalServerErrorResponse
-//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Success		200	{object}	apitypes.TietoevryUserResponse
+//	@Failure		400	{object}	apitypes.ValidationErrorResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		403	{object}	apitypes.ForbiddenResponse
+//	@Failure		404	{object}	apitypes.NotFoundResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/tietoevry/users [get]
 func (h *TietoevryUserHandler) GetUser(w http.ResponseWriter, r *http.Request) {
@@ -221,7 +206,7 @@ func (h *TietoevryUserHandler) GetUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	resp := swagger.TietoevryUserUpsertInput{
+	resp := apitypes.TietoevryUserUpsertInput{
 		ID:                        user.ID.String(),
 		SporttiID:                 user.SporttiID,
 		ProfileGender:             utils.StringPtrOrNil(user.ProfileGender),
@@ -235,9 +220,7 @@ func (h *TietoevryUserHandler) GetUser(w http.ResponseWriter, r *http.Request) {
 		ProfileVo2max:             utils.Int32PtrOrNil(user.ProfileVo2max),
 	}
 
-	utils.WriteJSON(w, http.StatusOK, map[string]any{
-		"user": resp,
-	})
+	utils.WriteJSON(w, http.StatusOK, apitypes.TietoevryUserResponse{User: resp})
 
 }
 
@@ -247,11 +230,11 @@ func (h *TietoevryUserHandler) GetUser(w http.ResponseWriter, r *http.Request) {
 //	@Description	Returns a list of deleted users with timestamps
 //	@Tags			Tietoevry - User
 //	@Produce		json
-//	@Success		200	{object}	swagger.TietoevryDeletedUsersResponse
-//	@Failure		401 {object}	swagger.UnauthorizedResponse
-//	@Failure		403	{object}	swagger.ForbiddenResponse
-//	@Failure		500	{object}	swagger.InternalServerErrorResponse
-//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Success		200	{object}	apitypes.TietoevryDeletedUsersResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		403	{object}	apitypes.ForbiddenResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/tietoevry/deleted-users [get]
 func (h *TietoevryUserHandler) GetDeletedUsers(w http.ResponseWriter, r *http.Request) {
@@ -266,16 +249,14 @@ func (h *TietoevryUserHandler) GetDeletedUsers(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	var output []swagger.TietoevryDeletedUser
+	var resp apitypes.TietoevryDeletedUsersResponse
 	for _, d := range data {
-		output = append(output, swagger.TietoevryDeletedUser{
+		resp.DeletedUsers = append(resp.DeletedUsers, apitypes.TietoevryDeletedUser{
 			UserID:    d.UserID.String(),
 			SporttiID: d.SporttiID,
 			DeletedAt: d.DeletedAt.Format(time.RFC3339),
 		})
 	}
 
-	utils.WriteJSON(w, http.StatusOK, map[string]any{
-		"deleted_users": output,
-	})
+	utils.WriteJSON(w, http.StatusOK, resp)
 }
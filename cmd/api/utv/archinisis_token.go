@@ -1,7 +1,6 @@
 package utvapi
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -9,6 +8,7 @@ import (
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/utv"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 type ArchinisisTokenHandler struct {
@@ -32,12 +32,12 @@ type ArchinisisStatusParams struct {
 //	@Accept			json
 //	@Produce		json
 //	@Param			user_id	query		string	true	"User ID (UUID)"
-//	@Success		200		{object}	swagger.ArchinisisStatusResponse
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Success		200		{object}	apitypes.ArchinisisStatusResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/archinisis/status [get]
 func (h *ArchinisisTokenHandler) GetStatus(w http.ResponseWriter, r *http.Request) {
@@ -72,14 +72,7 @@ func (h *ArchinisisTokenHandler) GetStatus(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	utils.WriteJSON(w, http.StatusOK, map[string]bool{
-		"connected": connected,
-	})
-}
-
-type ArchinisisTokenInput struct {
-	UserID  string          `json:"user_id" validate:"required,uuid4"`
-	Details json.RawMessage `json:"details" validate:"required"`
+	utils.WriteJSON(w, http.StatusOK, apitypes.ArchinisisStatusResponse{Connected: connected})
 }
 
 // UpsertToken godoc
@@ -89,13 +82,13 @@ type ArchinisisTokenInput struct {
 //	@Tags			UTV - Archinisis
 //	@Accept			json
 //	@Produce		json
-//	@Param			body	body	swagger.ArchinisisTokenInput	true	"Archinisis token input"
+//	@Param			body	body	apitypes.ArchinisisTokenInput	true	"Archinisis token input"
 //	@Success		201		"Created"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/archinisis/token [post]
 func (h *ArchinisisTokenHandler) UpsertToken(w http.ResponseWriter, r *http.Request) {
@@ -104,7 +97,7 @@ func (h *ArchinisisTokenHandler) UpsertToken(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	var input ArchinisisTokenInput
+	var input apitypes.ArchinisisTokenInput
 	if err := utils.ReadJSON(w, r, &input); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -136,12 +129,12 @@ func (h *ArchinisisTokenHandler) UpsertToken(w http.ResponseWriter, r *http.Requ
 //	@Tags			UTV - Archinisis
 //	@Accept			json
 //	@Produce		json
-//	@Success		200	{object}	swagger.SportIDsResponse
-//	@Failure		400	{object}	swagger.ValidationErrorResponse
-//	@Failure		401	{object}	swagger.UnauthorizedResponse
-//	@Failure		403	{object}	swagger.ForbiddenResponse
-//	@Failure		500	{object}	swagger.InternalServerErrorResponse
-//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Success		200	{object}	apitypes.SportIDsResponse
+//	@Failure		400	{object}	apitypes.ValidationErrorResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		403	{object}	apitypes.ForbiddenResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/archinisis/sport_ids [get]
 func (h *ArchinisisTokenHandler) GetSportIDs(w http.ResponseWriter, r *http.Request) {
@@ -154,5 +147,5 @@ func (h *ArchinisisTokenHandler) GetSportIDs(w http.ResponseWriter, r *http.Requ
 		utils.InternalServerError(w, r, err)
 		return
 	}
-	utils.WriteJSON(w, http.StatusOK, map[string][]string{"sportti_ids": ids})
+	utils.WriteJSON(w, http.StatusOK, apitypes.SportIDsResponse{SporttiIDs: ids})
 }
@@ -7,9 +7,11 @@ import (
 	"time"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/utv"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 type CoachtechDataHandler struct {
@@ -33,12 +35,12 @@ type CoachtechStatusParams struct {
 //	@Accept			json
 //	@Produce		json
 //	@Param			user_id	query		string	true	"User ID (UUID)"
-//	@Success		200		{object}	swagger.CoachtechStatusResponse
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Success		200		{object}	apitypes.CoachtechStatusResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/coachtech/status [get]
 func (h *CoachtechDataHandler) GetStatus(w http.ResponseWriter, r *http.Request) {
@@ -73,7 +75,7 @@ func (h *CoachtechDataHandler) GetStatus(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	utils.WriteJSON(w, http.StatusOK, map[string]bool{"data": status})
+	utils.WriteJSON(w, http.StatusOK, apitypes.CoachtechStatusResponse{Data: status})
 }
 
 type CoachtechDataParams struct {
@@ -94,11 +96,11 @@ type CoachtechDataParams struct {
 //	@Param			before_date	query	string	false	"Filter data before this date (YYYY-MM-DD)"
 //	@Success		200
 //	@Success		204	"No Content"
-//	@Failure		400	{object}	swagger.ValidationErrorResponse
-//	@Failure		401	{object}	swagger.UnauthorizedResponse
-//	@Failure		403	{object}	swagger.ForbiddenResponse
-//	@Failure		500	{object}	swagger.InternalServerErrorResponse
-//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400	{object}	apitypes.ValidationErrorResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		403	{object}	apitypes.ForbiddenResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/coachtech/data [get]
 func (h *CoachtechDataHandler) GetData(w http.ResponseWriter, r *http.Request) {
@@ -173,14 +175,6 @@ func (h *CoachtechDataHandler) GetData(w http.ResponseWriter, r *http.Request) {
 	utils.WriteJSON(w, http.StatusOK, data)
 }
 
-type CoachtechInsertInput struct {
-	UserID      string          `json:"user_id" validate:"required,uuid4"`
-	CoachtechID int32           `json:"coachtech_id" validate:"required"`
-	SummaryDate string          `json:"summary_date" validate:"required,datetime=2006-01-02"`
-	TestID      string          `json:"test_id" validate:"required"`
-	Data        json.RawMessage `json:"data" validate:"required"`
-}
-
 // InsertCoachtechData godoc
 //
 //	@Summary		Insert Coachtech data
@@ -188,13 +182,13 @@ type CoachtechInsertInput struct {
 //	@Tags			UTV - Coachtech
 //	@Accept			json
 //	@Produce		json
-//	@Param			body	body	swagger.CoachtechInsertInput	true	"Coachtech data input"
+//	@Param			body	body	apitypes.CoachtechInsertInput	true	"Coachtech data input"
 //	@Success		201		"Created"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/coachtech/insert [post]
 func (h *CoachtechDataHandler) Insert(w http.ResponseWriter, r *http.Request) {
@@ -203,7 +197,7 @@ func (h *CoachtechDataHandler) Insert(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var input CoachtechInsertInput
+	var input apitypes.CoachtechInsertInput
 	if err := utils.ReadJSON(w, r, &input); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -237,6 +231,7 @@ func (h *CoachtechDataHandler) Insert(w http.ResponseWriter, r *http.Request) {
 	}
 
 	invalidateUTVCoachtech(r.Context(), h.cache, userID)
+	events.Publish(events.UTVDataInserted, events.UTVData{UserID: userID.String(), Device: "coachtech", Date: input.SummaryDate})
 
 	w.WriteHeader(http.StatusCreated)
 }
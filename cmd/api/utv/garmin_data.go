@@ -6,9 +6,11 @@ import (
 	"net/http"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/utv"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 // Validation structs
@@ -29,12 +31,6 @@ type GarminGetDataParams struct {
 	Key    string `form:"key" validate:"omitempty,key"`
 }
 
-type GarminPostDataInput struct {
-	UserID string          `json:"user_id" validate:"required,uuid4"`
-	Date   string          `json:"date" validate:"required,datetime=2006-01-02"`
-	Data   json.RawMessage `json:"data" validate:"required"`
-}
-
 type DeleteAllGarminParams struct {
 	UserID string `validate:"required,uuid4" json:"user_id"`
 }
@@ -60,14 +56,14 @@ func NewGarminDataHandler(store utv.GarminData, cache *cache.Storage) *GarminDat
 //	@Param			user_id		query		string					true	"User ID (UUID)"
 //	@Param			after_date	query		string					false	"Filter dates after this date (YYYY-MM-DD)"
 //	@Param			before_date	query		string					false	"Filter dates before this date (YYYY-MM-DD)"
-//	@Success		200			{object}	swagger.DatesResponse	"List of available dates"
+//	@Success		200			{object}	apitypes.DatesResponse	"List of available dates"
 //	@Success		204			"No Content: No available dates found"
-//	@Failure		400			{object}	swagger.ValidationErrorResponse
-//	@Failure		401			{object}	swagger.UnauthorizedResponse
-//	@Failure		403			{object}	swagger.ForbiddenResponse
-//	@Failure		422			{object}	swagger.InvalidDateRange
-//	@Failure		500			{object}	swagger.InternalServerErrorResponse
-//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400			{object}	apitypes.ValidationErrorResponse
+//	@Failure		401			{object}	apitypes.UnauthorizedResponse
+//	@Failure		403			{object}	apitypes.ForbiddenResponse
+//	@Failure		422			{object}	apitypes.InvalidDateRange
+//	@Failure		500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/garmin/dates [get]
 func (h *GarminDataHandler) GetDates(w http.ResponseWriter, r *http.Request) {
@@ -135,15 +131,15 @@ func (h *GarminDataHandler) GetDates(w http.ResponseWriter, r *http.Request) {
 // @Tags			UTV - Garmin
 // @Accept			json
 // @Produce		json
-// @Param			user_id	query		string						true	"User ID (UUID)"
-// @Param			date	query		string						true	"Date (YYYY-MM-DD)"
-// @Success		200		{object}	swagger.GarminTypesResponse	"List of available types"
+// @Param			user_id	query		string							true	"User ID (UUID)"
+// @Param			date	query		string							true	"Date (YYYY-MM-DD)"
+// @Success		200		{object}	apitypes.GarminTypesResponse	"List of available types"
 // @Success		204		"No Content: No available types found"
-// @Failure		400		{object}	swagger.ValidationErrorResponse
-// @Failure		401		{object}	swagger.UnauthorizedResponse
-// @Failure		403		{object}	swagger.ForbiddenResponse
-// @Failure		500		{object}	swagger.InternalServerErrorResponse
-// @Failure		503		{object}	swagger.ServiceUnavailableResponse
+// @Failure		400		{object}	apitypes.ValidationErrorResponse
+// @Failure		401		{object}	apitypes.UnauthorizedResponse
+// @Failure		403		{object}	apitypes.ForbiddenResponse
+// @Failure		500		{object}	apitypes.InternalServerErrorResponse
+// @Failure		503		{object}	apitypes.ServiceUnavailableResponse
 // @Security		BearerAuth
 // @Router			/utv/garmin/types [get]
 func (h *GarminDataHandler) GetTypes(w http.ResponseWriter, r *http.Request) {
@@ -208,13 +204,13 @@ func (h *GarminDataHandler) GetTypes(w http.ResponseWriter, r *http.Request) {
 // @Param			user_id	query		string						true	"User ID (UUID)"
 // @Param			date	query		string						true	"Date (YYYY-MM-DD)"
 // @Param			key		query		string						false	"Type"
-// @Success		200		{object}	swagger.GarminDataResponse	"Data"
+// @Success		200		{object}	apitypes.GarminDataResponse	"Data"
 // @Success		204		"No Content: No data found"
-// @Failure		400		{object}	swagger.ValidationErrorResponse
-// @Failure		401		{object}	swagger.UnauthorizedResponse
-// @Failure		403		{object}	swagger.ForbiddenResponse
-// @Failure		500		{object}	swagger.InternalServerErrorResponse
-// @Failure		503		{object}	swagger.ServiceUnavailableResponse
+// @Failure		400		{object}	apitypes.ValidationErrorResponse
+// @Failure		401		{object}	apitypes.UnauthorizedResponse
+// @Failure		403		{object}	apitypes.ForbiddenResponse
+// @Failure		500		{object}	apitypes.InternalServerErrorResponse
+// @Failure		503		{object}	apitypes.ServiceUnavailableResponse
 // @Security		BearerAuth
 // @Router			/utv/garmin/data [get]
 func (h *GarminDataHandler) GetData(w http.ResponseWriter, r *http.Request) {
@@ -281,13 +277,13 @@ func (h *GarminDataHandler) GetData(w http.ResponseWriter, r *http.Request) {
 //	@Tags			UTV - Garmin
 //	@Accept			json
 //	@Produce		json
-//	@Param			body	body	swagger.GarminPostDataInput	true	"Garmin data input"
+//	@Param			body	body	apitypes.GarminPostDataInput	true	"Garmin data input"
 //	@Success		201		"Created: Data successfully stored (no content in response body)"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/garmin/data [post]
 func (h *GarminDataHandler) InsertData(w http.ResponseWriter, r *http.Request) {
@@ -296,7 +292,7 @@ func (h *GarminDataHandler) InsertData(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var input GarminPostDataInput
+	var input apitypes.GarminPostDataInput
 	if err := utils.ReadJSON(w, r, &input); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -326,27 +322,28 @@ func (h *GarminDataHandler) InsertData(w http.ResponseWriter, r *http.Request) {
 	}
 
 	invalidateUTVSource(r.Context(), h.cache, userID, "garmin")
+	events.Publish(events.UTVDataInserted, events.UTVData{UserID: userID.String(), Device: "garmin", Date: input.Date})
 
 	w.WriteHeader(http.StatusCreated)
 }
 
 // DeleteAllDataGarmin godoc
 //
-// @Summary		Delete all Garmin data for a user
-// @Description	Deletes all Garmin data entries for a specific user
-// @Tags			UTV - Garmin
-// @Accept			json
-// @Produce		json
-// @Param			user_id	query	string	true	"User ID (UUID)"
-// @Success		200		"Deleted: Data successfully removed"
-// @Success		204		"No Content: No matching data"
-// @Failure		400		{object} swagger.ValidationErrorResponse
-// @Failure		401		{object} swagger.UnauthorizedResponse
-// @Failure		403		{object} swagger.ForbiddenResponse
-// @Failure		500		{object} swagger.InternalServerErrorResponse
-// @Failure		503		{object} swagger.ServiceUnavailableResponse
-// @Security		BearerAuth
-// @Router			/utv/garmin/data [delete]
+//	@Summary		Delete all Garmin data for a user
+//	@Description	Deletes all Garmin data entries for a specific user
+//	@Tags			UTV - Garmin
+//	@Accept			json
+//	@Produce		json
+//	@Param			user_id	query	string	true	"User ID (UUID)"
+//	@Success		200		"Deleted: Data successfully removed"
+//	@Success		204		"No Content: No matching data"
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/utv/garmin/data [delete]
 func (h *GarminDataHandler) DeleteAllData(w http.ResponseWriter, r *http.Request) {
 	if !authz.Authorize(r) {
 		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
@@ -1,7 +1,6 @@
 package utvapi
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -9,6 +8,7 @@ import (
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/utv"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 type GarminTokenHandler struct {
@@ -32,12 +32,12 @@ type GarminStatusParams struct {
 //	@Accept			json
 //	@Produce		json
 //	@Param			user_id	query		string	true	"User ID (UUID)"
-//	@Success		200		{object}	swagger.GarminStatusResponse
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Success		200		{object}	apitypes.GarminStatusResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/garmin/status [get]
 func (h *GarminTokenHandler) GetStatus(w http.ResponseWriter, r *http.Request) {
@@ -72,15 +72,7 @@ func (h *GarminTokenHandler) GetStatus(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	utils.WriteJSON(w, http.StatusOK, map[string]bool{
-		"connected": connected,
-		"data":      dataExists,
-	})
-}
-
-type GarminTokenInput struct {
-	UserID  string          `json:"user_id" validate:"required,uuid4"`
-	Details json.RawMessage `json:"details" validate:"required"`
+	utils.WriteJSON(w, http.StatusOK, apitypes.GarminStatusResponse{Connected: connected, Data: dataExists})
 }
 
 // UpsertToken godoc
@@ -90,13 +82,13 @@ type GarminTokenInput struct {
 //	@Tags			UTV - Garmin
 //	@Accept			json
 //	@Produce		json
-//	@Param			body	body	swagger.GarminTokenInput	true	"Garmin token input"
+//	@Param			body	body	apitypes.GarminTokenInput	true	"Garmin token input"
 //	@Success		201		"Created"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/garmin/token [post]
 func (h *GarminTokenHandler) UpsertToken(w http.ResponseWriter, r *http.Request) {
@@ -105,7 +97,7 @@ func (h *GarminTokenHandler) UpsertToken(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	var input GarminTokenInput
+	var input apitypes.GarminTokenInput
 	if err := utils.ReadJSON(w, r, &input); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -142,12 +134,12 @@ type GarminTokenByAccessTokenParams struct {
 //	@Accept			json
 //	@Produce		json
 //	@Param			token	query		string	true	"Garmin access token"
-//	@Success		200		{object}	swagger.GarminUserIDResponse
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Success		200		{object}	apitypes.GarminUserIDResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/garmin/user-id-by-token [get]
 func (h *GarminTokenHandler) GetUserIDByToken(w http.ResponseWriter, r *http.Request) {
@@ -176,9 +168,7 @@ func (h *GarminTokenHandler) GetUserIDByToken(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	utils.WriteJSON(w, http.StatusOK, map[string]any{
-		"user_id": userID,
-	})
+	utils.WriteJSON(w, http.StatusOK, apitypes.GarminUserIDResponse{UserID: userID.String()})
 }
 
 // TokenExists godoc
@@ -189,12 +179,12 @@ func (h *GarminTokenHandler) GetUserIDByToken(w http.ResponseWriter, r *http.Req
 //	@Accept			json
 //	@Produce		json
 //	@Param			token	query		string	true	"Garmin access token"
-//	@Success		200		{object}	swagger.GarminTokenExistsResponse
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Success		200		{object}	apitypes.GarminTokenExistsResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/garmin/token-exists [get]
 func (h *GarminTokenHandler) TokenExists(w http.ResponseWriter, r *http.Request) {
@@ -223,7 +213,5 @@ func (h *GarminTokenHandler) TokenExists(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	utils.WriteJSON(w, http.StatusOK, map[string]bool{
-		"exists": exists,
-	})
+	utils.WriteJSON(w, http.StatusOK, apitypes.GarminTokenExistsResponse{Exists: exists})
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/utv"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 	"github.com/google/uuid"
 )
 
@@ -48,13 +49,6 @@ type GeneralDataHandler struct {
 	cache           *cache.Storage
 }
 
-// response structs
-type LatestDataResponse struct {
-	Device string          `json:"device"`
-	Date   string          `json:"date"`
-	Data   json.RawMessage `json:"data"`
-}
-
 // NewGeneralDataHandler initializes the handler
 func NewGeneralDataHandler(
 	oura utv.OuraData,
@@ -95,13 +89,13 @@ func NewGeneralDataHandler(
 //	@Param			type	query	string						true	"Data type (e.g., 'sleep', 'activity')"
 //	@Param			device	query	string						false	"Device type (one of: 'garmin', 'oura', 'polar', 'suunto')"
 //	@Param			limit	query	int							false	"Limit the number of results (default: 1, max: 100)"
-//	@Success		200		{array}	swagger.LatestDataResponse	"Latest Data"
+//	@Success		200		{array}	apitypes.LatestDataResponse	"Latest Data"
 //	@Success		204		"No Content: No data found"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/latest [get]
 func (h *GeneralDataHandler) GetLatestData(w http.ResponseWriter, r *http.Request) {
@@ -153,7 +147,7 @@ func (h *GeneralDataHandler) GetLatestData(w http.ResponseWriter, r *http.Reques
 		}
 	}
 
-	var results []LatestDataResponse
+	var results []apitypes.LatestDataResponse
 
 	// Helper to fetch from one device
 	fetch := func(name string, store interface {
@@ -164,7 +158,7 @@ func (h *GeneralDataHandler) GetLatestData(w http.ResponseWriter, r *http.Reques
 			return // silently ignore errors per device
 		}
 		for _, row := range data {
-			results = append(results, LatestDataResponse{
+			results = append(results, apitypes.LatestDataResponse{
 				Device: name,
 				Date:   row.Date.Format("2006-01-02"),
 				Data:   row.Data,
@@ -213,14 +207,14 @@ func (h *GeneralDataHandler) GetLatestData(w http.ResponseWriter, r *http.Reques
 //	@Param			limit		query	int							false	"Limit the number of results returned (default: 3, max: 10)"
 //	@Param			offset		query	int							false	"Offset for pagination (default: 0)"
 //
-//	@Success		200			{array}	swagger.LatestDataResponse	"List of data entries across devices"
+//	@Success		200			{array}	apitypes.LatestDataResponse	"List of data entries across devices"
 //	@Success		204			"No Content: No data available"
-//	@Failure		400			{object}	swagger.ValidationErrorResponse
-//	@Failure		401			{object}	swagger.UnauthorizedResponse
-//	@Failure		403			{object}	swagger.ForbiddenResponse
-//	@Failure		422			{object}	swagger.InvalidDateRange
-//	@Failure		500			{object}	swagger.InternalServerErrorResponse
-//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400			{object}	apitypes.ValidationErrorResponse
+//	@Failure		401			{object}	apitypes.UnauthorizedResponse
+//	@Failure		403			{object}	apitypes.ForbiddenResponse
+//	@Failure		422			{object}	apitypes.InvalidDateRange
+//	@Failure		500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/all [get]
 func (h *GeneralDataHandler) GetAllByType(w http.ResponseWriter, r *http.Request) {
@@ -302,7 +296,7 @@ func (h *GeneralDataHandler) GetAllByType(w http.ResponseWriter, r *http.Request
 		}
 	}
 
-	var results []LatestDataResponse
+	var results []apitypes.LatestDataResponse
 
 	// Helper to query one device with pagination
 	fetch := func(name string, store interface {
@@ -313,7 +307,7 @@ func (h *GeneralDataHandler) GetAllByType(w http.ResponseWriter, r *http.Request
 			return
 		}
 		for _, row := range data {
-			results = append(results, LatestDataResponse{
+			results = append(results, apitypes.LatestDataResponse{
 				Device: name,
 				Date:   row.Date.Format("2006-01-02"),
 				Data:   row.Data,
@@ -352,11 +346,11 @@ type DisconnectParams struct {
 //	@Param			user_id	query	string	true	"User ID (UUID)"
 //	@Param			source	query	string	true	"Source device to disconnect (one of: 'polar', 'oura', 'suunto', 'garmin', 'klab', 'archinisis')"
 //	@Success		200		"Successfully disconnected"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/disconnect [delete]
 func (h *GeneralDataHandler) Disconnect(w http.ResponseWriter, r *http.Request) {
@@ -416,11 +410,6 @@ type TokensForUpdateParams struct {
 	Hours  int    `form:"hours" validate:"required,min=1,max=8760"` // up to 1 year
 }
 
-type UserDataResponse struct {
-	UserID string      `json:"user_id"`
-	Data   interface{} `json:"data"`
-}
-
 // GetTokensForUpdate godoc
 //
 //	@Summary		Get tokens for update
@@ -428,15 +417,15 @@ type UserDataResponse struct {
 //	@Tags			UTV - General
 //	@Accept			json
 //	@Produce		json
-//	@Param			source	query	string					true	"Source device (one of: 'polar', 'oura', 'suunto', 'garmin')"
-//	@Param			hours	query	int						true	"Number of hours to look back (1-8760)"
-//	@Success		200		{array}	swagger.PolarTokenInput	"List of tokens needing update"
+//	@Param			source	query	string							true	"Source device (one of: 'polar', 'oura', 'suunto', 'garmin')"
+//	@Param			hours	query	int								true	"Number of hours to look back (1-8760)"
+//	@Success		200		{array}	apitypes.SourceUserDataResponse	"List of tokens needing update"
 //	@Success		204		"No Content: No tokens found"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/tokens4update [get]
 func (h *GeneralDataHandler) GetTokensForUpdate(w http.ResponseWriter, r *http.Request) {
@@ -481,9 +470,9 @@ func (h *GeneralDataHandler) GetTokensForUpdate(w http.ResponseWriter, r *http.R
 			w.WriteHeader(http.StatusNoContent)
 			return
 		}
-		resp := make([]UserDataResponse, 0, len(tokens))
+		resp := make([]apitypes.SourceUserDataResponse, 0, len(tokens))
 		for _, t := range tokens {
-			resp = append(resp, UserDataResponse{UserID: t.UserID.String(), Data: t.Data})
+			resp = append(resp, apitypes.SourceUserDataResponse{UserID: t.UserID.String(), Data: t.Data})
 		}
 		utils.WriteJSON(w, http.StatusOK, resp)
 
@@ -497,9 +486,9 @@ func (h *GeneralDataHandler) GetTokensForUpdate(w http.ResponseWriter, r *http.R
 			w.WriteHeader(http.StatusNoContent)
 			return
 		}
-		resp := make([]UserDataResponse, 0, len(tokens))
+		resp := make([]apitypes.SourceUserDataResponse, 0, len(tokens))
 		for _, t := range tokens {
-			resp = append(resp, UserDataResponse{UserID: t.UserID.String(), Data: t.Data})
+			resp = append(resp, apitypes.SourceUserDataResponse{UserID: t.UserID.String(), Data: t.Data})
 		}
 		utils.WriteJSON(w, http.StatusOK, resp)
 
@@ -513,9 +502,9 @@ func (h *GeneralDataHandler) GetTokensForUpdate(w http.ResponseWriter, r *http.R
 			w.WriteHeader(http.StatusNoContent)
 			return
 		}
-		resp := make([]UserDataResponse, 0, len(tokens))
+		resp := make([]apitypes.SourceUserDataResponse, 0, len(tokens))
 		for _, t := range tokens {
-			resp = append(resp, UserDataResponse{UserID: t.UserID.String(), Data: t.Data})
+			resp = append(resp, apitypes.SourceUserDataResponse{UserID: t.UserID.String(), Data: t.Data})
 		}
 		utils.WriteJSON(w, http.StatusOK, resp)
 
@@ -529,9 +518,9 @@ func (h *GeneralDataHandler) GetTokensForUpdate(w http.ResponseWriter, r *http.R
 			w.WriteHeader(http.StatusNoContent)
 			return
 		}
-		resp := make([]UserDataResponse, 0, len(tokens))
+		resp := make([]apitypes.SourceUserDataResponse, 0, len(tokens))
 		for _, t := range tokens {
-			resp = append(resp, UserDataResponse{UserID: t.UserID.String(), Data: t.Data})
+			resp = append(resp, apitypes.SourceUserDataResponse{UserID: t.UserID.String(), Data: t.Data})
 		}
 		utils.WriteJSON(w, http.StatusOK, resp)
 
@@ -552,15 +541,15 @@ type DataForUpdateParams struct {
 //	@Tags			UTV - General
 //	@Accept			json
 //	@Produce		json
-//	@Param			source	query	string					true	"Source (one of: 'polar', 'oura', 'suunto', 'garmin')"
-//	@Param			hours	query	int						true	"Number of hours to look back (1-8760)"
-//	@Success		200		{array}	swagger.PolarTokenInput	"List of tokens needing data update"
+//	@Param			source	query	string							true	"Source (one of: 'polar', 'oura', 'suunto', 'garmin')"
+//	@Param			hours	query	int								true	"Number of hours to look back (1-8760)"
+//	@Success		200		{array}	apitypes.SourceUserDataResponse	"List of tokens needing data update"
 //	@Success		204		"No Content: No data found"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/data4update [get]
 func (h *GeneralDataHandler) GetDataForUpdate(w http.ResponseWriter, r *http.Request) {
@@ -605,9 +594,9 @@ func (h *GeneralDataHandler) GetDataForUpdate(w http.ResponseWriter, r *http.Req
 			w.WriteHeader(http.StatusNoContent)
 			return
 		}
-		resp := make([]UserDataResponse, 0, len(data))
+		resp := make([]apitypes.SourceUserDataResponse, 0, len(data))
 		for _, t := range data {
-			resp = append(resp, UserDataResponse{UserID: t.UserID.String(), Data: t.Data})
+			resp = append(resp, apitypes.SourceUserDataResponse{UserID: t.UserID.String(), Data: t.Data})
 		}
 		utils.WriteJSON(w, http.StatusOK, resp)
 
@@ -621,9 +610,9 @@ func (h *GeneralDataHandler) GetDataForUpdate(w http.ResponseWriter, r *http.Req
 			w.WriteHeader(http.StatusNoContent)
 			return
 		}
-		resp := make([]UserDataResponse, 0, len(data))
+		resp := make([]apitypes.SourceUserDataResponse, 0, len(data))
 		for _, t := range data {
-			resp = append(resp, UserDataResponse{UserID: t.UserID.String(), Data: t.Data})
+			resp = append(resp, apitypes.SourceUserDataResponse{UserID: t.UserID.String(), Data: t.Data})
 		}
 		utils.WriteJSON(w, http.StatusOK, resp)
 
@@ -637,9 +626,9 @@ func (h *GeneralDataHandler) GetDataForUpdate(w http.ResponseWriter, r *http.Req
 			w.WriteHeader(http.StatusNoContent)
 			return
 		}
-		resp := make([]UserDataResponse, 0, len(data))
+		resp := make([]apitypes.SourceUserDataResponse, 0, len(data))
 		for _, t := range data {
-			resp = append(resp, UserDataResponse{UserID: t.UserID.String(), Data: t.Data})
+			resp = append(resp, apitypes.SourceUserDataResponse{UserID: t.UserID.String(), Data: t.Data})
 		}
 		utils.WriteJSON(w, http.StatusOK, resp)
 
@@ -653,9 +642,9 @@ func (h *GeneralDataHandler) GetDataForUpdate(w http.ResponseWriter, r *http.Req
 			w.WriteHeader(http.StatusNoContent)
 			return
 		}
-		resp := make([]UserDataResponse, 0, len(data))
+		resp := make([]apitypes.SourceUserDataResponse, 0, len(data))
 		for _, t := range data {
-			resp = append(resp, UserDataResponse{UserID: t.UserID.String(), Data: t.Data})
+			resp = append(resp, apitypes.SourceUserDataResponse{UserID: t.UserID.String(), Data: t.Data})
 		}
 		utils.WriteJSON(w, http.StatusOK, resp)
 
@@ -681,13 +670,13 @@ type TokenInput struct {
 //	@Produce		json
 //	@Param			user_id	query		string	true	"User ID (UUID)"
 //	@Param			source	query		string	true	"Source (one of: 'garmin', 'oura', 'polar', 'suunto')"
-//	@Success		200		{object}	swagger.GarminTokenResponse
+//	@Success		200		{object}	apitypes.GarminTokenResponse
 //	@Success		204		"No Content: Token not found"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/token [get]
 func (h *GeneralDataHandler) GetToken(w http.ResponseWriter, r *http.Request) {
@@ -741,9 +730,5 @@ func (h *GeneralDataHandler) GetToken(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	resp := map[string]json.RawMessage{
-		"token": token,
-	}
-
-	utils.WriteJSON(w, http.StatusOK, resp)
+	utils.WriteJSON(w, http.StatusOK, apitypes.SourceTokenResponse{Token: token})
 }
@@ -0,0 +1,122 @@
+package utvapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/utv"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/google/uuid"
+)
+
+// streamPage is how many entries a streamed read fetches per query
+const streamPage = 100
+
+// DeviceDataInput is one wearable entry of any device, checked like the body
+// of POST /utv/{device}/data
+type DeviceDataInput struct {
+	UserID string          `validate:"required,uuid4"`
+	Device string          `validate:"required,oneof=garmin oura polar suunto"`
+	Date   string          `validate:"required,datetime=2006-01-02"`
+	Data   json.RawMessage `validate:"required"`
+}
+
+// DeviceDataQuery selects the entries of a streamed read, checked like the
+// query parameters of GET /utv/all
+type DeviceDataQuery struct {
+	UserID     string `validate:"required,uuid4"`
+	Device     string `validate:"required,oneof=garmin oura polar suunto"`
+	Type       string `validate:"required,key"`
+	AfterDate  string `validate:"omitempty,datetime=2006-01-02"`
+	BeforeDate string `validate:"omitempty,datetime=2006-01-02"`
+}
+
+// deviceStore is the part of a device data store shared by every device
+type deviceStore interface {
+	InsertData(ctx context.Context, userID uuid.UUID, date time.Time, data json.RawMessage) error
+	GetAllByType(ctx context.Context, userID uuid.UUID, typ string, after, before *time.Time, limit, offset int32) ([]utv.LatestDataEntry, error)
+}
+
+// DeviceDataHandler reads and writes the entries of every device for
+// transports other than HTTP (the gRPC ingestion service)
+type DeviceDataHandler struct {
+	devices map[string]deviceStore
+	cache   *cache.Storage
+}
+
+// NewDeviceDataHandler initializes the handler
+func NewDeviceDataHandler(oura utv.OuraData, polar utv.PolarData, suunto utv.SuuntoData, garmin utv.GarminData, cache *cache.Storage) *DeviceDataHandler {
+	return &DeviceDataHandler{
+		devices: map[string]deviceStore{"oura": oura, "polar": polar, "suunto": suunto, "garmin": garmin},
+		cache:   cache,
+	}
+}
+
+// InsertDeviceData validates and stores one entry, then drops the cached
+// reads of its user and device
+func (h *DeviceDataHandler) InsertDeviceData(ctx context.Context, in DeviceDataInput) error {
+	if err := utils.GetValidator().Struct(in); err != nil {
+		return utils.RejectItem(utils.CodeValidationFailed, err)
+	}
+	if !json.Valid(in.Data) {
+		return utils.RejectItem(utils.CodeValidationFailed, errors.New("data must be valid JSON"))
+	}
+	userID, err := utils.ParseUUID(in.UserID)
+	if err != nil {
+		return utils.RejectItem(utils.CodeValidationFailed, err)
+	}
+	date, err := utils.ParseDate(in.Date)
+	if err != nil {
+		return utils.RejectItem(utils.CodeValidationFailed, err)
+	}
+
+	if err := h.devices[in.Device].InsertData(ctx, userID, date, in.Data); err != nil {
+		return err
+	}
+	invalidateUTVSource(ctx, h.cache, userID, in.Device)
+	events.Publish(events.UTVDataInserted, events.UTVData{UserID: userID.String(), Device: in.Device, Date: in.Date})
+	return nil
+}
+
+// StreamDeviceData calls fn with every entry matching q, newest first as
+// GET /utv/all orders them
+func (h *DeviceDataHandler) StreamDeviceData(ctx context.Context, q DeviceDataQuery, fn func(utv.LatestDataEntry) error) error {
+	if err := utils.GetValidator().Struct(q); err != nil {
+		return utils.RejectItem(utils.CodeValidationFailed, err)
+	}
+	userID, err := utils.ParseUUID(q.UserID)
+	if err != nil {
+		return utils.RejectItem(utils.CodeValidationFailed, err)
+	}
+	after, err := utils.ParseDatePtr(utils.NilIfEmpty(&q.AfterDate))
+	if err != nil {
+		return utils.RejectItem(utils.CodeValidationFailed, err)
+	}
+	before, err := utils.ParseDatePtr(utils.NilIfEmpty(&q.BeforeDate))
+	if err != nil {
+		return utils.RejectItem(utils.CodeValidationFailed, err)
+	}
+	if after != nil && before != nil && after.After(*before) {
+		return utils.RejectItem(utils.CodeUnprocessableEntity, utils.ErrInvalidDateRange)
+	}
+
+	store := h.devices[q.Device]
+	for offset := int32(0); ; offset += streamPage {
+		rows, err := store.GetAllByType(ctx, userID, q.Type, after, before, streamPage, offset)
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if err := fn(row); err != nil {
+				return err
+			}
+		}
+		if len(rows) < streamPage {
+			return nil
+		}
+	}
+}
@@ -6,9 +6,11 @@ import (
 	"net/http"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/utv"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 // Validation structs
@@ -29,12 +31,6 @@ type OuraGetDataParams struct {
 	Key    string `form:"key" validate:"omitempty,key"`
 }
 
-type OuraPostDataInput struct {
-	UserID string          `json:"user_id" validate:"required,uuid4"`
-	Date   string          `json:"date" validate:"required,datetime=2006-01-02"`
-	Data   json.RawMessage `json:"data" validate:"required"`
-}
-
 type OuraDeleteDataInput struct {
 	UserID string `json:"user_id" validate:"required,uuid4"`
 	Date   string `json:"date" validate:"required,datetime=2006-01-02"`
@@ -65,14 +61,14 @@ func NewOuraDataHandler(store utv.OuraData, cache *cache.Storage) *OuraDataHandl
 //	@Param			user_id		query		string					true	"User ID (UUID)"
 //	@Param			after_date	query		string					false	"Filter dates after this date (YYYY-MM-DD)"
 //	@Param			before_date	query		string					false	"Filter dates before this date (YYYY-MM-DD)"
-//	@Success		200			{object}	swagger.DatesResponse	"List of available dates"
+//	@Success		200			{object}	apitypes.DatesResponse	"List of available dates"
 //	@Success		204			"No Content: No available dates found"
-//	@Failure		400			{object}	swagger.ValidationErrorResponse
-//	@Failure		401			{object}	swagger.UnauthorizedResponse
-//	@Failure		403			{object}	swagger.ForbiddenResponse
-//	@Failure		422			{object}	swagger.InvalidDateRange
-//	@Failure		500			{object}	swagger.InternalServerErrorResponse
-//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400			{object}	apitypes.ValidationErrorResponse
+//	@Failure		401			{object}	apitypes.UnauthorizedResponse
+//	@Failure		403			{object}	apitypes.ForbiddenResponse
+//	@Failure		422			{object}	apitypes.InvalidDateRange
+//	@Failure		500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/oura/dates [get]
 func (h *OuraDataHandler) GetDates(w http.ResponseWriter, r *http.Request) {
@@ -142,13 +138,13 @@ func (h *OuraDataHandler) GetDates(w http.ResponseWriter, r *http.Request) {
 // @Produce		json
 // @Param			user_id	query		string						true	"User ID (UUID)"
 // @Param			date	query		string						true	"Date (YYYY-MM-DD)"
-// @Success		200		{object}	swagger.OuraTypesResponse	"List of available types"
+// @Success		200		{object}	apitypes.OuraTypesResponse	"List of available types"
 // @Success		204		"No Content: No available types found"
-// @Failure		400		{object}	swagger.ValidationErrorResponse
-// @Failure		401		{object}	swagger.UnauthorizedResponse
-// @Failure		403		{object}	swagger.ForbiddenResponse
-// @Failure		500		{object}	swagger.InternalServerErrorResponse
-// @Failure		503		{object}	swagger.ServiceUnavailableResponse
+// @Failure		400		{object}	apitypes.ValidationErrorResponse
+// @Failure		401		{object}	apitypes.UnauthorizedResponse
+// @Failure		403		{object}	apitypes.ForbiddenResponse
+// @Failure		500		{object}	apitypes.InternalServerErrorResponse
+// @Failure		503		{object}	apitypes.ServiceUnavailableResponse
 // @Security		BearerAuth
 // @Router			/utv/oura/types [get]
 func (h *OuraDataHandler) GetTypes(w http.ResponseWriter, r *http.Request) {
@@ -213,13 +209,13 @@ func (h *OuraDataHandler) GetTypes(w http.ResponseWriter, r *http.Request) {
 // @Param			user_id	query		string						true	"User ID (UUID)"
 // @Param			date	query		string						true	"Date (YYYY-MM-DD)"
 // @Param			key		query		string						false	"Type"
-// @Success		200		{object}	swagger.OuraDataResponse	"Data"
+// @Success		200		{object}	apitypes.OuraDataResponse	"Data"
 // @Success		204		"No Content: No data found"
-// @Failure		400		{object}	swagger.ValidationErrorResponse
-// @Failure		401		{object}	swagger.UnauthorizedResponse
-// @Failure		403		{object}	swagger.ForbiddenResponse
-// @Failure		500		{object}	swagger.InternalServerErrorResponse
-// @Failure		503		{object}	swagger.ServiceUnavailableResponse
+// @Failure		400		{object}	apitypes.ValidationErrorResponse
+// @Failure		401		{object}	apitypes.UnauthorizedResponse
+// @Failure		403		{object}	apitypes.ForbiddenResponse
+// @Failure		500		{object}	apitypes.InternalServerErrorResponse
+// @Failure		503		{object}	apitypes.ServiceUnavailableResponse
 // @Security		BearerAuth
 // @Router			/utv/oura/data [get]
 func (h *OuraDataHandler) GetData(w http.ResponseWriter, r *http.Request) {
@@ -286,13 +282,13 @@ func (h *OuraDataHandler) GetData(w http.ResponseWriter, r *http.Request) {
 //	@Tags			UTV - Oura
 //	@Accept			json
 //	@Produce		json
-//	@Param			body	body	swagger.OuraPostDataInput	true	"Oura data input"
+//	@Param			body	body	apitypes.OuraPostDataInput	true	"Oura data input"
 //	@Success		201		"Created: Data successfully stored (no content in response body)"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/oura/data [post]
 func (h *OuraDataHandler) InsertData(w http.ResponseWriter, r *http.Request) {
@@ -301,7 +297,7 @@ func (h *OuraDataHandler) InsertData(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var input OuraPostDataInput
+	var input apitypes.OuraPostDataInput
 	if err := utils.ReadJSON(w, r, &input); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -331,6 +327,7 @@ func (h *OuraDataHandler) InsertData(w http.ResponseWriter, r *http.Request) {
 	}
 
 	invalidateUTVSource(r.Context(), h.cache, userID, "oura")
+	events.Publish(events.UTVDataInserted, events.UTVData{UserID: userID.String(), Device: "oura", Date: input.Date})
 
 	w.WriteHeader(http.StatusCreated)
 }
@@ -345,11 +342,11 @@ func (h *OuraDataHandler) InsertData(w http.ResponseWriter, r *http.Request) {
 // @Param			user_id	query	string	true	"User ID (UUID)"
 // @Success		200		"Deleted: Data successfully removed"
 // @Success		204		"No Content: No matching data"
-// @Failure		400		{object} swagger.ValidationErrorResponse
-// @Failure		401		{object} swagger.UnauthorizedResponse
-// @Failure		403		{object} swagger.ForbiddenResponse
-// @Failure		500		{object} swagger.InternalServerErrorResponse
-// @Failure		503		{object} swagger.ServiceUnavailableResponse
+// @Failure		400		{object} apitypes.ValidationErrorResponse
+// @Failure		401		{object} apitypes.UnauthorizedResponse
+// @Failure		403		{object} apitypes.ForbiddenResponse
+// @Failure		500		{object} apitypes.InternalServerErrorResponse
+// @Failure		503		{object} apitypes.ServiceUnavailableResponse
 // @Security		BearerAuth
 // @Router			/utv/oura/data [delete]
 func (h *OuraDataHandler) DeleteAllData(w http.ResponseWriter, r *http.Request) {
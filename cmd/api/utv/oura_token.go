@@ -1,7 +1,6 @@
 package utvapi
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -9,6 +8,7 @@ import (
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/utv"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 type OuraTokenHandler struct {
@@ -32,12 +32,12 @@ type OuraStatusParams struct {
 //	@Accept			json
 //	@Produce		json
 //	@Param			user_id	query		string	true	"User ID (UUID)"
-//	@Success		200		{object}	swagger.OuraStatusResponse
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Success		200		{object}	apitypes.OuraStatusResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/oura/status [get]
 func (h *OuraTokenHandler) GetStatus(w http.ResponseWriter, r *http.Request) {
@@ -72,15 +72,7 @@ func (h *OuraTokenHandler) GetStatus(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	utils.WriteJSON(w, http.StatusOK, map[string]bool{
-		"connected": connected,
-		"data":      dataExists,
-	})
-}
-
-type OuraTokenInput struct {
-	UserID  string          `json:"user_id" validate:"required,uuid4"`
-	Details json.RawMessage `json:"details" validate:"required"`
+	utils.WriteJSON(w, http.StatusOK, apitypes.OuraStatusResponse{Connected: connected, Data: dataExists})
 }
 
 // UpsertToken godoc
@@ -90,13 +82,13 @@ type OuraTokenInput struct {
 //	@Tags			UTV - Oura
 //	@Accept			json
 //	@Produce		json
-//	@Param			body	body	swagger.OuraTokenInput	true	"Oura token input"
+//	@Param			body	body	apitypes.OuraTokenInput	true	"Oura token input"
 //	@Success		201		"Created"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/oura/token [post]
 func (h *OuraTokenHandler) UpsertToken(w http.ResponseWriter, r *http.Request) {
@@ -105,7 +97,7 @@ func (h *OuraTokenHandler) UpsertToken(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var input OuraTokenInput
+	var input apitypes.OuraTokenInput
 	if err := utils.ReadJSON(w, r, &input); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -142,12 +134,12 @@ type GetTokenByOuraIDParams struct {
 //	@Accept			json
 //	@Produce		json
 //	@Param			oura_id	query		string	true	"Oura ID"
-//	@Success		200		{object}	swagger.OuraTokenByIDResponse
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Success		200		{object}	apitypes.OuraTokenByIDResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/oura/token-by-id [get]
 func (h *OuraTokenHandler) GetTokenByOuraID(w http.ResponseWriter, r *http.Request) {
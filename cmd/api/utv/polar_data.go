@@ -6,9 +6,11 @@ import (
 	"net/http"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/utv"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 // Validation structs
@@ -29,12 +31,6 @@ type PolarGetDataParams struct {
 	Key    string `form:"key" validate:"omitempty,key"`
 }
 
-type PolarPostDataInput struct {
-	UserID string          `json:"user_id" validate:"required,uuid4"`
-	Date   string          `json:"date" validate:"required,datetime=2006-01-02"`
-	Data   json.RawMessage `json:"data" validate:"required"`
-}
-
 type PolarDeleteDataInput struct {
 	UserID string `json:"user_id" validate:"required,uuid4"`
 	Date   string `json:"date" validate:"required,datetime=2006-01-02"`
@@ -65,14 +61,14 @@ func NewPolarDataHandler(store utv.PolarData, cache *cache.Storage) *PolarDataHa
 //	@Param			user_id		query		string					true	"User ID (UUID)"
 //	@Param			after_date	query		string					false	"Filter dates after this date (YYYY-MM-DD)"
 //	@Param			before_date	query		string					false	"Filter dates before this date (YYYY-MM-DD)"
-//	@Success		200			{object}	swagger.DatesResponse	"List of available dates"
+//	@Success		200			{object}	apitypes.DatesResponse	"List of available dates"
 //	@Success		204			"No Content: No available dates found"
-//	@Failure		400			{object}	swagger.ValidationErrorResponse
-//	@Failure		401			{object}	swagger.UnauthorizedResponse
-//	@Failure		403			{object}	swagger.ForbiddenResponse
-//	@Failure		422			{object}	swagger.InvalidDateRange
-//	@Failure		500			{object}	swagger.InternalServerErrorResponse
-//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400			{object}	apitypes.ValidationErrorResponse
+//	@Failure		401			{object}	apitypes.UnauthorizedResponse
+//	@Failure		403			{object}	apitypes.ForbiddenResponse
+//	@Failure		422			{object}	apitypes.InvalidDateRange
+//	@Failure		500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/polar/dates [get]
 func (h *PolarDataHandler) GetDates(w http.ResponseWriter, r *http.Request) {
@@ -143,13 +139,13 @@ func (h *PolarDataHandler) GetDates(w http.ResponseWriter, r *http.Request) {
 //	@Produce		json
 //	@Param			user_id	query		string						true	"User ID (UUID)"
 //	@Param			date	query		string						true	"Date (YYYY-MM-DD)"
-//	@Success		200		{object}	swagger.PolarTypesResponse	"List of available types"
+//	@Success		200		{object}	apitypes.PolarTypesResponse	"List of available types"
 //	@Success		204		"No Content: No available types found"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/polar/types [get]
 func (h *PolarDataHandler) GetTypes(w http.ResponseWriter, r *http.Request) {
@@ -214,13 +210,13 @@ func (h *PolarDataHandler) GetTypes(w http.ResponseWriter, r *http.Request) {
 //	@Param			user_id	query		string						true	"User ID (UUID)"
 //	@Param			date	query		string						true	"Date (YYYY-MM-DD)"
 //	@Param			key		query		string						false	"Type"
-//	@Success		200		{object}	swagger.PolarDataResponse	"Data"
+//	@Success		200		{object}	apitypes.PolarDataResponse	"Data"
 //	@Success		204		"No Content: No data found"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/polar/data [get]
 func (h *PolarDataHandler) GetData(w http.ResponseWriter, r *http.Request) {
@@ -287,13 +283,13 @@ func (h *PolarDataHandler) GetData(w http.ResponseWriter, r *http.Request) {
 //	@Tags			UTV - Polar
 //	@Accept			json
 //	@Produce		json
-//	@Param			body	body	swagger.PolarPostDataInput	true	"Polar data input"
+//	@Param			body	body	apitypes.PolarPostDataInput	true	"Polar data input"
 //	@Success		201		"Created: Data successfully stored (no content in response body)"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/polar/data [post]
 func (h *PolarDataHandler) InsertData(w http.ResponseWriter, r *http.Request) {
@@ -302,7 +298,7 @@ func (h *PolarDataHandler) InsertData(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var input PolarPostDataInput
+	var input apitypes.PolarPostDataInput
 	if err := utils.ReadJSON(w, r, &input); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -332,6 +328,7 @@ func (h *PolarDataHandler) InsertData(w http.ResponseWriter, r *http.Request) {
 	}
 
 	invalidateUTVSource(r.Context(), h.cache, userID, "polar")
+	events.Publish(events.UTVDataInserted, events.UTVData{UserID: userID.String(), Device: "polar", Date: input.Date})
 
 	w.WriteHeader(http.StatusCreated)
 }
@@ -346,11 +343,11 @@ func (h *PolarDataHandler) InsertData(w http.ResponseWriter, r *http.Request) {
 // @Param			user_id	query	string	true	"User ID (UUID)"
 // @Success		200		"Deleted: Data successfully removed"
 // @Success		204		"No Content: No matching data"
-// @Failure		400		{object} swagger.ValidationErrorResponse
-// @Failure		401		{object} swagger.UnauthorizedResponse
-// @Failure		403		{object} swagger.ForbiddenResponse
-// @Failure		500		{object} swagger.InternalServerErrorResponse
-// @Failure		503		{object} swagger.ServiceUnavailableResponse
+// @Failure		400		{object} apitypes.ValidationErrorResponse
+// @Failure		401		{object} apitypes.UnauthorizedResponse
+// @Failure		403		{object} apitypes.ForbiddenResponse
+// @Failure		500		{object} apitypes.InternalServerErrorResponse
+// @Failure		503		{object} apitypes.ServiceUnavailableResponse
 // @Security		BearerAuth
 // @Router			/utv/polar/data [delete]
 func (h *PolarDataHandler) DeleteAllData(w http.ResponseWriter, r *http.Request) {
@@ -1,7 +1,6 @@
 package utvapi
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -9,6 +8,7 @@ import (
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/utv"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 type PolarTokenHandler struct {
@@ -32,12 +32,12 @@ type GetStatusParams struct {
 //	@Accept			json
 //	@Produce		json
 //	@Param			user_id	query		string	true	"User ID (UUID)"
-//	@Success		200		{object}	swagger.PolarStatusResponse
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Success		200		{object}	apitypes.PolarStatusResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/polar/status [get]
 func (h *PolarTokenHandler) GetStatus(w http.ResponseWriter, r *http.Request) {
@@ -72,15 +72,7 @@ func (h *PolarTokenHandler) GetStatus(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	utils.WriteJSON(w, http.StatusOK, map[string]bool{
-		"connected": connected,
-		"data":      dataExists,
-	})
-}
-
-type PolarTokenInput struct {
-	UserID  string          `json:"user_id" validate:"required,uuid4"`
-	Details json.RawMessage `json:"details" validate:"required"`
+	utils.WriteJSON(w, http.StatusOK, apitypes.PolarStatusResponse{Connected: connected, Data: dataExists})
 }
 
 // UpsertToken godoc
@@ -90,13 +82,13 @@ type PolarTokenInput struct {
 //	@Tags			UTV - Polar
 //	@Accept			json
 //	@Produce		json
-//	@Param			body	body	swagger.PolarTokenInput	true	"Polar token input"
+//	@Param			body	body	apitypes.PolarTokenInput	true	"Polar token input"
 //	@Success		201		"Created"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/polar/token [post]
 func (h *PolarTokenHandler) UpsertToken(w http.ResponseWriter, r *http.Request) {
@@ -105,7 +97,7 @@ func (h *PolarTokenHandler) UpsertToken(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	var input PolarTokenInput
+	var input apitypes.PolarTokenInput
 	if err := utils.ReadJSON(w, r, &input); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -142,12 +134,12 @@ type GetTokenByPolarIDParams struct {
 //	@Accept			json
 //	@Produce		json
 //	@Param			polar_id	query		string	true	"Polar x_user_id"
-//	@Success		200			{object}	swagger.PolarTokenByIDResponse
-//	@Failure		400			{object}	swagger.ValidationErrorResponse
-//	@Failure		401			{object}	swagger.UnauthorizedResponse
-//	@Failure		403			{object}	swagger.ForbiddenResponse
-//	@Failure		500			{object}	swagger.InternalServerErrorResponse
-//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Success		200			{object}	apitypes.PolarTokenByIDResponse
+//	@Failure		400			{object}	apitypes.ValidationErrorResponse
+//	@Failure		401			{object}	apitypes.UnauthorizedResponse
+//	@Failure		403			{object}	apitypes.ForbiddenResponse
+//	@Failure		500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/polar/token-by-id [get]
 func (h *PolarTokenHandler) GetTokenByPolarID(w http.ResponseWriter, r *http.Request) {
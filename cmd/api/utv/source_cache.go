@@ -10,6 +10,7 @@ import (
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/utv"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 type SourceCacheHandler struct {
@@ -25,11 +26,6 @@ type SourceParam struct {
 	Source string `form:"source"`
 }
 
-type UpsertSourceCacheBody struct {
-	Source string   `json:"source" validate:"required"`
-	Data   []string `json:"data"   validate:"required,min=1,dive,required"`
-}
-
 // GetAllDataTypes godoc
 //
 //	@Summary		Get data types (source_cache)
@@ -38,13 +34,13 @@ type UpsertSourceCacheBody struct {
 //	@Accept			json
 //	@Produce		json
 //	@Param			source	query		string	false	"Source name (e.g., oura, garmin, polar, suunto)"
-//	@Success		200		{object}	swagger.SourceCacheSingleResponse
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		404		{object}	swagger.NotFoundResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Success		200		{object}	apitypes.SourceCacheSingleResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		404		{object}	apitypes.NotFoundResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/source_cache/data-types [get]
 func (h *SourceCacheHandler) GetAllDataTypes(w http.ResponseWriter, r *http.Request) {
@@ -104,12 +100,12 @@ func (h *SourceCacheHandler) GetAllDataTypes(w http.ResponseWriter, r *http.Requ
 //	@Tags			UTV - Source_Cache
 //	@Accept			json
 //	@Produce		json
-//	@Param			body	body	swagger.SourceCacheUpsertInput	true	"source + data array"
+//	@Param			body	body	apitypes.SourceCacheUpsertInput	true	"source + data array"
 //	@Success		201		"Created"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
 //	@Security		BearerAuth
 //	@Router			/utv/source_cache/data-types [post]
 func (h *SourceCacheHandler) UpsertDataTypes(w http.ResponseWriter, r *http.Request) {
@@ -118,7 +114,7 @@ func (h *SourceCacheHandler) UpsertDataTypes(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	var input UpsertSourceCacheBody
+	var input apitypes.SourceCacheUpsertInput
 	if err := utils.ReadJSON(w, r, &input); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
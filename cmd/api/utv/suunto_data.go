@@ -6,9 +6,11 @@ import (
 	"net/http"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/utv"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 // Validation structs
@@ -29,12 +31,6 @@ type SuuntoGetDataParams struct {
 	Key    string `form:"key" validate:"omitempty,key"`
 }
 
-type SuuntoPostDataInput struct {
-	UserID string          `json:"user_id" validate:"required,uuid4"`
-	Date   string          `json:"date" validate:"required,datetime=2006-01-02"`
-	Data   json.RawMessage `json:"data" validate:"required"`
-}
-
 type SuuntoDeleteDataInput struct {
 	UserID string `json:"user_id" validate:"required,uuid4"`
 	Date   string `json:"date" validate:"required,datetime=2006-01-02"`
@@ -65,14 +61,14 @@ func NewSuuntoDataHandler(store utv.SuuntoData, cache *cache.Storage) *SuuntoDat
 //	@Param			user_id		query		string					true	"User ID (UUID)"
 //	@Param			after_date	query		string					false	"Filter dates after this date (YYYY-MM-DD)"
 //	@Param			before_date	query		string					false	"Filter dates before this date (YYYY-MM-DD)"
-//	@Success		200			{object}	swagger.DatesResponse	"List of available dates"
+//	@Success		200			{object}	apitypes.DatesResponse	"List of available dates"
 //	@Success		204			"No Content: No available dates found"
-//	@Failure		400			{object}	swagger.ValidationErrorResponse
-//	@Failure		401			{object}	swagger.UnauthorizedResponse
-//	@Failure		403			{object}	swagger.ForbiddenResponse
-//	@Failure		422			{object}	swagger.InvalidDateRange
-//	@Failure		500			{object}	swagger.InternalServerErrorResponse
-//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400			{object}	apitypes.ValidationErrorResponse
+//	@Failure		401			{object}	apitypes.UnauthorizedResponse
+//	@Failure		403			{object}	apitypes.ForbiddenResponse
+//	@Failure		422			{object}	apitypes.InvalidDateRange
+//	@Failure		500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/suunto/dates [get]
 func (h *SuuntoDataHandler) GetDates(w http.ResponseWriter, r *http.Request) {
@@ -139,15 +135,15 @@ func (h *SuuntoDataHandler) GetDates(w http.ResponseWriter, r *http.Request) {
 //	@Tags			UTV - Suunto
 //	@Accept			json
 //	@Produce		json
-//	@Param			user_id	query		string						true	"User ID (UUID)"
-//	@Param			date	query		string						true	"Date (YYYY-MM-DD)"
-//	@Success		200		{object}	swagger.SuuntoTypesResponse	"List of available types"
+//	@Param			user_id	query		string							true	"User ID (UUID)"
+//	@Param			date	query		string							true	"Date (YYYY-MM-DD)"
+//	@Success		200		{object}	apitypes.SuuntoTypesResponse	"List of available types"
 //	@Success		204		"No Content: No available types found"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/suunto/types [get]
 func (h *SuuntoDataHandler) GetTypes(w http.ResponseWriter, r *http.Request) {
@@ -212,13 +208,13 @@ func (h *SuuntoDataHandler) GetTypes(w http.ResponseWriter, r *http.Request) {
 //	@Param			user_id	query		string						true	"User ID (UUID)"
 //	@Param			date	query		string						true	"Date (YYYY-MM-DD)"
 //	@Param			key		query		string						false	"Type"
-//	@Success		200		{object}	swagger.SuuntoDataResponse	"Data"
+//	@Success		200		{object}	apitypes.SuuntoDataResponse	"Data"
 //	@Success		204		"No Content: No data found"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/suunto/data [get]
 func (h *SuuntoDataHandler) GetData(w http.ResponseWriter, r *http.Request) {
@@ -285,13 +281,13 @@ func (h *SuuntoDataHandler) GetData(w http.ResponseWriter, r *http.Request) {
 //	@Tags			UTV - Suunto
 //	@Accept			json
 //	@Produce		json
-//	@Param			body	body	swagger.SuuntoPostDataInput	true	"suunto data input"
+//	@Param			body	body	apitypes.SuuntoPostDataInput	true	"suunto data input"
 //	@Success		201		"Created: Data successfully stored (no content in response body)"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/suunto/data [post]
 func (h *SuuntoDataHandler) InsertData(w http.ResponseWriter, r *http.Request) {
@@ -300,7 +296,7 @@ func (h *SuuntoDataHandler) InsertData(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var input SuuntoPostDataInput
+	var input apitypes.SuuntoPostDataInput
 	if err := utils.ReadJSON(w, r, &input); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -330,27 +326,28 @@ func (h *SuuntoDataHandler) InsertData(w http.ResponseWriter, r *http.Request) {
 	}
 
 	invalidateUTVSource(r.Context(), h.cache, userID, "suunto")
+	events.Publish(events.UTVDataInserted, events.UTVData{UserID: userID.String(), Device: "suunto", Date: input.Date})
 
 	w.WriteHeader(http.StatusCreated)
 }
 
 // DeleteAllDataSuunto godoc
 //
-// @Summary		Delete all Suunto data for a user
-// @Description	Deletes all Suunto data entries for a specific user
-// @Tags			UTV - Suunto
-// @Accept			json
-// @Produce		json
-// @Param			user_id	query	string	true	"User ID (UUID)"
-// @Success		200		"Deleted: Data successfully removed"
-// @Success		204		"No Content: No matching data"
-// @Failure		400		{object} swagger.ValidationErrorResponse
-// @Failure		401		{object} swagger.UnauthorizedResponse
-// @Failure		403		{object} swagger.ForbiddenResponse
-// @Failure		500		{object} swagger.InternalServerErrorResponse
-// @Failure		503		{object} swagger.ServiceUnavailableResponse
-// @Security		BearerAuth
-// @Router			/utv/suunto/data [delete]
+//	@Summary		Delete all Suunto data for a user
+//	@Description	Deletes all Suunto data entries for a specific user
+//	@Tags			UTV - Suunto
+//	@Accept			json
+//	@Produce		json
+//	@Param			user_id	query	string	true	"User ID (UUID)"
+//	@Success		200		"Deleted: Data successfully removed"
+//	@Success		204		"No Content: No matching data"
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/utv/suunto/data [delete]
 func (h *SuuntoDataHandler) DeleteAllData(w http.ResponseWriter, r *http.Request) {
 	if !authz.Authorize(r) {
 		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
@@ -2,7 +2,6 @@ package utvapi
 
 import (
 	"database/sql"
-	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -11,6 +10,7 @@ import (
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/utv"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 	"github.com/google/uuid"
 )
 
@@ -31,10 +31,6 @@ type SportIDParam struct {
 	SportID string `form:"sport_id" validate:"required,numeric"`
 }
 
-type UserDataInput struct {
-	Data json.RawMessage `json:"data" validate:"required"`
-}
-
 // GetUserData godoc
 //
 //	@Summary		Get user
@@ -43,13 +39,13 @@ type UserDataInput struct {
 //	@Accept			json
 //	@Produce		json
 //	@Param			user_id	query		string	true	"User ID (UUID)"
-//	@Success		200		{object}	swagger.UserDataResponse
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		404		{object}	swagger.NotFoundResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Success		200		{object}	apitypes.UserDataResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		404		{object}	apitypes.NotFoundResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/user [get]
 func (h *UserDataHandler) GetUserData(w http.ResponseWriter, r *http.Request) {
@@ -87,13 +83,7 @@ func (h *UserDataHandler) GetUserData(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	utils.WriteJSON(w, http.StatusOK, map[string]any{
-		"data": data,
-	})
-}
-
-type UserDataUpsertInput struct {
-	Data json.RawMessage `json:"data" validate:"required"`
+	utils.WriteJSON(w, http.StatusOK, apitypes.UserDataResponse{Data: data})
 }
 
 // UpsertUserData godoc
@@ -104,13 +94,13 @@ type UserDataUpsertInput struct {
 //	@Accept			json
 //	@Produce		json
 //	@Param			user_id	query	string					true	"User ID (UUID)"
-//	@Param			body	body	swagger.UserDataInput	true	"User data input"
+//	@Param			body	body	apitypes.UserDataInput	true	"User data input"
 //	@Success		201		"Created"
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/user [post]
 func (h *UserDataHandler) UpsertUserData(w http.ResponseWriter, r *http.Request) {
@@ -137,7 +127,7 @@ func (h *UserDataHandler) UpsertUserData(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	var input UserDataUpsertInput
+	var input apitypes.UserDataInput
 	if err := utils.ReadJSON(w, r, &input); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -165,11 +155,11 @@ func (h *UserDataHandler) UpsertUserData(w http.ResponseWriter, r *http.Request)
 //	@Produce		json
 //	@Param			user_id	query	string	true	"User ID (UUID)"
 //	@Success		200
-//	@Failure		400	{object}	swagger.ValidationErrorResponse
-//	@Failure		401	{object}	swagger.UnauthorizedResponse
-//	@Failure		403	{object}	swagger.ForbiddenResponse
-//	@Failure		500	{object}	swagger.InternalServerErrorResponse
-//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Failure		400	{object}	apitypes.ValidationErrorResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		403	{object}	apitypes.ForbiddenResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503	{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/user [delete]
 func (h *UserDataHandler) DeleteUserData(w http.ResponseWriter, r *http.Request) {
@@ -213,13 +203,13 @@ func (h *UserDataHandler) DeleteUserData(w http.ResponseWriter, r *http.Request)
 //	@Accept			json
 //	@Produce		json
 //	@Param			sport_id	query		string	true	"Sport ID"
-//	@Success		200			{object}	swagger.UserIDResponse
-//	@Failure		400			{object}	swagger.ValidationErrorResponse
-//	@Failure		401			{object}	swagger.UnauthorizedResponse
-//	@Failure		403			{object}	swagger.ForbiddenResponse
-//	@Failure		404			{object}	swagger.NotFoundResponse
-//	@Failure		500			{object}	swagger.InternalServerErrorResponse
-//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Success		200			{object}	apitypes.UserIDResponse
+//	@Failure		400			{object}	apitypes.ValidationErrorResponse
+//	@Failure		401			{object}	apitypes.UnauthorizedResponse
+//	@Failure		403			{object}	apitypes.ForbiddenResponse
+//	@Failure		404			{object}	apitypes.NotFoundResponse
+//	@Failure		500			{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503			{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/user-id-by-sport-id [get]
 func (h *UserDataHandler) GetUserIDBySportID(w http.ResponseWriter, r *http.Request) {
@@ -253,9 +243,7 @@ func (h *UserDataHandler) GetUserIDBySportID(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	utils.WriteJSON(w, http.StatusOK, map[string]string{
-		"user_id": userID.String(),
-	})
+	utils.WriteJSON(w, http.StatusOK, apitypes.UserIDResponse{UserID: userID.String()})
 }
 
 // GetLinkedDevices godoc
@@ -266,12 +254,12 @@ func (h *UserDataHandler) GetUserIDBySportID(w http.ResponseWriter, r *http.Requ
 //	@Accept			json
 //	@Produce		json
 //	@Param			user_id	query		string	true	"User ID (UUID)"
-//	@Success		200		{object}	swagger.DeviceStatusResponse
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Success		200		{object}	apitypes.DeviceStatusResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/utv/user-linked-devices [get]
 func (h *UserDataHandler) GetLinkedDevices(w http.ResponseWriter, r *http.Request) {
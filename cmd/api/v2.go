@@ -0,0 +1,313 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+type deprecationConfig struct {
+	// date v1 counts as deprecated from
+	since time.Time
+	// date v1 is removed; zero leaves the Sunset header out
+	sunset time.Time
+}
+
+// FIS sectors; v1 spells them in the endpoint name (racecc, seasoncodeCC)
+var fisSectors = []string{"cc", "jp", "nk"}
+
+// UTV device sources that share the same data and token endpoints
+var utvDevices = []string{"oura", "polar", "suunto", "garmin"}
+
+// v2Routes serves the v1 handlers under resource-oriented paths. Every route
+// is handed to v1 as the request v1 expects (see forwardV1), so auth, role
+// checks, validation, caching and upload middleware are the same for both
+// versions and v2 needs no handlers or store access of its own.
+func (app *api) v2Routes(v1 http.Handler) chi.Router {
+	r := chi.NewRouter()
+
+	get := func(target string) http.HandlerFunc { return forwardV1(v1, http.MethodGet, target) }
+	post := func(target string) http.HandlerFunc { return forwardV1(v1, http.MethodPost, target) }
+	put := func(target string) http.HandlerFunc { return forwardV1(v1, http.MethodPut, target) }
+	del := func(target string) http.HandlerFunc { return forwardV1(v1, http.MethodDelete, target) }
+
+	r.Post("/auth/tokens", post("/auth/token"))
+	r.Post("/auth/tokens/refresh", post("/auth/refresh"))
+	r.Get("/health", get("/health"))
+	if app.openapi != nil {
+		r.Get("/openapi.yaml", get("/openapi.yaml"))
+		r.Get("/openapi.json", get("/openapi.json"))
+	}
+	r.Get("/jobs/{id}", get("/jobs/{id}"))
+	r.Post("/batch", post("/batch"))
+	r.Get("/graphql", get("/graphql"))
+	r.Post("/graphql", post("/graphql"))
+	r.Get("/graphql/schema", get("/graphql/schema"))
+
+	r.Get("/stream/athletes/{id}", get("/stream/athletes/{id}"))
+
+	r.Route("/identities", func(r chi.Router) {
+		r.Post("/", post("/identities"))
+		r.Get("/{id}", get("/identities/{id}"))
+		r.Delete("/{id}", del("/identities/{id}"))
+		r.Delete("/{id}/identifiers/{kind}", del("/identities/{id}/identifiers/{kind}"))
+	})
+
+	r.Get("/athletes/{id}/overview", get("/athletes/{id}/overview"))
+
+	r.Route("/webhooks", func(r chi.Router) {
+		r.Post("/", post("/webhooks"))
+		r.Get("/", get("/webhooks"))
+		r.Get("/dead-letters", get("/webhooks/dead-letters"))
+		r.Post("/deliveries/{id}/redeliver", post("/webhooks/deliveries/{id}/redeliver"))
+		r.Get("/{id}", get("/webhooks/{id}"))
+		r.Put("/{id}", put("/webhooks/{id}"))
+		r.Delete("/{id}", del("/webhooks/{id}"))
+		r.Get("/{id}/deliveries", get("/webhooks/{id}/deliveries"))
+	})
+
+	r.Route("/tietoevry", func(r chi.Router) {
+		r.Post("/users", post("/tietoevry/users"))
+		r.Get("/users/{id}", get("/tietoevry/users?id={id}"))
+		r.Delete("/users/{id}", del("/tietoevry/users?id={id}"))
+		r.Get("/deleted-users", get("/tietoevry/deleted-users"))
+
+		for _, res := range []string{"exercises", "symptoms", "measurements", "test-results", "questionnaires", "activity-zones"} {
+			r.Post("/"+res, post("/tietoevry/"+res))
+			r.Get("/users/{id}/"+res, get("/tietoevry/"+res+"?user_id={id}"))
+		}
+	})
+
+	r.Route("/kamk", func(r chi.Router) {
+		r.Post("/injuries", post("/kamk/injury"))
+		r.Post("/injuries/recovered", post("/kamk/injury-recovered"))
+		r.Delete("/injuries/{id}", del("/kamk/injury?injury_id={id}"))
+		r.Get("/users/{userId}/injuries", get("/kamk/injury?user_id={userId}"))
+		r.Get("/users/{userId}/injuries/latest-id", get("/kamk/injury-id?user_id={userId}"))
+
+		r.Post("/questionnaires", post("/kamk/questionnaire"))
+		r.Patch("/questionnaires/{id}", post("/kamk/update-quiz?id={id}"))
+		r.Delete("/questionnaires/{id}", del("/kamk/delete-quiz?id={id}"))
+		r.Get("/users/{userId}/questionnaires", get("/kamk/questionnaire?user_id={userId}"))
+		r.Get("/users/{userId}/questionnaires/done-today", get("/kamk/is-quiz-done?user_id={userId}"))
+	})
+
+	r.Route("/archinisis", func(r chi.Router) {
+		r.Post("/data", post("/archinisis/data"))
+		r.Post("/race-reports", post("/archinisis/race-report"))
+		r.Delete("/users/{id}", del("/archinisis/user?sportti_id={id}"))
+		r.Get("/users/{id}/data", get("/archinisis/data?id={id}"))
+		r.Get("/users/{id}/race-reports", get("/archinisis/race-report/sessions?sportti_id={id}"))
+		r.Get("/users/{id}/race-reports/{sessionId}", get("/archinisis/race-report?sportti_id={id}&session_id={sessionId}"))
+	})
+
+	r.Route("/klab", func(r chi.Router) {
+		r.Post("/data", post("/klab/data"))
+		r.Get("/users/{id}", get("/klab/user?id={id}"))
+		r.Delete("/users/{id}", del("/klab/user?sportti_id={id}"))
+		r.Get("/users/{id}/data", get("/klab/data?id={id}"))
+	})
+
+	r.Route("/fis", func(r chi.Router) {
+		// searches and counts across sectors; sector stays a query filter
+		r.Get("/races", get("/fis/races/search"))
+		r.Get("/races/by-ids", get("/fis/races/by-ids"))
+		r.Get("/races/counts", get("/fis/races/count-total"))
+		r.Get("/races/counts/by-category", get("/fis/races/count-by-category"))
+		r.Get("/races/counts/by-nation", get("/fis/races/count-by-nation"))
+
+		r.Get("/athletes", get("/fis/fiscode"))
+		r.Post("/athletes", post("/fis/athlete"))
+		r.Put("/athletes", put("/fis/athlete"))
+		r.Delete("/athletes/{fiscode}", del("/fis/athlete?fiscode={fiscode}"))
+		r.Get("/athletes/{fiscode}/sector", get("/fis/competitor/sectorcode?fiscode={fiscode}"))
+		r.Get("/athletes/{fiscode}/seasons", get("/fis/competitor/seasons-catcodes?fiscode={fiscode}"))
+		r.Get("/athletes/{fiscode}/latest-results", get("/fis/competitor/latest-results?fiscode={fiscode}"))
+
+		r.Get("/competitors", get("/fis/competitor/search"))
+		r.Post("/competitors", post("/fis/competitor"))
+		r.Put("/competitors", put("/fis/competitor"))
+		r.Get("/competitors/latest", get("/fis/lastrow/competitor"))
+		r.Get("/competitors/counts/by-nation", get("/fis/competitor/count-by-nation"))
+		r.Delete("/competitors/{id}", del("/fis/competitor?id={id}"))
+
+		for _, s := range fisSectors {
+			code := strings.ToUpper(s)
+			r.Route("/"+s, func(r chi.Router) {
+				r.Get("/seasons", get("/fis/seasoncode"+code))
+				r.Get("/disciplines", get("/fis/disciplinecode"+code))
+				r.Get("/categories", get("/fis/catcode"+code))
+				r.Get("/athletes", get("/fis/athlete?sectorcode="+code))
+				r.Get("/nations", get("/fis/nation?sectorcode="+code))
+				r.Get("/athletes/{fiscode}/results", get("/fis/resultathlete"+s+"?fiscode={fiscode}"))
+
+				// updates keep the id in the body, as in v1
+				r.Get("/races", get("/fis/race"+s))
+				r.Post("/races", post("/fis/race"+s))
+				r.Put("/races", put("/fis/race"+s))
+				r.Get("/races/latest", get("/fis/lastrow/race"+s))
+				r.Delete("/races/{raceId}", del("/fis/race"+s+"?id={raceId}"))
+				r.Get("/races/{raceId}/results", get("/fis/result"+s+"?raceid={raceId}"))
+
+				r.Post("/results", post("/fis/result"+s))
+				r.Put("/results", put("/fis/result"+s))
+				r.Get("/results/latest", get("/fis/lastrow/result"+s))
+				r.Delete("/results/{resultId}", del("/fis/result"+s+"?id={resultId}"))
+			})
+		}
+	})
+
+	r.Route("/utv", func(r chi.Router) {
+		r.Get("/users/by-sport-id/{sportId}", get("/utv/user-id-by-sport-id?sport_id={sportId}"))
+		r.Get("/users/{id}", get("/utv/user?user_id={id}"))
+		r.Put("/users/{id}", post("/utv/user?user_id={id}"))
+		r.Delete("/users/{id}", del("/utv/user?user_id={id}"))
+		r.Get("/users/{id}/devices", get("/utv/user-linked-devices?user_id={id}"))
+		r.Get("/users/{id}/latest", get("/utv/latest?user_id={id}"))
+		r.Get("/users/{id}/data", get("/utv/all?user_id={id}"))
+		r.Get("/users/{id}/sources/{source}/token", get("/utv/token?user_id={id}&source={source}"))
+		r.Delete("/users/{id}/sources/{source}", del("/utv/disconnect?user_id={id}&source={source}"))
+
+		r.Get("/sources/{source}/tokens/due", get("/utv/tokens4update?source={source}"))
+		r.Get("/sources/{source}/data/due", get("/utv/data4update?source={source}"))
+		r.Get("/data-types", get("/utv/source_cache/data-types"))
+		r.Post("/data-types", post("/utv/source_cache/data-types"))
+
+		for _, d := range utvDevices {
+			r.Post("/"+d+"/data", post("/utv/"+d+"/data"))
+			r.Post("/"+d+"/tokens", post("/utv/"+d+"/token"))
+			r.Get("/users/{id}/"+d+"/status", get("/utv/"+d+"/status?user_id={id}"))
+			r.Get("/users/{id}/"+d+"/dates", get("/utv/"+d+"/dates?user_id={id}"))
+			r.Get("/users/{id}/"+d+"/types", get("/utv/"+d+"/types?user_id={id}"))
+			r.Get("/users/{id}/"+d+"/data", get("/utv/"+d+"/data?user_id={id}"))
+			r.Delete("/users/{id}/"+d+"/data", del("/utv/"+d+"/data?user_id={id}"))
+		}
+		r.Get("/oura/tokens/{ouraId}", get("/utv/oura/token-by-id?oura_id={ouraId}"))
+		r.Get("/polar/tokens/{polarId}", get("/utv/polar/token-by-id?polar_id={polarId}"))
+		r.Get("/suunto/tokens/{username}", get("/utv/suunto/token-by-username?username={username}"))
+		// garmin tokens stay in the query string so they are not logged as paths
+		r.Get("/garmin/tokens/exists", get("/utv/garmin/token-exists"))
+		r.Get("/garmin/tokens/user-id", get("/utv/garmin/user-id-by-token"))
+
+		for _, d := range []string{"klab", "archinisis"} {
+			r.Post("/"+d+"/tokens", post("/utv/"+d+"/token"))
+			r.Get("/"+d+"/sport-ids", get("/utv/"+d+"/sport_ids"))
+			r.Get("/users/{id}/"+d+"/status", get("/utv/"+d+"/status?user_id={id}"))
+		}
+
+		r.Post("/coachtech/data", post("/utv/coachtech/insert"))
+		r.Get("/users/{id}/coachtech/status", get("/utv/coachtech/status?user_id={id}"))
+		r.Get("/users/{id}/coachtech/data", get("/utv/coachtech/data?user_id={id}"))
+	})
+
+	return r
+}
+
+// forwardV1 serves a v2 route with the v1 endpoint target, a v1 path and
+// query whose {param} placeholders are filled from the v2 URL. The client's
+// own query parameters are passed along; the public URL is kept so pagination
+// links and problem instances still name the v2 path.
+func forwardV1(v1 http.Handler, method, target string) http.HandlerFunc {
+	path, query, _ := strings.Cut(target, "?")
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		p, q := path, query
+		params := chi.RouteContext(r.Context()).URLParams
+		for i, key := range params.Keys {
+			p = strings.ReplaceAll(p, "{"+key+"}", url.PathEscape(params.Values[i]))
+			q = strings.ReplaceAll(q, "{"+key+"}", url.QueryEscape(params.Values[i]))
+		}
+
+		values := r.URL.Query()
+		fixed, _ := url.ParseQuery(q)
+		for key, v := range fixed {
+			values[key] = v
+		}
+
+		u := *r.URL
+		u.Path, u.RawPath, u.RawQuery = "/v1"+p, "", values.Encode()
+
+		rctx := chi.NewRouteContext()
+		rctx.RoutePath = p
+		req := r.Clone(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+		req.Method, req.URL = method, &u
+
+		v1.ServeHTTP(w, utils.WithPublicURL(req, r.URL))
+	}
+}
+
+type v1TargetKey struct{}
+
+// v1Target is the request v1 is handed for a v2 request
+type v1Target struct {
+	method string
+	path   string
+}
+
+// recordV1 stands in for v1 in the v2 routes v1Route runs requests through:
+// it keeps the request it is handed and serves nothing
+var recordV1 = http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+	if t, ok := r.Context().Value(v1TargetKey{}).(*v1Target); ok {
+		t.method, t.path = r.Method, r.URL.Path
+	}
+})
+
+// discardResponse swallows what the v2 router writes for unknown routes
+type discardResponse struct{ header http.Header }
+
+func (d *discardResponse) Header() http.Header         { return d.header }
+func (d *discardResponse) Write(b []byte) (int, error) { return len(b), nil }
+func (d *discardResponse) WriteHeader(int)             {}
+
+// v1Route returns the method and v1 path a request is served as, for role
+// checks made before routing. v2 paths are run through the v2 routes, which
+// have no handlers of their own, against a v1 that only records the request.
+func (app *api) v1Route(r *http.Request) (method, path string, ok bool) {
+	if !strings.HasPrefix(r.URL.Path, "/v2/") {
+		return r.Method, r.URL.Path, true
+	}
+	if app.v2Targets == nil {
+		return "", "", false
+	}
+
+	t := &v1Target{}
+	ctx := context.WithValue(r.Context(), v1TargetKey{}, t)
+	req := r.Clone(context.WithValue(ctx, chi.RouteCtxKey, chi.NewRouteContext()))
+	req.URL.Path, req.URL.RawPath = strings.TrimPrefix(r.URL.Path, "/v2"), ""
+	app.v2Targets.ServeHTTP(&discardResponse{header: http.Header{}}, req)
+	return t.method, t.path, t.method != ""
+}
+
+// apiPrefix is the version prefix the client called, for links in responses
+func apiPrefix(r *http.Request) string {
+	if strings.HasPrefix(utils.PublicURL(r).Path, "/v2/") {
+		return "/v2"
+	}
+	return "/v1"
+}
+
+// DeprecationMiddleware marks responses of a deprecated API version with the
+// Deprecation (RFC 9745) and Sunset (RFC 8594) headers
+func DeprecationMiddleware(cfg deprecationConfig) func(http.Handler) http.Handler {
+	deprecation := fmt.Sprintf("@%d", cfg.since.Unix())
+	var sunset string
+	if !cfg.sunset.IsZero() {
+		sunset = cfg.sunset.UTC().Format(http.TimeFormat)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Deprecation", deprecation)
+			if sunset != "" {
+				w.Header().Set("Sunset", sunset)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
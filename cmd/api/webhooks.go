@@ -0,0 +1,482 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authn"
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	authsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/internal/webhooks"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
+	"github.com/go-chi/chi/v5"
+)
+
+var errWebhookNotFound = errors.New("webhook not found")
+
+// eventReadRoutes is the read route whose roles an event type needs; a
+// client only receives events about data it could fetch itself, by webhook
+// or on an athlete stream
+var eventReadRoutes = map[string]string{
+	events.UTVDataInserted:          "/v1/utv/latest",
+	events.TietoevryExerciseCreated: "/v1/tietoevry/exercises",
+	events.KAMKInjuryAdded:          "/v1/kamk/injury",
+	events.FISResultUpdated:         "/v1/fis/resultathletecc",
+	events.TietoevryDataCreated:     "/v1/tietoevry/measurements",
+	events.KAMKInjuryUpdated:        "/v1/kamk/injury",
+	events.KAMKQuestionnaireUpdated: "/v1/kamk/questionnaire",
+	events.KLABDataInserted:         "/v1/klab/data",
+	events.ArchinisisDataInserted:   "/v1/archinisis/data",
+}
+
+type deliveryListParams struct {
+	Status string `validate:"omitempty,oneof=pending succeeded dead"`
+}
+
+func webhookFromRow(s authsqlc.WebhookSubscription) apitypes.WebhookResponse {
+	return apitypes.WebhookResponse{
+		ID:        s.ID,
+		URL:       s.Url,
+		Events:    s.Events,
+		Active:    s.Active,
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.UpdatedAt,
+	}
+}
+
+func deliveryFromRow(d authsqlc.WebhookDelivery) apitypes.WebhookDeliveryResponse {
+	out := apitypes.WebhookDeliveryResponse{
+		ID:             d.ID,
+		SubscriptionID: d.SubscriptionID,
+		EventID:        d.EventID,
+		EventType:      d.EventType,
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		LastAttemptAt:  utils.TimePtrOrNil(d.LastAttemptAt),
+		LastStatusCode: utils.Int32PtrOrNil(d.LastStatusCode),
+		LastError:      utils.StringPtrOrNil(d.LastError),
+		CreatedAt:      d.CreatedAt,
+		Payload:        d.Payload,
+	}
+	if d.Status == auth.DeliveryPending {
+		out.NextAttemptAt = &d.NextAttemptAt
+	}
+	return out
+}
+
+// checkWebhookInput rejects URLs that cannot receive deliveries and events
+// the client may not read
+func (app *api) checkWebhookInput(w http.ResponseWriter, r *http.Request, in apitypes.WebhookInput) bool {
+	if err := utils.GetValidator().Struct(in); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return false
+	}
+
+	u, err := url.Parse(in.URL)
+	if err != nil || u.Host == "" || (u.Scheme != "https" && u.Scheme != "http") {
+		utils.BadRequestResponse(w, r, fmt.Errorf("url must be an absolute http(s) URL"))
+		return false
+	}
+	if u.Scheme != "https" && app.config.env == "production" {
+		utils.BadRequestResponse(w, r, fmt.Errorf("url must use https"))
+		return false
+	}
+	if err := webhooks.CheckHost(r.Context(), u.Hostname()); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return false
+	}
+
+	roles := authn.GetClientRoles(r.Context())
+	for _, e := range in.Events {
+		if !authz.Can(roles, http.MethodGet, eventReadRoutes[e]) {
+			utils.ForbiddenResponse(w, r, fmt.Errorf("not allowed to receive %s events", e))
+			return false
+		}
+	}
+	return true
+}
+
+// ownWebhook loads the subscription in the {id} URL parameter. Other clients'
+// subscriptions look like they do not exist, except to admins.
+func (app *api) ownWebhook(w http.ResponseWriter, r *http.Request) (authsqlc.WebhookSubscription, bool) {
+	id, err := utils.ParsePositiveInt32(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return authsqlc.WebhookSubscription{}, false
+	}
+
+	sub, err := app.store.Auth.Webhooks().GetWebhook(r.Context(), id)
+	if errors.Is(err, sql.ErrNoRows) {
+		utils.NotFoundResponse(w, r, errWebhookNotFound)
+		return sub, false
+	}
+	if err != nil {
+		utils.HandleDatabaseError(w, r, err)
+		return sub, false
+	}
+
+	if !ownedByCaller(r, sub.ClientName) {
+		utils.NotFoundResponse(w, r, errWebhookNotFound)
+		return sub, false
+	}
+	return sub, true
+}
+
+func ownedByCaller(r *http.Request, clientName string) bool {
+	return clientName == authn.GetClientName(r.Context()) || slices.Contains(authn.GetClientRoles(r.Context()), "admin")
+}
+
+// CreateWebhook godoc
+//
+//	@Summary		Subscribe to events
+//	@Description	Registers a URL that is sent a signed POST for each event of the given types. Events are only accepted for data the client may read (e.g. utv.data.inserted needs read access to /utv).
+//	@Description	The response carries the signing secret; it is not shown again. Each delivery has an X-Kuha-Signature header `t=<unix time>,v1=<hex HMAC-SHA256 of "<t>.<body>" under the secret>`, plus X-Kuha-Event and X-Kuha-Delivery.
+//	@Description	Any 2xx response acknowledges a delivery. Failed ones are retried with exponential backoff and become dead letters after WEBHOOK_MAX_ATTEMPTS (default 8) attempts.
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Param			input	body		apitypes.WebhookInput	true	"Subscription"
+//	@Success		201		{object}	apitypes.WebhookResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/webhooks [post]
+func (app *api) createWebhookHandler(w http.ResponseWriter, r *http.Request) {
+	var in apitypes.WebhookInput
+	if err := utils.ReadJSON(w, r, &in); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	if !app.checkWebhookInput(w, r, in) {
+		return
+	}
+
+	secret, err := webhooks.NewSecret()
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	sub, err := app.store.Auth.Webhooks().CreateWebhook(r.Context(), authsqlc.CreateWebhookParams{
+		ClientName: authn.GetClientName(r.Context()),
+		Url:        in.URL,
+		Events:     in.Events,
+		Secret:     secret,
+	})
+	if err != nil {
+		utils.HandleDatabaseError(w, r, err)
+		return
+	}
+
+	resp := webhookFromRow(sub)
+	resp.Secret = sub.Secret
+	w.Header().Set("Location", fmt.Sprintf("%s/webhooks/%d", apiPrefix(r), sub.ID))
+	utils.WriteJSON(w, http.StatusCreated, resp)
+}
+
+// ListWebhooks godoc
+//
+//	@Summary		List webhook subscriptions
+//	@Description	The subscriptions of the calling client
+//	@Tags			Webhooks
+//	@Produce		json
+//	@Success		200	{object}	apitypes.WebhookListResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503	{object}	apitypes.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/webhooks [get]
+func (app *api) listWebhooksHandler(w http.ResponseWriter, r *http.Request) {
+	if err := utils.ValidateParams(r, []string{}); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	subs, err := app.store.Auth.Webhooks().ListWebhooks(r.Context(), authn.GetClientName(r.Context()))
+	if err != nil {
+		utils.HandleDatabaseError(w, r, err)
+		return
+	}
+
+	out := make([]apitypes.WebhookResponse, 0, len(subs))
+	for _, s := range subs {
+		out = append(out, webhookFromRow(s))
+	}
+	utils.WriteJSON(w, http.StatusOK, apitypes.WebhookListResponse{Webhooks: out})
+}
+
+// GetWebhook godoc
+//
+//	@Summary	Get webhook subscription
+//	@Tags		Webhooks
+//	@Produce	json
+//	@Param		id	path		integer	true	"Subscription ID"
+//	@Success	200	{object}	apitypes.WebhookResponse
+//	@Failure	400	{object}	apitypes.ValidationErrorResponse
+//	@Failure	401	{object}	apitypes.UnauthorizedResponse
+//	@Failure	404	{object}	apitypes.NotFoundResponse
+//	@Failure	500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure	503	{object}	apitypes.ServiceUnavailableResponse
+//	@Security	BearerAuth
+//	@Router		/webhooks/{id} [get]
+func (app *api) getWebhookHandler(w http.ResponseWriter, r *http.Request) {
+	sub, ok := app.ownWebhook(w, r)
+	if !ok {
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, webhookFromRow(sub))
+}
+
+// UpdateWebhook godoc
+//
+//	@Summary		Update webhook subscription
+//	@Description	Replaces the URL and event types; active=false pauses deliveries (events are not queued while paused) and leaving it out keeps the current state. The secret does not change.
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		integer					true	"Subscription ID"
+//	@Param			input	body		apitypes.WebhookInput	true	"Subscription"
+//	@Success		200		{object}	apitypes.WebhookResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		403		{object}	apitypes.ForbiddenResponse
+//	@Failure		404		{object}	apitypes.NotFoundResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/webhooks/{id} [put]
+func (app *api) updateWebhookHandler(w http.ResponseWriter, r *http.Request) {
+	sub, ok := app.ownWebhook(w, r)
+	if !ok {
+		return
+	}
+
+	var in apitypes.WebhookInput
+	if err := utils.ReadJSON(w, r, &in); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	if !app.checkWebhookInput(w, r, in) {
+		return
+	}
+
+	active := sub.Active
+	if in.Active != nil {
+		active = *in.Active
+	}
+	sub, err := app.store.Auth.Webhooks().UpdateWebhook(r.Context(), authsqlc.UpdateWebhookParams{
+		ID:     sub.ID,
+		Url:    in.URL,
+		Events: in.Events,
+		Active: active,
+	})
+	if err != nil {
+		utils.HandleDatabaseError(w, r, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, webhookFromRow(sub))
+}
+
+// DeleteWebhook godoc
+//
+//	@Summary		Delete webhook subscription
+//	@Description	Removes the subscription together with its delivery log and dead letters
+//	@Tags			Webhooks
+//	@Param			id	path	integer	true	"Subscription ID"
+//	@Success		204	"Deleted"
+//	@Failure		400	{object}	apitypes.ValidationErrorResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		404	{object}	apitypes.NotFoundResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503	{object}	apitypes.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/webhooks/{id} [delete]
+func (app *api) deleteWebhookHandler(w http.ResponseWriter, r *http.Request) {
+	sub, ok := app.ownWebhook(w, r)
+	if !ok {
+		return
+	}
+	if err := app.store.Auth.Webhooks().DeleteWebhook(r.Context(), sub.ID); err != nil {
+		utils.HandleDatabaseError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListWebhookDeliveries godoc
+//
+//	@Summary		Webhook delivery log
+//	@Description	Deliveries of a subscription, newest first, with their attempts and the last response status or error. Pages hold 100 deliveries unless limit says otherwise; follow next_cursor or the Link header for more. Succeeded deliveries are kept for WEBHOOK_RETENTION_DAYS (default 30).
+//	@Tags			Webhooks
+//	@Produce		json
+//	@Param			id		path		integer	true	"Subscription ID"
+//	@Param			status	query		string	false	"Only deliveries in this state"	Enums(pending, succeeded, dead)
+//	@Param			limit	query		integer	false	"Page size (max 1000)"
+//	@Param			cursor	query		string	false	"Cursor from next_cursor"
+//	@Success		200		{object}	apitypes.WebhookDeliveryListResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		404		{object}	apitypes.NotFoundResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/webhooks/{id}/deliveries [get]
+func (app *api) listWebhookDeliveriesHandler(w http.ResponseWriter, r *http.Request) {
+	if err := utils.ValidateParams(r, append([]string{"status"}, utils.PageParams...)); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	params := deliveryListParams{Status: r.URL.Query().Get("status")}
+	if err := utils.GetValidator().Struct(params); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	page, ok := deliveryPage(w, r)
+	if !ok {
+		return
+	}
+
+	sub, ok := app.ownWebhook(w, r)
+	if !ok {
+		return
+	}
+
+	rows, next, err := app.store.Auth.Webhooks().ListDeliveries(r.Context(), sub.ID, utils.NilIfEmpty(&params.Status), page)
+	if err != nil {
+		utils.HandleDatabaseError(w, r, err)
+		return
+	}
+	writeDeliveries(w, r, rows, page, next)
+}
+
+// ListDeadLetters godoc
+//
+//	@Summary		Webhook dead letters
+//	@Description	Deliveries of all the client's subscriptions that ran out of attempts, newest first. Send one again with POST /webhooks/deliveries/{id}/redeliver.
+//	@Tags			Webhooks
+//	@Produce		json
+//	@Param			limit	query		integer	false	"Page size (max 1000)"
+//	@Param			cursor	query		string	false	"Cursor from next_cursor"
+//	@Success		200		{object}	apitypes.WebhookDeliveryListResponse
+//	@Failure		400		{object}	apitypes.ValidationErrorResponse
+//	@Failure		401		{object}	apitypes.UnauthorizedResponse
+//	@Failure		500		{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503		{object}	apitypes.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/webhooks/dead-letters [get]
+func (app *api) listDeadLettersHandler(w http.ResponseWriter, r *http.Request) {
+	if err := utils.ValidateParams(r, utils.PageParams); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	page, ok := deliveryPage(w, r)
+	if !ok {
+		return
+	}
+
+	rows, next, err := app.store.Auth.Webhooks().ListDeadDeliveries(r.Context(), authn.GetClientName(r.Context()), page)
+	if err != nil {
+		utils.HandleDatabaseError(w, r, err)
+		return
+	}
+	writeDeliveries(w, r, rows, page, next)
+}
+
+// RedeliverWebhook godoc
+//
+//	@Summary		Retry a dead letter
+//	@Description	Queues a dead-lettered delivery for a new round of attempts, with the original event ID and payload
+//	@Tags			Webhooks
+//	@Produce		json
+//	@Param			id	path		integer	true	"Delivery ID"
+//	@Success		202	{object}	apitypes.WebhookDeliveryResponse
+//	@Failure		400	{object}	apitypes.ValidationErrorResponse
+//	@Failure		401	{object}	apitypes.UnauthorizedResponse
+//	@Failure		404	{object}	apitypes.NotFoundResponse
+//	@Failure		409	{object}	apitypes.ConflictResponse
+//	@Failure		500	{object}	apitypes.InternalServerErrorResponse
+//	@Failure		503	{object}	apitypes.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/webhooks/deliveries/{id}/redeliver [post]
+func (app *api) redeliverWebhookHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := utils.ParsePositiveInt64(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	store := app.store.Auth.Webhooks()
+	d, err := store.GetDelivery(r.Context(), id)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && !ownedByCaller(r, d.ClientName)) {
+		utils.NotFoundResponse(w, r, fmt.Errorf("delivery not found"))
+		return
+	}
+	if err != nil {
+		utils.HandleDatabaseError(w, r, err)
+		return
+	}
+
+	requeued, err := store.RequeueDelivery(r.Context(), id)
+	if err != nil {
+		utils.HandleDatabaseError(w, r, err)
+		return
+	}
+	if !requeued {
+		utils.ConflictResponse(w, r, fmt.Errorf("delivery %d is %s; only dead letters can be redelivered", id, d.Status))
+		return
+	}
+	app.webhooks.Wake()
+
+	now := time.Now().UTC()
+	resp := deliveryFromRow(authsqlc.WebhookDelivery{
+		ID:             d.ID,
+		SubscriptionID: d.SubscriptionID,
+		EventID:        d.EventID,
+		EventType:      d.EventType,
+		Payload:        d.Payload,
+		Status:         auth.DeliveryPending,
+		NextAttemptAt:  now,
+		LastAttemptAt:  d.LastAttemptAt,
+		LastStatusCode: d.LastStatusCode,
+		LastError:      d.LastError,
+		CreatedAt:      d.CreatedAt,
+	})
+	w.Header().Set("Location", fmt.Sprintf("%s/webhooks/%d/deliveries", apiPrefix(r), d.SubscriptionID))
+	utils.WriteJSON(w, http.StatusAccepted, resp)
+}
+
+// deliveryPage parses the page of a delivery list; the log can grow large, so
+// it is always paged
+func deliveryPage(w http.ResponseWriter, r *http.Request) (utils.Page, bool) {
+	page, err := utils.ParsePage(r)
+	if err == nil && !page.Paged() {
+		page, err = utils.NewPage(utils.DefaultPageLimit, "")
+	}
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return page, false
+	}
+	return page, true
+}
+
+func writeDeliveries(w http.ResponseWriter, r *http.Request, rows []authsqlc.WebhookDelivery, page utils.Page, next string) {
+	out := make([]apitypes.WebhookDeliveryResponse, 0, len(rows))
+	for _, d := range rows {
+		out = append(out, deliveryFromRow(d))
+	}
+	resp := map[string]any{"deliveries": out}
+	utils.AddPageInfo(resp, page, next)
+	utils.SetNextLink(w, r, next)
+	utils.WriteJSON(w, http.StatusOK, resp)
+}
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS api_usage_rollups (
+    bucket_date DATE NOT NULL,
+    client_name TEXT NOT NULL,
+    route_group TEXT NOT NULL,
+    request_count BIGINT NOT NULL DEFAULT 0,
+    client_error_count BIGINT NOT NULL DEFAULT 0,
+    server_error_count BIGINT NOT NULL DEFAULT 0,
+    bytes_in BIGINT NOT NULL DEFAULT 0,
+    bytes_out BIGINT NOT NULL DEFAULT 0,
+    latency_sum_ms DOUBLE PRECISION NOT NULL DEFAULT 0,
+    latency_buckets BIGINT[] NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (bucket_date, client_name, route_group)
+);
+
+CREATE INDEX IF NOT EXISTS idx_api_usage_rollups_client ON api_usage_rollups (client_name, bucket_date);
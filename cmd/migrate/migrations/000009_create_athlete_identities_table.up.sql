@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS athlete_identities (
+    id SERIAL PRIMARY KEY,
+    sportti_id TEXT UNIQUE,
+    utv_user_id UUID UNIQUE,
+    tietoevry_user_id UUID UNIQUE,
+    fiscode INTEGER UNIQUE,
+    fis_competitorid INTEGER UNIQUE,
+    kamk_user_id INTEGER UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
@@ -0,0 +1,2 @@
+-- The removed response bodies cannot be restored
+SELECT 1;
@@ -0,0 +1,5 @@
+-- Failed deliveries used to keep the start of the response body in last_error,
+-- which the subscriber can read back; keep only the status part
+UPDATE webhook_deliveries
+SET last_error = substring(last_error FROM '^unexpected status [0-9]+')
+WHERE last_error LIKE 'unexpected status %:%';
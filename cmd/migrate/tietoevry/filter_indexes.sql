@@ -0,0 +1,36 @@
+-- Indexes for the Tietoevry list filters (from/to, updated_since) and their
+-- keyset pagination, as in internal/db/tietoevry/sqlc/schema.sql.
+--
+-- The Tietoevry database is not managed by cmd/migrate, so this script is run
+-- by hand with psql (make tietoevry-indexes). CONCURRENTLY keeps the tables
+-- writable while the indexes build; it cannot run inside a transaction, so do
+-- not pass --single-transaction. A build that fails leaves an INVALID index
+-- behind, which IF NOT EXISTS would skip: drop it and run the script again.
+
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_exercises_user_start_time
+    ON exercises(user_id, start_time DESC, id DESC);
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_exercises_user_updated_at
+    ON exercises(user_id, updated_at);
+
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_symptoms_user_date
+    ON symptoms(user_id, date DESC, created_at DESC, id DESC);
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_symptoms_user_updated_at
+    ON symptoms(user_id, updated_at);
+
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_measurements_user_date
+    ON measurements(user_id, date DESC, created_at DESC, id DESC);
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_measurements_user_updated_at
+    ON measurements(user_id, updated_at);
+
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_test_results_user_timestamp
+    ON test_results(user_id, "timestamp" DESC, created_at DESC, id DESC);
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_test_results_user_updated_at
+    ON test_results(user_id, updated_at);
+
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_question_answers_user_created_at
+    ON question_answers(user_id, created_at DESC);
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_question_answers_user_updated_at
+    ON question_answers(user_id, updated_at);
+
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_activity_zones_user_updated_at
+    ON activity_zones(user_id, updated_at);
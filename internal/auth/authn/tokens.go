@@ -80,3 +80,16 @@ func ValidateJWT(tokenStr string) (*jwt.Token, jwt.MapClaims, error) {
 
 	return token, claims, nil
 }
+
+// RolesFromClaims returns the string roles of a validated token
+func RolesFromClaims(claims jwt.MapClaims) []string {
+	rawRoles, _ := claims["roles"].([]interface{})
+
+	var roles []string
+	for _, r := range rawRoles {
+		if s, ok := r.(string); ok {
+			roles = append(roles, s)
+		}
+	}
+	return roles
+}
@@ -9,8 +9,13 @@ import (
 )
 
 func Authorize(r *http.Request) bool {
-	target := fmt.Sprintf("%s:%s", r.Method, r.URL.Path)
-	roles := authn.GetClientRoles(r.Context())
+	return Can(authn.GetClientRoles(r.Context()), r.Method, r.URL.Path)
+}
+
+// Can reports whether roles allow method on path, for callers that check
+// access to a route without serving it
+func Can(roles []string, method, path string) bool {
+	target := fmt.Sprintf("%s:%s", method, path)
 
 	for _, role := range roles {
 		for _, perm := range RolePermissions[role] {
@@ -80,4 +80,15 @@ var RolePermissions = map[string][]string{
 	"archinisis_read": {
 		"GET:/v1/archinisis",
 	},
+
+	// Athlete identity roles
+	"identities": {
+		"GET:/v1/identities",
+		"POST:/v1/identities",
+		"PUT:/v1/identities",
+		"DELETE:/v1/identities",
+	},
+	"identities_read": {
+		"GET:/v1/identities",
+	},
 }
@@ -27,6 +27,12 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.addClientRoleStmt, err = db.PrepareContext(ctx, addClientRole); err != nil {
 		return nil, fmt.Errorf("error preparing query AddClientRole: %w", err)
 	}
+	if q.claimDueWebhookDeliveriesStmt, err = db.PrepareContext(ctx, claimDueWebhookDeliveries); err != nil {
+		return nil, fmt.Errorf("error preparing query ClaimDueWebhookDeliveries: %w", err)
+	}
+	if q.createAthleteIdentityStmt, err = db.PrepareContext(ctx, createAthleteIdentity); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateAthleteIdentity: %w", err)
+	}
 	if q.createClientStmt, err = db.PrepareContext(ctx, createClient); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateClient: %w", err)
 	}
@@ -39,15 +45,27 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.createRevokedTokenStmt, err = db.PrepareContext(ctx, createRevokedToken); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateRevokedToken: %w", err)
 	}
+	if q.createWebhookStmt, err = db.PrepareContext(ctx, createWebhook); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateWebhook: %w", err)
+	}
+	if q.createWebhookDeliveryStmt, err = db.PrepareContext(ctx, createWebhookDelivery); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateWebhookDelivery: %w", err)
+	}
 	if q.deleteAllRefreshTokensForClientStmt, err = db.PrepareContext(ctx, deleteAllRefreshTokensForClient); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteAllRefreshTokensForClient: %w", err)
 	}
+	if q.deleteAthleteIdentityStmt, err = db.PrepareContext(ctx, deleteAthleteIdentity); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteAthleteIdentity: %w", err)
+	}
 	if q.deleteClientStmt, err = db.PrepareContext(ctx, deleteClient); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteClient: %w", err)
 	}
 	if q.deleteExpiredRefreshTokensStmt, err = db.PrepareContext(ctx, deleteExpiredRefreshTokens); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteExpiredRefreshTokens: %w", err)
 	}
+	if q.deleteOldWebhookDeliveriesStmt, err = db.PrepareContext(ctx, deleteOldWebhookDeliveries); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteOldWebhookDeliveries: %w", err)
+	}
 	if q.deleteRefreshTokenStmt, err = db.PrepareContext(ctx, deleteRefreshToken); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteRefreshToken: %w", err)
 	}
@@ -60,6 +78,15 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.deleteRevokedTokenStmt, err = db.PrepareContext(ctx, deleteRevokedToken); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteRevokedToken: %w", err)
 	}
+	if q.deleteWebhookStmt, err = db.PrepareContext(ctx, deleteWebhook); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteWebhook: %w", err)
+	}
+	if q.findAthleteIdentitiesStmt, err = db.PrepareContext(ctx, findAthleteIdentities); err != nil {
+		return nil, fmt.Errorf("error preparing query FindAthleteIdentities: %w", err)
+	}
+	if q.getAthleteIdentityStmt, err = db.PrepareContext(ctx, getAthleteIdentity); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAthleteIdentity: %w", err)
+	}
 	if q.getClientByNameStmt, err = db.PrepareContext(ctx, getClientByName); err != nil {
 		return nil, fmt.Errorf("error preparing query GetClientByName: %w", err)
 	}
@@ -87,6 +114,15 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getRefreshTokenByClientStmt, err = db.PrepareContext(ctx, getRefreshTokenByClient); err != nil {
 		return nil, fmt.Errorf("error preparing query GetRefreshTokenByClient: %w", err)
 	}
+	if q.getUsageRollupsStmt, err = db.PrepareContext(ctx, getUsageRollups); err != nil {
+		return nil, fmt.Errorf("error preparing query GetUsageRollups: %w", err)
+	}
+	if q.getWebhookStmt, err = db.PrepareContext(ctx, getWebhook); err != nil {
+		return nil, fmt.Errorf("error preparing query GetWebhook: %w", err)
+	}
+	if q.getWebhookDeliveryStmt, err = db.PrepareContext(ctx, getWebhookDelivery); err != nil {
+		return nil, fmt.Errorf("error preparing query GetWebhookDelivery: %w", err)
+	}
 	if q.hasRoleStmt, err = db.PrepareContext(ctx, hasRole); err != nil {
 		return nil, fmt.Errorf("error preparing query HasRole: %w", err)
 	}
@@ -108,18 +144,51 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.isRevokedTokenStmt, err = db.PrepareContext(ctx, isRevokedToken); err != nil {
 		return nil, fmt.Errorf("error preparing query IsRevokedToken: %w", err)
 	}
+	if q.linkAthleteIdentifiersStmt, err = db.PrepareContext(ctx, linkAthleteIdentifiers); err != nil {
+		return nil, fmt.Errorf("error preparing query LinkAthleteIdentifiers: %w", err)
+	}
+	if q.listActiveWebhooksForEventStmt, err = db.PrepareContext(ctx, listActiveWebhooksForEvent); err != nil {
+		return nil, fmt.Errorf("error preparing query ListActiveWebhooksForEvent: %w", err)
+	}
 	if q.listClientsStmt, err = db.PrepareContext(ctx, listClients); err != nil {
 		return nil, fmt.Errorf("error preparing query ListClients: %w", err)
 	}
+	if q.listDeadWebhookDeliveriesStmt, err = db.PrepareContext(ctx, listDeadWebhookDeliveries); err != nil {
+		return nil, fmt.Errorf("error preparing query ListDeadWebhookDeliveries: %w", err)
+	}
+	if q.listRecentTokenLogsStmt, err = db.PrepareContext(ctx, listRecentTokenLogs); err != nil {
+		return nil, fmt.Errorf("error preparing query ListRecentTokenLogs: %w", err)
+	}
+	if q.listWebhookDeliveriesStmt, err = db.PrepareContext(ctx, listWebhookDeliveries); err != nil {
+		return nil, fmt.Errorf("error preparing query ListWebhookDeliveries: %w", err)
+	}
+	if q.listWebhooksByClientStmt, err = db.PrepareContext(ctx, listWebhooksByClient); err != nil {
+		return nil, fmt.Errorf("error preparing query ListWebhooksByClient: %w", err)
+	}
+	if q.recordWebhookAttemptStmt, err = db.PrepareContext(ctx, recordWebhookAttempt); err != nil {
+		return nil, fmt.Errorf("error preparing query RecordWebhookAttempt: %w", err)
+	}
 	if q.removeClientRoleStmt, err = db.PrepareContext(ctx, removeClientRole); err != nil {
 		return nil, fmt.Errorf("error preparing query RemoveClientRole: %w", err)
 	}
+	if q.requeueWebhookDeliveryStmt, err = db.PrepareContext(ctx, requeueWebhookDelivery); err != nil {
+		return nil, fmt.Errorf("error preparing query RequeueWebhookDelivery: %w", err)
+	}
+	if q.unlinkAthleteIdentifierStmt, err = db.PrepareContext(ctx, unlinkAthleteIdentifier); err != nil {
+		return nil, fmt.Errorf("error preparing query UnlinkAthleteIdentifier: %w", err)
+	}
 	if q.updateClientRolesStmt, err = db.PrepareContext(ctx, updateClientRoles); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateClientRoles: %w", err)
 	}
 	if q.updateClientTokenStmt, err = db.PrepareContext(ctx, updateClientToken); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateClientToken: %w", err)
 	}
+	if q.updateWebhookStmt, err = db.PrepareContext(ctx, updateWebhook); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateWebhook: %w", err)
+	}
+	if q.upsertUsageRollupStmt, err = db.PrepareContext(ctx, upsertUsageRollup); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertUsageRollup: %w", err)
+	}
 	return &q, nil
 }
 
@@ -130,6 +199,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing addClientRoleStmt: %w", cerr)
 		}
 	}
+	if q.claimDueWebhookDeliveriesStmt != nil {
+		if cerr := q.claimDueWebhookDeliveriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing claimDueWebhookDeliveriesStmt: %w", cerr)
+		}
+	}
+	if q.createAthleteIdentityStmt != nil {
+		if cerr := q.createAthleteIdentityStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createAthleteIdentityStmt: %w", cerr)
+		}
+	}
 	if q.createClientStmt != nil {
 		if cerr := q.createClientStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createClientStmt: %w", cerr)
@@ -150,11 +229,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createRevokedTokenStmt: %w", cerr)
 		}
 	}
+	if q.createWebhookStmt != nil {
+		if cerr := q.createWebhookStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createWebhookStmt: %w", cerr)
+		}
+	}
+	if q.createWebhookDeliveryStmt != nil {
+		if cerr := q.createWebhookDeliveryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createWebhookDeliveryStmt: %w", cerr)
+		}
+	}
 	if q.deleteAllRefreshTokensForClientStmt != nil {
 		if cerr := q.deleteAllRefreshTokensForClientStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteAllRefreshTokensForClientStmt: %w", cerr)
 		}
 	}
+	if q.deleteAthleteIdentityStmt != nil {
+		if cerr := q.deleteAthleteIdentityStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteAthleteIdentityStmt: %w", cerr)
+		}
+	}
 	if q.deleteClientStmt != nil {
 		if cerr := q.deleteClientStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteClientStmt: %w", cerr)
@@ -165,6 +259,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteExpiredRefreshTokensStmt: %w", cerr)
 		}
 	}
+	if q.deleteOldWebhookDeliveriesStmt != nil {
+		if cerr := q.deleteOldWebhookDeliveriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteOldWebhookDeliveriesStmt: %w", cerr)
+		}
+	}
 	if q.deleteRefreshTokenStmt != nil {
 		if cerr := q.deleteRefreshTokenStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteRefreshTokenStmt: %w", cerr)
@@ -185,6 +284,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteRevokedTokenStmt: %w", cerr)
 		}
 	}
+	if q.deleteWebhookStmt != nil {
+		if cerr := q.deleteWebhookStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteWebhookStmt: %w", cerr)
+		}
+	}
+	if q.findAthleteIdentitiesStmt != nil {
+		if cerr := q.findAthleteIdentitiesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findAthleteIdentitiesStmt: %w", cerr)
+		}
+	}
+	if q.getAthleteIdentityStmt != nil {
+		if cerr := q.getAthleteIdentityStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAthleteIdentityStmt: %w", cerr)
+		}
+	}
 	if q.getClientByNameStmt != nil {
 		if cerr := q.getClientByNameStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getClientByNameStmt: %w", cerr)
@@ -230,6 +344,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getRefreshTokenByClientStmt: %w", cerr)
 		}
 	}
+	if q.getUsageRollupsStmt != nil {
+		if cerr := q.getUsageRollupsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getUsageRollupsStmt: %w", cerr)
+		}
+	}
+	if q.getWebhookStmt != nil {
+		if cerr := q.getWebhookStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getWebhookStmt: %w", cerr)
+		}
+	}
+	if q.getWebhookDeliveryStmt != nil {
+		if cerr := q.getWebhookDeliveryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getWebhookDeliveryStmt: %w", cerr)
+		}
+	}
 	if q.hasRoleStmt != nil {
 		if cerr := q.hasRoleStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing hasRoleStmt: %w", cerr)
@@ -265,16 +394,61 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing isRevokedTokenStmt: %w", cerr)
 		}
 	}
+	if q.linkAthleteIdentifiersStmt != nil {
+		if cerr := q.linkAthleteIdentifiersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing linkAthleteIdentifiersStmt: %w", cerr)
+		}
+	}
+	if q.listActiveWebhooksForEventStmt != nil {
+		if cerr := q.listActiveWebhooksForEventStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listActiveWebhooksForEventStmt: %w", cerr)
+		}
+	}
 	if q.listClientsStmt != nil {
 		if cerr := q.listClientsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listClientsStmt: %w", cerr)
 		}
 	}
+	if q.listDeadWebhookDeliveriesStmt != nil {
+		if cerr := q.listDeadWebhookDeliveriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listDeadWebhookDeliveriesStmt: %w", cerr)
+		}
+	}
+	if q.listRecentTokenLogsStmt != nil {
+		if cerr := q.listRecentTokenLogsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listRecentTokenLogsStmt: %w", cerr)
+		}
+	}
+	if q.listWebhookDeliveriesStmt != nil {
+		if cerr := q.listWebhookDeliveriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listWebhookDeliveriesStmt: %w", cerr)
+		}
+	}
+	if q.listWebhooksByClientStmt != nil {
+		if cerr := q.listWebhooksByClientStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listWebhooksByClientStmt: %w", cerr)
+		}
+	}
+	if q.recordWebhookAttemptStmt != nil {
+		if cerr := q.recordWebhookAttemptStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing recordWebhookAttemptStmt: %w", cerr)
+		}
+	}
 	if q.removeClientRoleStmt != nil {
 		if cerr := q.removeClientRoleStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing removeClientRoleStmt: %w", cerr)
 		}
 	}
+	if q.requeueWebhookDeliveryStmt != nil {
+		if cerr := q.requeueWebhookDeliveryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing requeueWebhookDeliveryStmt: %w", cerr)
+		}
+	}
+	if q.unlinkAthleteIdentifierStmt != nil {
+		if cerr := q.unlinkAthleteIdentifierStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing unlinkAthleteIdentifierStmt: %w", cerr)
+		}
+	}
 	if q.updateClientRolesStmt != nil {
 		if cerr := q.updateClientRolesStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateClientRolesStmt: %w", cerr)
@@ -285,6 +459,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateClientTokenStmt: %w", cerr)
 		}
 	}
+	if q.updateWebhookStmt != nil {
+		if cerr := q.updateWebhookStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateWebhookStmt: %w", cerr)
+		}
+	}
+	if q.upsertUsageRollupStmt != nil {
+		if cerr := q.upsertUsageRollupStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertUsageRollupStmt: %w", cerr)
+		}
+	}
 	return err
 }
 
@@ -325,17 +509,26 @@ type Queries struct {
 	db                                  DBTX
 	tx                                  *sql.Tx
 	addClientRoleStmt                   *sql.Stmt
+	claimDueWebhookDeliveriesStmt       *sql.Stmt
+	createAthleteIdentityStmt           *sql.Stmt
 	createClientStmt                    *sql.Stmt
 	createRefreshTokenStmt              *sql.Stmt
 	createRevokedRefreshTokenStmt       *sql.Stmt
 	createRevokedTokenStmt              *sql.Stmt
+	createWebhookDeliveryStmt           *sql.Stmt
+	createWebhookStmt                   *sql.Stmt
 	deleteAllRefreshTokensForClientStmt *sql.Stmt
+	deleteAthleteIdentityStmt           *sql.Stmt
 	deleteClientStmt                    *sql.Stmt
 	deleteExpiredRefreshTokensStmt      *sql.Stmt
-	deleteRefreshTokenStmt              *sql.Stmt
+	deleteOldWebhookDeliveriesStmt      *sql.Stmt
 	deleteRefreshTokenByTokenStmt       *sql.Stmt
+	deleteRefreshTokenStmt              *sql.Stmt
 	deleteRevokedRefreshTokenStmt       *sql.Stmt
 	deleteRevokedTokenStmt              *sql.Stmt
+	deleteWebhookStmt                   *sql.Stmt
+	findAthleteIdentitiesStmt           *sql.Stmt
+	getAthleteIdentityStmt              *sql.Stmt
 	getClientByNameStmt                 *sql.Stmt
 	getClientByTokenStmt                *sql.Stmt
 	getClientRolesStmt                  *sql.Stmt
@@ -343,8 +536,11 @@ type Queries struct {
 	getLogsByActionStmt                 *sql.Stmt
 	getLogsByClientStmt                 *sql.Stmt
 	getLogsByTokenTypeStmt              *sql.Stmt
-	getRefreshTokenStmt                 *sql.Stmt
 	getRefreshTokenByClientStmt         *sql.Stmt
+	getRefreshTokenStmt                 *sql.Stmt
+	getUsageRollupsStmt                 *sql.Stmt
+	getWebhookDeliveryStmt              *sql.Stmt
+	getWebhookStmt                      *sql.Stmt
 	hasRoleStmt                         *sql.Stmt
 	insertNewRefreshTokenStmt           *sql.Stmt
 	insertRevokedRefreshTokenStmt       *sql.Stmt
@@ -352,10 +548,21 @@ type Queries struct {
 	isRefreshTokenExpiredStmt           *sql.Stmt
 	isRevokedRefreshTokenStmt           *sql.Stmt
 	isRevokedTokenStmt                  *sql.Stmt
+	linkAthleteIdentifiersStmt          *sql.Stmt
+	listActiveWebhooksForEventStmt      *sql.Stmt
 	listClientsStmt                     *sql.Stmt
+	listDeadWebhookDeliveriesStmt       *sql.Stmt
+	listRecentTokenLogsStmt             *sql.Stmt
+	listWebhookDeliveriesStmt           *sql.Stmt
+	listWebhooksByClientStmt            *sql.Stmt
+	recordWebhookAttemptStmt            *sql.Stmt
 	removeClientRoleStmt                *sql.Stmt
+	requeueWebhookDeliveryStmt          *sql.Stmt
+	unlinkAthleteIdentifierStmt         *sql.Stmt
 	updateClientRolesStmt               *sql.Stmt
 	updateClientTokenStmt               *sql.Stmt
+	updateWebhookStmt                   *sql.Stmt
+	upsertUsageRollupStmt               *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
@@ -363,17 +570,26 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		db:                                  tx,
 		tx:                                  tx,
 		addClientRoleStmt:                   q.addClientRoleStmt,
+		claimDueWebhookDeliveriesStmt:       q.claimDueWebhookDeliveriesStmt,
+		createAthleteIdentityStmt:           q.createAthleteIdentityStmt,
 		createClientStmt:                    q.createClientStmt,
 		createRefreshTokenStmt:              q.createRefreshTokenStmt,
 		createRevokedRefreshTokenStmt:       q.createRevokedRefreshTokenStmt,
 		createRevokedTokenStmt:              q.createRevokedTokenStmt,
+		createWebhookDeliveryStmt:           q.createWebhookDeliveryStmt,
+		createWebhookStmt:                   q.createWebhookStmt,
 		deleteAllRefreshTokensForClientStmt: q.deleteAllRefreshTokensForClientStmt,
+		deleteAthleteIdentityStmt:           q.deleteAthleteIdentityStmt,
 		deleteClientStmt:                    q.deleteClientStmt,
 		deleteExpiredRefreshTokensStmt:      q.deleteExpiredRefreshTokensStmt,
-		deleteRefreshTokenStmt:              q.deleteRefreshTokenStmt,
+		deleteOldWebhookDeliveriesStmt:      q.deleteOldWebhookDeliveriesStmt,
 		deleteRefreshTokenByTokenStmt:       q.deleteRefreshTokenByTokenStmt,
+		deleteRefreshTokenStmt:              q.deleteRefreshTokenStmt,
 		deleteRevokedRefreshTokenStmt:       q.deleteRevokedRefreshTokenStmt,
 		deleteRevokedTokenStmt:              q.deleteRevokedTokenStmt,
+		deleteWebhookStmt:                   q.deleteWebhookStmt,
+		findAthleteIdentitiesStmt:           q.findAthleteIdentitiesStmt,
+		getAthleteIdentityStmt:              q.getAthleteIdentityStmt,
 		getClientByNameStmt:                 q.getClientByNameStmt,
 		getClientByTokenStmt:                q.getClientByTokenStmt,
 		getClientRolesStmt:                  q.getClientRolesStmt,
@@ -381,8 +597,11 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		getLogsByActionStmt:                 q.getLogsByActionStmt,
 		getLogsByClientStmt:                 q.getLogsByClientStmt,
 		getLogsByTokenTypeStmt:              q.getLogsByTokenTypeStmt,
-		getRefreshTokenStmt:                 q.getRefreshTokenStmt,
 		getRefreshTokenByClientStmt:         q.getRefreshTokenByClientStmt,
+		getRefreshTokenStmt:                 q.getRefreshTokenStmt,
+		getUsageRollupsStmt:                 q.getUsageRollupsStmt,
+		getWebhookDeliveryStmt:              q.getWebhookDeliveryStmt,
+		getWebhookStmt:                      q.getWebhookStmt,
 		hasRoleStmt:                         q.hasRoleStmt,
 		insertNewRefreshTokenStmt:           q.insertNewRefreshTokenStmt,
 		insertRevokedRefreshTokenStmt:       q.insertRevokedRefreshTokenStmt,
@@ -390,9 +609,20 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		isRefreshTokenExpiredStmt:           q.isRefreshTokenExpiredStmt,
 		isRevokedRefreshTokenStmt:           q.isRevokedRefreshTokenStmt,
 		isRevokedTokenStmt:                  q.isRevokedTokenStmt,
+		linkAthleteIdentifiersStmt:          q.linkAthleteIdentifiersStmt,
+		listActiveWebhooksForEventStmt:      q.listActiveWebhooksForEventStmt,
 		listClientsStmt:                     q.listClientsStmt,
+		listDeadWebhookDeliveriesStmt:       q.listDeadWebhookDeliveriesStmt,
+		listRecentTokenLogsStmt:             q.listRecentTokenLogsStmt,
+		listWebhookDeliveriesStmt:           q.listWebhookDeliveriesStmt,
+		listWebhooksByClientStmt:            q.listWebhooksByClientStmt,
+		recordWebhookAttemptStmt:            q.recordWebhookAttemptStmt,
 		removeClientRoleStmt:                q.removeClientRoleStmt,
+		requeueWebhookDeliveryStmt:          q.requeueWebhookDeliveryStmt,
+		unlinkAthleteIdentifierStmt:         q.unlinkAthleteIdentifierStmt,
 		updateClientRolesStmt:               q.updateClientRolesStmt,
 		updateClientTokenStmt:               q.updateClientTokenStmt,
+		updateWebhookStmt:                   q.updateWebhookStmt,
+		upsertUsageRollupStmt:               q.upsertUsageRollupStmt,
 	}
 }
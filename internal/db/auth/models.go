@@ -6,11 +6,39 @@ package authsqlc
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/sqlc-dev/pqtype"
 )
 
+type ApiUsageRollup struct {
+	BucketDate       time.Time
+	ClientName       string
+	RouteGroup       string
+	RequestCount     int64
+	ClientErrorCount int64
+	ServerErrorCount int64
+	BytesIn          int64
+	BytesOut         int64
+	LatencySumMs     float64
+	LatencyBuckets   []int64
+	UpdatedAt        time.Time
+}
+
+type AthleteIdentity struct {
+	ID              int32
+	SporttiID       sql.NullString
+	UtvUserID       uuid.NullUUID
+	TietoevryUserID uuid.NullUUID
+	Fiscode         sql.NullInt32
+	FisCompetitorid sql.NullInt32
+	KamkUserID      sql.NullInt32
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
 type Client struct {
 	ID          int32
 	ClientName  string
@@ -48,3 +76,29 @@ type TokenLog struct {
 	Metadata    pqtype.NullRawMessage
 	CreatedAt   sql.NullTime
 }
+
+type WebhookDelivery struct {
+	ID             int64
+	SubscriptionID int32
+	EventID        string
+	EventType      string
+	Payload        json.RawMessage
+	Status         string
+	Attempts       int32
+	NextAttemptAt  time.Time
+	LastAttemptAt  sql.NullTime
+	LastStatusCode sql.NullInt32
+	LastError      sql.NullString
+	CreatedAt      time.Time
+}
+
+type WebhookSubscription struct {
+	ID         int32
+	ClientName string
+	Url        string
+	Events     []string
+	Secret     string
+	Active     bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
@@ -8,8 +8,10 @@ package authsqlc
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/lib/pq"
 	"github.com/sqlc-dev/pqtype"
 )
@@ -30,6 +32,107 @@ func (q *Queries) AddClientRole(ctx context.Context, arg AddClientRoleParams) er
 	return err
 }
 
+const claimDueWebhookDeliveries = `-- name: ClaimDueWebhookDeliveries :many
+UPDATE webhook_deliveries d
+SET next_attempt_at = now() + make_interval(secs => $1::int)
+FROM webhook_subscriptions s
+WHERE s.id = d.subscription_id
+  AND d.id IN (
+    SELECT id
+    FROM webhook_deliveries
+    WHERE status = 'pending' AND next_attempt_at <= now()
+    ORDER BY next_attempt_at
+    LIMIT $2::int
+    FOR UPDATE SKIP LOCKED
+  )
+RETURNING d.id, d.event_id, d.event_type, d.payload, d.attempts, s.url, s.secret
+`
+
+type ClaimDueWebhookDeliveriesParams struct {
+	LeaseSeconds int32
+	BatchSize    int32
+}
+
+type ClaimDueWebhookDeliveriesRow struct {
+	ID        int64
+	EventID   string
+	EventType string
+	Payload   json.RawMessage
+	Attempts  int32
+	Url       string
+	Secret    string
+}
+
+func (q *Queries) ClaimDueWebhookDeliveries(ctx context.Context, arg ClaimDueWebhookDeliveriesParams) ([]ClaimDueWebhookDeliveriesRow, error) {
+	rows, err := q.query(ctx, q.claimDueWebhookDeliveriesStmt, claimDueWebhookDeliveries, arg.LeaseSeconds, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClaimDueWebhookDeliveriesRow
+	for rows.Next() {
+		var i ClaimDueWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.EventType,
+			&i.Payload,
+			&i.Attempts,
+			&i.Url,
+			&i.Secret,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createAthleteIdentity = `-- name: CreateAthleteIdentity :one
+INSERT INTO athlete_identities (sportti_id, utv_user_id, tietoevry_user_id, fiscode, fis_competitorid, kamk_user_id)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, sportti_id, utv_user_id, tietoevry_user_id, fiscode, fis_competitorid, kamk_user_id, created_at, updated_at
+`
+
+type CreateAthleteIdentityParams struct {
+	SporttiID       sql.NullString
+	UtvUserID       uuid.NullUUID
+	TietoevryUserID uuid.NullUUID
+	Fiscode         sql.NullInt32
+	FisCompetitorid sql.NullInt32
+	KamkUserID      sql.NullInt32
+}
+
+func (q *Queries) CreateAthleteIdentity(ctx context.Context, arg CreateAthleteIdentityParams) (AthleteIdentity, error) {
+	row := q.queryRow(ctx, q.createAthleteIdentityStmt, createAthleteIdentity,
+		arg.SporttiID,
+		arg.UtvUserID,
+		arg.TietoevryUserID,
+		arg.Fiscode,
+		arg.FisCompetitorid,
+		arg.KamkUserID,
+	)
+	var i AthleteIdentity
+	err := row.Scan(
+		&i.ID,
+		&i.SporttiID,
+		&i.UtvUserID,
+		&i.TietoevryUserID,
+		&i.Fiscode,
+		&i.FisCompetitorid,
+		&i.KamkUserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const createClient = `-- name: CreateClient :exec
 INSERT INTO clients (client_name, client_token, role)
 VALUES ($1, $2, $3)
@@ -82,6 +185,63 @@ func (q *Queries) CreateRevokedToken(ctx context.Context, clientToken string) er
 	return err
 }
 
+const createWebhook = `-- name: CreateWebhook :one
+INSERT INTO webhook_subscriptions (client_name, url, events, secret)
+VALUES ($1, $2, $3, $4)
+RETURNING id, client_name, url, events, secret, active, created_at, updated_at
+`
+
+type CreateWebhookParams struct {
+	ClientName string
+	Url        string
+	Events     []string
+	Secret     string
+}
+
+func (q *Queries) CreateWebhook(ctx context.Context, arg CreateWebhookParams) (WebhookSubscription, error) {
+	row := q.queryRow(ctx, q.createWebhookStmt, createWebhook,
+		arg.ClientName,
+		arg.Url,
+		pq.Array(arg.Events),
+		arg.Secret,
+	)
+	var i WebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.ClientName,
+		&i.Url,
+		pq.Array(&i.Events),
+		&i.Secret,
+		&i.Active,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createWebhookDelivery = `-- name: CreateWebhookDelivery :exec
+INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (subscription_id, event_id) DO NOTHING
+`
+
+type CreateWebhookDeliveryParams struct {
+	SubscriptionID int32
+	EventID        string
+	EventType      string
+	Payload        json.RawMessage
+}
+
+func (q *Queries) CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) error {
+	_, err := q.exec(ctx, q.createWebhookDeliveryStmt, createWebhookDelivery,
+		arg.SubscriptionID,
+		arg.EventID,
+		arg.EventType,
+		arg.Payload,
+	)
+	return err
+}
+
 const deleteAllRefreshTokensForClient = `-- name: DeleteAllRefreshTokensForClient :exec
 DELETE FROM refresh_tokens WHERE client_token = $1
 `
@@ -91,6 +251,19 @@ func (q *Queries) DeleteAllRefreshTokensForClient(ctx context.Context, clientTok
 	return err
 }
 
+const deleteAthleteIdentity = `-- name: DeleteAthleteIdentity :execrows
+DELETE FROM athlete_identities
+WHERE id = $1
+`
+
+func (q *Queries) DeleteAthleteIdentity(ctx context.Context, id int32) (int64, error) {
+	result, err := q.exec(ctx, q.deleteAthleteIdentityStmt, deleteAthleteIdentity, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const deleteClient = `-- name: DeleteClient :exec
 DELETE FROM clients WHERE client_token = $1
 `
@@ -109,6 +282,19 @@ func (q *Queries) DeleteExpiredRefreshTokens(ctx context.Context) error {
 	return err
 }
 
+const deleteOldWebhookDeliveries = `-- name: DeleteOldWebhookDeliveries :execrows
+DELETE FROM webhook_deliveries
+WHERE status = 'succeeded' AND created_at < $1
+`
+
+func (q *Queries) DeleteOldWebhookDeliveries(ctx context.Context, createdAt time.Time) (int64, error) {
+	result, err := q.exec(ctx, q.deleteOldWebhookDeliveriesStmt, deleteOldWebhookDeliveries, createdAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const deleteRefreshToken = `-- name: DeleteRefreshToken :exec
 DELETE FROM refresh_tokens WHERE token = $1
 `
@@ -146,6 +332,100 @@ func (q *Queries) DeleteRevokedToken(ctx context.Context, clientToken string) er
 	return err
 }
 
+const deleteWebhook = `-- name: DeleteWebhook :exec
+DELETE FROM webhook_subscriptions
+WHERE id = $1
+`
+
+func (q *Queries) DeleteWebhook(ctx context.Context, id int32) error {
+	_, err := q.exec(ctx, q.deleteWebhookStmt, deleteWebhook, id)
+	return err
+}
+
+const findAthleteIdentities = `-- name: FindAthleteIdentities :many
+SELECT id, sportti_id, utv_user_id, tietoevry_user_id, fiscode, fis_competitorid, kamk_user_id, created_at, updated_at
+FROM athlete_identities
+WHERE sportti_id = $1
+   OR utv_user_id = $2
+   OR tietoevry_user_id = $3
+   OR fiscode = $4
+   OR fis_competitorid = $5
+   OR kamk_user_id = $6
+ORDER BY id
+`
+
+type FindAthleteIdentitiesParams struct {
+	SporttiID       sql.NullString
+	UtvUserID       uuid.NullUUID
+	TietoevryUserID uuid.NullUUID
+	Fiscode         sql.NullInt32
+	FisCompetitorid sql.NullInt32
+	KamkUserID      sql.NullInt32
+}
+
+func (q *Queries) FindAthleteIdentities(ctx context.Context, arg FindAthleteIdentitiesParams) ([]AthleteIdentity, error) {
+	rows, err := q.query(ctx, q.findAthleteIdentitiesStmt, findAthleteIdentities,
+		arg.SporttiID,
+		arg.UtvUserID,
+		arg.TietoevryUserID,
+		arg.Fiscode,
+		arg.FisCompetitorid,
+		arg.KamkUserID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AthleteIdentity
+	for rows.Next() {
+		var i AthleteIdentity
+		if err := rows.Scan(
+			&i.ID,
+			&i.SporttiID,
+			&i.UtvUserID,
+			&i.TietoevryUserID,
+			&i.Fiscode,
+			&i.FisCompetitorid,
+			&i.KamkUserID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAthleteIdentity = `-- name: GetAthleteIdentity :one
+SELECT id, sportti_id, utv_user_id, tietoevry_user_id, fiscode, fis_competitorid, kamk_user_id, created_at, updated_at
+FROM athlete_identities
+WHERE id = $1
+`
+
+func (q *Queries) GetAthleteIdentity(ctx context.Context, id int32) (AthleteIdentity, error) {
+	row := q.queryRow(ctx, q.getAthleteIdentityStmt, getAthleteIdentity, id)
+	var i AthleteIdentity
+	err := row.Scan(
+		&i.ID,
+		&i.SporttiID,
+		&i.UtvUserID,
+		&i.TietoevryUserID,
+		&i.Fiscode,
+		&i.FisCompetitorid,
+		&i.KamkUserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const getClientByName = `-- name: GetClientByName :one
 SELECT id, client_name, client_token, role, created_at
 FROM clients
@@ -392,6 +672,141 @@ func (q *Queries) GetRefreshTokenByClient(ctx context.Context, clientToken strin
 	return i, err
 }
 
+const getUsageRollups = `-- name: GetUsageRollups :many
+SELECT bucket_date, client_name, route_group, request_count, client_error_count, server_error_count,
+       bytes_in, bytes_out, latency_sum_ms, latency_buckets
+FROM api_usage_rollups
+WHERE bucket_date BETWEEN $1 AND $2
+  AND ($3::text IS NULL OR client_name = $3)
+  AND ($4::text IS NULL OR route_group = $4)
+ORDER BY bucket_date, client_name, route_group
+`
+
+type GetUsageRollupsParams struct {
+	FromDate   time.Time
+	ToDate     time.Time
+	ClientName sql.NullString
+	RouteGroup sql.NullString
+}
+
+type GetUsageRollupsRow struct {
+	BucketDate       time.Time
+	ClientName       string
+	RouteGroup       string
+	RequestCount     int64
+	ClientErrorCount int64
+	ServerErrorCount int64
+	BytesIn          int64
+	BytesOut         int64
+	LatencySumMs     float64
+	LatencyBuckets   []int64
+}
+
+func (q *Queries) GetUsageRollups(ctx context.Context, arg GetUsageRollupsParams) ([]GetUsageRollupsRow, error) {
+	rows, err := q.query(ctx, q.getUsageRollupsStmt, getUsageRollups,
+		arg.FromDate,
+		arg.ToDate,
+		arg.ClientName,
+		arg.RouteGroup,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUsageRollupsRow
+	for rows.Next() {
+		var i GetUsageRollupsRow
+		if err := rows.Scan(
+			&i.BucketDate,
+			&i.ClientName,
+			&i.RouteGroup,
+			&i.RequestCount,
+			&i.ClientErrorCount,
+			&i.ServerErrorCount,
+			&i.BytesIn,
+			&i.BytesOut,
+			&i.LatencySumMs,
+			pq.Array(&i.LatencyBuckets),
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWebhook = `-- name: GetWebhook :one
+SELECT id, client_name, url, events, secret, active, created_at, updated_at
+FROM webhook_subscriptions
+WHERE id = $1
+`
+
+func (q *Queries) GetWebhook(ctx context.Context, id int32) (WebhookSubscription, error) {
+	row := q.queryRow(ctx, q.getWebhookStmt, getWebhook, id)
+	var i WebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.ClientName,
+		&i.Url,
+		pq.Array(&i.Events),
+		&i.Secret,
+		&i.Active,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getWebhookDelivery = `-- name: GetWebhookDelivery :one
+SELECT d.id, d.subscription_id, d.event_id, d.event_type, d.payload, d.status, d.attempts, d.next_attempt_at, d.last_attempt_at, d.last_status_code, d.last_error, d.created_at, s.client_name
+FROM webhook_deliveries d
+JOIN webhook_subscriptions s ON s.id = d.subscription_id
+WHERE d.id = $1
+`
+
+type GetWebhookDeliveryRow struct {
+	ID             int64
+	SubscriptionID int32
+	EventID        string
+	EventType      string
+	Payload        json.RawMessage
+	Status         string
+	Attempts       int32
+	NextAttemptAt  time.Time
+	LastAttemptAt  sql.NullTime
+	LastStatusCode sql.NullInt32
+	LastError      sql.NullString
+	CreatedAt      time.Time
+	ClientName     string
+}
+
+func (q *Queries) GetWebhookDelivery(ctx context.Context, id int64) (GetWebhookDeliveryRow, error) {
+	row := q.queryRow(ctx, q.getWebhookDeliveryStmt, getWebhookDelivery, id)
+	var i GetWebhookDeliveryRow
+	err := row.Scan(
+		&i.ID,
+		&i.SubscriptionID,
+		&i.EventID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.NextAttemptAt,
+		&i.LastAttemptAt,
+		&i.LastStatusCode,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.ClientName,
+	)
+	return i, err
+}
+
 const hasRole = `-- name: HasRole :one
 SELECT EXISTS (
   SELECT 1 FROM clients
@@ -512,6 +927,92 @@ func (q *Queries) IsRevokedToken(ctx context.Context, clientToken string) (bool,
 	return revoked, err
 }
 
+const linkAthleteIdentifiers = `-- name: LinkAthleteIdentifiers :one
+UPDATE athlete_identities
+SET sportti_id = COALESCE($1, sportti_id),
+    utv_user_id = COALESCE($2, utv_user_id),
+    tietoevry_user_id = COALESCE($3, tietoevry_user_id),
+    fiscode = COALESCE($4, fiscode),
+    fis_competitorid = COALESCE($5, fis_competitorid),
+    kamk_user_id = COALESCE($6, kamk_user_id),
+    updated_at = now()
+WHERE id = $7
+RETURNING id, sportti_id, utv_user_id, tietoevry_user_id, fiscode, fis_competitorid, kamk_user_id, created_at, updated_at
+`
+
+type LinkAthleteIdentifiersParams struct {
+	SporttiID       sql.NullString
+	UtvUserID       uuid.NullUUID
+	TietoevryUserID uuid.NullUUID
+	Fiscode         sql.NullInt32
+	FisCompetitorid sql.NullInt32
+	KamkUserID      sql.NullInt32
+	ID              int32
+}
+
+func (q *Queries) LinkAthleteIdentifiers(ctx context.Context, arg LinkAthleteIdentifiersParams) (AthleteIdentity, error) {
+	row := q.queryRow(ctx, q.linkAthleteIdentifiersStmt, linkAthleteIdentifiers,
+		arg.SporttiID,
+		arg.UtvUserID,
+		arg.TietoevryUserID,
+		arg.Fiscode,
+		arg.FisCompetitorid,
+		arg.KamkUserID,
+		arg.ID,
+	)
+	var i AthleteIdentity
+	err := row.Scan(
+		&i.ID,
+		&i.SporttiID,
+		&i.UtvUserID,
+		&i.TietoevryUserID,
+		&i.Fiscode,
+		&i.FisCompetitorid,
+		&i.KamkUserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listActiveWebhooksForEvent = `-- name: ListActiveWebhooksForEvent :many
+SELECT id, client_name, url, events, secret, active, created_at, updated_at
+FROM webhook_subscriptions
+WHERE active AND $1::text = ANY(events)
+`
+
+func (q *Queries) ListActiveWebhooksForEvent(ctx context.Context, eventType string) ([]WebhookSubscription, error) {
+	rows, err := q.query(ctx, q.listActiveWebhooksForEventStmt, listActiveWebhooksForEvent, eventType)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookSubscription
+	for rows.Next() {
+		var i WebhookSubscription
+		if err := rows.Scan(
+			&i.ID,
+			&i.ClientName,
+			&i.Url,
+			pq.Array(&i.Events),
+			&i.Secret,
+			&i.Active,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listClients = `-- name: ListClients :many
 SELECT id, client_name, client_token, role, created_at
 FROM clients
@@ -547,6 +1048,235 @@ func (q *Queries) ListClients(ctx context.Context) ([]Client, error) {
 	return items, nil
 }
 
+const listDeadWebhookDeliveries = `-- name: ListDeadWebhookDeliveries :many
+SELECT d.id, d.subscription_id, d.event_id, d.event_type, d.payload, d.status, d.attempts, d.next_attempt_at, d.last_attempt_at, d.last_status_code, d.last_error, d.created_at
+FROM webhook_deliveries d
+JOIN webhook_subscriptions s ON s.id = d.subscription_id
+WHERE s.client_name = $1
+  AND d.status = 'dead'
+  AND ($2::int8 IS NULL OR d.id < $2)
+ORDER BY d.id DESC
+LIMIT $3::int4
+`
+
+type ListDeadWebhookDeliveriesParams struct {
+	ClientName string
+	CursorID   sql.NullInt64
+	PageLimit  sql.NullInt32
+}
+
+func (q *Queries) ListDeadWebhookDeliveries(ctx context.Context, arg ListDeadWebhookDeliveriesParams) ([]WebhookDelivery, error) {
+	rows, err := q.query(ctx, q.listDeadWebhookDeliveriesStmt, listDeadWebhookDeliveries, arg.ClientName, arg.CursorID, arg.PageLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookDelivery
+	for rows.Next() {
+		var i WebhookDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.SubscriptionID,
+			&i.EventID,
+			&i.EventType,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastAttemptAt,
+			&i.LastStatusCode,
+			&i.LastError,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecentTokenLogs = `-- name: ListRecentTokenLogs :many
+SELECT l.id, c.client_name, l.token_type, l.action, l.ip_address, l.user_agent, l.created_at
+FROM token_logs l
+JOIN clients c ON c.client_token = l.client_token
+ORDER BY l.created_at DESC
+LIMIT $1
+`
+
+type ListRecentTokenLogsRow struct {
+	ID         int32
+	ClientName string
+	TokenType  string
+	Action     string
+	IpAddress  sql.NullString
+	UserAgent  sql.NullString
+	CreatedAt  sql.NullTime
+}
+
+func (q *Queries) ListRecentTokenLogs(ctx context.Context, limit int32) ([]ListRecentTokenLogsRow, error) {
+	rows, err := q.query(ctx, q.listRecentTokenLogsStmt, listRecentTokenLogs, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRecentTokenLogsRow
+	for rows.Next() {
+		var i ListRecentTokenLogsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ClientName,
+			&i.TokenType,
+			&i.Action,
+			&i.IpAddress,
+			&i.UserAgent,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookDeliveries = `-- name: ListWebhookDeliveries :many
+SELECT id, subscription_id, event_id, event_type, payload, status, attempts, next_attempt_at, last_attempt_at, last_status_code, last_error, created_at
+FROM webhook_deliveries
+WHERE subscription_id = $1
+  AND ($2::text IS NULL OR status = $2)
+  AND ($3::int8 IS NULL OR id < $3)
+ORDER BY id DESC
+LIMIT $4::int4
+`
+
+type ListWebhookDeliveriesParams struct {
+	SubscriptionID int32
+	Status         sql.NullString
+	CursorID       sql.NullInt64
+	PageLimit      sql.NullInt32
+}
+
+func (q *Queries) ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error) {
+	rows, err := q.query(ctx, q.listWebhookDeliveriesStmt, listWebhookDeliveries,
+		arg.SubscriptionID,
+		arg.Status,
+		arg.CursorID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookDelivery
+	for rows.Next() {
+		var i WebhookDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.SubscriptionID,
+			&i.EventID,
+			&i.EventType,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastAttemptAt,
+			&i.LastStatusCode,
+			&i.LastError,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhooksByClient = `-- name: ListWebhooksByClient :many
+SELECT id, client_name, url, events, secret, active, created_at, updated_at
+FROM webhook_subscriptions
+WHERE client_name = $1
+ORDER BY id
+`
+
+func (q *Queries) ListWebhooksByClient(ctx context.Context, clientName string) ([]WebhookSubscription, error) {
+	rows, err := q.query(ctx, q.listWebhooksByClientStmt, listWebhooksByClient, clientName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookSubscription
+	for rows.Next() {
+		var i WebhookSubscription
+		if err := rows.Scan(
+			&i.ID,
+			&i.ClientName,
+			&i.Url,
+			pq.Array(&i.Events),
+			&i.Secret,
+			&i.Active,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const recordWebhookAttempt = `-- name: RecordWebhookAttempt :exec
+UPDATE webhook_deliveries
+SET status = $1,
+    attempts = attempts + 1,
+    next_attempt_at = now() + make_interval(secs => $2::int),
+    last_attempt_at = now(),
+    last_status_code = $3,
+    last_error = $4
+WHERE id = $5
+`
+
+type RecordWebhookAttemptParams struct {
+	Status         string
+	RetryInSeconds int32
+	LastStatusCode sql.NullInt32
+	LastError      sql.NullString
+	ID             int64
+}
+
+func (q *Queries) RecordWebhookAttempt(ctx context.Context, arg RecordWebhookAttemptParams) error {
+	_, err := q.exec(ctx, q.recordWebhookAttemptStmt, recordWebhookAttempt,
+		arg.Status,
+		arg.RetryInSeconds,
+		arg.LastStatusCode,
+		arg.LastError,
+		arg.ID,
+	)
+	return err
+}
+
 const removeClientRole = `-- name: RemoveClientRole :exec
 UPDATE clients
 SET role = array_remove(role, $2::text)
@@ -563,6 +1293,55 @@ func (q *Queries) RemoveClientRole(ctx context.Context, arg RemoveClientRolePara
 	return err
 }
 
+const requeueWebhookDelivery = `-- name: RequeueWebhookDelivery :execrows
+UPDATE webhook_deliveries
+SET status = 'pending', attempts = 0, next_attempt_at = now()
+WHERE id = $1 AND status = 'dead'
+`
+
+func (q *Queries) RequeueWebhookDelivery(ctx context.Context, id int64) (int64, error) {
+	result, err := q.exec(ctx, q.requeueWebhookDeliveryStmt, requeueWebhookDelivery, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const unlinkAthleteIdentifier = `-- name: UnlinkAthleteIdentifier :one
+UPDATE athlete_identities
+SET sportti_id = CASE WHEN $1::text = 'sportti_id' THEN NULL ELSE sportti_id END,
+    utv_user_id = CASE WHEN $1::text = 'utv_user_id' THEN NULL ELSE utv_user_id END,
+    tietoevry_user_id = CASE WHEN $1::text = 'tietoevry_user_id' THEN NULL ELSE tietoevry_user_id END,
+    fiscode = CASE WHEN $1::text = 'fiscode' THEN NULL ELSE fiscode END,
+    fis_competitorid = CASE WHEN $1::text = 'fis_competitorid' THEN NULL ELSE fis_competitorid END,
+    kamk_user_id = CASE WHEN $1::text = 'kamk_user_id' THEN NULL ELSE kamk_user_id END,
+    updated_at = now()
+WHERE id = $2
+RETURNING id, sportti_id, utv_user_id, tietoevry_user_id, fiscode, fis_competitorid, kamk_user_id, created_at, updated_at
+`
+
+type UnlinkAthleteIdentifierParams struct {
+	Kind string
+	ID   int32
+}
+
+func (q *Queries) UnlinkAthleteIdentifier(ctx context.Context, arg UnlinkAthleteIdentifierParams) (AthleteIdentity, error) {
+	row := q.queryRow(ctx, q.unlinkAthleteIdentifierStmt, unlinkAthleteIdentifier, arg.Kind, arg.ID)
+	var i AthleteIdentity
+	err := row.Scan(
+		&i.ID,
+		&i.SporttiID,
+		&i.UtvUserID,
+		&i.TietoevryUserID,
+		&i.Fiscode,
+		&i.FisCompetitorid,
+		&i.KamkUserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const updateClientRoles = `-- name: UpdateClientRoles :exec
 UPDATE clients SET role = $2
 WHERE client_token = $1
@@ -592,3 +1371,94 @@ func (q *Queries) UpdateClientToken(ctx context.Context, arg UpdateClientTokenPa
 	_, err := q.exec(ctx, q.updateClientTokenStmt, updateClientToken, arg.ClientName, arg.ClientToken)
 	return err
 }
+
+const updateWebhook = `-- name: UpdateWebhook :one
+UPDATE webhook_subscriptions
+SET url = $2, events = $3, active = $4, updated_at = now()
+WHERE id = $1
+RETURNING id, client_name, url, events, secret, active, created_at, updated_at
+`
+
+type UpdateWebhookParams struct {
+	ID     int32
+	Url    string
+	Events []string
+	Active bool
+}
+
+func (q *Queries) UpdateWebhook(ctx context.Context, arg UpdateWebhookParams) (WebhookSubscription, error) {
+	row := q.queryRow(ctx, q.updateWebhookStmt, updateWebhook,
+		arg.ID,
+		arg.Url,
+		pq.Array(arg.Events),
+		arg.Active,
+	)
+	var i WebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.ClientName,
+		&i.Url,
+		pq.Array(&i.Events),
+		&i.Secret,
+		&i.Active,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertUsageRollup = `-- name: UpsertUsageRollup :exec
+INSERT INTO api_usage_rollups (
+    bucket_date,
+    client_name,
+    route_group,
+    request_count,
+    client_error_count,
+    server_error_count,
+    bytes_in,
+    bytes_out,
+    latency_sum_ms,
+    latency_buckets
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+ON CONFLICT (bucket_date, client_name, route_group) DO UPDATE SET
+    request_count      = api_usage_rollups.request_count + EXCLUDED.request_count,
+    client_error_count = api_usage_rollups.client_error_count + EXCLUDED.client_error_count,
+    server_error_count = api_usage_rollups.server_error_count + EXCLUDED.server_error_count,
+    bytes_in           = api_usage_rollups.bytes_in + EXCLUDED.bytes_in,
+    bytes_out          = api_usage_rollups.bytes_out + EXCLUDED.bytes_out,
+    latency_sum_ms     = api_usage_rollups.latency_sum_ms + EXCLUDED.latency_sum_ms,
+    latency_buckets    = (
+        SELECT array_agg(COALESCE(a, 0) + COALESCE(b, 0) ORDER BY i)
+        FROM unnest(api_usage_rollups.latency_buckets, EXCLUDED.latency_buckets) WITH ORDINALITY AS t(a, b, i)
+    ),
+    updated_at         = now()
+`
+
+type UpsertUsageRollupParams struct {
+	BucketDate       time.Time
+	ClientName       string
+	RouteGroup       string
+	RequestCount     int64
+	ClientErrorCount int64
+	ServerErrorCount int64
+	BytesIn          int64
+	BytesOut         int64
+	LatencySumMs     float64
+	LatencyBuckets   []int64
+}
+
+func (q *Queries) UpsertUsageRollup(ctx context.Context, arg UpsertUsageRollupParams) error {
+	_, err := q.exec(ctx, q.upsertUsageRollupStmt, upsertUsageRollup,
+		arg.BucketDate,
+		arg.ClientName,
+		arg.RouteGroup,
+		arg.RequestCount,
+		arg.ClientErrorCount,
+		arg.ServerErrorCount,
+		arg.BytesIn,
+		arg.BytesOut,
+		arg.LatencySumMs,
+		pq.Array(arg.LatencyBuckets),
+	)
+	return err
+}
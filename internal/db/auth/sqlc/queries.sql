@@ -160,3 +160,189 @@ WHERE action = $1
 ORDER BY created_at DESC;
 
 
+
+-- name: UpsertUsageRollup :exec
+INSERT INTO api_usage_rollups (
+    bucket_date,
+    client_name,
+    route_group,
+    request_count,
+    client_error_count,
+    server_error_count,
+    bytes_in,
+    bytes_out,
+    latency_sum_ms,
+    latency_buckets
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+ON CONFLICT (bucket_date, client_name, route_group) DO UPDATE SET
+    request_count      = api_usage_rollups.request_count + EXCLUDED.request_count,
+    client_error_count = api_usage_rollups.client_error_count + EXCLUDED.client_error_count,
+    server_error_count = api_usage_rollups.server_error_count + EXCLUDED.server_error_count,
+    bytes_in           = api_usage_rollups.bytes_in + EXCLUDED.bytes_in,
+    bytes_out          = api_usage_rollups.bytes_out + EXCLUDED.bytes_out,
+    latency_sum_ms     = api_usage_rollups.latency_sum_ms + EXCLUDED.latency_sum_ms,
+    latency_buckets    = (
+        SELECT array_agg(COALESCE(a, 0) + COALESCE(b, 0) ORDER BY i)
+        FROM unnest(api_usage_rollups.latency_buckets, EXCLUDED.latency_buckets) WITH ORDINALITY AS t(a, b, i)
+    ),
+    updated_at         = now();
+
+-- name: GetUsageRollups :many
+SELECT bucket_date, client_name, route_group, request_count, client_error_count, server_error_count,
+       bytes_in, bytes_out, latency_sum_ms, latency_buckets
+FROM api_usage_rollups
+WHERE bucket_date BETWEEN sqlc.arg(from_date) AND sqlc.arg(to_date)
+  AND (sqlc.narg(client_name)::text IS NULL OR client_name = sqlc.narg(client_name))
+  AND (sqlc.narg(route_group)::text IS NULL OR route_group = sqlc.narg(route_group))
+ORDER BY bucket_date, client_name, route_group;
+
+-- name: ListRecentTokenLogs :many
+SELECT l.id, c.client_name, l.token_type, l.action, l.ip_address, l.user_agent, l.created_at
+FROM token_logs l
+JOIN clients c ON c.client_token = l.client_token
+ORDER BY l.created_at DESC
+LIMIT $1;
+
+-- name: CreateWebhook :one
+INSERT INTO webhook_subscriptions (client_name, url, events, secret)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetWebhook :one
+SELECT *
+FROM webhook_subscriptions
+WHERE id = $1;
+
+-- name: ListWebhooksByClient :many
+SELECT *
+FROM webhook_subscriptions
+WHERE client_name = $1
+ORDER BY id;
+
+-- name: UpdateWebhook :one
+UPDATE webhook_subscriptions
+SET url = $2, events = $3, active = $4, updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteWebhook :exec
+DELETE FROM webhook_subscriptions
+WHERE id = $1;
+
+-- name: ListActiveWebhooksForEvent :many
+SELECT *
+FROM webhook_subscriptions
+WHERE active AND sqlc.arg(event_type)::text = ANY(events);
+
+-- name: CreateWebhookDelivery :exec
+INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (subscription_id, event_id) DO NOTHING;
+
+-- name: ClaimDueWebhookDeliveries :many
+UPDATE webhook_deliveries d
+SET next_attempt_at = now() + make_interval(secs => sqlc.arg(lease_seconds)::int)
+FROM webhook_subscriptions s
+WHERE s.id = d.subscription_id
+  AND d.id IN (
+    SELECT id
+    FROM webhook_deliveries
+    WHERE status = 'pending' AND next_attempt_at <= now()
+    ORDER BY next_attempt_at
+    LIMIT sqlc.arg(batch_size)::int
+    FOR UPDATE SKIP LOCKED
+  )
+RETURNING d.id, d.event_id, d.event_type, d.payload, d.attempts, s.url, s.secret;
+
+-- name: RecordWebhookAttempt :exec
+UPDATE webhook_deliveries
+SET status = sqlc.arg(status),
+    attempts = attempts + 1,
+    next_attempt_at = now() + make_interval(secs => sqlc.arg(retry_in_seconds)::int),
+    last_attempt_at = now(),
+    last_status_code = sqlc.arg(last_status_code),
+    last_error = sqlc.arg(last_error)
+WHERE id = sqlc.arg(id);
+
+-- name: GetWebhookDelivery :one
+SELECT d.*, s.client_name
+FROM webhook_deliveries d
+JOIN webhook_subscriptions s ON s.id = d.subscription_id
+WHERE d.id = $1;
+
+-- name: ListWebhookDeliveries :many
+SELECT *
+FROM webhook_deliveries
+WHERE subscription_id = sqlc.arg(subscription_id)
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status))
+  AND (sqlc.narg(cursor_id)::int8 IS NULL OR id < sqlc.narg(cursor_id))
+ORDER BY id DESC
+LIMIT sqlc.narg(page_limit)::int4;
+
+-- name: ListDeadWebhookDeliveries :many
+SELECT d.*
+FROM webhook_deliveries d
+JOIN webhook_subscriptions s ON s.id = d.subscription_id
+WHERE s.client_name = sqlc.arg(client_name)
+  AND d.status = 'dead'
+  AND (sqlc.narg(cursor_id)::int8 IS NULL OR d.id < sqlc.narg(cursor_id))
+ORDER BY d.id DESC
+LIMIT sqlc.narg(page_limit)::int4;
+
+-- name: RequeueWebhookDelivery :execrows
+UPDATE webhook_deliveries
+SET status = 'pending', attempts = 0, next_attempt_at = now()
+WHERE id = $1 AND status = 'dead';
+
+-- name: DeleteOldWebhookDeliveries :execrows
+DELETE FROM webhook_deliveries
+WHERE status = 'succeeded' AND created_at < $1;
+
+-- name: CreateAthleteIdentity :one
+INSERT INTO athlete_identities (sportti_id, utv_user_id, tietoevry_user_id, fiscode, fis_competitorid, kamk_user_id)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetAthleteIdentity :one
+SELECT *
+FROM athlete_identities
+WHERE id = $1;
+
+-- name: FindAthleteIdentities :many
+SELECT *
+FROM athlete_identities
+WHERE sportti_id = sqlc.narg(sportti_id)
+   OR utv_user_id = sqlc.narg(utv_user_id)
+   OR tietoevry_user_id = sqlc.narg(tietoevry_user_id)
+   OR fiscode = sqlc.narg(fiscode)
+   OR fis_competitorid = sqlc.narg(fis_competitorid)
+   OR kamk_user_id = sqlc.narg(kamk_user_id)
+ORDER BY id;
+
+-- name: LinkAthleteIdentifiers :one
+UPDATE athlete_identities
+SET sportti_id = COALESCE(sqlc.narg(sportti_id), sportti_id),
+    utv_user_id = COALESCE(sqlc.narg(utv_user_id), utv_user_id),
+    tietoevry_user_id = COALESCE(sqlc.narg(tietoevry_user_id), tietoevry_user_id),
+    fiscode = COALESCE(sqlc.narg(fiscode), fiscode),
+    fis_competitorid = COALESCE(sqlc.narg(fis_competitorid), fis_competitorid),
+    kamk_user_id = COALESCE(sqlc.narg(kamk_user_id), kamk_user_id),
+    updated_at = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: UnlinkAthleteIdentifier :one
+UPDATE athlete_identities
+SET sportti_id = CASE WHEN sqlc.arg(kind)::text = 'sportti_id' THEN NULL ELSE sportti_id END,
+    utv_user_id = CASE WHEN sqlc.arg(kind)::text = 'utv_user_id' THEN NULL ELSE utv_user_id END,
+    tietoevry_user_id = CASE WHEN sqlc.arg(kind)::text = 'tietoevry_user_id' THEN NULL ELSE tietoevry_user_id END,
+    fiscode = CASE WHEN sqlc.arg(kind)::text = 'fiscode' THEN NULL ELSE fiscode END,
+    fis_competitorid = CASE WHEN sqlc.arg(kind)::text = 'fis_competitorid' THEN NULL ELSE fis_competitorid END,
+    kamk_user_id = CASE WHEN sqlc.arg(kind)::text = 'kamk_user_id' THEN NULL ELSE kamk_user_id END,
+    updated_at = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: DeleteAthleteIdentity :execrows
+DELETE FROM athlete_identities
+WHERE id = $1;
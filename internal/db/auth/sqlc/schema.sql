@@ -40,4 +40,61 @@ CREATE TABLE IF NOT EXISTS token_logs (
     user_agent TEXT,
     metadata JSONB,
     created_at TIMESTAMP DEFAULT now()
-);
\ No newline at end of file
+);
+-- api_usage_rollups
+CREATE TABLE IF NOT EXISTS api_usage_rollups (
+    bucket_date DATE NOT NULL,
+    client_name TEXT NOT NULL,
+    route_group TEXT NOT NULL,
+    request_count BIGINT NOT NULL DEFAULT 0,
+    client_error_count BIGINT NOT NULL DEFAULT 0,
+    server_error_count BIGINT NOT NULL DEFAULT 0,
+    bytes_in BIGINT NOT NULL DEFAULT 0,
+    bytes_out BIGINT NOT NULL DEFAULT 0,
+    latency_sum_ms DOUBLE PRECISION NOT NULL DEFAULT 0,
+    latency_buckets BIGINT[] NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (bucket_date, client_name, route_group)
+);
+
+-- webhook_subscriptions
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id SERIAL PRIMARY KEY,
+    client_name TEXT NOT NULL,
+    url TEXT NOT NULL,
+    events TEXT[] NOT NULL,
+    secret TEXT NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+-- webhook_deliveries
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id BIGSERIAL PRIMARY KEY,
+    subscription_id INTEGER NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    event_id TEXT NOT NULL,
+    event_type TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT now(),
+    last_attempt_at TIMESTAMP,
+    last_status_code INTEGER,
+    last_error TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    UNIQUE (subscription_id, event_id)
+);
+
+-- athlete_identities
+CREATE TABLE IF NOT EXISTS athlete_identities (
+    id SERIAL PRIMARY KEY,
+    sportti_id TEXT UNIQUE,
+    utv_user_id UUID UNIQUE,
+    tietoevry_user_id UUID UNIQUE,
+    fiscode INTEGER UNIQUE,
+    fis_competitorid INTEGER UNIQUE,
+    kamk_user_id INTEGER UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
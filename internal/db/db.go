@@ -105,7 +105,7 @@ func NewWithGracefulFailure(
 			errors[name] = fmt.Errorf("not configured")
 			return nil
 		}
-		db, err := connectToDB(addr, maxOpenConns, maxIdleConns, maxIdleTime)
+		db, err := connectToDB(name, addr, maxOpenConns, maxIdleConns, maxIdleTime)
 		if err != nil {
 			errors[name] = err
 			return nil
@@ -125,8 +125,8 @@ func NewWithGracefulFailure(
 	return databases, errors
 }
 
-func connectToDB(addr string, maxOpenConns, maxIdleConns int, maxIdleTime string) (*sql.DB, error) {
-	db, err := sql.Open("postgres", addr)
+func connectToDB(name, addr string, maxOpenConns, maxIdleConns int, maxIdleTime string) (*sql.DB, error) {
+	db, err := openInstrumented(name, addr)
 	if err != nil {
 		return nil, err
 	}
@@ -180,6 +180,15 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getRacesNKStmt, err = db.PrepareContext(ctx, getRacesNK); err != nil {
 		return nil, fmt.Errorf("error preparing query GetRacesNK: %w", err)
 	}
+	if q.getResultsByRaceIDsCCStmt, err = db.PrepareContext(ctx, getResultsByRaceIDsCC); err != nil {
+		return nil, fmt.Errorf("error preparing query GetResultsByRaceIDsCC: %w", err)
+	}
+	if q.getResultsByRaceIDsJPStmt, err = db.PrepareContext(ctx, getResultsByRaceIDsJP); err != nil {
+		return nil, fmt.Errorf("error preparing query GetResultsByRaceIDsJP: %w", err)
+	}
+	if q.getResultsByRaceIDsNKStmt, err = db.PrepareContext(ctx, getResultsByRaceIDsNK); err != nil {
+		return nil, fmt.Errorf("error preparing query GetResultsByRaceIDsNK: %w", err)
+	}
 	if q.getSeasonsCatcodesCCByCompetitorStmt, err = db.PrepareContext(ctx, getSeasonsCatcodesCCByCompetitor); err != nil {
 		return nil, fmt.Errorf("error preparing query GetSeasonsCatcodesCCByCompetitor: %w", err)
 	}
@@ -526,6 +535,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getRacesNKStmt: %w", cerr)
 		}
 	}
+	if q.getResultsByRaceIDsCCStmt != nil {
+		if cerr := q.getResultsByRaceIDsCCStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getResultsByRaceIDsCCStmt: %w", cerr)
+		}
+	}
+	if q.getResultsByRaceIDsJPStmt != nil {
+		if cerr := q.getResultsByRaceIDsJPStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getResultsByRaceIDsJPStmt: %w", cerr)
+		}
+	}
+	if q.getResultsByRaceIDsNKStmt != nil {
+		if cerr := q.getResultsByRaceIDsNKStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getResultsByRaceIDsNKStmt: %w", cerr)
+		}
+	}
 	if q.getSeasonsCatcodesCCByCompetitorStmt != nil {
 		if cerr := q.getSeasonsCatcodesCCByCompetitorStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getSeasonsCatcodesCCByCompetitorStmt: %w", cerr)
@@ -752,6 +776,9 @@ type Queries struct {
 	getRacesCCStmt                       *sql.Stmt
 	getRacesJPStmt                       *sql.Stmt
 	getRacesNKStmt                       *sql.Stmt
+	getResultsByRaceIDsCCStmt            *sql.Stmt
+	getResultsByRaceIDsJPStmt            *sql.Stmt
+	getResultsByRaceIDsNKStmt            *sql.Stmt
 	getSeasonsCatcodesCCByCompetitorStmt *sql.Stmt
 	getSeasonsCatcodesJPByCompetitorStmt *sql.Stmt
 	getSeasonsCatcodesNKByCompetitorStmt *sql.Stmt
@@ -837,6 +864,9 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		getRacesCCStmt:                       q.getRacesCCStmt,
 		getRacesJPStmt:                       q.getRacesJPStmt,
 		getRacesNKStmt:                       q.getRacesNKStmt,
+		getResultsByRaceIDsCCStmt:            q.getResultsByRaceIDsCCStmt,
+		getResultsByRaceIDsJPStmt:            q.getResultsByRaceIDsJPStmt,
+		getResultsByRaceIDsNKStmt:            q.getResultsByRaceIDsNKStmt,
 		getSeasonsCatcodesCCByCompetitorStmt: q.getSeasonsCatcodesCCByCompetitorStmt,
 		getSeasonsCatcodesJPByCompetitorStmt: q.getSeasonsCatcodesJPByCompetitorStmt,
 		getSeasonsCatcodesNKByCompetitorStmt: q.getSeasonsCatcodesNKByCompetitorStmt,
@@ -0,0 +1,1551 @@
+package fissqlc
+
+// Column projections and row streaming for the wide race and result tables.
+//
+// Not generated by sqlc: the *Columns lists and columnPtr switches mirror the
+// SELECT list and scan order of GetRaces* / GetRaceResults*ByRaceID so that a
+// subset of columns can be selected and scanned into the same model types.
+// Columns that are not selected are left at their zero value.
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+// projectSelect replaces the SELECT list of a generated query with cols. It
+// fails when the query does not have the "SELECT ... FROM" layout sqlc
+// generates, rather than running it with every column selected.
+func projectSelect(query string, cols []string) (string, error) {
+	start := strings.Index(query, "\nSELECT ")
+	end := strings.Index(query, "\nFROM ")
+	if start < 0 || end < start {
+		return "", fmt.Errorf("project columns: query has no SELECT ... FROM list")
+	}
+	return query[:start] + "\nSELECT " + strings.Join(cols, ", ") + query[end:], nil
+}
+
+func queryColumns[T any](ctx context.Context, db DBTX, query string, cols []string, ptr func(*T, string) any, args ...interface{}) ([]T, error) {
+	var items []T
+	err := streamColumns(ctx, db, query, cols, ptr, func(i T) error {
+		items = append(items, i)
+		return nil
+	}, args...)
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// streamColumns scans the rows of a projected query one at a time into fn
+func streamColumns[T any](ctx context.Context, db DBTX, query string, cols []string, ptr func(*T, string) any, fn func(T) error, args ...interface{}) error {
+	for _, c := range cols {
+		var zero T
+		if ptr(&zero, c) == nil {
+			return fmt.Errorf("unknown column %q", c)
+		}
+	}
+
+	projected, err := projectSelect(query, cols)
+	if err != nil {
+		return err
+	}
+	rows, err := db.QueryContext(ctx, projected, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	dest := make([]interface{}, len(cols))
+	for rows.Next() {
+		var i T
+		for k, c := range cols {
+			dest[k] = ptr(&i, c)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return err
+		}
+		if err := fn(i); err != nil {
+			return err
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	return rows.Err()
+}
+
+// GetRacesCCColumns is GetRacesCC selecting only cols
+func (q *Queries) GetRacesCCColumns(ctx context.Context, cols []string, arg GetRacesCCParams) ([]ARacecc, error) {
+	return queryColumns(ctx, q.db, getRacesCC, cols, (*ARacecc).columnPtr,
+		pq.Array(arg.Column1),
+		pq.Array(arg.Column2),
+		pq.Array(arg.Column3),
+		arg.Column4,
+		arg.Column5,
+	)
+}
+
+// GetRacesJPColumns is GetRacesJP selecting only cols
+func (q *Queries) GetRacesJPColumns(ctx context.Context, cols []string, arg GetRacesJPParams) ([]ARacejp, error) {
+	return queryColumns(ctx, q.db, getRacesJP, cols, (*ARacejp).columnPtr,
+		pq.Array(arg.Column1),
+		pq.Array(arg.Column2),
+		pq.Array(arg.Column3),
+		arg.Column4,
+		arg.Column5,
+	)
+}
+
+// GetRacesNKColumns is GetRacesNK selecting only cols
+func (q *Queries) GetRacesNKColumns(ctx context.Context, cols []string, arg GetRacesNKParams) ([]ARacenk, error) {
+	return queryColumns(ctx, q.db, getRacesNK, cols, (*ARacenk).columnPtr,
+		pq.Array(arg.Column1),
+		pq.Array(arg.Column2),
+		pq.Array(arg.Column3),
+		arg.Column4,
+		arg.Column5,
+	)
+}
+
+// StreamRacesCC is GetRacesCC passing rows to fn one at a time; nil cols selects every column
+func (q *Queries) StreamRacesCC(ctx context.Context, cols []string, arg GetRacesCCParams, fn func(ARacecc) error) error {
+	if len(cols) == 0 {
+		cols = ARaceccColumns
+	}
+	return streamColumns(ctx, q.db, getRacesCC, cols, (*ARacecc).columnPtr, fn,
+		pq.Array(arg.Column1),
+		pq.Array(arg.Column2),
+		pq.Array(arg.Column3),
+		arg.Column4,
+		arg.Column5,
+	)
+}
+
+// StreamRacesJP is GetRacesJP passing rows to fn one at a time; nil cols selects every column
+func (q *Queries) StreamRacesJP(ctx context.Context, cols []string, arg GetRacesJPParams, fn func(ARacejp) error) error {
+	if len(cols) == 0 {
+		cols = ARacejpColumns
+	}
+	return streamColumns(ctx, q.db, getRacesJP, cols, (*ARacejp).columnPtr, fn,
+		pq.Array(arg.Column1),
+		pq.Array(arg.Column2),
+		pq.Array(arg.Column3),
+		arg.Column4,
+		arg.Column5,
+	)
+}
+
+// StreamRacesNK is GetRacesNK passing rows to fn one at a time; nil cols selects every column
+func (q *Queries) StreamRacesNK(ctx context.Context, cols []string, arg GetRacesNKParams, fn func(ARacenk) error) error {
+	if len(cols) == 0 {
+		cols = ARacenkColumns
+	}
+	return streamColumns(ctx, q.db, getRacesNK, cols, (*ARacenk).columnPtr, fn,
+		pq.Array(arg.Column1),
+		pq.Array(arg.Column2),
+		pq.Array(arg.Column3),
+		arg.Column4,
+		arg.Column5,
+	)
+}
+
+// GetRaceResultsCCByRaceIDColumns is GetRaceResultsCCByRaceID selecting only cols
+func (q *Queries) GetRaceResultsCCByRaceIDColumns(ctx context.Context, cols []string, raceid sql.NullInt32) ([]AResultcc, error) {
+	return queryColumns(ctx, q.db, getRaceResultsCCByRaceID, cols, (*AResultcc).columnPtr, raceid)
+}
+
+// GetRaceResultsJPByRaceIDColumns is GetRaceResultsJPByRaceID selecting only cols
+func (q *Queries) GetRaceResultsJPByRaceIDColumns(ctx context.Context, cols []string, raceid sql.NullInt32) ([]AResultjp, error) {
+	return queryColumns(ctx, q.db, getRaceResultsJPByRaceID, cols, (*AResultjp).columnPtr, raceid)
+}
+
+// GetRaceResultsNKByRaceIDColumns is GetRaceResultsNKByRaceID selecting only cols
+func (q *Queries) GetRaceResultsNKByRaceIDColumns(ctx context.Context, cols []string, raceid sql.NullInt32) ([]AResultnk, error) {
+	return queryColumns(ctx, q.db, getRaceResultsNKByRaceID, cols, (*AResultnk).columnPtr, raceid)
+}
+
+// ARaceccColumns lists the selectable columns of ARacecc in scan order
+var ARaceccColumns = []string{
+	"raceid",
+	"eventid",
+	"seasoncode",
+	"racecodex",
+	"disciplineid",
+	"disciplinecode",
+	"catcode",
+	"catcode2",
+	"catcode3",
+	"catcode4",
+	"gender",
+	"racedate",
+	"starteventdate",
+	"description",
+	"place",
+	"nationcode",
+	"td1id",
+	"td1name",
+	"td1nation",
+	"td1code",
+	"td2id",
+	"td2name",
+	"td2nation",
+	"td2code",
+	"calstatuscode",
+	"procstatuscode",
+	"receiveddate",
+	"pursuit",
+	"masse",
+	"relay",
+	"distance",
+	"hill",
+	"style",
+	"qualif",
+	"finale",
+	"homol",
+	"webcomment",
+	"displaystatus",
+	"fisinterncomment",
+	"published",
+	"validforfispoints",
+	"usedfislist",
+	"tolist",
+	"discforlistcode",
+	"calculatedpenalty",
+	"appliedpenalty",
+	"appliedscala",
+	"penscafixed",
+	"version",
+	"nationraceid",
+	"provraceid",
+	"msql7evid",
+	"mssql7id",
+	"results",
+	"pdf",
+	"topbanner",
+	"bottombanner",
+	"toplogo",
+	"bottomlogo",
+	"gallery",
+	"indi",
+	"team",
+	"tabcount",
+	"columncount",
+	"level",
+	"hloc1",
+	"hloc2",
+	"hloc3",
+	"hcet1",
+	"hcet2",
+	"hcet3",
+	"live",
+	"livestatus1",
+	"livestatus2",
+	"livestatus3",
+	"liveinfo1",
+	"liveinfo2",
+	"liveinfo3",
+	"passwd",
+	"timinglogo",
+	"validdate",
+	"noepr",
+	"tddoc",
+	"timingreport",
+	"special_cup_points",
+	"skip_wcsl",
+	"validforowg",
+	"lastupdate",
+}
+
+func (i *ARacecc) columnPtr(col string) any {
+	switch col {
+	case "raceid":
+		return &i.Raceid
+	case "eventid":
+		return &i.Eventid
+	case "seasoncode":
+		return &i.Seasoncode
+	case "racecodex":
+		return &i.Racecodex
+	case "disciplineid":
+		return &i.Disciplineid
+	case "disciplinecode":
+		return &i.Disciplinecode
+	case "catcode":
+		return &i.Catcode
+	case "catcode2":
+		return &i.Catcode2
+	case "catcode3":
+		return &i.Catcode3
+	case "catcode4":
+		return &i.Catcode4
+	case "gender":
+		return &i.Gender
+	case "racedate":
+		return &i.Racedate
+	case "starteventdate":
+		return &i.Starteventdate
+	case "description":
+		return &i.Description
+	case "place":
+		return &i.Place
+	case "nationcode":
+		return &i.Nationcode
+	case "td1id":
+		return &i.Td1id
+	case "td1name":
+		return &i.Td1name
+	case "td1nation":
+		return &i.Td1nation
+	case "td1code":
+		return &i.Td1code
+	case "td2id":
+		return &i.Td2id
+	case "td2name":
+		return &i.Td2name
+	case "td2nation":
+		return &i.Td2nation
+	case "td2code":
+		return &i.Td2code
+	case "calstatuscode":
+		return &i.Calstatuscode
+	case "procstatuscode":
+		return &i.Procstatuscode
+	case "receiveddate":
+		return &i.Receiveddate
+	case "pursuit":
+		return &i.Pursuit
+	case "masse":
+		return &i.Masse
+	case "relay":
+		return &i.Relay
+	case "distance":
+		return &i.Distance
+	case "hill":
+		return &i.Hill
+	case "style":
+		return &i.Style
+	case "qualif":
+		return &i.Qualif
+	case "finale":
+		return &i.Finale
+	case "homol":
+		return &i.Homol
+	case "webcomment":
+		return &i.Webcomment
+	case "displaystatus":
+		return &i.Displaystatus
+	case "fisinterncomment":
+		return &i.Fisinterncomment
+	case "published":
+		return &i.Published
+	case "validforfispoints":
+		return &i.Validforfispoints
+	case "usedfislist":
+		return &i.Usedfislist
+	case "tolist":
+		return &i.Tolist
+	case "discforlistcode":
+		return &i.Discforlistcode
+	case "calculatedpenalty":
+		return &i.Calculatedpenalty
+	case "appliedpenalty":
+		return &i.Appliedpenalty
+	case "appliedscala":
+		return &i.Appliedscala
+	case "penscafixed":
+		return &i.Penscafixed
+	case "version":
+		return &i.Version
+	case "nationraceid":
+		return &i.Nationraceid
+	case "provraceid":
+		return &i.Provraceid
+	case "msql7evid":
+		return &i.Msql7evid
+	case "mssql7id":
+		return &i.Mssql7id
+	case "results":
+		return &i.Results
+	case "pdf":
+		return &i.Pdf
+	case "topbanner":
+		return &i.Topbanner
+	case "bottombanner":
+		return &i.Bottombanner
+	case "toplogo":
+		return &i.Toplogo
+	case "bottomlogo":
+		return &i.Bottomlogo
+	case "gallery":
+		return &i.Gallery
+	case "indi":
+		return &i.Indi
+	case "team":
+		return &i.Team
+	case "tabcount":
+		return &i.Tabcount
+	case "columncount":
+		return &i.Columncount
+	case "level":
+		return &i.Level
+	case "hloc1":
+		return &i.Hloc1
+	case "hloc2":
+		return &i.Hloc2
+	case "hloc3":
+		return &i.Hloc3
+	case "hcet1":
+		return &i.Hcet1
+	case "hcet2":
+		return &i.Hcet2
+	case "hcet3":
+		return &i.Hcet3
+	case "live":
+		return &i.Live
+	case "livestatus1":
+		return &i.Livestatus1
+	case "livestatus2":
+		return &i.Livestatus2
+	case "livestatus3":
+		return &i.Livestatus3
+	case "liveinfo1":
+		return &i.Liveinfo1
+	case "liveinfo2":
+		return &i.Liveinfo2
+	case "liveinfo3":
+		return &i.Liveinfo3
+	case "passwd":
+		return &i.Passwd
+	case "timinglogo":
+		return &i.Timinglogo
+	case "validdate":
+		return &i.Validdate
+	case "noepr":
+		return &i.Noepr
+	case "tddoc":
+		return &i.Tddoc
+	case "timingreport":
+		return &i.Timingreport
+	case "special_cup_points":
+		return &i.SpecialCupPoints
+	case "skip_wcsl":
+		return &i.SkipWcsl
+	case "validforowg":
+		return &i.Validforowg
+	case "lastupdate":
+		return &i.Lastupdate
+	}
+	return nil
+}
+
+// ARacejpColumns lists the selectable columns of ARacejp in scan order
+var ARacejpColumns = []string{
+	"raceid",
+	"eventid",
+	"seasoncode",
+	"racecodex",
+	"disciplineid",
+	"disciplinecode",
+	"catcode",
+	"catcode2",
+	"catcode3",
+	"catcode4",
+	"gender",
+	"racedate",
+	"starteventdate",
+	"description",
+	"place",
+	"nationcode",
+	"td1id",
+	"td1name",
+	"td1nation",
+	"td1code",
+	"td2id",
+	"td2name",
+	"td2nation",
+	"td2code",
+	"calstatuscode",
+	"procstatuscode",
+	"receiveddate",
+	"pursuit",
+	"masse",
+	"relay",
+	"distance",
+	"hill",
+	"style",
+	"qualif",
+	"finale",
+	"homol",
+	"webcomment",
+	"displaystatus",
+	"fisinterncomment",
+	"published",
+	"validforfispoints",
+	"usedfislist",
+	"tolist",
+	"discforlistcode",
+	"calculatedpenalty",
+	"appliedpenalty",
+	"appliedscala",
+	"penscafixed",
+	"version",
+	"nationraceid",
+	"provraceid",
+	"msql7evid",
+	"mssql7id",
+	"results",
+	"pdf",
+	"topbanner",
+	"bottombanner",
+	"toplogo",
+	"bottomlogo",
+	"gallery",
+	"indi",
+	"team",
+	"tabcount",
+	"columncount",
+	"level",
+	"hloc1",
+	"hloc2",
+	"hloc3",
+	"hcet1",
+	"hcet2",
+	"hcet3",
+	"live",
+	"livestatus1",
+	"livestatus2",
+	"livestatus3",
+	"liveinfo1",
+	"liveinfo2",
+	"liveinfo3",
+	"passwd",
+	"timinglogo",
+	"validdate",
+	"noepr",
+	"tddoc",
+	"timingreport",
+	"special_cup_points",
+	"skip_wcsl",
+	"lastupdate",
+	"validforowg",
+}
+
+func (i *ARacejp) columnPtr(col string) any {
+	switch col {
+	case "raceid":
+		return &i.Raceid
+	case "eventid":
+		return &i.Eventid
+	case "seasoncode":
+		return &i.Seasoncode
+	case "racecodex":
+		return &i.Racecodex
+	case "disciplineid":
+		return &i.Disciplineid
+	case "disciplinecode":
+		return &i.Disciplinecode
+	case "catcode":
+		return &i.Catcode
+	case "catcode2":
+		return &i.Catcode2
+	case "catcode3":
+		return &i.Catcode3
+	case "catcode4":
+		return &i.Catcode4
+	case "gender":
+		return &i.Gender
+	case "racedate":
+		return &i.Racedate
+	case "starteventdate":
+		return &i.Starteventdate
+	case "description":
+		return &i.Description
+	case "place":
+		return &i.Place
+	case "nationcode":
+		return &i.Nationcode
+	case "td1id":
+		return &i.Td1id
+	case "td1name":
+		return &i.Td1name
+	case "td1nation":
+		return &i.Td1nation
+	case "td1code":
+		return &i.Td1code
+	case "td2id":
+		return &i.Td2id
+	case "td2name":
+		return &i.Td2name
+	case "td2nation":
+		return &i.Td2nation
+	case "td2code":
+		return &i.Td2code
+	case "calstatuscode":
+		return &i.Calstatuscode
+	case "procstatuscode":
+		return &i.Procstatuscode
+	case "receiveddate":
+		return &i.Receiveddate
+	case "pursuit":
+		return &i.Pursuit
+	case "masse":
+		return &i.Masse
+	case "relay":
+		return &i.Relay
+	case "distance":
+		return &i.Distance
+	case "hill":
+		return &i.Hill
+	case "style":
+		return &i.Style
+	case "qualif":
+		return &i.Qualif
+	case "finale":
+		return &i.Finale
+	case "homol":
+		return &i.Homol
+	case "webcomment":
+		return &i.Webcomment
+	case "displaystatus":
+		return &i.Displaystatus
+	case "fisinterncomment":
+		return &i.Fisinterncomment
+	case "published":
+		return &i.Published
+	case "validforfispoints":
+		return &i.Validforfispoints
+	case "usedfislist":
+		return &i.Usedfislist
+	case "tolist":
+		return &i.Tolist
+	case "discforlistcode":
+		return &i.Discforlistcode
+	case "calculatedpenalty":
+		return &i.Calculatedpenalty
+	case "appliedpenalty":
+		return &i.Appliedpenalty
+	case "appliedscala":
+		return &i.Appliedscala
+	case "penscafixed":
+		return &i.Penscafixed
+	case "version":
+		return &i.Version
+	case "nationraceid":
+		return &i.Nationraceid
+	case "provraceid":
+		return &i.Provraceid
+	case "msql7evid":
+		return &i.Msql7evid
+	case "mssql7id":
+		return &i.Mssql7id
+	case "results":
+		return &i.Results
+	case "pdf":
+		return &i.Pdf
+	case "topbanner":
+		return &i.Topbanner
+	case "bottombanner":
+		return &i.Bottombanner
+	case "toplogo":
+		return &i.Toplogo
+	case "bottomlogo":
+		return &i.Bottomlogo
+	case "gallery":
+		return &i.Gallery
+	case "indi":
+		return &i.Indi
+	case "team":
+		return &i.Team
+	case "tabcount":
+		return &i.Tabcount
+	case "columncount":
+		return &i.Columncount
+	case "level":
+		return &i.Level
+	case "hloc1":
+		return &i.Hloc1
+	case "hloc2":
+		return &i.Hloc2
+	case "hloc3":
+		return &i.Hloc3
+	case "hcet1":
+		return &i.Hcet1
+	case "hcet2":
+		return &i.Hcet2
+	case "hcet3":
+		return &i.Hcet3
+	case "live":
+		return &i.Live
+	case "livestatus1":
+		return &i.Livestatus1
+	case "livestatus2":
+		return &i.Livestatus2
+	case "livestatus3":
+		return &i.Livestatus3
+	case "liveinfo1":
+		return &i.Liveinfo1
+	case "liveinfo2":
+		return &i.Liveinfo2
+	case "liveinfo3":
+		return &i.Liveinfo3
+	case "passwd":
+		return &i.Passwd
+	case "timinglogo":
+		return &i.Timinglogo
+	case "validdate":
+		return &i.Validdate
+	case "noepr":
+		return &i.Noepr
+	case "tddoc":
+		return &i.Tddoc
+	case "timingreport":
+		return &i.Timingreport
+	case "special_cup_points":
+		return &i.SpecialCupPoints
+	case "skip_wcsl":
+		return &i.SkipWcsl
+	case "lastupdate":
+		return &i.Lastupdate
+	case "validforowg":
+		return &i.Validforowg
+	}
+	return nil
+}
+
+// ARacenkColumns lists the selectable columns of ARacenk in scan order
+var ARacenkColumns = []string{
+	"raceid",
+	"eventid",
+	"seasoncode",
+	"racecodex",
+	"disciplineid",
+	"disciplinecode",
+	"catcode",
+	"catcode2",
+	"catcode3",
+	"catcode4",
+	"gender",
+	"racedate",
+	"starteventdate",
+	"description",
+	"place",
+	"nationcode",
+	"td1id",
+	"td1name",
+	"td1nation",
+	"td1code",
+	"td2id",
+	"td2name",
+	"td2nation",
+	"td2code",
+	"calstatuscode",
+	"procstatuscode",
+	"receiveddate",
+	"pursuit",
+	"masse",
+	"relay",
+	"distance",
+	"hill",
+	"style",
+	"qualif",
+	"finale",
+	"homol",
+	"webcomment",
+	"displaystatus",
+	"fisinterncomment",
+	"published",
+	"validforfispoints",
+	"usedfislist",
+	"tolist",
+	"discforlistcode",
+	"calculatedpenalty",
+	"appliedpenalty",
+	"appliedscala",
+	"penscafixed",
+	"version",
+	"nationraceid",
+	"provraceid",
+	"msql7evid",
+	"mssql7id",
+	"results",
+	"pdf",
+	"topbanner",
+	"bottombanner",
+	"toplogo",
+	"bottomlogo",
+	"gallery",
+	"indi",
+	"team",
+	"tabcount",
+	"columncount",
+	"level",
+	"hloc1",
+	"hloc2",
+	"hloc3",
+	"hcet1",
+	"hcet2",
+	"hcet3",
+	"live",
+	"livestatus1",
+	"livestatus2",
+	"livestatus3",
+	"liveinfo1",
+	"liveinfo2",
+	"liveinfo3",
+	"passwd",
+	"timinglogo",
+	"validdate",
+	"noepr",
+	"tddoc",
+	"timingreport",
+	"special_cup_points",
+	"skip_wcsl",
+	"validforowg",
+	"lastupdate",
+}
+
+func (i *ARacenk) columnPtr(col string) any {
+	switch col {
+	case "raceid":
+		return &i.Raceid
+	case "eventid":
+		return &i.Eventid
+	case "seasoncode":
+		return &i.Seasoncode
+	case "racecodex":
+		return &i.Racecodex
+	case "disciplineid":
+		return &i.Disciplineid
+	case "disciplinecode":
+		return &i.Disciplinecode
+	case "catcode":
+		return &i.Catcode
+	case "catcode2":
+		return &i.Catcode2
+	case "catcode3":
+		return &i.Catcode3
+	case "catcode4":
+		return &i.Catcode4
+	case "gender":
+		return &i.Gender
+	case "racedate":
+		return &i.Racedate
+	case "starteventdate":
+		return &i.Starteventdate
+	case "description":
+		return &i.Description
+	case "place":
+		return &i.Place
+	case "nationcode":
+		return &i.Nationcode
+	case "td1id":
+		return &i.Td1id
+	case "td1name":
+		return &i.Td1name
+	case "td1nation":
+		return &i.Td1nation
+	case "td1code":
+		return &i.Td1code
+	case "td2id":
+		return &i.Td2id
+	case "td2name":
+		return &i.Td2name
+	case "td2nation":
+		return &i.Td2nation
+	case "td2code":
+		return &i.Td2code
+	case "calstatuscode":
+		return &i.Calstatuscode
+	case "procstatuscode":
+		return &i.Procstatuscode
+	case "receiveddate":
+		return &i.Receiveddate
+	case "pursuit":
+		return &i.Pursuit
+	case "masse":
+		return &i.Masse
+	case "relay":
+		return &i.Relay
+	case "distance":
+		return &i.Distance
+	case "hill":
+		return &i.Hill
+	case "style":
+		return &i.Style
+	case "qualif":
+		return &i.Qualif
+	case "finale":
+		return &i.Finale
+	case "homol":
+		return &i.Homol
+	case "webcomment":
+		return &i.Webcomment
+	case "displaystatus":
+		return &i.Displaystatus
+	case "fisinterncomment":
+		return &i.Fisinterncomment
+	case "published":
+		return &i.Published
+	case "validforfispoints":
+		return &i.Validforfispoints
+	case "usedfislist":
+		return &i.Usedfislist
+	case "tolist":
+		return &i.Tolist
+	case "discforlistcode":
+		return &i.Discforlistcode
+	case "calculatedpenalty":
+		return &i.Calculatedpenalty
+	case "appliedpenalty":
+		return &i.Appliedpenalty
+	case "appliedscala":
+		return &i.Appliedscala
+	case "penscafixed":
+		return &i.Penscafixed
+	case "version":
+		return &i.Version
+	case "nationraceid":
+		return &i.Nationraceid
+	case "provraceid":
+		return &i.Provraceid
+	case "msql7evid":
+		return &i.Msql7evid
+	case "mssql7id":
+		return &i.Mssql7id
+	case "results":
+		return &i.Results
+	case "pdf":
+		return &i.Pdf
+	case "topbanner":
+		return &i.Topbanner
+	case "bottombanner":
+		return &i.Bottombanner
+	case "toplogo":
+		return &i.Toplogo
+	case "bottomlogo":
+		return &i.Bottomlogo
+	case "gallery":
+		return &i.Gallery
+	case "indi":
+		return &i.Indi
+	case "team":
+		return &i.Team
+	case "tabcount":
+		return &i.Tabcount
+	case "columncount":
+		return &i.Columncount
+	case "level":
+		return &i.Level
+	case "hloc1":
+		return &i.Hloc1
+	case "hloc2":
+		return &i.Hloc2
+	case "hloc3":
+		return &i.Hloc3
+	case "hcet1":
+		return &i.Hcet1
+	case "hcet2":
+		return &i.Hcet2
+	case "hcet3":
+		return &i.Hcet3
+	case "live":
+		return &i.Live
+	case "livestatus1":
+		return &i.Livestatus1
+	case "livestatus2":
+		return &i.Livestatus2
+	case "livestatus3":
+		return &i.Livestatus3
+	case "liveinfo1":
+		return &i.Liveinfo1
+	case "liveinfo2":
+		return &i.Liveinfo2
+	case "liveinfo3":
+		return &i.Liveinfo3
+	case "passwd":
+		return &i.Passwd
+	case "timinglogo":
+		return &i.Timinglogo
+	case "validdate":
+		return &i.Validdate
+	case "noepr":
+		return &i.Noepr
+	case "tddoc":
+		return &i.Tddoc
+	case "timingreport":
+		return &i.Timingreport
+	case "special_cup_points":
+		return &i.SpecialCupPoints
+	case "skip_wcsl":
+		return &i.SkipWcsl
+	case "validforowg":
+		return &i.Validforowg
+	case "lastupdate":
+		return &i.Lastupdate
+	}
+	return nil
+}
+
+// AResultccColumns lists the selectable columns of AResultcc in scan order
+var AResultccColumns = []string{
+	"recid",
+	"raceid",
+	"competitorid",
+	"status",
+	"reason",
+	"position",
+	"pf",
+	"status2",
+	"bib",
+	"bibcolor",
+	"fiscode",
+	"competitorname",
+	"nationcode",
+	"stage",
+	"level",
+	"heat",
+	"timer1",
+	"timer2",
+	"timer3",
+	"timetot",
+	"valid",
+	"racepoints",
+	"cuppoints",
+	"bonustime",
+	"bonuscuppoints",
+	"version",
+	"rg1",
+	"rg2",
+	"lastupdate",
+}
+
+func (i *AResultcc) columnPtr(col string) any {
+	switch col {
+	case "recid":
+		return &i.Recid
+	case "raceid":
+		return &i.Raceid
+	case "competitorid":
+		return &i.Competitorid
+	case "status":
+		return &i.Status
+	case "reason":
+		return &i.Reason
+	case "position":
+		return &i.Position
+	case "pf":
+		return &i.Pf
+	case "status2":
+		return &i.Status2
+	case "bib":
+		return &i.Bib
+	case "bibcolor":
+		return &i.Bibcolor
+	case "fiscode":
+		return &i.Fiscode
+	case "competitorname":
+		return &i.Competitorname
+	case "nationcode":
+		return &i.Nationcode
+	case "stage":
+		return &i.Stage
+	case "level":
+		return &i.Level
+	case "heat":
+		return &i.Heat
+	case "timer1":
+		return &i.Timer1
+	case "timer2":
+		return &i.Timer2
+	case "timer3":
+		return &i.Timer3
+	case "timetot":
+		return &i.Timetot
+	case "valid":
+		return &i.Valid
+	case "racepoints":
+		return &i.Racepoints
+	case "cuppoints":
+		return &i.Cuppoints
+	case "bonustime":
+		return &i.Bonustime
+	case "bonuscuppoints":
+		return &i.Bonuscuppoints
+	case "version":
+		return &i.Version
+	case "rg1":
+		return &i.Rg1
+	case "rg2":
+		return &i.Rg2
+	case "lastupdate":
+		return &i.Lastupdate
+	}
+	return nil
+}
+
+// AResultjpColumns lists the selectable columns of AResultjp in scan order
+var AResultjpColumns = []string{
+	"recid",
+	"raceid",
+	"competitorid",
+	"status",
+	"status2",
+	"position",
+	"bib",
+	"fiscode",
+	"competitorname",
+	"nationcode",
+	"level",
+	"heat",
+	"stage",
+	"j1r1",
+	"j2r1",
+	"j3r1",
+	"j4r1",
+	"j5r1",
+	"speedr1",
+	"distr1",
+	"disptsr1",
+	"judptsr1",
+	"totrun1",
+	"posr1",
+	"statusr1",
+	"j1r2",
+	"j2r2",
+	"j3r2",
+	"j4r2",
+	"j5r2",
+	"speedr2",
+	"distr2",
+	"disptsr2",
+	"judptsr2",
+	"totrun2",
+	"posr2",
+	"statusr2",
+	"j1r3",
+	"j2r3",
+	"j3r3",
+	"j4r3",
+	"j5r3",
+	"speedr3",
+	"distr3",
+	"disptsr3",
+	"judptsr3",
+	"totrun3",
+	"posr3",
+	"statusr3",
+	"j1r4",
+	"j2r4",
+	"j3r4",
+	"j4r4",
+	"j5r4",
+	"speedr4",
+	"distr4",
+	"disptsr4",
+	"judptsr4",
+	"gater1",
+	"gater2",
+	"gater3",
+	"gater4",
+	"gateptsr1",
+	"gateptsr2",
+	"gateptsr3",
+	"gateptsr4",
+	"windr1",
+	"windr2",
+	"windr3",
+	"windr4",
+	"windptsr1",
+	"windptsr2",
+	"windptsr3",
+	"windptsr4",
+	"reason",
+	"totrun4",
+	"tot",
+	"valid",
+	"racepoints",
+	"cuppoints",
+	"version",
+	"lastupdate",
+	"posr4",
+	"statusr4",
+}
+
+func (i *AResultjp) columnPtr(col string) any {
+	switch col {
+	case "recid":
+		return &i.Recid
+	case "raceid":
+		return &i.Raceid
+	case "competitorid":
+		return &i.Competitorid
+	case "status":
+		return &i.Status
+	case "status2":
+		return &i.Status2
+	case "position":
+		return &i.Position
+	case "bib":
+		return &i.Bib
+	case "fiscode":
+		return &i.Fiscode
+	case "competitorname":
+		return &i.Competitorname
+	case "nationcode":
+		return &i.Nationcode
+	case "level":
+		return &i.Level
+	case "heat":
+		return &i.Heat
+	case "stage":
+		return &i.Stage
+	case "j1r1":
+		return &i.J1r1
+	case "j2r1":
+		return &i.J2r1
+	case "j3r1":
+		return &i.J3r1
+	case "j4r1":
+		return &i.J4r1
+	case "j5r1":
+		return &i.J5r1
+	case "speedr1":
+		return &i.Speedr1
+	case "distr1":
+		return &i.Distr1
+	case "disptsr1":
+		return &i.Disptsr1
+	case "judptsr1":
+		return &i.Judptsr1
+	case "totrun1":
+		return &i.Totrun1
+	case "posr1":
+		return &i.Posr1
+	case "statusr1":
+		return &i.Statusr1
+	case "j1r2":
+		return &i.J1r2
+	case "j2r2":
+		return &i.J2r2
+	case "j3r2":
+		return &i.J3r2
+	case "j4r2":
+		return &i.J4r2
+	case "j5r2":
+		return &i.J5r2
+	case "speedr2":
+		return &i.Speedr2
+	case "distr2":
+		return &i.Distr2
+	case "disptsr2":
+		return &i.Disptsr2
+	case "judptsr2":
+		return &i.Judptsr2
+	case "totrun2":
+		return &i.Totrun2
+	case "posr2":
+		return &i.Posr2
+	case "statusr2":
+		return &i.Statusr2
+	case "j1r3":
+		return &i.J1r3
+	case "j2r3":
+		return &i.J2r3
+	case "j3r3":
+		return &i.J3r3
+	case "j4r3":
+		return &i.J4r3
+	case "j5r3":
+		return &i.J5r3
+	case "speedr3":
+		return &i.Speedr3
+	case "distr3":
+		return &i.Distr3
+	case "disptsr3":
+		return &i.Disptsr3
+	case "judptsr3":
+		return &i.Judptsr3
+	case "totrun3":
+		return &i.Totrun3
+	case "posr3":
+		return &i.Posr3
+	case "statusr3":
+		return &i.Statusr3
+	case "j1r4":
+		return &i.J1r4
+	case "j2r4":
+		return &i.J2r4
+	case "j3r4":
+		return &i.J3r4
+	case "j4r4":
+		return &i.J4r4
+	case "j5r4":
+		return &i.J5r4
+	case "speedr4":
+		return &i.Speedr4
+	case "distr4":
+		return &i.Distr4
+	case "disptsr4":
+		return &i.Disptsr4
+	case "judptsr4":
+		return &i.Judptsr4
+	case "gater1":
+		return &i.Gater1
+	case "gater2":
+		return &i.Gater2
+	case "gater3":
+		return &i.Gater3
+	case "gater4":
+		return &i.Gater4
+	case "gateptsr1":
+		return &i.Gateptsr1
+	case "gateptsr2":
+		return &i.Gateptsr2
+	case "gateptsr3":
+		return &i.Gateptsr3
+	case "gateptsr4":
+		return &i.Gateptsr4
+	case "windr1":
+		return &i.Windr1
+	case "windr2":
+		return &i.Windr2
+	case "windr3":
+		return &i.Windr3
+	case "windr4":
+		return &i.Windr4
+	case "windptsr1":
+		return &i.Windptsr1
+	case "windptsr2":
+		return &i.Windptsr2
+	case "windptsr3":
+		return &i.Windptsr3
+	case "windptsr4":
+		return &i.Windptsr4
+	case "reason":
+		return &i.Reason
+	case "totrun4":
+		return &i.Totrun4
+	case "tot":
+		return &i.Tot
+	case "valid":
+		return &i.Valid
+	case "racepoints":
+		return &i.Racepoints
+	case "cuppoints":
+		return &i.Cuppoints
+	case "version":
+		return &i.Version
+	case "lastupdate":
+		return &i.Lastupdate
+	case "posr4":
+		return &i.Posr4
+	case "statusr4":
+		return &i.Statusr4
+	}
+	return nil
+}
+
+// AResultnkColumns lists the selectable columns of AResultnk in scan order
+var AResultnkColumns = []string{
+	"recid",
+	"raceid",
+	"competitorid",
+	"status",
+	"reason",
+	"position",
+	"pf",
+	"status2",
+	"bib",
+	"bibcolor",
+	"fiscode",
+	"competitorname",
+	"nationcode",
+	"level",
+	"heat",
+	"stage",
+	"j1r1",
+	"j2r1",
+	"j3r1",
+	"j4r1",
+	"j5r1",
+	"speedr1",
+	"distr1",
+	"disptsr1",
+	"judptsr1",
+	"gater1",
+	"gateptsr1",
+	"windr1",
+	"windptsr1",
+	"totrun1",
+	"posr1",
+	"statusr1",
+	"j1r2",
+	"j2r2",
+	"j3r2",
+	"j4r2",
+	"j5r2",
+	"speedr2",
+	"distr2",
+	"disptsr2",
+	"judptsr2",
+	"gater2",
+	"gateptsr2",
+	"windr2",
+	"windptsr2",
+	"totrun2",
+	"posr2",
+	"statusr2",
+	"pointsjump",
+	"behindjump",
+	"posjump",
+	"timecc",
+	"timeccint",
+	"poscc",
+	"starttime",
+	"statuscc",
+	"totbehind",
+	"timetot",
+	"timetotint",
+	"valid",
+	"racepoints",
+	"cuppoints",
+	"version",
+	"lastupdate",
+}
+
+func (i *AResultnk) columnPtr(col string) any {
+	switch col {
+	case "recid":
+		return &i.Recid
+	case "raceid":
+		return &i.Raceid
+	case "competitorid":
+		return &i.Competitorid
+	case "status":
+		return &i.Status
+	case "reason":
+		return &i.Reason
+	case "position":
+		return &i.Position
+	case "pf":
+		return &i.Pf
+	case "status2":
+		return &i.Status2
+	case "bib":
+		return &i.Bib
+	case "bibcolor":
+		return &i.Bibcolor
+	case "fiscode":
+		return &i.Fiscode
+	case "competitorname":
+		return &i.Competitorname
+	case "nationcode":
+		return &i.Nationcode
+	case "level":
+		return &i.Level
+	case "heat":
+		return &i.Heat
+	case "stage":
+		return &i.Stage
+	case "j1r1":
+		return &i.J1r1
+	case "j2r1":
+		return &i.J2r1
+	case "j3r1":
+		return &i.J3r1
+	case "j4r1":
+		return &i.J4r1
+	case "j5r1":
+		return &i.J5r1
+	case "speedr1":
+		return &i.Speedr1
+	case "distr1":
+		return &i.Distr1
+	case "disptsr1":
+		return &i.Disptsr1
+	case "judptsr1":
+		return &i.Judptsr1
+	case "gater1":
+		return &i.Gater1
+	case "gateptsr1":
+		return &i.Gateptsr1
+	case "windr1":
+		return &i.Windr1
+	case "windptsr1":
+		return &i.Windptsr1
+	case "totrun1":
+		return &i.Totrun1
+	case "posr1":
+		return &i.Posr1
+	case "statusr1":
+		return &i.Statusr1
+	case "j1r2":
+		return &i.J1r2
+	case "j2r2":
+		return &i.J2r2
+	case "j3r2":
+		return &i.J3r2
+	case "j4r2":
+		return &i.J4r2
+	case "j5r2":
+		return &i.J5r2
+	case "speedr2":
+		return &i.Speedr2
+	case "distr2":
+		return &i.Distr2
+	case "disptsr2":
+		return &i.Disptsr2
+	case "judptsr2":
+		return &i.Judptsr2
+	case "gater2":
+		return &i.Gater2
+	case "gateptsr2":
+		return &i.Gateptsr2
+	case "windr2":
+		return &i.Windr2
+	case "windptsr2":
+		return &i.Windptsr2
+	case "totrun2":
+		return &i.Totrun2
+	case "posr2":
+		return &i.Posr2
+	case "statusr2":
+		return &i.Statusr2
+	case "pointsjump":
+		return &i.Pointsjump
+	case "behindjump":
+		return &i.Behindjump
+	case "posjump":
+		return &i.Posjump
+	case "timecc":
+		return &i.Timecc
+	case "timeccint":
+		return &i.Timeccint
+	case "poscc":
+		return &i.Poscc
+	case "starttime":
+		return &i.Starttime
+	case "statuscc":
+		return &i.Statuscc
+	case "totbehind":
+		return &i.Totbehind
+	case "timetot":
+		return &i.Timetot
+	case "timetotint":
+		return &i.Timetotint
+	case "valid":
+		return &i.Valid
+	case "racepoints":
+		return &i.Racepoints
+	case "cuppoints":
+		return &i.Cuppoints
+	case "version":
+		return &i.Version
+	case "lastupdate":
+		return &i.Lastupdate
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package fissqlc
+
+import (
+	"strings"
+	"testing"
+)
+
+// selectList returns the columns of the SELECT list of a generated query
+func selectList(t *testing.T, query string) []string {
+	t.Helper()
+	start := strings.Index(query, "\nSELECT ")
+	end := strings.Index(query, "\nFROM ")
+	if start < 0 || end < start {
+		t.Fatalf("query has no SELECT ... FROM list:\n%s", query)
+	}
+	return strings.Split(query[start+len("\nSELECT "):end], ", ")
+}
+
+// TestProjections checks every query the *Columns and Stream* methods project
+// against the column lists and columnPtr switches, so a regenerated query
+// that moves, adds or drops a column fails here instead of at scan time
+func TestProjections(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		cols  []string
+		ptr   func(string) any
+	}{
+		{"GetRacesCC", getRacesCC, ARaceccColumns, new(ARacecc).columnPtr},
+		{"GetRacesJP", getRacesJP, ARacejpColumns, new(ARacejp).columnPtr},
+		{"GetRacesNK", getRacesNK, ARacenkColumns, new(ARacenk).columnPtr},
+		{"GetRaceResultsCCByRaceID", getRaceResultsCCByRaceID, AResultccColumns, new(AResultcc).columnPtr},
+		{"GetRaceResultsJPByRaceID", getRaceResultsJPByRaceID, AResultjpColumns, new(AResultjp).columnPtr},
+		{"GetRaceResultsNKByRaceID", getRaceResultsNKByRaceID, AResultnkColumns, new(AResultnk).columnPtr},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := selectList(t, tt.query)
+			if strings.Join(got, ", ") != strings.Join(tt.cols, ", ") {
+				t.Fatalf("SELECT list and column list differ:\nquery:   %s\ncolumns: %s", strings.Join(got, ", "), strings.Join(tt.cols, ", "))
+			}
+
+			seen := make(map[any]string, len(tt.cols))
+			for _, c := range tt.cols {
+				p := tt.ptr(c)
+				if p == nil {
+					t.Fatalf("columnPtr(%q) = nil", c)
+				}
+				if prev, ok := seen[p]; ok {
+					t.Fatalf("columnPtr(%q) and columnPtr(%q) point at the same field", prev, c)
+				}
+				seen[p] = c
+			}
+
+			sub := []string{tt.cols[len(tt.cols)-1], tt.cols[0]}
+			projected, err := projectSelect(tt.query, sub)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := selectList(t, projected); strings.Join(got, ", ") != strings.Join(sub, ", ") {
+				t.Fatalf("projected SELECT list = %v, want %v", got, sub)
+			}
+			if !strings.HasSuffix(projected, tt.query[strings.Index(tt.query, "\nFROM "):]) {
+				t.Fatalf("projection changed the query after the SELECT list:\n%s", projected)
+			}
+		})
+	}
+
+	if _, err := projectSelect("SELECT raceid FROM a_racecc", []string{"raceid"}); err == nil {
+		t.Fatal("projectSelect accepted a query without the generated layout")
+	}
+}
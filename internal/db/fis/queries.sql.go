@@ -2683,17 +2683,27 @@ FROM A_raceCC
 WHERE ($1::int[]  IS NULL OR SeasonCode     = ANY($1))
   AND ($2::text[] IS NULL OR DisciplineCode = ANY($2))
   AND ($3::text[] IS NULL OR CatCode        = ANY($3))
+  AND ($4::int4   = 0    OR RaceID         > $4::int4)
 ORDER BY RaceID
+LIMIT NULLIF($5::int4, 0)
 `
 
 type GetRacesCCParams struct {
 	Column1 []int32
 	Column2 []string
 	Column3 []string
+	Column4 int32
+	Column5 int32
 }
 
 func (q *Queries) GetRacesCC(ctx context.Context, arg GetRacesCCParams) ([]ARacecc, error) {
-	rows, err := q.query(ctx, q.getRacesCCStmt, getRacesCC, pq.Array(arg.Column1), pq.Array(arg.Column2), pq.Array(arg.Column3))
+	rows, err := q.query(ctx, q.getRacesCCStmt, getRacesCC,
+		pq.Array(arg.Column1),
+		pq.Array(arg.Column2),
+		pq.Array(arg.Column3),
+		arg.Column4,
+		arg.Column5,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -2810,17 +2820,27 @@ FROM A_raceJP
 WHERE ($1::int[]  IS NULL OR SeasonCode     = ANY($1))
   AND ($2::text[] IS NULL OR DisciplineCode = ANY($2))
   AND ($3::text[] IS NULL OR CatCode        = ANY($3))
+  AND ($4::int4   = 0    OR RaceID         > $4::int4)
 ORDER BY RaceID
+LIMIT NULLIF($5::int4, 0)
 `
 
 type GetRacesJPParams struct {
 	Column1 []int32
 	Column2 []string
 	Column3 []string
+	Column4 int32
+	Column5 int32
 }
 
 func (q *Queries) GetRacesJP(ctx context.Context, arg GetRacesJPParams) ([]ARacejp, error) {
-	rows, err := q.query(ctx, q.getRacesJPStmt, getRacesJP, pq.Array(arg.Column1), pq.Array(arg.Column2), pq.Array(arg.Column3))
+	rows, err := q.query(ctx, q.getRacesJPStmt, getRacesJP,
+		pq.Array(arg.Column1),
+		pq.Array(arg.Column2),
+		pq.Array(arg.Column3),
+		arg.Column4,
+		arg.Column5,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -2937,17 +2957,27 @@ FROM A_raceNK
 WHERE ($1::int[]  IS NULL OR SeasonCode     = ANY($1))
   AND ($2::text[] IS NULL OR DisciplineCode = ANY($2))
   AND ($3::text[] IS NULL OR CatCode        = ANY($3))
+  AND ($4::int4   = 0    OR RaceID         > $4::int4)
 ORDER BY RaceID
+LIMIT NULLIF($5::int4, 0)
 `
 
 type GetRacesNKParams struct {
 	Column1 []int32
 	Column2 []string
 	Column3 []string
+	Column4 int32
+	Column5 int32
 }
 
 func (q *Queries) GetRacesNK(ctx context.Context, arg GetRacesNKParams) ([]ARacenk, error) {
-	rows, err := q.query(ctx, q.getRacesNKStmt, getRacesNK, pq.Array(arg.Column1), pq.Array(arg.Column2), pq.Array(arg.Column3))
+	rows, err := q.query(ctx, q.getRacesNKStmt, getRacesNK,
+		pq.Array(arg.Column1),
+		pq.Array(arg.Column2),
+		pq.Array(arg.Column3),
+		arg.Column4,
+		arg.Column5,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -3058,6 +3088,276 @@ func (q *Queries) GetRacesNK(ctx context.Context, arg GetRacesNKParams) ([]ARace
 	return items, nil
 }
 
+const getResultsByRaceIDsCC = `-- name: GetResultsByRaceIDsCC :many
+SELECT recid, raceid, competitorid, status, reason, position, pf, status2, bib, bibcolor, fiscode, competitorname, nationcode, stage, level, heat, timer1, timer2, timer3, timetot, valid, racepoints, cuppoints, bonustime, bonuscuppoints, version, rg1, rg2, lastupdate
+FROM public.a_resultcc
+WHERE raceid = ANY($1::int[])
+ORDER BY raceid, recid
+`
+
+func (q *Queries) GetResultsByRaceIDsCC(ctx context.Context, dollar_1 []int32) ([]AResultcc, error) {
+	rows, err := q.query(ctx, q.getResultsByRaceIDsCCStmt, getResultsByRaceIDsCC, pq.Array(dollar_1))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AResultcc
+	for rows.Next() {
+		var i AResultcc
+		if err := rows.Scan(
+			&i.Recid,
+			&i.Raceid,
+			&i.Competitorid,
+			&i.Status,
+			&i.Reason,
+			&i.Position,
+			&i.Pf,
+			&i.Status2,
+			&i.Bib,
+			&i.Bibcolor,
+			&i.Fiscode,
+			&i.Competitorname,
+			&i.Nationcode,
+			&i.Stage,
+			&i.Level,
+			&i.Heat,
+			&i.Timer1,
+			&i.Timer2,
+			&i.Timer3,
+			&i.Timetot,
+			&i.Valid,
+			&i.Racepoints,
+			&i.Cuppoints,
+			&i.Bonustime,
+			&i.Bonuscuppoints,
+			&i.Version,
+			&i.Rg1,
+			&i.Rg2,
+			&i.Lastupdate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getResultsByRaceIDsJP = `-- name: GetResultsByRaceIDsJP :many
+SELECT recid, raceid, competitorid, status, status2, position, bib, fiscode, competitorname, nationcode, level, heat, stage, j1r1, j2r1, j3r1, j4r1, j5r1, speedr1, distr1, disptsr1, judptsr1, totrun1, posr1, statusr1, j1r2, j2r2, j3r2, j4r2, j5r2, speedr2, distr2, disptsr2, judptsr2, totrun2, posr2, statusr2, j1r3, j2r3, j3r3, j4r3, j5r3, speedr3, distr3, disptsr3, judptsr3, totrun3, posr3, statusr3, j1r4, j2r4, j3r4, j4r4, j5r4, speedr4, distr4, disptsr4, judptsr4, gater1, gater2, gater3, gater4, gateptsr1, gateptsr2, gateptsr3, gateptsr4, windr1, windr2, windr3, windr4, windptsr1, windptsr2, windptsr3, windptsr4, reason, totrun4, tot, valid, racepoints, cuppoints, version, lastupdate, posr4, statusr4
+FROM public.a_resultjp
+WHERE raceid = ANY($1::int[])
+ORDER BY raceid, recid
+`
+
+func (q *Queries) GetResultsByRaceIDsJP(ctx context.Context, dollar_1 []int32) ([]AResultjp, error) {
+	rows, err := q.query(ctx, q.getResultsByRaceIDsJPStmt, getResultsByRaceIDsJP, pq.Array(dollar_1))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AResultjp
+	for rows.Next() {
+		var i AResultjp
+		if err := rows.Scan(
+			&i.Recid,
+			&i.Raceid,
+			&i.Competitorid,
+			&i.Status,
+			&i.Status2,
+			&i.Position,
+			&i.Bib,
+			&i.Fiscode,
+			&i.Competitorname,
+			&i.Nationcode,
+			&i.Level,
+			&i.Heat,
+			&i.Stage,
+			&i.J1r1,
+			&i.J2r1,
+			&i.J3r1,
+			&i.J4r1,
+			&i.J5r1,
+			&i.Speedr1,
+			&i.Distr1,
+			&i.Disptsr1,
+			&i.Judptsr1,
+			&i.Totrun1,
+			&i.Posr1,
+			&i.Statusr1,
+			&i.J1r2,
+			&i.J2r2,
+			&i.J3r2,
+			&i.J4r2,
+			&i.J5r2,
+			&i.Speedr2,
+			&i.Distr2,
+			&i.Disptsr2,
+			&i.Judptsr2,
+			&i.Totrun2,
+			&i.Posr2,
+			&i.Statusr2,
+			&i.J1r3,
+			&i.J2r3,
+			&i.J3r3,
+			&i.J4r3,
+			&i.J5r3,
+			&i.Speedr3,
+			&i.Distr3,
+			&i.Disptsr3,
+			&i.Judptsr3,
+			&i.Totrun3,
+			&i.Posr3,
+			&i.Statusr3,
+			&i.J1r4,
+			&i.J2r4,
+			&i.J3r4,
+			&i.J4r4,
+			&i.J5r4,
+			&i.Speedr4,
+			&i.Distr4,
+			&i.Disptsr4,
+			&i.Judptsr4,
+			&i.Gater1,
+			&i.Gater2,
+			&i.Gater3,
+			&i.Gater4,
+			&i.Gateptsr1,
+			&i.Gateptsr2,
+			&i.Gateptsr3,
+			&i.Gateptsr4,
+			&i.Windr1,
+			&i.Windr2,
+			&i.Windr3,
+			&i.Windr4,
+			&i.Windptsr1,
+			&i.Windptsr2,
+			&i.Windptsr3,
+			&i.Windptsr4,
+			&i.Reason,
+			&i.Totrun4,
+			&i.Tot,
+			&i.Valid,
+			&i.Racepoints,
+			&i.Cuppoints,
+			&i.Version,
+			&i.Lastupdate,
+			&i.Posr4,
+			&i.Statusr4,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getResultsByRaceIDsNK = `-- name: GetResultsByRaceIDsNK :many
+SELECT recid, raceid, competitorid, status, reason, position, pf, status2, bib, bibcolor, fiscode, competitorname, nationcode, level, heat, stage, j1r1, j2r1, j3r1, j4r1, j5r1, speedr1, distr1, disptsr1, judptsr1, gater1, gateptsr1, windr1, windptsr1, totrun1, posr1, statusr1, j1r2, j2r2, j3r2, j4r2, j5r2, speedr2, distr2, disptsr2, judptsr2, gater2, gateptsr2, windr2, windptsr2, totrun2, posr2, statusr2, pointsjump, behindjump, posjump, timecc, timeccint, poscc, starttime, statuscc, totbehind, timetot, timetotint, valid, racepoints, cuppoints, version, lastupdate
+FROM public.a_resultnk
+WHERE raceid = ANY($1::int[])
+ORDER BY raceid, recid
+`
+
+func (q *Queries) GetResultsByRaceIDsNK(ctx context.Context, dollar_1 []int32) ([]AResultnk, error) {
+	rows, err := q.query(ctx, q.getResultsByRaceIDsNKStmt, getResultsByRaceIDsNK, pq.Array(dollar_1))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AResultnk
+	for rows.Next() {
+		var i AResultnk
+		if err := rows.Scan(
+			&i.Recid,
+			&i.Raceid,
+			&i.Competitorid,
+			&i.Status,
+			&i.Reason,
+			&i.Position,
+			&i.Pf,
+			&i.Status2,
+			&i.Bib,
+			&i.Bibcolor,
+			&i.Fiscode,
+			&i.Competitorname,
+			&i.Nationcode,
+			&i.Level,
+			&i.Heat,
+			&i.Stage,
+			&i.J1r1,
+			&i.J2r1,
+			&i.J3r1,
+			&i.J4r1,
+			&i.J5r1,
+			&i.Speedr1,
+			&i.Distr1,
+			&i.Disptsr1,
+			&i.Judptsr1,
+			&i.Gater1,
+			&i.Gateptsr1,
+			&i.Windr1,
+			&i.Windptsr1,
+			&i.Totrun1,
+			&i.Posr1,
+			&i.Statusr1,
+			&i.J1r2,
+			&i.J2r2,
+			&i.J3r2,
+			&i.J4r2,
+			&i.J5r2,
+			&i.Speedr2,
+			&i.Distr2,
+			&i.Disptsr2,
+			&i.Judptsr2,
+			&i.Gater2,
+			&i.Gateptsr2,
+			&i.Windr2,
+			&i.Windptsr2,
+			&i.Totrun2,
+			&i.Posr2,
+			&i.Statusr2,
+			&i.Pointsjump,
+			&i.Behindjump,
+			&i.Posjump,
+			&i.Timecc,
+			&i.Timeccint,
+			&i.Poscc,
+			&i.Starttime,
+			&i.Statuscc,
+			&i.Totbehind,
+			&i.Timetot,
+			&i.Timetotint,
+			&i.Valid,
+			&i.Racepoints,
+			&i.Cuppoints,
+			&i.Version,
+			&i.Lastupdate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getSeasonsCatcodesCCByCompetitor = `-- name: GetSeasonsCatcodesCCByCompetitor :many
 SELECT DISTINCT
   rcc.seasoncode,
@@ -4567,7 +4867,9 @@ WHERE ($1::text = '' OR nationcode  = $1::text)
   AND ($3::text = '' OR gender      = $3::text)
   AND ($4::date = '0001-01-01' OR birthdate >= $4::date)
   AND ($5::date = '0001-01-01' OR birthdate <= $5::date)
+  AND ($6::int4 = 0            OR competitorid > $6::int4)
 ORDER BY competitorid
+LIMIT NULLIF($7::int4, 0)
 `
 
 type SearchCompetitorsParams struct {
@@ -4576,6 +4878,8 @@ type SearchCompetitorsParams struct {
 	Column3 string
 	Column4 time.Time
 	Column5 time.Time
+	Column6 int32
+	Column7 int32
 }
 
 func (q *Queries) SearchCompetitors(ctx context.Context, arg SearchCompetitorsParams) ([]ACompetitor, error) {
@@ -4585,6 +4889,8 @@ func (q *Queries) SearchCompetitors(ctx context.Context, arg SearchCompetitorsPa
 		arg.Column3,
 		arg.Column4,
 		arg.Column5,
+		arg.Column6,
+		arg.Column7,
 	)
 	if err != nil {
 		return nil, err
@@ -162,7 +162,9 @@ FROM A_raceNK
 WHERE ($1::int[]  IS NULL OR SeasonCode     = ANY($1))
   AND ($2::text[] IS NULL OR DisciplineCode = ANY($2))
   AND ($3::text[] IS NULL OR CatCode        = ANY($3))
-ORDER BY RaceID;
+  AND ($4::int4   = 0    OR RaceID         > $4::int4)
+ORDER BY RaceID
+LIMIT NULLIF($5::int4, 0);
 
 -- name: GetRacesJP :many
 SELECT *
@@ -170,7 +172,9 @@ FROM A_raceJP
 WHERE ($1::int[]  IS NULL OR SeasonCode     = ANY($1))
   AND ($2::text[] IS NULL OR DisciplineCode = ANY($2))
   AND ($3::text[] IS NULL OR CatCode        = ANY($3))
-ORDER BY RaceID;
+  AND ($4::int4   = 0    OR RaceID         > $4::int4)
+ORDER BY RaceID
+LIMIT NULLIF($5::int4, 0);
 
 -- name: GetRacesCC :many
 SELECT *
@@ -178,7 +182,9 @@ FROM A_raceCC
 WHERE ($1::int[]  IS NULL OR SeasonCode     = ANY($1))
   AND ($2::text[] IS NULL OR DisciplineCode = ANY($2))
   AND ($3::text[] IS NULL OR CatCode        = ANY($3))
-ORDER BY RaceID;
+  AND ($4::int4   = 0    OR RaceID         > $4::int4)
+ORDER BY RaceID
+LIMIT NULLIF($5::int4, 0);
 
 
 -- name: GetRaceResultsNKByRaceID :many
@@ -1095,7 +1101,9 @@ WHERE ($1::text = '' OR nationcode  = $1::text)
   AND ($3::text = '' OR gender      = $3::text)
   AND ($4::date = '0001-01-01' OR birthdate >= $4::date)
   AND ($5::date = '0001-01-01' OR birthdate <= $5::date)
-ORDER BY competitorid;
+  AND ($6::int4 = 0            OR competitorid > $6::int4)
+ORDER BY competitorid
+LIMIT NULLIF($7::int4, 0);
 
 
 -- name: GetRacesByIDsCC :many
@@ -1116,6 +1124,24 @@ FROM A_raceNK
 WHERE raceid = ANY($1::int[])
 ORDER BY raceid;
 
+-- name: GetResultsByRaceIDsCC :many
+SELECT *
+FROM public.a_resultcc
+WHERE raceid = ANY($1::int[])
+ORDER BY raceid, recid;
+
+-- name: GetResultsByRaceIDsJP :many
+SELECT *
+FROM public.a_resultjp
+WHERE raceid = ANY($1::int[])
+ORDER BY raceid, recid;
+
+-- name: GetResultsByRaceIDsNK :many
+SELECT *
+FROM public.a_resultnk
+WHERE raceid = ANY($1::int[])
+ORDER BY raceid, recid;
+
 -- name: GetSeasonsCatcodesCCByCompetitor :many
 SELECT DISTINCT
   rcc.seasoncode,
@@ -0,0 +1,337 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"expvar"
+	"fmt"
+	"io"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authn"
+	"github.com/DeRuina/KUHA-REST-API/internal/logger"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/lib/pq"
+)
+
+// Query instrumentation config
+type QueryLogConfig struct {
+	Enabled       bool
+	SlowThreshold time.Duration
+	AppName       string
+}
+
+var queryLogCfg = QueryLogConfig{
+	Enabled:       true,
+	SlowThreshold: 500 * time.Millisecond,
+	AppName:       "kuha-rest-api",
+}
+
+func LoadQueryLogConfig(cfg QueryLogConfig) {
+	queryLogCfg = cfg
+}
+
+// QueryStat holds the aggregated timings of a single named query
+type QueryStat struct {
+	Calls   int64   `json:"calls"`
+	Errors  int64   `json:"errors"`
+	Slow    int64   `json:"slow"`
+	Rows    int64   `json:"rows"`
+	TotalMs float64 `json:"total_ms"`
+	MaxMs   float64 `json:"max_ms"`
+	AvgMs   float64 `json:"avg_ms"`
+}
+
+var (
+	queryStatsMu sync.Mutex
+	queryStats   = map[string]*QueryStat{}
+)
+
+// QueryStats returns a snapshot of the per-query statistics, keyed by "<database>.<query name>"
+func QueryStats() map[string]QueryStat {
+	queryStatsMu.Lock()
+	defer queryStatsMu.Unlock()
+
+	out := make(map[string]QueryStat, len(queryStats))
+	for k, v := range queryStats {
+		s := *v
+		if s.Calls > 0 {
+			s.AvgMs = s.TotalMs / float64(s.Calls)
+		}
+		out[k] = s
+	}
+	return out
+}
+
+func init() {
+	expvar.Publish("db_queries", expvar.Func(func() any {
+		return QueryStats()
+	}))
+}
+
+// sqlc prefixes every generated statement with "-- name: <Name> :<kind>"
+var queryNameRe = regexp.MustCompile(`^\s*--\s*name:\s*(\w+)`)
+
+func queryName(query string) string {
+	if m := queryNameRe.FindStringSubmatch(query); m != nil {
+		return m[1]
+	}
+	return "raw"
+}
+
+func recordQuery(ctx context.Context, database, query string, start time.Time, rows int64, err error) {
+	elapsed := time.Since(start)
+	ms := float64(elapsed) / float64(time.Millisecond)
+	name := queryName(query)
+	slow := queryLogCfg.SlowThreshold > 0 && elapsed >= queryLogCfg.SlowThreshold
+
+	queryStatsMu.Lock()
+	key := database + "." + name
+	s, ok := queryStats[key]
+	if !ok {
+		s = &QueryStat{}
+		queryStats[key] = s
+	}
+	s.Calls++
+	s.TotalMs += ms
+	if ms > s.MaxMs {
+		s.MaxMs = ms
+	}
+	if rows > 0 {
+		s.Rows += rows
+	}
+	if err != nil && err != io.EOF {
+		s.Errors++
+	}
+	if slow {
+		s.Slow++
+	}
+	queryStatsMu.Unlock()
+
+	if !slow || logger.Logger == nil {
+		return
+	}
+
+	clientID := authn.GetClientName(ctx)
+	if clientID == "" {
+		clientID = "anonymous"
+	}
+
+	logger.Logger.Warnw("slow query",
+		"database", database,
+		"query", name,
+		"duration", elapsed,
+		"rows", rows,
+		"client_id", clientID,
+		"request_id", middleware.GetReqID(ctx),
+	)
+}
+
+// tagQuery prepends a SQL comment so statements can be correlated in pg_stat_statements and the server logs
+func tagQuery(ctx context.Context, database, query string) string {
+	var b strings.Builder
+	b.WriteString("/* app=")
+	b.WriteString(sanitizeComment(queryLogCfg.AppName))
+	b.WriteString(",db=")
+	b.WriteString(database)
+	b.WriteString(",query=")
+	b.WriteString(queryName(query))
+	if client := authn.GetClientName(ctx); client != "" {
+		b.WriteString(",client=")
+		b.WriteString(sanitizeComment(client))
+	}
+	if reqID := middleware.GetReqID(ctx); reqID != "" {
+		b.WriteString(",request_id=")
+		b.WriteString(sanitizeComment(reqID))
+	}
+	b.WriteString(" */ ")
+	b.WriteString(query)
+	return b.String()
+}
+
+func sanitizeComment(s string) string {
+	s = strings.ReplaceAll(s, "*/", "")
+	s = strings.ReplaceAll(s, "/*", "")
+	return strings.ReplaceAll(s, "\n", " ")
+}
+
+// withApplicationName adds application_name to the DSN unless it is already set
+func withApplicationName(dsn, database string) string {
+	appName := fmt.Sprintf("%s/%s", queryLogCfg.AppName, database)
+
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		u, err := url.Parse(dsn)
+		if err != nil {
+			return dsn
+		}
+		q := u.Query()
+		if q.Get("application_name") == "" {
+			q.Set("application_name", appName)
+			u.RawQuery = q.Encode()
+		}
+		return u.String()
+	}
+
+	if strings.Contains(dsn, "application_name=") {
+		return dsn
+	}
+	return strings.TrimSpace(dsn + " application_name='" + strings.ReplaceAll(appName, "'", `\'`) + "'")
+}
+
+// openInstrumented opens a *sql.DB whose connections time, count and tag every statement.
+// It sits below the sqlc DBTX so queries run through *sql.DB, *sql.Tx and raw SQL are all covered.
+func openInstrumented(database, addr string) (*sql.DB, error) {
+	if !queryLogCfg.Enabled {
+		return sql.Open("postgres", addr)
+	}
+
+	connector, err := pq.NewConnector(withApplicationName(addr, database))
+	if err != nil {
+		return nil, err
+	}
+	return sql.OpenDB(&instrumentedConnector{Connector: connector, database: database}), nil
+}
+
+type instrumentedConnector struct {
+	*pq.Connector
+	database string
+}
+
+func (c *instrumentedConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &instrumentedConn{conn: conn, database: c.database}, nil
+}
+
+// pqConn lists the optional driver interfaces implemented by lib/pq connections
+type pqConn interface {
+	driver.Conn
+	driver.ConnBeginTx
+	driver.ConnPrepareContext
+	driver.QueryerContext
+	driver.ExecerContext
+	driver.Pinger
+	driver.SessionResetter
+	driver.Validator
+}
+
+type instrumentedConn struct {
+	conn     driver.Conn
+	database string
+}
+
+func (c *instrumentedConn) pq() pqConn {
+	return c.conn.(pqConn)
+}
+
+func (c *instrumentedConn) Prepare(query string) (driver.Stmt, error) {
+	return c.conn.Prepare(query)
+}
+
+func (c *instrumentedConn) Close() error {
+	return c.conn.Close()
+}
+
+func (c *instrumentedConn) Begin() (driver.Tx, error) {
+	return c.pq().BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *instrumentedConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return c.pq().BeginTx(ctx, opts)
+}
+
+func (c *instrumentedConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return c.pq().PrepareContext(ctx, tagQuery(ctx, c.database, query))
+}
+
+func (c *instrumentedConn) Ping(ctx context.Context) error {
+	return c.pq().Ping(ctx)
+}
+
+func (c *instrumentedConn) ResetSession(ctx context.Context) error {
+	return c.pq().ResetSession(ctx)
+}
+
+func (c *instrumentedConn) IsValid() bool {
+	return c.pq().IsValid()
+}
+
+func (c *instrumentedConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	start := time.Now()
+	res, err := c.pq().ExecContext(ctx, tagQuery(ctx, c.database, query), args)
+
+	var affected int64
+	if err == nil {
+		affected, _ = res.RowsAffected()
+	}
+	recordQuery(ctx, c.database, query, start, affected, err)
+	return res, err
+}
+
+func (c *instrumentedConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	start := time.Now()
+	rows, err := c.pq().QueryContext(ctx, tagQuery(ctx, c.database, query), args)
+	if err != nil {
+		recordQuery(ctx, c.database, query, start, 0, err)
+		return nil, err
+	}
+	return &instrumentedRows{
+		Rows:     rows,
+		ctx:      ctx,
+		database: c.database,
+		query:    query,
+		start:    start,
+	}, nil
+}
+
+// instrumentedRows counts the rows read and records the query once the result set is closed
+type instrumentedRows struct {
+	driver.Rows
+	ctx      context.Context
+	database string
+	query    string
+	start    time.Time
+	count    int64
+	err      error
+	once     sync.Once
+}
+
+func (r *instrumentedRows) Next(dest []driver.Value) error {
+	err := r.Rows.Next(dest)
+	if err == nil {
+		r.count++
+	} else if err != io.EOF {
+		r.err = err
+	}
+	return err
+}
+
+func (r *instrumentedRows) Close() error {
+	err := r.Rows.Close()
+	r.once.Do(func() {
+		recordQuery(r.ctx, r.database, r.query, r.start, r.count, r.err)
+	})
+	return err
+}
+
+func (r *instrumentedRows) ColumnTypeScanType(index int) reflect.Type {
+	if ct, ok := r.Rows.(driver.RowsColumnTypeScanType); ok {
+		return ct.ColumnTypeScanType(index)
+	}
+	return reflect.TypeOf(new(any)).Elem()
+}
+
+func (r *instrumentedRows) ColumnTypeDatabaseTypeName(index int) string {
+	if ct, ok := r.Rows.(driver.RowsColumnTypeDatabaseTypeName); ok {
+		return ct.ColumnTypeDatabaseTypeName(index)
+	}
+	return ""
+}
@@ -7,6 +7,7 @@ package kamksqlc
 
 import (
 	"context"
+	"database/sql"
 	"time"
 )
 
@@ -63,7 +64,7 @@ SELECT
 FROM public.injuries
 WHERE user_id = $1
   AND status = 0
-ORDER BY date_start DESC
+ORDER BY date_start DESC, injury_id DESC
 `
 
 func (q *Queries) GetActiveInjuriesByUser(ctx context.Context, userID int32) ([]Injury, error) {
@@ -124,9 +125,19 @@ SELECT
   meta
 FROM public.querys
 WHERE user_id = $1
-ORDER BY "timestamp" DESC
+  AND ($2::timestamp IS NULL
+       OR ("timestamp", id) < ($2::timestamp, $3::int8))
+ORDER BY "timestamp" DESC, id DESC
+LIMIT $4::int4
 `
 
+type GetQuestionnairesByUserParams struct {
+	UserID     int32
+	CursorTime sql.NullTime
+	CursorID   sql.NullInt64
+	PageLimit  sql.NullInt32
+}
+
 type GetQuestionnairesByUserRow struct {
 	ID        int64
 	UserID    int32
@@ -137,8 +148,13 @@ type GetQuestionnairesByUserRow struct {
 	Meta      string
 }
 
-func (q *Queries) GetQuestionnairesByUser(ctx context.Context, userID int32) ([]GetQuestionnairesByUserRow, error) {
-	rows, err := q.query(ctx, q.getQuestionnairesByUserStmt, getQuestionnairesByUser, userID)
+func (q *Queries) GetQuestionnairesByUser(ctx context.Context, arg GetQuestionnairesByUserParams) ([]GetQuestionnairesByUserRow, error) {
+	rows, err := q.query(ctx, q.getQuestionnairesByUserStmt, getQuestionnairesByUser,
+		arg.UserID,
+		arg.CursorTime,
+		arg.CursorID,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -28,7 +28,7 @@ SELECT
 FROM public.injuries
 WHERE user_id = $1
   AND status = 0
-ORDER BY date_start DESC;
+ORDER BY date_start DESC, injury_id DESC;
 
 -- name: GetMaxInjuryIDForUser :one
 SELECT COALESCE(MAX(injury_id), 0)::int4 AS id
@@ -54,8 +54,11 @@ SELECT
   "timestamp",
   meta
 FROM public.querys
-WHERE user_id = $1
-ORDER BY "timestamp" DESC;
+WHERE user_id = sqlc.arg('user_id')
+  AND (sqlc.narg('cursor_time')::timestamp IS NULL
+       OR ("timestamp", id) < (sqlc.narg('cursor_time')::timestamp, sqlc.narg('cursor_id')::int8))
+ORDER BY "timestamp" DESC, id DESC
+LIMIT sqlc.narg('page_limit')::int4;
 
 -- name: IsQuizDoneToday :many
 SELECT
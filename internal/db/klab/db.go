@@ -48,6 +48,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getDirTestsByMeasurementIDsStmt, err = db.PrepareContext(ctx, getDirTestsByMeasurementIDs); err != nil {
 		return nil, fmt.Errorf("error preparing query GetDirTestsByMeasurementIDs: %w", err)
 	}
+	if q.getLatestMeasurementByCustomerStmt, err = db.PrepareContext(ctx, getLatestMeasurementByCustomer); err != nil {
+		return nil, fmt.Errorf("error preparing query GetLatestMeasurementByCustomer: %w", err)
+	}
 	if q.getMeasurementsByCustomerStmt, err = db.PrepareContext(ctx, getMeasurementsByCustomer); err != nil {
 		return nil, fmt.Errorf("error preparing query GetMeasurementsByCustomer: %w", err)
 	}
@@ -117,6 +120,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getDirTestsByMeasurementIDsStmt: %w", cerr)
 		}
 	}
+	if q.getLatestMeasurementByCustomerStmt != nil {
+		if cerr := q.getLatestMeasurementByCustomerStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getLatestMeasurementByCustomerStmt: %w", cerr)
+		}
+	}
 	if q.getMeasurementsByCustomerStmt != nil {
 		if cerr := q.getMeasurementsByCustomerStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getMeasurementsByCustomerStmt: %w", cerr)
@@ -204,6 +212,7 @@ type Queries struct {
 	getDirResultsByMeasurementIDsStmt   *sql.Stmt
 	getDirTestStepsByMeasurementIDsStmt *sql.Stmt
 	getDirTestsByMeasurementIDsStmt     *sql.Stmt
+	getLatestMeasurementByCustomerStmt  *sql.Stmt
 	getMeasurementsByCustomerStmt       *sql.Stmt
 	insertDirRawDataStmt                *sql.Stmt
 	insertDirReportStmt                 *sql.Stmt
@@ -226,6 +235,7 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		getDirResultsByMeasurementIDsStmt:   q.getDirResultsByMeasurementIDsStmt,
 		getDirTestStepsByMeasurementIDsStmt: q.getDirTestStepsByMeasurementIDsStmt,
 		getDirTestsByMeasurementIDsStmt:     q.getDirTestsByMeasurementIDsStmt,
+		getLatestMeasurementByCustomerStmt:  q.getLatestMeasurementByCustomerStmt,
 		getMeasurementsByCustomerStmt:       q.getMeasurementsByCustomerStmt,
 		insertDirRawDataStmt:                q.insertDirRawDataStmt,
 		insertDirReportStmt:                 q.insertDirReportStmt,
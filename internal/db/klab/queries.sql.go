@@ -481,15 +481,67 @@ func (q *Queries) GetDirTestsByMeasurementIDs(ctx context.Context, dollar_1 []in
 	return items, nil
 }
 
+const getLatestMeasurementByCustomer = `-- name: GetLatestMeasurementByCustomer :one
+SELECT idmeasurement, measname, idcustomer, tablename, idpatterndef, do_year, do_month, do_day, do_hour, do_min, sessionno, info, measurements, groupnotes, cbcharts, cbcomments, created_by, mod_by, mod_date, deleted, created_date, modded, test_location, keywords, tester_name, modder_name, meastype, sent_to_sprintai
+FROM measurement_list
+WHERE idcustomer = $1
+ORDER BY idmeasurement DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLatestMeasurementByCustomer(ctx context.Context, idcustomer int32) (MeasurementList, error) {
+	row := q.queryRow(ctx, q.getLatestMeasurementByCustomerStmt, getLatestMeasurementByCustomer, idcustomer)
+	var i MeasurementList
+	err := row.Scan(
+		&i.Idmeasurement,
+		&i.Measname,
+		&i.Idcustomer,
+		&i.Tablename,
+		&i.Idpatterndef,
+		&i.DoYear,
+		&i.DoMonth,
+		&i.DoDay,
+		&i.DoHour,
+		&i.DoMin,
+		&i.Sessionno,
+		&i.Info,
+		&i.Measurements,
+		&i.Groupnotes,
+		&i.Cbcharts,
+		&i.Cbcomments,
+		&i.CreatedBy,
+		&i.ModBy,
+		&i.ModDate,
+		&i.Deleted,
+		&i.CreatedDate,
+		&i.Modded,
+		&i.TestLocation,
+		&i.Keywords,
+		&i.TesterName,
+		&i.ModderName,
+		&i.Meastype,
+		&i.SentToSprintai,
+	)
+	return i, err
+}
+
 const getMeasurementsByCustomer = `-- name: GetMeasurementsByCustomer :many
 SELECT idmeasurement, measname, idcustomer, tablename, idpatterndef, do_year, do_month, do_day, do_hour, do_min, sessionno, info, measurements, groupnotes, cbcharts, cbcomments, created_by, mod_by, mod_date, deleted, created_date, modded, test_location, keywords, tester_name, modder_name, meastype, sent_to_sprintai
 FROM measurement_list
 WHERE idcustomer = $1
+  AND ($2::int4 = 0 OR idmeasurement > $2::int4)
 ORDER BY idmeasurement
+LIMIT NULLIF($3::int4, 0)
 `
 
-func (q *Queries) GetMeasurementsByCustomer(ctx context.Context, idcustomer int32) ([]MeasurementList, error) {
-	rows, err := q.query(ctx, q.getMeasurementsByCustomerStmt, getMeasurementsByCustomer, idcustomer)
+type GetMeasurementsByCustomerParams struct {
+	Idcustomer int32
+	Column2    int32
+	Column3    int32
+}
+
+func (q *Queries) GetMeasurementsByCustomer(ctx context.Context, arg GetMeasurementsByCustomerParams) ([]MeasurementList, error) {
+	rows, err := q.query(ctx, q.getMeasurementsByCustomerStmt, getMeasurementsByCustomer, arg.Idcustomer, arg.Column2, arg.Column3)
 	if err != nil {
 		return nil, err
 	}
@@ -443,11 +443,20 @@ ON CONFLICT (iddirresults) DO UPDATE SET
 SELECT * FROM customer
 WHERE idcustomer = $1;
 
+-- name: GetLatestMeasurementByCustomer :one
+SELECT *
+FROM measurement_list
+WHERE idcustomer = $1
+ORDER BY idmeasurement DESC
+LIMIT 1;
+
 -- name: GetMeasurementsByCustomer :many
 SELECT *
 FROM measurement_list
 WHERE idcustomer = $1
-ORDER BY idmeasurement;
+  AND ($2::int4 = 0 OR idmeasurement > $2::int4)
+ORDER BY idmeasurement
+LIMIT NULLIF($3::int4, 0);
 
 -- name: GetDirTestsByMeasurementIDs :many
 SELECT *
@@ -36,12 +36,21 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getExerciseHRZonesStmt, err = db.PrepareContext(ctx, getExerciseHRZones); err != nil {
 		return nil, fmt.Errorf("error preparing query GetExerciseHRZones: %w", err)
 	}
+	if q.getExerciseHRZonesByExerciseIDsStmt, err = db.PrepareContext(ctx, getExerciseHRZonesByExerciseIDs); err != nil {
+		return nil, fmt.Errorf("error preparing query GetExerciseHRZonesByExerciseIDs: %w", err)
+	}
 	if q.getExerciseSamplesStmt, err = db.PrepareContext(ctx, getExerciseSamples); err != nil {
 		return nil, fmt.Errorf("error preparing query GetExerciseSamples: %w", err)
 	}
+	if q.getExerciseSamplesByExerciseIDsStmt, err = db.PrepareContext(ctx, getExerciseSamplesByExerciseIDs); err != nil {
+		return nil, fmt.Errorf("error preparing query GetExerciseSamplesByExerciseIDs: %w", err)
+	}
 	if q.getExerciseSectionsStmt, err = db.PrepareContext(ctx, getExerciseSections); err != nil {
 		return nil, fmt.Errorf("error preparing query GetExerciseSections: %w", err)
 	}
+	if q.getExerciseSectionsByExerciseIDsStmt, err = db.PrepareContext(ctx, getExerciseSectionsByExerciseIDs); err != nil {
+		return nil, fmt.Errorf("error preparing query GetExerciseSectionsByExerciseIDs: %w", err)
+	}
 	if q.getExercisesByUserStmt, err = db.PrepareContext(ctx, getExercisesByUser); err != nil {
 		return nil, fmt.Errorf("error preparing query GetExercisesByUser: %w", err)
 	}
@@ -118,16 +127,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getExerciseHRZonesStmt: %w", cerr)
 		}
 	}
+	if q.getExerciseHRZonesByExerciseIDsStmt != nil {
+		if cerr := q.getExerciseHRZonesByExerciseIDsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getExerciseHRZonesByExerciseIDsStmt: %w", cerr)
+		}
+	}
 	if q.getExerciseSamplesStmt != nil {
 		if cerr := q.getExerciseSamplesStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getExerciseSamplesStmt: %w", cerr)
 		}
 	}
+	if q.getExerciseSamplesByExerciseIDsStmt != nil {
+		if cerr := q.getExerciseSamplesByExerciseIDsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getExerciseSamplesByExerciseIDsStmt: %w", cerr)
+		}
+	}
 	if q.getExerciseSectionsStmt != nil {
 		if cerr := q.getExerciseSectionsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getExerciseSectionsStmt: %w", cerr)
 		}
 	}
+	if q.getExerciseSectionsByExerciseIDsStmt != nil {
+		if cerr := q.getExerciseSectionsByExerciseIDsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getExerciseSectionsByExerciseIDsStmt: %w", cerr)
+		}
+	}
 	if q.getExercisesByUserStmt != nil {
 		if cerr := q.getExercisesByUserStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getExercisesByUserStmt: %w", cerr)
@@ -250,59 +274,65 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 }
 
 type Queries struct {
-	db                            DBTX
-	tx                            *sql.Tx
-	deleteUserStmt                *sql.Stmt
-	getActivityZonesByUserStmt    *sql.Stmt
-	getDeletedUsersStmt           *sql.Stmt
-	getExerciseHRZonesStmt        *sql.Stmt
-	getExerciseSamplesStmt        *sql.Stmt
-	getExerciseSectionsStmt       *sql.Stmt
-	getExercisesByUserStmt        *sql.Stmt
-	getMeasurementsByUserStmt     *sql.Stmt
-	getQuestionnairesByUserStmt   *sql.Stmt
-	getSymptomsByUserStmt         *sql.Stmt
-	getTestResultsByUserStmt      *sql.Stmt
-	getUserStmt                   *sql.Stmt
-	insertActivityZoneStmt        *sql.Stmt
-	insertExerciseStmt            *sql.Stmt
-	insertExerciseHRZoneStmt      *sql.Stmt
-	insertExerciseSampleStmt      *sql.Stmt
-	insertExerciseSectionStmt     *sql.Stmt
-	insertMeasurementStmt         *sql.Stmt
-	insertQuestionnaireAnswerStmt *sql.Stmt
-	insertSymptomStmt             *sql.Stmt
-	insertTestResultStmt          *sql.Stmt
-	logDeletedUserStmt            *sql.Stmt
-	upsertUserStmt                *sql.Stmt
+	db                                   DBTX
+	tx                                   *sql.Tx
+	deleteUserStmt                       *sql.Stmt
+	getActivityZonesByUserStmt           *sql.Stmt
+	getDeletedUsersStmt                  *sql.Stmt
+	getExerciseHRZonesStmt               *sql.Stmt
+	getExerciseHRZonesByExerciseIDsStmt  *sql.Stmt
+	getExerciseSamplesStmt               *sql.Stmt
+	getExerciseSamplesByExerciseIDsStmt  *sql.Stmt
+	getExerciseSectionsStmt              *sql.Stmt
+	getExerciseSectionsByExerciseIDsStmt *sql.Stmt
+	getExercisesByUserStmt               *sql.Stmt
+	getMeasurementsByUserStmt            *sql.Stmt
+	getQuestionnairesByUserStmt          *sql.Stmt
+	getSymptomsByUserStmt                *sql.Stmt
+	getTestResultsByUserStmt             *sql.Stmt
+	getUserStmt                          *sql.Stmt
+	insertActivityZoneStmt               *sql.Stmt
+	insertExerciseStmt                   *sql.Stmt
+	insertExerciseHRZoneStmt             *sql.Stmt
+	insertExerciseSampleStmt             *sql.Stmt
+	insertExerciseSectionStmt            *sql.Stmt
+	insertMeasurementStmt                *sql.Stmt
+	insertQuestionnaireAnswerStmt        *sql.Stmt
+	insertSymptomStmt                    *sql.Stmt
+	insertTestResultStmt                 *sql.Stmt
+	logDeletedUserStmt                   *sql.Stmt
+	upsertUserStmt                       *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
-		db:                            tx,
-		tx:                            tx,
-		deleteUserStmt:                q.deleteUserStmt,
-		getActivityZonesByUserStmt:    q.getActivityZonesByUserStmt,
-		getDeletedUsersStmt:           q.getDeletedUsersStmt,
-		getExerciseHRZonesStmt:        q.getExerciseHRZonesStmt,
-		getExerciseSamplesStmt:        q.getExerciseSamplesStmt,
-		getExerciseSectionsStmt:       q.getExerciseSectionsStmt,
-		getExercisesByUserStmt:        q.getExercisesByUserStmt,
-		getMeasurementsByUserStmt:     q.getMeasurementsByUserStmt,
-		getQuestionnairesByUserStmt:   q.getQuestionnairesByUserStmt,
-		getSymptomsByUserStmt:         q.getSymptomsByUserStmt,
-		getTestResultsByUserStmt:      q.getTestResultsByUserStmt,
-		getUserStmt:                   q.getUserStmt,
-		insertActivityZoneStmt:        q.insertActivityZoneStmt,
-		insertExerciseStmt:            q.insertExerciseStmt,
-		insertExerciseHRZoneStmt:      q.insertExerciseHRZoneStmt,
-		insertExerciseSampleStmt:      q.insertExerciseSampleStmt,
-		insertExerciseSectionStmt:     q.insertExerciseSectionStmt,
-		insertMeasurementStmt:         q.insertMeasurementStmt,
-		insertQuestionnaireAnswerStmt: q.insertQuestionnaireAnswerStmt,
-		insertSymptomStmt:             q.insertSymptomStmt,
-		insertTestResultStmt:          q.insertTestResultStmt,
-		logDeletedUserStmt:            q.logDeletedUserStmt,
-		upsertUserStmt:                q.upsertUserStmt,
+		db:                                   tx,
+		tx:                                   tx,
+		deleteUserStmt:                       q.deleteUserStmt,
+		getActivityZonesByUserStmt:           q.getActivityZonesByUserStmt,
+		getDeletedUsersStmt:                  q.getDeletedUsersStmt,
+		getExerciseHRZonesStmt:               q.getExerciseHRZonesStmt,
+		getExerciseHRZonesByExerciseIDsStmt:  q.getExerciseHRZonesByExerciseIDsStmt,
+		getExerciseSamplesStmt:               q.getExerciseSamplesStmt,
+		getExerciseSamplesByExerciseIDsStmt:  q.getExerciseSamplesByExerciseIDsStmt,
+		getExerciseSectionsStmt:              q.getExerciseSectionsStmt,
+		getExerciseSectionsByExerciseIDsStmt: q.getExerciseSectionsByExerciseIDsStmt,
+		getExercisesByUserStmt:               q.getExercisesByUserStmt,
+		getMeasurementsByUserStmt:            q.getMeasurementsByUserStmt,
+		getQuestionnairesByUserStmt:          q.getQuestionnairesByUserStmt,
+		getSymptomsByUserStmt:                q.getSymptomsByUserStmt,
+		getTestResultsByUserStmt:             q.getTestResultsByUserStmt,
+		getUserStmt:                          q.getUserStmt,
+		insertActivityZoneStmt:               q.insertActivityZoneStmt,
+		insertExerciseStmt:                   q.insertExerciseStmt,
+		insertExerciseHRZoneStmt:             q.insertExerciseHRZoneStmt,
+		insertExerciseSampleStmt:             q.insertExerciseSampleStmt,
+		insertExerciseSectionStmt:            q.insertExerciseSectionStmt,
+		insertMeasurementStmt:                q.insertMeasurementStmt,
+		insertQuestionnaireAnswerStmt:        q.insertQuestionnaireAnswerStmt,
+		insertSymptomStmt:                    q.insertSymptomStmt,
+		insertTestResultStmt:                 q.insertTestResultStmt,
+		logDeletedUserStmt:                   q.logDeletedUserStmt,
+		upsertUserStmt:                       q.upsertUserStmt,
 	}
 }
@@ -32,11 +32,37 @@ func (q *Queries) DeleteUser(ctx context.Context, id uuid.UUID) (int64, error) {
 const getActivityZonesByUser = `-- name: GetActivityZonesByUser :many
 SELECT user_id, date, created_at, updated_at, seconds_in_zone_0, seconds_in_zone_1, seconds_in_zone_2, seconds_in_zone_3, seconds_in_zone_4, seconds_in_zone_5, source, raw_data FROM activity_zones
 WHERE user_id = $1
-ORDER BY date DESC, created_at DESC
+  AND ($2::date IS NULL OR date >= $2::date)
+  AND ($3::date IS NULL OR date <= $3::date)
+  AND ($4::timestamptz IS NULL OR updated_at >= $4::timestamptz)
+  AND ($5::date IS NULL
+       OR (date, created_at, source) < ($5::date, $6::timestamptz, $7::text))
+ORDER BY date DESC, created_at DESC, source DESC
+LIMIT $8::int4
 `
 
-func (q *Queries) GetActivityZonesByUser(ctx context.Context, userID uuid.UUID) ([]ActivityZone, error) {
-	rows, err := q.query(ctx, q.getActivityZonesByUserStmt, getActivityZonesByUser, userID)
+type GetActivityZonesByUserParams struct {
+	UserID          uuid.UUID
+	FromTime        sql.NullTime
+	ToTime          sql.NullTime
+	UpdatedSince    sql.NullTime
+	CursorDate      sql.NullTime
+	CursorCreatedAt sql.NullTime
+	CursorSource    sql.NullString
+	PageLimit       sql.NullInt32
+}
+
+func (q *Queries) GetActivityZonesByUser(ctx context.Context, arg GetActivityZonesByUserParams) ([]ActivityZone, error) {
+	rows, err := q.query(ctx, q.getActivityZonesByUserStmt, getActivityZonesByUser,
+		arg.UserID,
+		arg.FromTime,
+		arg.ToTime,
+		arg.UpdatedSince,
+		arg.CursorDate,
+		arg.CursorCreatedAt,
+		arg.CursorSource,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -142,6 +168,43 @@ func (q *Queries) GetExerciseHRZones(ctx context.Context, exerciseID uuid.UUID)
 	return items, nil
 }
 
+const getExerciseHRZonesByExerciseIDs = `-- name: GetExerciseHRZonesByExerciseIDs :many
+SELECT exercise_id, zone_index, seconds_in_zone, lower_limit, upper_limit, created_at, updated_at FROM exercise_hr_zones
+WHERE exercise_id = ANY($1::uuid[])
+ORDER BY exercise_id, zone_index
+`
+
+func (q *Queries) GetExerciseHRZonesByExerciseIDs(ctx context.Context, exerciseIds []uuid.UUID) ([]ExerciseHrZone, error) {
+	rows, err := q.query(ctx, q.getExerciseHRZonesByExerciseIDsStmt, getExerciseHRZonesByExerciseIDs, pq.Array(exerciseIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ExerciseHrZone
+	for rows.Next() {
+		var i ExerciseHrZone
+		if err := rows.Scan(
+			&i.ExerciseID,
+			&i.ZoneIndex,
+			&i.SecondsInZone,
+			&i.LowerLimit,
+			&i.UpperLimit,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getExerciseSamples = `-- name: GetExerciseSamples :many
 SELECT id, user_id, exercise_id, sample_type, recording_rate, samples, source FROM exercise_samples
 WHERE exercise_id = $1
@@ -178,6 +241,43 @@ func (q *Queries) GetExerciseSamples(ctx context.Context, exerciseID uuid.UUID)
 	return items, nil
 }
 
+const getExerciseSamplesByExerciseIDs = `-- name: GetExerciseSamplesByExerciseIDs :many
+SELECT id, user_id, exercise_id, sample_type, recording_rate, samples, source FROM exercise_samples
+WHERE exercise_id = ANY($1::uuid[])
+ORDER BY exercise_id
+`
+
+func (q *Queries) GetExerciseSamplesByExerciseIDs(ctx context.Context, exerciseIds []uuid.UUID) ([]ExerciseSample, error) {
+	rows, err := q.query(ctx, q.getExerciseSamplesByExerciseIDsStmt, getExerciseSamplesByExerciseIDs, pq.Array(exerciseIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ExerciseSample
+	for rows.Next() {
+		var i ExerciseSample
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ExerciseID,
+			&i.SampleType,
+			&i.RecordingRate,
+			pq.Array(&i.Samples),
+			&i.Source,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getExerciseSections = `-- name: GetExerciseSections :many
 SELECT id, user_id, exercise_id, created_at, updated_at, start_time, end_time, section_type, name, comment, source, raw_id, raw_data FROM exercise_sections
 WHERE exercise_id = $1
@@ -221,14 +321,81 @@ func (q *Queries) GetExerciseSections(ctx context.Context, exerciseID uuid.UUID)
 	return items, nil
 }
 
+const getExerciseSectionsByExerciseIDs = `-- name: GetExerciseSectionsByExerciseIDs :many
+SELECT id, user_id, exercise_id, created_at, updated_at, start_time, end_time, section_type, name, comment, source, raw_id, raw_data FROM exercise_sections
+WHERE exercise_id = ANY($1::uuid[])
+ORDER BY exercise_id, start_time
+`
+
+func (q *Queries) GetExerciseSectionsByExerciseIDs(ctx context.Context, exerciseIds []uuid.UUID) ([]ExerciseSection, error) {
+	rows, err := q.query(ctx, q.getExerciseSectionsByExerciseIDsStmt, getExerciseSectionsByExerciseIDs, pq.Array(exerciseIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ExerciseSection
+	for rows.Next() {
+		var i ExerciseSection
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ExerciseID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.StartTime,
+			&i.EndTime,
+			&i.SectionType,
+			&i.Name,
+			&i.Comment,
+			&i.Source,
+			&i.RawID,
+			&i.RawData,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getExercisesByUser = `-- name: GetExercisesByUser :many
 SELECT id, created_at, updated_at, user_id, start_time, duration, comment, sport_type, detailed_sport_type, distance, avg_heart_rate, max_heart_rate, trimp, sprint_count, avg_speed, max_speed, source, status, calories, training_load, raw_id, raw_data, feeling, recovery, rpe FROM exercises
 WHERE user_id = $1
-ORDER BY start_time DESC
+  AND ($2::timestamptz IS NULL OR start_time >= $2::timestamptz)
+  AND ($3::timestamptz IS NULL OR start_time <= $3::timestamptz)
+  AND ($4::timestamptz IS NULL OR updated_at >= $4::timestamptz)
+  AND ($5::timestamptz IS NULL
+       OR (start_time, id) < ($5::timestamptz, $6::uuid))
+ORDER BY start_time DESC, id DESC
+LIMIT $7::int4
 `
 
-func (q *Queries) GetExercisesByUser(ctx context.Context, userID uuid.UUID) ([]Exercise, error) {
-	rows, err := q.query(ctx, q.getExercisesByUserStmt, getExercisesByUser, userID)
+type GetExercisesByUserParams struct {
+	UserID       uuid.UUID
+	FromTime     sql.NullTime
+	ToTime       sql.NullTime
+	UpdatedSince sql.NullTime
+	CursorTime   sql.NullTime
+	CursorID     uuid.NullUUID
+	PageLimit    sql.NullInt32
+}
+
+func (q *Queries) GetExercisesByUser(ctx context.Context, arg GetExercisesByUserParams) ([]Exercise, error) {
+	rows, err := q.query(ctx, q.getExercisesByUserStmt, getExercisesByUser,
+		arg.UserID,
+		arg.FromTime,
+		arg.ToTime,
+		arg.UpdatedSince,
+		arg.CursorTime,
+		arg.CursorID,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -279,11 +446,37 @@ func (q *Queries) GetExercisesByUser(ctx context.Context, userID uuid.UUID) ([]E
 const getMeasurementsByUser = `-- name: GetMeasurementsByUser :many
 SELECT id, created_at, updated_at, user_id, date, name, name_type, source, value, value_numeric, comment, raw_id, raw_data, additional_info FROM measurements
 WHERE user_id = $1
-ORDER BY date DESC, created_at DESC
+  AND ($2::date IS NULL OR date >= $2::date)
+  AND ($3::date IS NULL OR date <= $3::date)
+  AND ($4::timestamptz IS NULL OR updated_at >= $4::timestamptz)
+  AND ($5::date IS NULL
+       OR (date, created_at, id) < ($5::date, $6::timestamptz, $7::uuid))
+ORDER BY date DESC, created_at DESC, id DESC
+LIMIT $8::int4
 `
 
-func (q *Queries) GetMeasurementsByUser(ctx context.Context, userID uuid.UUID) ([]Measurement, error) {
-	rows, err := q.query(ctx, q.getMeasurementsByUserStmt, getMeasurementsByUser, userID)
+type GetMeasurementsByUserParams struct {
+	UserID          uuid.UUID
+	FromTime        sql.NullTime
+	ToTime          sql.NullTime
+	UpdatedSince    sql.NullTime
+	CursorDate      sql.NullTime
+	CursorCreatedAt sql.NullTime
+	CursorID        uuid.NullUUID
+	PageLimit       sql.NullInt32
+}
+
+func (q *Queries) GetMeasurementsByUser(ctx context.Context, arg GetMeasurementsByUserParams) ([]Measurement, error) {
+	rows, err := q.query(ctx, q.getMeasurementsByUserStmt, getMeasurementsByUser,
+		arg.UserID,
+		arg.FromTime,
+		arg.ToTime,
+		arg.UpdatedSince,
+		arg.CursorDate,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -323,11 +516,37 @@ func (q *Queries) GetMeasurementsByUser(ctx context.Context, userID uuid.UUID) (
 const getQuestionnairesByUser = `-- name: GetQuestionnairesByUser :many
 SELECT user_id, questionnaire_instance_id, questionnaire_name_fi, questionnaire_name_en, questionnaire_key, question_id, question_label_fi, question_label_en, question_type, option_id, option_value, option_label_fi, option_label_en, free_text, created_at, updated_at, value FROM question_answers
 WHERE user_id = $1
-ORDER BY created_at DESC
+  AND ($2::timestamptz IS NULL OR created_at >= $2::timestamptz)
+  AND ($3::timestamptz IS NULL OR created_at <= $3::timestamptz)
+  AND ($4::timestamptz IS NULL OR updated_at >= $4::timestamptz)
+  AND ($5::timestamptz IS NULL
+       OR (created_at, questionnaire_instance_id, question_id) < ($5::timestamptz, $6::uuid, $7::uuid))
+ORDER BY created_at DESC, questionnaire_instance_id DESC, question_id DESC
+LIMIT $8::int4
 `
 
-func (q *Queries) GetQuestionnairesByUser(ctx context.Context, userID uuid.UUID) ([]QuestionAnswer, error) {
-	rows, err := q.query(ctx, q.getQuestionnairesByUserStmt, getQuestionnairesByUser, userID)
+type GetQuestionnairesByUserParams struct {
+	UserID           uuid.UUID
+	FromTime         sql.NullTime
+	ToTime           sql.NullTime
+	UpdatedSince     sql.NullTime
+	CursorCreatedAt  sql.NullTime
+	CursorInstanceID uuid.NullUUID
+	CursorQuestionID uuid.NullUUID
+	PageLimit        sql.NullInt32
+}
+
+func (q *Queries) GetQuestionnairesByUser(ctx context.Context, arg GetQuestionnairesByUserParams) ([]QuestionAnswer, error) {
+	rows, err := q.query(ctx, q.getQuestionnairesByUserStmt, getQuestionnairesByUser,
+		arg.UserID,
+		arg.FromTime,
+		arg.ToTime,
+		arg.UpdatedSince,
+		arg.CursorCreatedAt,
+		arg.CursorInstanceID,
+		arg.CursorQuestionID,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -370,11 +589,37 @@ func (q *Queries) GetQuestionnairesByUser(ctx context.Context, userID uuid.UUID)
 const getSymptomsByUser = `-- name: GetSymptomsByUser :many
 SELECT id, user_id, date, symptom, severity, comment, source, created_at, updated_at, raw_id, original_id, recovered, pain_index, side, category, additional_data FROM symptoms
 WHERE user_id = $1
-ORDER BY date DESC, created_at DESC
+  AND ($2::date IS NULL OR date >= $2::date)
+  AND ($3::date IS NULL OR date <= $3::date)
+  AND ($4::timestamptz IS NULL OR updated_at >= $4::timestamptz)
+  AND ($5::date IS NULL
+       OR (date, created_at, id) < ($5::date, $6::timestamptz, $7::uuid))
+ORDER BY date DESC, created_at DESC, id DESC
+LIMIT $8::int4
 `
 
-func (q *Queries) GetSymptomsByUser(ctx context.Context, userID uuid.UUID) ([]Symptom, error) {
-	rows, err := q.query(ctx, q.getSymptomsByUserStmt, getSymptomsByUser, userID)
+type GetSymptomsByUserParams struct {
+	UserID          uuid.UUID
+	FromTime        sql.NullTime
+	ToTime          sql.NullTime
+	UpdatedSince    sql.NullTime
+	CursorDate      sql.NullTime
+	CursorCreatedAt sql.NullTime
+	CursorID        uuid.NullUUID
+	PageLimit       sql.NullInt32
+}
+
+func (q *Queries) GetSymptomsByUser(ctx context.Context, arg GetSymptomsByUserParams) ([]Symptom, error) {
+	rows, err := q.query(ctx, q.getSymptomsByUserStmt, getSymptomsByUser,
+		arg.UserID,
+		arg.FromTime,
+		arg.ToTime,
+		arg.UpdatedSince,
+		arg.CursorDate,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -416,11 +661,37 @@ func (q *Queries) GetSymptomsByUser(ctx context.Context, userID uuid.UUID) ([]Sy
 const getTestResultsByUser = `-- name: GetTestResultsByUser :many
 SELECT id, user_id, type_id, type_type, type_result_type, type_name, timestamp, name, comment, data, created_at, updated_at, test_event_id, test_event_name, test_event_date, test_event_template_test_id, test_event_template_test_name, test_event_template_test_limits FROM test_results
 WHERE user_id = $1
-ORDER BY timestamp DESC, created_at DESC
+  AND ($2::timestamptz IS NULL OR "timestamp" >= $2::timestamptz)
+  AND ($3::timestamptz IS NULL OR "timestamp" <= $3::timestamptz)
+  AND ($4::timestamptz IS NULL OR updated_at >= $4::timestamptz)
+  AND ($5::timestamptz IS NULL
+       OR ("timestamp", created_at, id) < ($5::timestamptz, $6::timestamptz, $7::uuid))
+ORDER BY timestamp DESC, created_at DESC, id DESC
+LIMIT $8::int4
 `
 
-func (q *Queries) GetTestResultsByUser(ctx context.Context, userID uuid.UUID) ([]TestResult, error) {
-	rows, err := q.query(ctx, q.getTestResultsByUserStmt, getTestResultsByUser, userID)
+type GetTestResultsByUserParams struct {
+	UserID          uuid.UUID
+	FromTime        sql.NullTime
+	ToTime          sql.NullTime
+	UpdatedSince    sql.NullTime
+	CursorTime      sql.NullTime
+	CursorCreatedAt sql.NullTime
+	CursorID        uuid.NullUUID
+	PageLimit       sql.NullInt32
+}
+
+func (q *Queries) GetTestResultsByUser(ctx context.Context, arg GetTestResultsByUserParams) ([]TestResult, error) {
+	rows, err := q.query(ctx, q.getTestResultsByUserStmt, getTestResultsByUser,
+		arg.UserID,
+		arg.FromTime,
+		arg.ToTime,
+		arg.UpdatedSince,
+		arg.CursorTime,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
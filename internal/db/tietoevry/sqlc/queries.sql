@@ -246,44 +246,95 @@ ORDER BY deleted_at DESC;
 
 -- name: GetExercisesByUser :many
 SELECT * FROM exercises
-WHERE user_id = $1
-ORDER BY start_time DESC;
+WHERE user_id = sqlc.arg('user_id')
+  AND (sqlc.narg('from_time')::timestamptz IS NULL OR start_time >= sqlc.narg('from_time')::timestamptz)
+  AND (sqlc.narg('to_time')::timestamptz IS NULL OR start_time <= sqlc.narg('to_time')::timestamptz)
+  AND (sqlc.narg('updated_since')::timestamptz IS NULL OR updated_at >= sqlc.narg('updated_since')::timestamptz)
+  AND (sqlc.narg('cursor_time')::timestamptz IS NULL
+       OR (start_time, id) < (sqlc.narg('cursor_time')::timestamptz, sqlc.narg('cursor_id')::uuid))
+ORDER BY start_time DESC, id DESC
+LIMIT sqlc.narg('page_limit')::int4;
 
 -- name: GetExerciseHRZones :many
 SELECT * FROM exercise_hr_zones
 WHERE exercise_id = $1
 ORDER BY zone_index;
 
+-- name: GetExerciseHRZonesByExerciseIDs :many
+SELECT * FROM exercise_hr_zones
+WHERE exercise_id = ANY(sqlc.arg('exercise_ids')::uuid[])
+ORDER BY exercise_id, zone_index;
+
 -- name: GetExerciseSamples :many
 SELECT * FROM exercise_samples
 WHERE exercise_id = $1;
 
+-- name: GetExerciseSamplesByExerciseIDs :many
+SELECT * FROM exercise_samples
+WHERE exercise_id = ANY(sqlc.arg('exercise_ids')::uuid[])
+ORDER BY exercise_id;
+
 -- name: GetExerciseSections :many
 SELECT * FROM exercise_sections
 WHERE exercise_id = $1
 ORDER BY start_time;
 
+-- name: GetExerciseSectionsByExerciseIDs :many
+SELECT * FROM exercise_sections
+WHERE exercise_id = ANY(sqlc.arg('exercise_ids')::uuid[])
+ORDER BY exercise_id, start_time;
+
 -- name: GetSymptomsByUser :many
 SELECT * FROM symptoms
-WHERE user_id = $1
-ORDER BY date DESC, created_at DESC;
+WHERE user_id = sqlc.arg('user_id')
+  AND (sqlc.narg('from_time')::date IS NULL OR date >= sqlc.narg('from_time')::date)
+  AND (sqlc.narg('to_time')::date IS NULL OR date <= sqlc.narg('to_time')::date)
+  AND (sqlc.narg('updated_since')::timestamptz IS NULL OR updated_at >= sqlc.narg('updated_since')::timestamptz)
+  AND (sqlc.narg('cursor_date')::date IS NULL
+       OR (date, created_at, id) < (sqlc.narg('cursor_date')::date, sqlc.narg('cursor_created_at')::timestamptz, sqlc.narg('cursor_id')::uuid))
+ORDER BY date DESC, created_at DESC, id DESC
+LIMIT sqlc.narg('page_limit')::int4;
 
 -- name: GetMeasurementsByUser :many
 SELECT * FROM measurements
-WHERE user_id = $1
-ORDER BY date DESC, created_at DESC;
+WHERE user_id = sqlc.arg('user_id')
+  AND (sqlc.narg('from_time')::date IS NULL OR date >= sqlc.narg('from_time')::date)
+  AND (sqlc.narg('to_time')::date IS NULL OR date <= sqlc.narg('to_time')::date)
+  AND (sqlc.narg('updated_since')::timestamptz IS NULL OR updated_at >= sqlc.narg('updated_since')::timestamptz)
+  AND (sqlc.narg('cursor_date')::date IS NULL
+       OR (date, created_at, id) < (sqlc.narg('cursor_date')::date, sqlc.narg('cursor_created_at')::timestamptz, sqlc.narg('cursor_id')::uuid))
+ORDER BY date DESC, created_at DESC, id DESC
+LIMIT sqlc.narg('page_limit')::int4;
 
 -- name: GetTestResultsByUser :many
 SELECT * FROM test_results
-WHERE user_id = $1
-ORDER BY timestamp DESC, created_at DESC;
+WHERE user_id = sqlc.arg('user_id')
+  AND (sqlc.narg('from_time')::timestamptz IS NULL OR "timestamp" >= sqlc.narg('from_time')::timestamptz)
+  AND (sqlc.narg('to_time')::timestamptz IS NULL OR "timestamp" <= sqlc.narg('to_time')::timestamptz)
+  AND (sqlc.narg('updated_since')::timestamptz IS NULL OR updated_at >= sqlc.narg('updated_since')::timestamptz)
+  AND (sqlc.narg('cursor_time')::timestamptz IS NULL
+       OR ("timestamp", created_at, id) < (sqlc.narg('cursor_time')::timestamptz, sqlc.narg('cursor_created_at')::timestamptz, sqlc.narg('cursor_id')::uuid))
+ORDER BY timestamp DESC, created_at DESC, id DESC
+LIMIT sqlc.narg('page_limit')::int4;
 
 -- name: GetQuestionnairesByUser :many
 SELECT * FROM question_answers
-WHERE user_id = $1
-ORDER BY created_at DESC;
+WHERE user_id = sqlc.arg('user_id')
+  AND (sqlc.narg('from_time')::timestamptz IS NULL OR created_at >= sqlc.narg('from_time')::timestamptz)
+  AND (sqlc.narg('to_time')::timestamptz IS NULL OR created_at <= sqlc.narg('to_time')::timestamptz)
+  AND (sqlc.narg('updated_since')::timestamptz IS NULL OR updated_at >= sqlc.narg('updated_since')::timestamptz)
+  AND (sqlc.narg('cursor_created_at')::timestamptz IS NULL
+       OR (created_at, questionnaire_instance_id, question_id) < (sqlc.narg('cursor_created_at')::timestamptz, sqlc.narg('cursor_instance_id')::uuid, sqlc.narg('cursor_question_id')::uuid))
+ORDER BY created_at DESC, questionnaire_instance_id DESC, question_id DESC
+LIMIT sqlc.narg('page_limit')::int4;
 
 -- name: GetActivityZonesByUser :many
 SELECT * FROM activity_zones
-WHERE user_id = $1
-ORDER BY date DESC, created_at DESC;
\ No newline at end of file
+WHERE user_id = sqlc.arg('user_id')
+  AND (sqlc.narg('from_time')::date IS NULL OR date >= sqlc.narg('from_time')::date)
+  AND (sqlc.narg('to_time')::date IS NULL OR date <= sqlc.narg('to_time')::date)
+  AND (sqlc.narg('updated_since')::timestamptz IS NULL OR updated_at >= sqlc.narg('updated_since')::timestamptz)
+  AND (sqlc.narg('cursor_date')::date IS NULL
+       OR (date, created_at, source) < (sqlc.narg('cursor_date')::date, sqlc.narg('cursor_created_at')::timestamptz, sqlc.narg('cursor_source')::text))
+ORDER BY date DESC, created_at DESC, source DESC
+LIMIT sqlc.narg('page_limit')::int4;
\ No newline at end of file
@@ -273,3 +273,32 @@ CREATE TABLE deleted_users_log (
 
 CREATE INDEX idx_deleted_users_log_deleted_at
     ON deleted_users_log(deleted_at DESC);
+
+-- Per-user list reads: ordering/keyset pagination, from/to and updated_since filters
+CREATE INDEX idx_exercises_user_start_time
+    ON exercises(user_id, start_time DESC, id DESC);
+CREATE INDEX idx_exercises_user_updated_at
+    ON exercises(user_id, updated_at);
+
+CREATE INDEX idx_symptoms_user_date
+    ON symptoms(user_id, date DESC, created_at DESC, id DESC);
+CREATE INDEX idx_symptoms_user_updated_at
+    ON symptoms(user_id, updated_at);
+
+CREATE INDEX idx_measurements_user_date
+    ON measurements(user_id, date DESC, created_at DESC, id DESC);
+CREATE INDEX idx_measurements_user_updated_at
+    ON measurements(user_id, updated_at);
+
+CREATE INDEX idx_test_results_user_timestamp
+    ON test_results(user_id, "timestamp" DESC, created_at DESC, id DESC);
+CREATE INDEX idx_test_results_user_updated_at
+    ON test_results(user_id, updated_at);
+
+CREATE INDEX idx_question_answers_user_created_at
+    ON question_answers(user_id, created_at DESC);
+CREATE INDEX idx_question_answers_user_updated_at
+    ON question_answers(user_id, updated_at);
+
+CREATE INDEX idx_activity_zones_user_updated_at
+    ON activity_zones(user_id, updated_at);
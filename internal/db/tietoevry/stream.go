@@ -0,0 +1,116 @@
+package tietoevrysqlc
+
+// Row-at-a-time variants of the per-user list queries for streamed responses.
+// Not generated by sqlc: they reuse the generated SQL and scan order.
+
+import (
+	"context"
+)
+
+// StreamExercisesByUser runs GetExercisesByUser and passes each row to fn; an error from fn stops the iteration
+func (q *Queries) StreamExercisesByUser(ctx context.Context, arg GetExercisesByUserParams, fn func(Exercise) error) error {
+	rows, err := q.query(ctx, q.getExercisesByUserStmt, getExercisesByUser,
+		arg.UserID,
+		arg.FromTime,
+		arg.ToTime,
+		arg.UpdatedSince,
+		arg.CursorTime,
+		arg.CursorID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var i Exercise
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.UserID,
+			&i.StartTime,
+			&i.Duration,
+			&i.Comment,
+			&i.SportType,
+			&i.DetailedSportType,
+			&i.Distance,
+			&i.AvgHeartRate,
+			&i.MaxHeartRate,
+			&i.Trimp,
+			&i.SprintCount,
+			&i.AvgSpeed,
+			&i.MaxSpeed,
+			&i.Source,
+			&i.Status,
+			&i.Calories,
+			&i.TrainingLoad,
+			&i.RawID,
+			&i.RawData,
+			&i.Feeling,
+			&i.Recovery,
+			&i.Rpe,
+		); err != nil {
+			return err
+		}
+		if err := fn(i); err != nil {
+			return err
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// StreamMeasurementsByUser runs GetMeasurementsByUser and passes each row to fn; an error from fn stops the iteration
+func (q *Queries) StreamMeasurementsByUser(ctx context.Context, arg GetMeasurementsByUserParams, fn func(Measurement) error) error {
+	rows, err := q.query(ctx, q.getMeasurementsByUserStmt, getMeasurementsByUser,
+		arg.UserID,
+		arg.FromTime,
+		arg.ToTime,
+		arg.UpdatedSince,
+		arg.CursorDate,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var i Measurement
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.UserID,
+			&i.Date,
+			&i.Name,
+			&i.NameType,
+			&i.Source,
+			&i.Value,
+			&i.ValueNumeric,
+			&i.Comment,
+			&i.RawID,
+			&i.RawData,
+			&i.AdditionalInfo,
+		); err != nil {
+			return err
+		}
+		if err := fn(i); err != nil {
+			return err
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	return nil
+}
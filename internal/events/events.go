@@ -0,0 +1,181 @@
+package events
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
+	"github.com/google/uuid"
+)
+
+// Event types published after a successful write
+const (
+	UTVDataInserted          = "utv.data.inserted"
+	TietoevryExerciseCreated = "tietoevry.exercise.created"
+	KAMKInjuryAdded          = "kamk.injury.added"
+	FISResultUpdated         = "fis.result.updated"
+
+	// only sent to athlete streams
+	TietoevryDataCreated     = "tietoevry.data.created"
+	KAMKInjuryUpdated        = "kamk.injury.updated"
+	KAMKQuestionnaireUpdated = "kamk.questionnaire.updated"
+	KLABDataInserted         = "klab.data.inserted"
+	ArchinisisDataInserted   = "archinisis.data.inserted"
+)
+
+// Types lists the event types webhooks can subscribe to
+var Types = []string{UTVDataInserted, TietoevryExerciseCreated, KAMKInjuryAdded, FISResultUpdated}
+
+// AthleteData is implemented by the data of every event about one athlete.
+// Athlete returns the id the event's domain knows the athlete by, prefixed
+// with its kind as in the athlete registry (utv_user_id:<uuid>,
+// tietoevry_user_id:<uuid>, kamk_user_id:<n>, fis_competitorid:<n>,
+// sportti_id:<n>), or "" when it is not known. The kind keeps the numeric ids
+// of different domains apart.
+type AthleteData interface {
+	Athlete() string
+}
+
+// Athlete id kinds, named after the athlete registry columns
+const (
+	AthleteUTV       = "utv_user_id"
+	AthleteTietoevry = "tietoevry_user_id"
+	AthleteKAMK      = "kamk_user_id"
+	AthleteFIS       = "fis_competitorid"
+	AthleteSportti   = "sportti_id"
+)
+
+// AthleteKey joins kind and id the way Athlete returns them; UUIDs are
+// written in their canonical form
+func AthleteKey(kind, id string) string {
+	if id == "" {
+		return ""
+	}
+	if kind == AthleteUTV || kind == AthleteTietoevry {
+		if u, err := uuid.Parse(id); err == nil {
+			id = u.String()
+		}
+	}
+	return kind + ":" + id
+}
+
+// Athlete returns the athlete e is about, or ""
+func (e Event) Athlete() string {
+	if d, ok := e.Data.(AthleteData); ok {
+		return d.Athlete()
+	}
+	return ""
+}
+
+// Event is a change to stored data, as sent to subscribers
+type Event apitypes.Event
+
+// UTVData is the data of utv.data.inserted
+type UTVData struct {
+	UserID string `json:"user_id"`
+	Device string `json:"device"`
+	Date   string `json:"date"`
+}
+
+func (d UTVData) Athlete() string { return AthleteKey(AthleteUTV, d.UserID) }
+
+// TietoevryExercises is the data of tietoevry.exercise.created, sent once per
+// user and upload; the new exercises are found with updated_since
+type TietoevryExercises struct {
+	UserID string `json:"user_id"`
+}
+
+func (d TietoevryExercises) Athlete() string { return AthleteKey(AthleteTietoevry, d.UserID) }
+
+// TietoevryData is the data of tietoevry.data.created, sent once per user and
+// upload of measurements, symptoms, test-results, questionnaires or
+// activity-zones
+type TietoevryData struct {
+	UserID   string `json:"user_id"`
+	Resource string `json:"resource"`
+}
+
+func (d TietoevryData) Athlete() string { return AthleteKey(AthleteTietoevry, d.UserID) }
+
+// KAMKInjury is the data of kamk.injury.added, and of kamk.injury.updated
+// when an injury is marked recovered or deleted
+type KAMKInjury struct {
+	UserID   int32 `json:"user_id"`
+	InjuryID int32 `json:"injury_id"`
+}
+
+func (d KAMKInjury) Athlete() string { return AthleteKey(AthleteKAMK, strconv.Itoa(int(d.UserID))) }
+
+// KAMKQuestionnaire is the data of kamk.questionnaire.updated, sent when a
+// questionnaire is added, changed or deleted
+type KAMKQuestionnaire struct {
+	UserID int32 `json:"user_id"`
+	ID     int64 `json:"id"`
+}
+
+func (d KAMKQuestionnaire) Athlete() string {
+	return AthleteKey(AthleteKAMK, strconv.Itoa(int(d.UserID)))
+}
+
+// FISResult is the data of fis.result.updated, sent when a result is inserted
+// or changed
+type FISResult struct {
+	Sector       string `json:"sector"`
+	RecID        int32  `json:"recid"`
+	RaceID       *int32 `json:"raceid"`
+	CompetitorID *int32 `json:"competitorid"`
+}
+
+func (d FISResult) Athlete() string {
+	if d.CompetitorID == nil {
+		return ""
+	}
+	return AthleteKey(AthleteFIS, strconv.Itoa(int(*d.CompetitorID)))
+}
+
+// KLABData is the data of klab.data.inserted
+type KLABData struct {
+	SporttiID string `json:"sportti_id"`
+}
+
+func (d KLABData) Athlete() string { return AthleteKey(AthleteSportti, d.SporttiID) }
+
+// ArchinisisData is the data of archinisis.data.inserted, sent for athlete
+// data and race reports; session_id is set for a race report
+type ArchinisisData struct {
+	SporttiID string `json:"sportti_id"`
+	SessionID *int32 `json:"session_id,omitempty"`
+}
+
+func (d ArchinisisData) Athlete() string { return AthleteKey(AthleteSportti, d.SporttiID) }
+
+// Handler receives published events. It runs on the publishing request, so it
+// must hand the event off instead of doing slow work.
+type Handler func(Event)
+
+var (
+	mu       sync.RWMutex
+	handlers []Handler
+)
+
+// Subscribe registers h for every event published from now on
+func Subscribe(h Handler) {
+	mu.Lock()
+	defer mu.Unlock()
+	handlers = append(handlers, h)
+}
+
+// Publish sends an event of typ with data to every subscriber
+func Publish(typ string, data any) {
+	mu.RLock()
+	defer mu.RUnlock()
+	if len(handlers) == 0 {
+		return
+	}
+
+	e := Event{ID: uuid.NewString(), Type: typ, Time: time.Now().UTC(), Data: data}
+	for _, h := range handlers {
+		h(e)
+	}
+}
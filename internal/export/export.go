@@ -0,0 +1,90 @@
+package export
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+// Tabular export formats for list endpoints. The column schema of a row type
+// is derived from its JSON fields (see SchemaOf), so CSV, NDJSON and Parquet
+// use the same column names as the JSON response.
+
+type Format string
+
+const (
+	JSON    Format = "json"
+	CSV     Format = "csv"
+	NDJSON  Format = "ndjson"
+	Parquet Format = "parquet"
+)
+
+// FormatParam is the query parameter that overrides the Accept header
+const FormatParam = "format"
+
+var contentTypes = map[Format]string{
+	JSON:    "application/json",
+	CSV:     "text/csv",
+	NDJSON:  "application/x-ndjson",
+	Parquet: "application/vnd.apache.parquet",
+}
+
+// Negotiate picks the response format from ?format= or, failing that, the Accept header.
+// Anything unrecognised in Accept falls back to JSON.
+func Negotiate(r *http.Request) (Format, error) {
+	if v := strings.ToLower(strings.TrimSpace(r.URL.Query().Get(FormatParam))); v != "" {
+		f := Format(v)
+		if _, ok := contentTypes[f]; !ok {
+			return JSON, fmt.Errorf("invalid format: must be one of json, csv, ndjson, parquet")
+		}
+		return f, nil
+	}
+
+	for _, part := range strings.Split(r.Header.Get("Accept"), ",") {
+		mt, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		switch mt {
+		case "application/json", "*/*":
+			return JSON, nil
+		case contentTypes[CSV]:
+			return CSV, nil
+		case contentTypes[NDJSON], "application/ndjson", "application/jsonl":
+			return NDJSON, nil
+		case contentTypes[Parquet], "application/parquet", "application/x-parquet":
+			return Parquet, nil
+		}
+	}
+	return JSON, nil
+}
+
+// Write sends rows in the given tabular format. cols restricts and orders the
+// columns (nil means the whole schema); name becomes the download file name.
+func Write[T any](w http.ResponseWriter, format Format, name string, rows []T, cols []string) error {
+	rw, err := NewRowWriter[T](w, format, name, cols)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := rw.Write(row); err != nil {
+			return err
+		}
+	}
+	return rw.Close()
+}
+
+// Streamed reports whether a list request is answered as a stream: a full
+// listing (no limit or cursor) in a tabular format or with stream=true.
+// Streams are not bound by the request timeout.
+func Streamed(r *http.Request) bool {
+	q := r.URL.Query()
+	if strings.TrimSpace(q.Get("limit")) != "" || strings.TrimSpace(q.Get("cursor")) != "" {
+		return false
+	}
+	format, err := Negotiate(r)
+	return err == nil && (format != JSON || utils.WantsStream(r))
+}
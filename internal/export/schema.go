@@ -0,0 +1,183 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Kind is the logical type of an exported column
+type Kind int
+
+const (
+	KindString Kind = iota
+	KindInt
+	KindFloat
+	KindBool
+	KindTime
+)
+
+// Column is one flat, scalar field of a row type
+type Column struct {
+	Name  string
+	Kind  Kind
+	index []int
+}
+
+// Schema is the documented column layout of a row type:
+//   - every scalar JSON field becomes a column named after its JSON key
+//   - pointer fields are nullable columns
+//   - nested structs are flattened as <parent>_<child>
+//   - arrays, maps and raw JSON blobs are dropped and listed in Dropped
+type Schema struct {
+	Columns []Column
+	Dropped []string
+}
+
+var (
+	timeType = reflect.TypeOf(time.Time{})
+	rawType  = reflect.TypeOf(json.RawMessage{})
+
+	schemasMu sync.Mutex
+	schemas   = map[reflect.Type]Schema{}
+)
+
+// SchemaOf returns the column schema of T
+func SchemaOf[T any]() Schema {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+
+	schemasMu.Lock()
+	defer schemasMu.Unlock()
+	if s, ok := schemas[t]; ok {
+		return s
+	}
+	var s Schema
+	collect(&s, t, "", nil)
+	schemas[t] = s
+	return s
+}
+
+// Names lists the column names in order
+func (s Schema) Names() []string {
+	out := make([]string, len(s.Columns))
+	for i, c := range s.Columns {
+		out[i] = c.Name
+	}
+	return out
+}
+
+// Select narrows the schema to cols, in that order. nil keeps every column.
+func (s Schema) Select(cols []string) (Schema, error) {
+	if len(cols) == 0 {
+		return s, nil
+	}
+	byName := make(map[string]Column, len(s.Columns))
+	for _, c := range s.Columns {
+		byName[c.Name] = c
+	}
+	out := Schema{Dropped: s.Dropped}
+	for _, name := range cols {
+		c, ok := byName[name]
+		if !ok {
+			return Schema{}, fmt.Errorf("unknown export column %q", name)
+		}
+		out.Columns = append(out.Columns, c)
+	}
+	return out, nil
+}
+
+func collect(s *Schema, t reflect.Type, prefix string, index []int) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		name = prefix + name
+		idx := append(append([]int{}, index...), i)
+
+		ft := f.Type
+		for ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+
+		switch {
+		case ft == timeType:
+			s.Columns = append(s.Columns, Column{Name: name, Kind: KindTime, index: idx})
+		case ft == rawType:
+			s.Dropped = append(s.Dropped, name)
+		case ft.Kind() == reflect.Struct:
+			collect(s, ft, name+"_", idx)
+		default:
+			kind, ok := scalarKind(ft.Kind())
+			if !ok {
+				s.Dropped = append(s.Dropped, name)
+				continue
+			}
+			s.Columns = append(s.Columns, Column{Name: name, Kind: kind, index: idx})
+		}
+	}
+}
+
+func scalarKind(k reflect.Kind) (Kind, bool) {
+	switch k {
+	case reflect.String:
+		return KindString, true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return KindInt, true
+	case reflect.Float32, reflect.Float64:
+		return KindFloat, true
+	case reflect.Bool:
+		return KindBool, true
+	}
+	return 0, false
+}
+
+// value returns the column value of row, or nil when it (or a parent) is a nil pointer
+func (c Column) value(row reflect.Value) any {
+	v := row
+	for _, i := range c.index {
+		for v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return nil
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	switch c.Kind {
+	case KindTime:
+		return v.Interface().(time.Time)
+	case KindInt:
+		if v.CanInt() {
+			return v.Int()
+		}
+		return int64(v.Uint())
+	case KindFloat:
+		return v.Float()
+	case KindBool:
+		return v.Bool()
+	default:
+		return v.String()
+	}
+}
@@ -0,0 +1,97 @@
+package export
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+// Stream writes rows as the store yields them: a {"<key>":[...]} document for
+// JSON, otherwise the tabular format. Nothing is sent before the first row (or
+// Close), so a failing query can still be answered with an error response.
+type Stream[T any] struct {
+	w      http.ResponseWriter
+	format Format
+	key    string
+	name   string
+	cols   []string
+
+	json *utils.JSONStream
+	rows *RowWriter[T]
+}
+
+// NewStream prepares a stream; key is the JSON array field, name the download file
+// name and cols the optional column selection (nil means every column)
+func NewStream[T any](w http.ResponseWriter, format Format, key, name string, cols []string) (*Stream[T], error) {
+	if _, err := SchemaOf[T]().Select(cols); err != nil {
+		return nil, err
+	}
+	s := &Stream[T]{w: w, format: format, key: key, name: name, cols: cols}
+	if format == JSON {
+		s.json = utils.NewJSONStream(w, key)
+	}
+	return s, nil
+}
+
+func (s *Stream[T]) Write(row T) error {
+	if s.json != nil {
+		if len(s.cols) == 0 {
+			return s.json.Write(row)
+		}
+		v, err := ProjectJSON(row, s.cols)
+		if err != nil {
+			return err
+		}
+		return s.json.Write(v)
+	}
+	if s.rows == nil {
+		rw, err := NewRowWriter[T](s.w, s.format, s.name, s.cols)
+		if err != nil {
+			return err
+		}
+		s.rows = rw
+	}
+	return s.rows.Write(row)
+}
+
+func (s *Stream[T]) Close() error {
+	if s.json != nil {
+		return s.json.Close()
+	}
+	if s.rows == nil {
+		rw, err := NewRowWriter[T](s.w, s.format, s.name, s.cols)
+		if err != nil {
+			return err
+		}
+		s.rows = rw
+	}
+	return s.rows.Close()
+}
+
+// Started reports whether the response has begun, after which errors can only abort it
+func (s *Stream[T]) Started() bool {
+	if s.json != nil {
+		return s.json.Started()
+	}
+	return s.rows != nil
+}
+
+// ProjectJSON returns the JSON object of row restricted to the given keys
+func ProjectJSON(row any, keys []string) (map[string]json.RawMessage, error) {
+	b, err := json.Marshal(row)
+	if err != nil {
+		return nil, err
+	}
+	var full map[string]json.RawMessage
+	if err := json.Unmarshal(b, &full); err != nil {
+		return nil, err
+	}
+	m := make(map[string]json.RawMessage, len(keys))
+	for _, k := range keys {
+		if v, ok := full[k]; ok {
+			m[k] = v
+		}
+	}
+	return m, nil
+}
@@ -0,0 +1,258 @@
+package export
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/parquet-go/parquet-go"
+)
+
+const (
+	// rows between flushes of CSV and NDJSON output
+	flushEvery = 500
+	// rows per Parquet row group; each group is written out as soon as it is full
+	rowGroupSize = 10000
+)
+
+// RowWriter encodes rows one at a time so large results never have to be held in memory
+type RowWriter[T any] struct {
+	w      http.ResponseWriter
+	rc     *http.ResponseController
+	dl     *utils.WriteDeadline
+	format Format
+	schema Schema
+	n      int
+
+	bw   *bufio.Writer // ndjson
+	keys [][]byte
+
+	cw *csv.Writer
+
+	pw   *parquet.Writer
+	leaf []int
+	rows []parquet.Row
+}
+
+// NewRowWriter sets the response headers and starts the body; cols restricts and
+// orders the columns (nil means the whole schema), name becomes the download file name
+func NewRowWriter[T any](w http.ResponseWriter, format Format, name string, cols []string) (*RowWriter[T], error) {
+	if _, ok := contentTypes[format]; !ok || format == JSON {
+		return nil, fmt.Errorf("unsupported export format %q", format)
+	}
+	schema, err := SchemaOf[T]().Select(cols)
+	if err != nil {
+		return nil, err
+	}
+
+	rc := http.NewResponseController(w)
+	rw := &RowWriter[T]{w: w, rc: rc, dl: utils.NewWriteDeadline(rc), format: format, schema: schema}
+	rw.dl.Extend()
+	switch format {
+	case CSV:
+		rw.cw = csv.NewWriter(w)
+	case NDJSON:
+		rw.bw = bufio.NewWriter(w)
+		// keys are encoded once; objects are assembled by hand to keep the schema's column order
+		rw.keys = make([][]byte, len(schema.Columns))
+		for k, c := range schema.Columns {
+			rw.keys[k], _ = json.Marshal(c.Name)
+		}
+	case Parquet:
+		rw.initParquet(name)
+	}
+
+	h := w.Header()
+	if format == CSV {
+		h.Set("Content-Type", contentTypes[CSV]+"; charset=utf-8")
+	} else {
+		h.Set("Content-Type", contentTypes[format])
+	}
+	h.Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, name, format))
+	w.WriteHeader(http.StatusOK)
+
+	if rw.cw != nil {
+		if err := rw.cw.Write(schema.Names()); err != nil {
+			return nil, err
+		}
+	}
+	return rw, nil
+}
+
+func (rw *RowWriter[T]) Write(row T) error {
+	rw.dl.Extend()
+	rv := reflect.ValueOf(&row).Elem()
+	rw.n++
+
+	switch rw.format {
+	case CSV:
+		record := make([]string, len(rw.schema.Columns))
+		for k, c := range rw.schema.Columns {
+			record[k] = formatCell(c.value(rv))
+		}
+		if err := rw.cw.Write(record); err != nil {
+			return err
+		}
+		if rw.n%flushEvery == 0 {
+			rw.cw.Flush()
+			return rw.flush(rw.cw.Error())
+		}
+
+	case NDJSON:
+		rw.bw.WriteByte('{')
+		for k, c := range rw.schema.Columns {
+			if k > 0 {
+				rw.bw.WriteByte(',')
+			}
+			rw.bw.Write(rw.keys[k])
+			rw.bw.WriteByte(':')
+			v, err := json.Marshal(c.value(rv))
+			if err != nil {
+				return err
+			}
+			rw.bw.Write(v)
+		}
+		rw.bw.WriteString("}\n")
+		if rw.n%flushEvery == 0 {
+			return rw.flush(rw.bw.Flush())
+		}
+
+	case Parquet:
+		rw.rows = append(rw.rows, rw.parquetRow(rv))
+		if len(rw.rows) == rowGroupSize {
+			return rw.writeRowGroup()
+		}
+	}
+	return nil
+}
+
+// Close writes whatever is still buffered and, for Parquet, the file footer
+func (rw *RowWriter[T]) Close() error {
+	rw.dl.Extend()
+	switch rw.format {
+	case CSV:
+		rw.cw.Flush()
+		return rw.cw.Error()
+	case NDJSON:
+		return rw.bw.Flush()
+	case Parquet:
+		if len(rw.rows) > 0 {
+			if _, err := rw.pw.WriteRows(rw.rows); err != nil {
+				return err
+			}
+		}
+		return rw.pw.Close()
+	}
+	return nil
+}
+
+func (rw *RowWriter[T]) flush(err error) error {
+	if err != nil {
+		return err
+	}
+	// not every writer in the chain can flush; buffering is then left to it
+	_ = rw.rc.Flush()
+	return nil
+}
+
+func (rw *RowWriter[T]) initParquet(name string) {
+	group := parquet.Group{}
+	for _, c := range rw.schema.Columns {
+		group[c.Name] = parquet.Optional(parquetNode(c.Kind))
+	}
+	schema := parquet.NewSchema(name, group)
+
+	// parquet orders the leaf columns of a group by name
+	pos := map[string]int{}
+	for i, path := range schema.Columns() {
+		pos[path[0]] = i
+	}
+	rw.leaf = make([]int, len(rw.schema.Columns))
+	for k, c := range rw.schema.Columns {
+		rw.leaf[k] = pos[c.Name]
+	}
+
+	rw.pw = parquet.NewWriter(rw.w, schema, parquet.Compression(&parquet.Zstd))
+	rw.rows = make([]parquet.Row, 0, rowGroupSize)
+}
+
+func (rw *RowWriter[T]) parquetRow(rv reflect.Value) parquet.Row {
+	row := make(parquet.Row, len(rw.schema.Columns))
+	for k, c := range rw.schema.Columns {
+		col := rw.leaf[k]
+		v := c.value(rv)
+		if v == nil {
+			row[col] = parquet.NullValue().Level(0, 0, col)
+			continue
+		}
+		row[col] = parquetValue(v).Level(0, 1, col)
+	}
+	return row
+}
+
+func (rw *RowWriter[T]) writeRowGroup() error {
+	if _, err := rw.pw.WriteRows(rw.rows); err != nil {
+		return err
+	}
+	rw.rows = rw.rows[:0]
+	return rw.flush(rw.pw.Flush())
+}
+
+func formatCell(v any) string {
+	switch x := v.(type) {
+	case nil:
+		return ""
+	case string:
+		// a leading =, +, - or @ would be run as a formula by spreadsheet apps
+		if x != "" && strings.ContainsRune("=+-@", rune(x[0])) {
+			return "'" + x
+		}
+		return x
+	case int64:
+		return strconv.FormatInt(x, 10)
+	case float64:
+		return strconv.FormatFloat(x, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(x)
+	case time.Time:
+		return x.Format(time.RFC3339Nano)
+	}
+	return ""
+}
+
+func parquetNode(k Kind) parquet.Node {
+	switch k {
+	case KindInt:
+		return parquet.Int(64)
+	case KindFloat:
+		return parquet.Leaf(parquet.DoubleType)
+	case KindBool:
+		return parquet.Leaf(parquet.BooleanType)
+	case KindTime:
+		return parquet.Timestamp(parquet.Microsecond)
+	}
+	return parquet.String()
+}
+
+func parquetValue(v any) parquet.Value {
+	switch x := v.(type) {
+	case string:
+		return parquet.ByteArrayValue([]byte(x))
+	case int64:
+		return parquet.Int64Value(x)
+	case float64:
+		return parquet.DoubleValue(x)
+	case bool:
+		return parquet.BooleanValue(x)
+	case time.Time:
+		return parquet.Int64Value(x.UnixMicro())
+	}
+	return parquet.NullValue()
+}
@@ -0,0 +1,54 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// Header is the request header carrying the client-chosen key
+const Header = "Idempotency-Key"
+
+// ReplayedHeader is set on responses served from a stored record
+const ReplayedHeader = "Idempotent-Replayed"
+
+// MaxKeyLength bounds the accepted key size
+const MaxKeyLength = 255
+
+var (
+	// ErrFingerprintMismatch means the key was first used with a different request
+	ErrFingerprintMismatch = errors.New("idempotency key was already used with a different request body")
+	// ErrInProgress means the first request with this key has not finished yet
+	ErrInProgress = errors.New("a request with this idempotency key is still being processed")
+)
+
+// Record is what is kept per key: the request fingerprint and, once the first
+// request has finished, its response
+type Record struct {
+	Fingerprint string      `json:"fingerprint"`
+	Done        bool        `json:"done"`
+	Status      int         `json:"status,omitempty"`
+	Header      http.Header `json:"header,omitempty"`
+	Body        []byte      `json:"body,omitempty"`
+}
+
+// Store keeps idempotency records. Begin claims key for a request with the
+// given fingerprint; when the key is already taken it returns the existing
+// record instead (started is then false).
+type Store interface {
+	Begin(ctx context.Context, key, fingerprint string, lockTTL time.Duration) (rec *Record, started bool, err error)
+	Complete(ctx context.Context, key string, rec Record, ttl time.Duration) error
+	Release(ctx context.Context, key string) error
+}
+
+// Check reports what to do with an existing record for a request with the given fingerprint
+func Check(rec *Record, fingerprint string) error {
+	if rec.Fingerprint != fingerprint {
+		return ErrFingerprintMismatch
+	}
+	if !rec.Done {
+		return ErrInProgress
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package idempotency
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore is the single-instance fallback used when Redis is not available
+type MemoryStore struct {
+	sync.Mutex
+	records map[string]memoryRecord
+}
+
+type memoryRecord struct {
+	rec     Record
+	expires time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	s := &MemoryStore{records: make(map[string]memoryRecord)}
+	go s.sweep(time.Minute)
+	return s
+}
+
+func (s *MemoryStore) Begin(_ context.Context, key, fingerprint string, lockTTL time.Duration) (*Record, bool, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if m, ok := s.records[key]; ok && time.Now().Before(m.expires) {
+		rec := m.rec
+		return &rec, false, nil
+	}
+	s.records[key] = memoryRecord{rec: Record{Fingerprint: fingerprint}, expires: time.Now().Add(lockTTL)}
+	return nil, true, nil
+}
+
+func (s *MemoryStore) Complete(_ context.Context, key string, rec Record, ttl time.Duration) error {
+	rec.Done = true
+	s.Lock()
+	s.records[key] = memoryRecord{rec: rec, expires: time.Now().Add(ttl)}
+	s.Unlock()
+	return nil
+}
+
+func (s *MemoryStore) Release(_ context.Context, key string) error {
+	s.Lock()
+	delete(s.records, key)
+	s.Unlock()
+	return nil
+}
+
+func (s *MemoryStore) sweep(every time.Duration) {
+	for range time.Tick(every) {
+		now := time.Now()
+		s.Lock()
+		for k, m := range s.records {
+			if now.After(m.expires) {
+				delete(s.records, k)
+			}
+		}
+		s.Unlock()
+	}
+}
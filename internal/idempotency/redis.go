@@ -0,0 +1,64 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type RedisStore struct {
+	Client *redis.Client
+}
+
+func NewRedisStore(client *redis.Client) *RedisStore {
+	return &RedisStore{Client: client}
+}
+
+func redisKey(key string) string {
+	return "idempotency:" + key
+}
+
+func (s *RedisStore) Begin(ctx context.Context, key, fingerprint string, lockTTL time.Duration) (*Record, bool, error) {
+	pending, err := json.Marshal(Record{Fingerprint: fingerprint})
+	if err != nil {
+		return nil, false, err
+	}
+
+	ok, err := s.Client.SetNX(ctx, redisKey(key), pending, lockTTL).Result()
+	if err != nil {
+		return nil, false, err
+	}
+	if ok {
+		return nil, true, nil
+	}
+
+	val, err := s.Client.Get(ctx, redisKey(key)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		// expired between SETNX and GET; let the client retry
+		return &Record{Fingerprint: fingerprint}, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	var rec Record
+	if err := json.Unmarshal(val, &rec); err != nil {
+		return nil, false, err
+	}
+	return &rec, false, nil
+}
+
+func (s *RedisStore) Complete(ctx context.Context, key string, rec Record, ttl time.Duration) error {
+	rec.Done = true
+	val, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	return s.Client.Set(ctx, redisKey(key), val, ttl).Err()
+}
+
+func (s *RedisStore) Release(ctx context.Context, key string) error {
+	return s.Client.Del(ctx, redisKey(key)).Err()
+}
@@ -0,0 +1,42 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
+)
+
+type Status = apitypes.JobStatus
+
+const (
+	StatusQueued    Status = "queued"
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+)
+
+// MaxItemErrors caps the per-item errors kept on a job; the counts stay exact
+const MaxItemErrors = 1000
+
+var (
+	ErrNotFound  = errors.New("job not found")
+	ErrQueueFull = errors.New("import queue is full, try again later")
+)
+
+// Progress is measured on the spooled payload, so it is known before the items are counted
+type Progress = apitypes.JobProgress
+
+// Job is the pollable state of one asynchronous bulk import
+type Job apitypes.JobResponse
+
+// Done reports whether the job has finished, successfully or not
+func (j *Job) Done() bool {
+	return j.Status == StatusSucceeded || j.Status == StatusFailed
+}
+
+type Store interface {
+	Save(ctx context.Context, job *Job, ttl time.Duration) error
+	Get(ctx context.Context, id string) (*Job, error)
+}
@@ -0,0 +1,64 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// MemoryStore is the single-instance fallback used when Redis is not available
+type MemoryStore struct {
+	sync.Mutex
+	jobs map[string]memoryJob
+}
+
+type memoryJob struct {
+	val     []byte
+	expires time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	s := &MemoryStore{jobs: make(map[string]memoryJob)}
+	go s.sweep(time.Minute)
+	return s
+}
+
+// Save keeps a copy, so later changes by the worker are only seen after the next Save
+func (s *MemoryStore) Save(_ context.Context, job *Job, ttl time.Duration) error {
+	val, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	s.Lock()
+	s.jobs[job.ID] = memoryJob{val: val, expires: time.Now().Add(ttl)}
+	s.Unlock()
+	return nil
+}
+
+func (s *MemoryStore) Get(_ context.Context, id string) (*Job, error) {
+	s.Lock()
+	m, ok := s.jobs[id]
+	s.Unlock()
+	if !ok || time.Now().After(m.expires) {
+		return nil, ErrNotFound
+	}
+	var job Job
+	if err := json.Unmarshal(m.val, &job); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (s *MemoryStore) sweep(every time.Duration) {
+	for range time.Tick(every) {
+		now := time.Now()
+		s.Lock()
+		for k, m := range s.jobs {
+			if now.After(m.expires) {
+				delete(s.jobs, k)
+			}
+		}
+		s.Unlock()
+	}
+}
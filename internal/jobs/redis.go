@@ -0,0 +1,45 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type RedisStore struct {
+	Client *redis.Client
+}
+
+func NewRedisStore(client *redis.Client) *RedisStore {
+	return &RedisStore{Client: client}
+}
+
+func redisKey(id string) string {
+	return "job:" + id
+}
+
+func (s *RedisStore) Save(ctx context.Context, job *Job, ttl time.Duration) error {
+	val, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	return s.Client.Set(ctx, redisKey(job.ID), val, ttl).Err()
+}
+
+func (s *RedisStore) Get(ctx context.Context, id string) (*Job, error) {
+	val, err := s.Client.Get(ctx, redisKey(id)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	var job Job
+	if err := json.Unmarshal(val, &job); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
@@ -0,0 +1,248 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/logger"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/google/uuid"
+)
+
+// Processor does the work of one job: it reads the spooled payload from body
+// and records outcomes through t. An error fails the whole job; items already
+// recorded stay committed.
+type Processor func(ctx context.Context, body io.Reader, t *Tracker) error
+
+type Config struct {
+	Workers   int
+	QueueSize int
+	// directory for spooled payloads; empty means the system temp directory
+	Dir string
+	// how long a job stays pollable after its last update
+	TTL time.Duration
+}
+
+// Runner spools uploads to disk and processes them on a fixed pool of workers
+type Runner struct {
+	store  Store
+	cfg    Config
+	queue  chan task
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+type task struct {
+	job     *Job
+	path    string
+	process Processor
+}
+
+func NewRunner(store Store, cfg Config) *Runner {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Runner{
+		store:  store,
+		cfg:    cfg,
+		queue:  make(chan task, cfg.QueueSize),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+func (r *Runner) Store() Store {
+	return r.store
+}
+
+func (r *Runner) Start() {
+	for i := 0; i < r.cfg.Workers; i++ {
+		r.wg.Add(1)
+		go r.work()
+	}
+}
+
+// Stop cancels running jobs, waits for the workers and fails whatever is still queued
+func (r *Runner) Stop() {
+	r.cancel()
+	r.wg.Wait()
+	for {
+		select {
+		case t := <-r.queue:
+			r.finish(t, fmt.Errorf("server shut down before the job started"))
+		default:
+			return
+		}
+	}
+}
+
+// Submit spools body to disk and queues a job of kind for client. The body is
+// read completely before Submit returns, so the request can finish right away.
+func (r *Runner) Submit(ctx context.Context, kind, client string, body io.Reader, process Processor) (*Job, error) {
+	f, err := os.CreateTemp(r.cfg.Dir, "kuha-job-*")
+	if err != nil {
+		return nil, err
+	}
+	size, err := io.Copy(f, body)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return nil, err
+	}
+
+	job := &Job{
+		ID:        uuid.NewString(),
+		Kind:      kind,
+		Client:    client,
+		Status:    StatusQueued,
+		CreatedAt: time.Now().UTC(),
+		Progress:  Progress{BytesTotal: size},
+		Errors:    []utils.ItemResult{},
+	}
+	// the request may be past its deadline after a long upload; the job is
+	// saved and queued regardless, with a deadline of its own
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+	if err := r.store.Save(ctx, job, r.cfg.TTL); err != nil {
+		os.Remove(f.Name())
+		return nil, err
+	}
+
+	select {
+	case r.queue <- task{job: job, path: f.Name(), process: process}:
+		return job, nil
+	default:
+		os.Remove(f.Name())
+		job.Status, job.Error = StatusFailed, ErrQueueFull.Error()
+		if err := r.store.Save(ctx, job, r.cfg.TTL); err != nil {
+			logger.Logger.Warnw("failed to save job", "job_id", job.ID, "error", err)
+		}
+		return nil, ErrQueueFull
+	}
+}
+
+func (r *Runner) work() {
+	defer r.wg.Done()
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case t := <-r.queue:
+			r.run(t)
+		}
+	}
+}
+
+func (r *Runner) run(t task) {
+	var err error
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("job panicked: %v", p)
+		}
+		r.finish(t, err)
+	}()
+
+	f, err := os.Open(t.path)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	now := time.Now().UTC()
+	t.job.Status, t.job.StartedAt = StatusRunning, &now
+	tr := &Tracker{runner: r, job: t.job, body: &countingReader{r: f}}
+	if err = tr.save(r.ctx); err != nil {
+		return
+	}
+
+	logger.Logger.Infow("job started", "job_id", t.job.ID, "kind", t.job.Kind, "client", t.job.Client, "bytes", t.job.Progress.BytesTotal)
+	err = t.process(r.ctx, tr.body, tr)
+	tr.updateProgress()
+}
+
+// finish records the final state of a job and removes its payload
+func (r *Runner) finish(t task, err error) {
+	os.Remove(t.path)
+
+	now := time.Now().UTC()
+	t.job.FinishedAt = &now
+	if err != nil {
+		t.job.Status, t.job.Error = StatusFailed, err.Error()
+		logger.Logger.Warnw("job failed", "job_id", t.job.ID, "kind", t.job.Kind, "error", err)
+	} else {
+		t.job.Status = StatusSucceeded
+		t.job.Progress.BytesRead, t.job.Progress.Percent = t.job.Progress.BytesTotal, 100
+		logger.Logger.Infow("job finished", "job_id", t.job.ID, "kind", t.job.Kind, "items", t.job.Progress.Items, "errors", t.job.Counts.Errors)
+	}
+
+	// the runner context may already be cancelled; the final state must still be written
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := r.store.Save(ctx, t.job, r.cfg.TTL); err != nil {
+		logger.Logger.Warnw("failed to save job", "job_id", t.job.ID, "error", err)
+	}
+}
+
+// Tracker is a processor's handle on its job
+type Tracker struct {
+	runner *Runner
+	job    *Job
+	body   *countingReader
+}
+
+// Record adds the outcomes of a processed chunk to the job and saves its progress
+func (t *Tracker) Record(ctx context.Context, res utils.BulkResult) error {
+	t.job.Counts.Created += res.Counts.Created
+	t.job.Counts.Updated += res.Counts.Updated
+	t.job.Counts.Skipped += res.Counts.Skipped
+	t.job.Counts.Errors += res.Counts.Errors
+	t.job.Progress.Items += len(res.Items)
+
+	for _, item := range res.Items {
+		if item.Status != utils.ItemError {
+			continue
+		}
+		if len(t.job.Errors) == MaxItemErrors {
+			t.job.ErrorsTruncated = true
+			break
+		}
+		t.job.Errors = append(t.job.Errors, item)
+	}
+	return t.save(ctx)
+}
+
+// Items is the number of items recorded so far
+func (t *Tracker) Items() int {
+	return t.job.Progress.Items
+}
+
+func (t *Tracker) save(ctx context.Context) error {
+	t.updateProgress()
+	return t.runner.store.Save(ctx, t.job, t.runner.cfg.TTL)
+}
+
+func (t *Tracker) updateProgress() {
+	p := &t.job.Progress
+	p.BytesRead = t.body.n.Load()
+	if p.BytesTotal > 0 {
+		p.Percent = math.Round(float64(p.BytesRead)/float64(p.BytesTotal)*1000) / 10
+	}
+}
+
+type countingReader struct {
+	r io.Reader
+	n atomic.Int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n.Add(int64(n))
+	return n, err
+}
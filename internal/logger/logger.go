@@ -1,9 +1,12 @@
 package logger
 
 import (
+	"context"
+	"io"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authn"
@@ -16,6 +19,9 @@ import (
 var Logger *zap.SugaredLogger
 var tj *timberjack.Logger
 
+// level is shared by every core so it can be changed at runtime
+var level = zap.NewAtomicLevelAt(zapcore.InfoLevel)
+
 // Initialize the global zap logger with timberjack for file rotation
 func Init(logDir string) {
 	_ = os.MkdirAll(logDir, os.ModePerm)
@@ -38,13 +44,13 @@ func Init(logDir string) {
 	fileCore := zapcore.NewCore(
 		zapcore.NewJSONEncoder(encoderCfg),
 		zapcore.AddSync(tj),
-		zapcore.InfoLevel,
+		level,
 	)
 
 	stdoutCore := zapcore.NewCore(
 		zapcore.NewJSONEncoder(encoderCfg),
 		zapcore.AddSync(os.Stdout),
-		zapcore.InfoLevel,
+		level,
 	)
 
 	combinedCore := zapcore.NewTee(fileCore, stdoutCore)
@@ -53,6 +59,21 @@ func Init(logDir string) {
 	Logger = zapLogger.Sugar()
 }
 
+// Level returns the current log level
+func Level() string {
+	return level.Level().String()
+}
+
+// SetLevel changes the log level of all cores without a restart
+func SetLevel(l string) error {
+	parsed, err := zapcore.ParseLevel(strings.ToLower(strings.TrimSpace(l)))
+	if err != nil {
+		return err
+	}
+	level.SetLevel(parsed)
+	return nil
+}
+
 // Ensures logs are flushed before the application exits
 func Cleanup() {
 	_ = Logger.Sync()
@@ -64,6 +85,7 @@ func Cleanup() {
 type responseRecorder struct {
 	http.ResponseWriter
 	statusCode int
+	bytesOut   int64
 }
 
 func (rw *responseRecorder) WriteHeader(code int) {
@@ -71,11 +93,42 @@ func (rw *responseRecorder) WriteHeader(code int) {
 	rw.ResponseWriter.WriteHeader(code)
 }
 
+func (rw *responseRecorder) Write(b []byte) (int, error) {
+	n, err := rw.ResponseWriter.Write(b)
+	rw.bytesOut += int64(n)
+	return n, err
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer (Flush, deadlines)
+func (rw *responseRecorder) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
+type countingReader struct {
+	io.ReadCloser
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.ReadCloser.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
 func LoggerMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
 		rr := &responseRecorder{ResponseWriter: w, statusCode: http.StatusOK}
 
+		var body *countingReader
+		if r.Body != nil && r.Body != http.NoBody {
+			body = &countingReader{ReadCloser: r.Body}
+			r.Body = body
+		}
+
+		info := &requestInfo{}
+		r = r.WithContext(context.WithValue(r.Context(), requestInfoKey, info))
+
 		next.ServeHTTP(rr, r)
 
 		clientID := authn.GetClientName(r.Context())
@@ -85,15 +138,24 @@ func LoggerMiddleware(next http.Handler) http.Handler {
 
 		requestID := middleware.GetReqID(r.Context())
 
+		var bytesIn int64
+		if body != nil {
+			bytesIn = body.n
+		}
+
 		logFields := []zap.Field{
 			zap.String("method", r.Method),
-			zap.String("path", r.URL.Path),
-			zap.String("query_params", r.URL.RawQuery),
+			zap.String("path", RedactPath(r)),
+			zap.String("query_params", RedactQuery(r.URL.Query())),
 			zap.String("client_id", clientID),
+			zap.Strings("roles", authn.GetClientRoles(r.Context())),
 			zap.String("request_id", requestID),
 			zap.String("ip", r.RemoteAddr),
 			zap.String("user_agent", r.UserAgent()),
 			zap.Int("status", rr.statusCode),
+			zap.Int64("bytes_in", bytesIn),
+			zap.Int64("bytes_out", rr.bytesOut),
+			zap.Bool("cache_hit", info.cacheHit.Load()),
 			zap.Duration("response_time", time.Since(start)),
 		}
 
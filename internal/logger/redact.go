@@ -0,0 +1,160 @@
+package logger
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Redaction config
+type RedactionConfig struct {
+	Params []string // query parameter names to redact (case-insensitive)
+	Mode   string   // "redact" replaces the value, "hash" replaces it with a keyed hash
+	Salt   []byte   // key for "hash" mode so values can be correlated but not reversed
+}
+
+const (
+	RedactModeRedact = "redact"
+	RedactModeHash   = "hash"
+
+	redactedValue = "[REDACTED]"
+)
+
+var DefaultRedactedParams = []string{
+	"user_id",
+	"sportti_id",
+	"sporttiid",
+	"sport_id",
+	"fiscode",
+	"national_id",
+	"competitorid",
+	"username",
+	"token",
+	"polar-id",
+	"oura-id",
+}
+
+var redaction = RedactionConfig{
+	Params: DefaultRedactedParams,
+	Mode:   RedactModeRedact,
+}
+var redactedParams = toSet(DefaultRedactedParams)
+
+// LoadRedactionConfig redacts cfg.Params on top of DefaultRedactedParams. Hash
+// mode needs a salt: unkeyed hashes of short ids are reversed by trying them all.
+func LoadRedactionConfig(cfg RedactionConfig) error {
+	cfg.Params = append(slices.Clone(DefaultRedactedParams), cfg.Params...)
+	if cfg.Mode != RedactModeHash {
+		cfg.Mode = RedactModeRedact
+	} else if len(cfg.Salt) == 0 {
+		return errors.New("hash mode needs a salt")
+	}
+	redaction = cfg
+	redactedParams = toSet(cfg.Params)
+	return nil
+}
+
+func toSet(params []string) map[string]bool {
+	set := make(map[string]bool, len(params))
+	for _, p := range params {
+		p = strings.ToLower(strings.TrimSpace(p))
+		if p != "" {
+			set[p] = true
+		}
+	}
+	return set
+}
+
+// RedactValue masks a single sensitive value according to the configured mode
+func RedactValue(v string) string {
+	if v == "" {
+		return v
+	}
+	if redaction.Mode == RedactModeHash {
+		mac := hmac.New(sha256.New, redaction.Salt)
+		mac.Write([]byte(v))
+		return "h:" + hex.EncodeToString(mac.Sum(nil))[:16]
+	}
+	return redactedValue
+}
+
+// RedactQuery encodes the query string with sensitive parameter values masked
+func RedactQuery(q url.Values) string {
+	if len(q) == 0 {
+		return ""
+	}
+
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, k := range keys {
+		sensitive := redactedParams[strings.ToLower(k)]
+		for _, v := range q[k] {
+			if b.Len() > 0 {
+				b.WriteByte('&')
+			}
+			b.WriteString(url.QueryEscape(k))
+			b.WriteByte('=')
+			if sensitive {
+				b.WriteString(RedactValue(v))
+			} else {
+				b.WriteString(url.QueryEscape(v))
+			}
+		}
+	}
+	return b.String()
+}
+
+// RedactPath is the request path with every path parameter value masked,
+// built from the route pattern: path parameters are athlete and record ids.
+// A request that was answered before its route matched (a 401 from a domain
+// router, a 404) ends in the pattern's *, so the unmatched rest is not logged.
+func RedactPath(r *http.Request) string {
+	rctx := chi.RouteContext(r.Context())
+	if rctx == nil {
+		return ""
+	}
+	pattern := rctx.RoutePattern()
+	if pattern == "" {
+		return ""
+	}
+
+	segs := strings.Split(pattern, "/")
+	path := strings.Split(r.URL.Path, "/")
+	for i, seg := range segs {
+		if strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}") && i < len(path) {
+			segs[i] = RedactValue(path[i])
+		}
+	}
+	return strings.Join(segs, "/")
+}
+
+// Per-request values filled in by downstream layers and written by LoggerMiddleware
+type requestInfo struct {
+	cacheHit atomic.Bool
+}
+
+type ctxKey string
+
+const requestInfoKey ctxKey = "request_info"
+
+// MarkCacheHit flags the current request as served from cache
+func MarkCacheHit(ctx context.Context) {
+	if info, ok := ctx.Value(requestInfoKey).(*requestInfo); ok {
+		info.cacheHit.Store(true)
+	}
+}
@@ -0,0 +1,510 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Schema is a compiled JSON Schema (2020-12). Only the keywords the spec uses
+// for request contracts are enforced; annotations are ignored.
+type Schema struct {
+	Types            []string
+	Format           string
+	Enum             []any
+	Pattern          *regexp.Regexp
+	MinLength        *int
+	MaxLength        *int
+	Minimum          *float64
+	Maximum          *float64
+	ExclusiveMinimum *float64
+	ExclusiveMaximum *float64
+
+	Items       *Schema
+	MinItems    *int
+	MaxItems    *int
+	UniqueItems bool
+
+	Properties           map[string]*Schema
+	Required             []string
+	AdditionalProperties *Schema
+	NoAdditional         bool
+	PropertyNames        *Schema
+	MinProperties        *int
+	MaxProperties        *int
+
+	AllOf []*Schema
+	AnyOf []*Schema
+	OneOf []*Schema
+}
+
+// Violation is one way a value breaks its schema. Field is a dotted path
+// such as "exercises[2].user_id"; it is empty for the value itself. In is
+// set for request validation: path, query, header or body.
+type Violation struct {
+	In      string
+	Field   string
+	Message string
+}
+
+type compiler struct {
+	root    map[string]any
+	schemas map[string]*Schema
+}
+
+func (c *compiler) compile(v any) (*Schema, error) {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("schema must be an object, got %T", v)
+	}
+	if ref, ok := m["$ref"].(string); ok {
+		return c.resolve(ref)
+	}
+	s := &Schema{}
+	if err := c.fill(s, m); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// resolve compiles a local reference once so recursive schemas terminate
+func (c *compiler) resolve(ref string) (*Schema, error) {
+	if s, ok := c.schemas[ref]; ok {
+		return s, nil
+	}
+	if !strings.HasPrefix(ref, "#/") {
+		return nil, fmt.Errorf("unsupported $ref %q", ref)
+	}
+	var node any = c.root
+	for _, part := range strings.Split(ref[2:], "/") {
+		part = strings.ReplaceAll(strings.ReplaceAll(part, "~1", "/"), "~0", "~")
+		m, ok := node.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("unresolvable $ref %q", ref)
+		}
+		if node, ok = m[part]; !ok {
+			return nil, fmt.Errorf("unresolvable $ref %q", ref)
+		}
+	}
+	m, ok := node.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("$ref %q is not a schema", ref)
+	}
+	s := &Schema{}
+	c.schemas[ref] = s
+	if err := c.fill(s, m); err != nil {
+		return nil, fmt.Errorf("%s: %w", ref, err)
+	}
+	return s, nil
+}
+
+func (c *compiler) fill(s *Schema, m map[string]any) error {
+	switch t := m["type"].(type) {
+	case string:
+		s.Types = []string{t}
+	case []any:
+		for _, v := range t {
+			if name, ok := v.(string); ok {
+				s.Types = append(s.Types, name)
+			}
+		}
+	}
+	s.Format, _ = m["format"].(string)
+	if e, ok := m["enum"].([]any); ok {
+		s.Enum = e
+	}
+	if p, ok := m["pattern"].(string); ok {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return fmt.Errorf("pattern %q: %w", p, err)
+		}
+		s.Pattern = re
+	}
+	s.MinLength = intKeyword(m, "minLength")
+	s.MaxLength = intKeyword(m, "maxLength")
+	s.Minimum = numKeyword(m, "minimum")
+	s.Maximum = numKeyword(m, "maximum")
+	s.ExclusiveMinimum = numKeyword(m, "exclusiveMinimum")
+	s.ExclusiveMaximum = numKeyword(m, "exclusiveMaximum")
+	s.MinItems = intKeyword(m, "minItems")
+	s.MaxItems = intKeyword(m, "maxItems")
+	s.UniqueItems, _ = m["uniqueItems"].(bool)
+	s.MinProperties = intKeyword(m, "minProperties")
+	s.MaxProperties = intKeyword(m, "maxProperties")
+
+	var err error
+	if v, ok := m["items"]; ok {
+		if s.Items, err = c.compile(v); err != nil {
+			return fmt.Errorf("items: %w", err)
+		}
+	}
+	if props, ok := m["properties"].(map[string]any); ok {
+		s.Properties = make(map[string]*Schema, len(props))
+		for name, v := range props {
+			if s.Properties[name], err = c.compile(v); err != nil {
+				return fmt.Errorf("properties.%s: %w", name, err)
+			}
+		}
+	}
+	if req, ok := m["required"].([]any); ok {
+		for _, v := range req {
+			if name, ok := v.(string); ok {
+				s.Required = append(s.Required, name)
+			}
+		}
+	}
+	switch ap := m["additionalProperties"].(type) {
+	case bool:
+		s.NoAdditional = !ap
+	case map[string]any:
+		if s.AdditionalProperties, err = c.compile(ap); err != nil {
+			return fmt.Errorf("additionalProperties: %w", err)
+		}
+	}
+	if v, ok := m["propertyNames"]; ok {
+		if s.PropertyNames, err = c.compile(v); err != nil {
+			return fmt.Errorf("propertyNames: %w", err)
+		}
+	}
+	for key, dst := range map[string]*[]*Schema{"allOf": &s.AllOf, "anyOf": &s.AnyOf, "oneOf": &s.OneOf} {
+		list, ok := m[key].([]any)
+		if !ok {
+			continue
+		}
+		for i, v := range list {
+			sub, err := c.compile(v)
+			if err != nil {
+				return fmt.Errorf("%s[%d]: %w", key, i, err)
+			}
+			*dst = append(*dst, sub)
+		}
+	}
+	return nil
+}
+
+func intKeyword(m map[string]any, key string) *int {
+	if f := numKeyword(m, key); f != nil {
+		n := int(*f)
+		return &n
+	}
+	return nil
+}
+
+func numKeyword(m map[string]any, key string) *float64 {
+	var f float64
+	switch v := m[key].(type) {
+	case int:
+		f = float64(v)
+	case int64:
+		f = float64(v)
+	case uint64:
+		f = float64(v)
+	case float64:
+		f = v
+	default:
+		return nil
+	}
+	return &f
+}
+
+// Validate checks a decoded JSON value (numbers as json.Number) against s
+func (s *Schema) Validate(v any) []Violation {
+	return s.check(v, false)
+}
+
+// check validates v; with skipItems array items are not descended into, which
+// is what x-validate-items: false asks for (partial bulk mode reports
+// per-item errors itself)
+func (s *Schema) check(v any, skipItems bool) []Violation {
+	st := &state{skipItems: skipItems}
+	s.validate("", v, st)
+	return st.out
+}
+
+type state struct {
+	out       []Violation
+	skipItems bool
+}
+
+func (s *Schema) validate(path string, v any, st *state) {
+	out := &st.out
+	add := func(format string, args ...any) {
+		*out = append(*out, Violation{Field: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if len(s.Types) > 0 && !s.typeMatches(v) {
+		add("must be of type %s", strings.Join(s.Types, " or "))
+		return
+	}
+	if len(s.Enum) > 0 && !inEnum(s.Enum, v) {
+		add("must be one of: %s", enumList(s.Enum))
+		return
+	}
+
+	switch val := v.(type) {
+	case string:
+		s.validateString(val, add)
+	case json.Number:
+		s.validateNumber(val, add)
+	case []any:
+		s.validateArray(path, val, st, add)
+	case map[string]any:
+		s.validateObject(path, val, st, add)
+	}
+
+	for _, sub := range s.AllOf {
+		sub.validate(path, v, st)
+	}
+	if len(s.AnyOf) > 0 && matching(s.AnyOf, v, st.skipItems) == 0 {
+		add("must match at least one of the allowed schemas")
+	}
+	if len(s.OneOf) > 0 && matching(s.OneOf, v, st.skipItems) != 1 {
+		add("must match exactly one of the allowed schemas")
+	}
+}
+
+func matching(list []*Schema, v any, skipItems bool) int {
+	n := 0
+	for _, sub := range list {
+		if len(sub.check(v, skipItems)) == 0 {
+			n++
+		}
+	}
+	return n
+}
+
+func (s *Schema) typeMatches(v any) bool {
+	for _, t := range s.Types {
+		switch t {
+		case "null":
+			if v == nil {
+				return true
+			}
+		case "boolean":
+			if _, ok := v.(bool); ok {
+				return true
+			}
+		case "string":
+			if _, ok := v.(string); ok {
+				return true
+			}
+		case "number":
+			if _, ok := v.(json.Number); ok {
+				return true
+			}
+		case "integer":
+			if n, ok := v.(json.Number); ok && isInteger(n) {
+				return true
+			}
+		case "array":
+			if _, ok := v.([]any); ok {
+				return true
+			}
+		case "object":
+			if _, ok := v.(map[string]any); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isInteger(n json.Number) bool {
+	if _, err := strconv.ParseInt(string(n), 10, 64); err == nil {
+		return true
+	}
+	f, err := n.Float64()
+	return err == nil && f == math.Trunc(f) && !math.IsInf(f, 0)
+}
+
+func (s *Schema) validateString(v string, add func(string, ...any)) {
+	n := utf8.RuneCountInString(v)
+	if s.MinLength != nil && n < *s.MinLength {
+		if *s.MinLength == 1 {
+			add("must not be empty")
+		} else {
+			add("must be at least %d characters long", *s.MinLength)
+		}
+	}
+	if s.MaxLength != nil && n > *s.MaxLength {
+		add("must be at most %d characters long", *s.MaxLength)
+	}
+	if s.Pattern != nil && !s.Pattern.MatchString(v) {
+		add("must match pattern %s", s.Pattern.String())
+	}
+	if v == "" {
+		return
+	}
+	switch s.Format {
+	case "uuid":
+		if !uuidRe.MatchString(v) {
+			add("must be a valid UUID")
+		}
+	case "date":
+		if _, err := time.Parse("2006-01-02", v); err != nil {
+			add("must be a date in YYYY-MM-DD format")
+		}
+	case "date-time":
+		if _, err := time.Parse(time.RFC3339, v); err != nil {
+			add("must be an RFC 3339 date-time")
+		}
+	case "uri":
+		if u, err := url.Parse(v); err != nil || u.Scheme == "" {
+			add("must be an absolute URI")
+		}
+	}
+}
+
+var uuidRe = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+func (s *Schema) validateNumber(v json.Number, add func(string, ...any)) {
+	f, err := v.Float64()
+	if err != nil {
+		add("must be a number")
+		return
+	}
+	switch s.Format {
+	case "int32":
+		if f < math.MinInt32 || f > math.MaxInt32 {
+			add("must fit in a 32-bit integer")
+			return
+		}
+	case "int64":
+		if _, err := strconv.ParseInt(string(v), 10, 64); err != nil && isInteger(v) {
+			add("must fit in a 64-bit integer")
+			return
+		}
+	}
+	if s.Minimum != nil && f < *s.Minimum {
+		add("must be greater than or equal to %s", formatNum(*s.Minimum))
+	}
+	if s.Maximum != nil && f > *s.Maximum {
+		add("must be less than or equal to %s", formatNum(*s.Maximum))
+	}
+	if s.ExclusiveMinimum != nil && f <= *s.ExclusiveMinimum {
+		add("must be greater than %s", formatNum(*s.ExclusiveMinimum))
+	}
+	if s.ExclusiveMaximum != nil && f >= *s.ExclusiveMaximum {
+		add("must be less than %s", formatNum(*s.ExclusiveMaximum))
+	}
+}
+
+func formatNum(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func (s *Schema) validateArray(path string, v []any, st *state, add func(string, ...any)) {
+	if s.MinItems != nil && len(v) < *s.MinItems {
+		add("must contain at least %d item(s)", *s.MinItems)
+	}
+	if s.MaxItems != nil && len(v) > *s.MaxItems {
+		add("must contain at most %d item(s)", *s.MaxItems)
+	}
+	if s.UniqueItems {
+		seen := make(map[string]struct{}, len(v))
+		for _, item := range v {
+			b, _ := json.Marshal(item)
+			if _, dup := seen[string(b)]; dup {
+				add("must not contain duplicate items")
+				break
+			}
+			seen[string(b)] = struct{}{}
+		}
+	}
+	if s.Items == nil || st.skipItems {
+		return
+	}
+	for i, item := range v {
+		s.Items.validate(fmt.Sprintf("%s[%d]", path, i), item, st)
+	}
+}
+
+func (s *Schema) validateObject(path string, v map[string]any, st *state, add func(string, ...any)) {
+	out := &st.out
+	if s.MinProperties != nil && len(v) < *s.MinProperties {
+		add("must have at least %d key(s)", *s.MinProperties)
+	}
+	if s.MaxProperties != nil && len(v) > *s.MaxProperties {
+		add("must have at most %d key(s)", *s.MaxProperties)
+	}
+	for _, name := range s.Required {
+		if _, ok := v[name]; !ok {
+			*out = append(*out, Violation{Field: join(path, name), Message: "is required"})
+		}
+	}
+
+	keys := make([]string, 0, len(v))
+	for k := range v {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		field := join(path, k)
+		if s.PropertyNames != nil {
+			for _, pv := range s.PropertyNames.Validate(k) {
+				*out = append(*out, Violation{Field: field, Message: "property name " + pv.Message})
+			}
+		}
+		if sub, ok := s.Properties[k]; ok {
+			sub.validate(field, v[k], st)
+			continue
+		}
+		switch {
+		case s.AdditionalProperties != nil:
+			s.AdditionalProperties.validate(field, v[k], st)
+		case s.NoAdditional:
+			*out = append(*out, Violation{Field: field, Message: "unknown field"})
+		}
+	}
+}
+
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func inEnum(enum []any, v any) bool {
+	for _, e := range enum {
+		switch ev := e.(type) {
+		case string:
+			if sv, ok := v.(string); ok && sv == ev {
+				return true
+			}
+		case bool:
+			if bv, ok := v.(bool); ok && bv == ev {
+				return true
+			}
+		case nil:
+			if v == nil {
+				return true
+			}
+		default:
+			n, ok := v.(json.Number)
+			if !ok {
+				continue
+			}
+			f, err := n.Float64()
+			if ef := numKeyword(map[string]any{"v": e}, "v"); err == nil && ef != nil && *ef == f {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func enumList(enum []any) string {
+	parts := make([]string, len(enum))
+	for i, e := range enum {
+		parts[i] = fmt.Sprint(e)
+	}
+	return strings.Join(parts, ", ")
+}
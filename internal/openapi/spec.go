@@ -0,0 +1,370 @@
+// Package openapi holds the OpenAPI 3.1 document that is the source of truth
+// for the request contract, and validates incoming requests against it.
+package openapi
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed openapi.yaml
+var document []byte
+
+// Parameter is a compiled path, query or header parameter
+type Parameter struct {
+	Name     string
+	In       string
+	Required bool
+	Schema   *Schema
+	// Identity mirrors x-identity: the athlete identifier kind the parameter
+	// holds, so it also takes an athlete reference (kind:value)
+	Identity string
+}
+
+// Operation is what the spec declares for one method on one path
+type Operation struct {
+	ID     string
+	Method string
+	Path   string
+	Params []Parameter
+	// Body is the application/json request body schema; nil when the
+	// operation takes no JSON body
+	Body         *Schema
+	BodyRequired bool
+	// SkipItems mirrors x-validate-items: false on the request body
+	SkipItems bool
+}
+
+type route struct {
+	segments []string
+	ops      map[string]*Operation
+}
+
+// Spec is a loaded and compiled OpenAPI document
+type Spec struct {
+	yaml   []byte
+	json   []byte
+	routes map[int][]*route
+}
+
+// Load parses the embedded document. version, when not empty, replaces
+// info.version so the served document follows the binary.
+func Load(version string) (*Spec, error) {
+	return Parse(document, version)
+}
+
+// Parse compiles an OpenAPI 3.1 document
+func Parse(doc []byte, version string) (*Spec, error) {
+	var node yaml.Node
+	if err := yaml.Unmarshal(doc, &node); err != nil {
+		return nil, fmt.Errorf("openapi: %w", err)
+	}
+	if version != "" {
+		setVersion(&node, version)
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return nil, fmt.Errorf("openapi: %w", err)
+	}
+
+	var root map[string]any
+	if err := node.Decode(&root); err != nil {
+		return nil, fmt.Errorf("openapi: %w", err)
+	}
+	js, err := json.Marshal(root)
+	if err != nil {
+		return nil, fmt.Errorf("openapi: %w", err)
+	}
+
+	s := &Spec{yaml: buf.Bytes(), json: js, routes: make(map[int][]*route)}
+	if err := s.compile(root); err != nil {
+		return nil, fmt.Errorf("openapi: %w", err)
+	}
+	return s, nil
+}
+
+func setVersion(node *yaml.Node, version string) {
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+	info := mappingValue(node, "info")
+	if info == nil {
+		return
+	}
+	if v := mappingValue(info, "version"); v != nil {
+		v.Value = version
+		v.Tag = "!!str"
+		v.Style = 0
+	}
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+var methods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+func (s *Spec) compile(root map[string]any) error {
+	paths, ok := root["paths"].(map[string]any)
+	if !ok {
+		return fmt.Errorf("document has no paths")
+	}
+	c := &compiler{root: root, schemas: make(map[string]*Schema)}
+
+	for path, v := range paths {
+		item, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+		rt := &route{segments: split(path), ops: make(map[string]*Operation)}
+		shared, err := c.params(item["parameters"])
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		for _, m := range methods {
+			raw, ok := item[m].(map[string]any)
+			if !ok {
+				continue
+			}
+			op, err := c.operation(raw, shared)
+			if err != nil {
+				return fmt.Errorf("%s %s: %w", strings.ToUpper(m), path, err)
+			}
+			op.Method = strings.ToUpper(m)
+			op.Path = path
+			rt.ops[op.Method] = op
+		}
+		s.routes[len(rt.segments)] = append(s.routes[len(rt.segments)], rt)
+	}
+
+	// literal segments win over templated ones, as in the router
+	for _, list := range s.routes {
+		sort.Slice(list, func(i, j int) bool { return moreSpecific(list[i].segments, list[j].segments) })
+	}
+	return nil
+}
+
+func (c *compiler) params(v any) ([]Parameter, error) {
+	list, _ := v.([]any)
+	var out []Parameter
+	for _, raw := range list {
+		m, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		if ref, ok := m["$ref"].(string); ok {
+			resolved, err := c.lookup(ref)
+			if err != nil {
+				return nil, err
+			}
+			m = resolved
+		}
+		p := Parameter{}
+		p.Name, _ = m["name"].(string)
+		p.In, _ = m["in"].(string)
+		p.Required, _ = m["required"].(bool)
+		p.Identity, _ = m["x-identity"].(string)
+		if sch, ok := m["schema"]; ok {
+			compiled, err := c.compile(sch)
+			if err != nil {
+				return nil, fmt.Errorf("parameter %s: %w", p.Name, err)
+			}
+			p.Schema = compiled
+		}
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+func (c *compiler) operation(raw map[string]any, shared []Parameter) (*Operation, error) {
+	op := &Operation{}
+	op.ID, _ = raw["operationId"].(string)
+
+	own, err := c.params(raw["parameters"])
+	if err != nil {
+		return nil, err
+	}
+	// operation parameters override path-level ones with the same name and location
+	for _, p := range shared {
+		overridden := false
+		for _, o := range own {
+			if o.Name == p.Name && o.In == p.In {
+				overridden = true
+				break
+			}
+		}
+		if !overridden {
+			op.Params = append(op.Params, p)
+		}
+	}
+	op.Params = append(op.Params, own...)
+
+	body, ok := raw["requestBody"].(map[string]any)
+	if !ok {
+		return op, nil
+	}
+	if ref, ok := body["$ref"].(string); ok {
+		if body, err = c.lookup(ref); err != nil {
+			return nil, err
+		}
+	}
+	op.BodyRequired, _ = body["required"].(bool)
+	if v, ok := body["x-validate-items"].(bool); ok {
+		op.SkipItems = !v
+	}
+	content, _ := body["content"].(map[string]any)
+	media, _ := content["application/json"].(map[string]any)
+	if sch, ok := media["schema"]; ok {
+		if op.Body, err = c.compile(sch); err != nil {
+			return nil, fmt.Errorf("request body: %w", err)
+		}
+	}
+	return op, nil
+}
+
+func (c *compiler) lookup(ref string) (map[string]any, error) {
+	var node any = c.root
+	for _, part := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+		m, ok := node.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("unresolvable $ref %q", ref)
+		}
+		node = m[part]
+	}
+	m, ok := node.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("unresolvable $ref %q", ref)
+	}
+	return m, nil
+}
+
+func split(path string) []string {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return nil
+	}
+	return strings.Split(path, "/")
+}
+
+func isParam(seg string) bool {
+	return strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}")
+}
+
+func moreSpecific(a, b []string) bool {
+	for i := range a {
+		if pa, pb := isParam(a[i]), isParam(b[i]); pa != pb {
+			return !pa
+		}
+	}
+	return false
+}
+
+// Find returns the operation matching method and a request path together
+// with the path parameter values, or nil when the spec does not declare it
+func (s *Spec) Find(method, path string) (*Operation, map[string]string) {
+	segs := split(path)
+	for _, rt := range s.routes[len(segs)] {
+		params, ok := rt.match(segs)
+		if !ok {
+			continue
+		}
+		op, ok := rt.ops[method]
+		if !ok {
+			// a more generic template may still declare the method
+			continue
+		}
+		return op, params
+	}
+	return nil, nil
+}
+
+func (rt *route) match(segs []string) (map[string]string, bool) {
+	var params map[string]string
+	for i, seg := range rt.segments {
+		if isParam(seg) {
+			if segs[i] == "" {
+				return nil, false
+			}
+			if params == nil {
+				params = make(map[string]string)
+			}
+			params[seg[1:len(seg)-1]] = segs[i]
+			continue
+		}
+		if seg != segs[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+// HasOperation reports whether the spec declares method on a path template
+// such as a chi route pattern; parameter names need not match
+func (s *Spec) HasOperation(method, pattern string) bool {
+	segs := split(pattern)
+	for _, rt := range s.routes[len(segs)] {
+		if sameTemplate(rt.segments, segs) {
+			_, ok := rt.ops[method]
+			return ok
+		}
+	}
+	return false
+}
+
+func sameTemplate(a, b []string) bool {
+	for i := range a {
+		if isParam(a[i]) != isParam(b[i]) || (!isParam(a[i]) && a[i] != b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Operations lists every declared operation, sorted by path and method
+func (s *Spec) Operations() []*Operation {
+	var out []*Operation
+	for _, list := range s.routes {
+		for _, rt := range list {
+			for _, op := range rt.ops {
+				out = append(out, op)
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Path != out[j].Path {
+			return out[i].Path < out[j].Path
+		}
+		return out[i].Method < out[j].Method
+	})
+	return out
+}
+
+// YAMLHandler serves the document as YAML
+func (s *Spec) YAMLHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/yaml")
+	w.Write(s.yaml)
+}
+
+// JSONHandler serves the document as JSON
+func (s *Spec) JSONHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(s.json)
+}
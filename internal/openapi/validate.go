@@ -0,0 +1,190 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ValidateRequest checks r against op. Parameters arrive as strings and are
+// coerced to their declared type before validation. The JSON body is checked
+// only when it is at most maxBody bytes and not content-encoded; a body that
+// is not valid JSON is left to the handler, whose decoding errors are more
+// specific. The body is always restored for the handler.
+func (op *Operation) ValidateRequest(r *http.Request, pathParams map[string]string, maxBody int64) []Violation {
+	var out []Violation
+	query := r.URL.Query()
+
+	for _, p := range op.Params {
+		var raw []string
+		switch p.In {
+		case "path":
+			if v, ok := pathParams[p.Name]; ok {
+				raw = []string{v}
+			}
+		case "query":
+			raw = query[p.Name]
+		case "header":
+			raw = r.Header.Values(p.Name)
+		default:
+			continue
+		}
+		if len(raw) == 0 || (len(raw) == 1 && raw[0] == "" && p.In != "path") {
+			if p.Required {
+				out = append(out, Violation{In: p.In, Field: p.Name, Message: "is required"})
+			}
+			continue
+		}
+		if p.Schema == nil {
+			continue
+		}
+		value, ok := coerce(p.Schema, raw)
+		if !ok {
+			out = append(out, Violation{In: p.In, Field: p.Name, Message: "must be of type " + typeName(p.Schema)})
+			continue
+		}
+		for _, v := range p.Schema.Validate(value) {
+			v.In = p.In
+			v.Field = paramField(p.Name, v.Field)
+			out = append(out, v)
+		}
+	}
+
+	if op.Body != nil {
+		out = append(out, op.validateBody(r, maxBody)...)
+	}
+	return out
+}
+
+func paramField(name, sub string) string {
+	if sub == "" {
+		return name
+	}
+	return name + sub
+}
+
+func (op *Operation) validateBody(r *http.Request, maxBody int64) []Violation {
+	if enc := r.Header.Get("Content-Encoding"); enc != "" && !strings.EqualFold(enc, "identity") {
+		return nil
+	}
+	if ct := r.Header.Get("Content-Type"); ct != "" {
+		mt, _, err := mime.ParseMediaType(ct)
+		if err != nil || (mt != "application/json" && !strings.HasSuffix(mt, "+json")) {
+			return nil
+		}
+	}
+	if r.Body == nil || r.Body == http.NoBody || r.ContentLength > maxBody {
+		if op.BodyRequired && (r.Body == nil || r.Body == http.NoBody) {
+			return []Violation{{In: "body", Message: "request body is required"}}
+		}
+		return nil
+	}
+
+	buf, err := io.ReadAll(io.LimitReader(r.Body, maxBody+1))
+	rest := r.Body
+	r.Body = readCloser{io.MultiReader(bytes.NewReader(buf), errReader{err}, rest), rest}
+	if err != nil || int64(len(buf)) > maxBody {
+		return nil
+	}
+
+	if len(bytes.TrimSpace(buf)) == 0 {
+		if op.BodyRequired {
+			return []Violation{{In: "body", Message: "request body is required"}}
+		}
+		return nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(buf))
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return nil
+	}
+
+	violations := op.Body.check(value, op.SkipItems)
+	for i := range violations {
+		violations[i].In = "body"
+	}
+	return violations
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// errReader replays a read error after the buffered bytes
+type errReader struct{ err error }
+
+func (e errReader) Read([]byte) (int, error) {
+	if e.err != nil {
+		return 0, e.err
+	}
+	return 0, io.EOF
+}
+
+// coerce turns raw parameter values into the JSON value the schema describes.
+// Arrays take every value (form style, exploded); scalars the first one.
+func coerce(s *Schema, raw []string) (any, bool) {
+	if has(s.Types, "array") {
+		items := make([]any, 0, len(raw))
+		for _, v := range raw {
+			item := any(v)
+			if s.Items != nil {
+				var ok bool
+				if item, ok = scalar(s.Items, v); !ok {
+					return nil, false
+				}
+			}
+			items = append(items, item)
+		}
+		return items, true
+	}
+	return scalar(s, raw[0])
+}
+
+func scalar(s *Schema, v string) (any, bool) {
+	if len(s.Types) == 0 || has(s.Types, "string") {
+		return v, true
+	}
+	t := strings.TrimSpace(v)
+	switch {
+	case has(s.Types, "integer"):
+		if _, err := strconv.ParseInt(t, 10, 64); err != nil {
+			return nil, false
+		}
+		return json.Number(t), true
+	case has(s.Types, "number"):
+		if _, err := strconv.ParseFloat(t, 64); err != nil {
+			return nil, false
+		}
+		return json.Number(t), true
+	case has(s.Types, "boolean"):
+		b, err := strconv.ParseBool(t)
+		if err != nil {
+			return nil, false
+		}
+		return b, true
+	}
+	return v, true
+}
+
+func has(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func typeName(s *Schema) string {
+	if has(s.Types, "array") && s.Items != nil && len(s.Items.Types) > 0 {
+		return "array of " + strings.Join(s.Items.Types, " or ")
+	}
+	return strings.Join(s.Types, " or ")
+}
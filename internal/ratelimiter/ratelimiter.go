@@ -1,6 +1,38 @@
 package ratelimiter
 
-import "time"
+import (
+	"expvar"
+	"sync"
+	"time"
+)
+
+// maxRejectionKeys caps the clients Rejections keeps a counter for; the
+// rejections of clients past it are counted under "other"
+const maxRejectionKeys = 1000
+
+// Rejections counts rate-limited requests per client
+var Rejections = expvar.NewMap("rate_limit_rejections")
+
+var (
+	rejectionMu   sync.Mutex
+	rejectionKeys int
+)
+
+func RecordRejection(clientID string) {
+	if Rejections.Get(clientID) == nil {
+		rejectionMu.Lock()
+		if Rejections.Get(clientID) == nil {
+			if rejectionKeys >= maxRejectionKeys {
+				clientID = "other"
+			} else {
+				rejectionKeys++
+				Rejections.Add(clientID, 0)
+			}
+		}
+		rejectionMu.Unlock()
+	}
+	Rejections.Add(clientID, 1)
+}
 
 type Limiter interface {
 	Allow(ip string) (bool, time.Duration)
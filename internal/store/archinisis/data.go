@@ -6,6 +6,7 @@ import (
 
 	archsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/archinisis"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 type DataStore struct {
@@ -17,32 +18,9 @@ type ArchDataPayload struct {
 	Measurements []archsqlc.UpsertMeasurementParams
 }
 
-type ArchDataResponse struct {
-	// Athlete
-	NationalID  string   `json:"national_id"`
-	FirstName   *string  `json:"first_name,omitempty"`
-	LastName    *string  `json:"last_name,omitempty"`
-	Initials    *string  `json:"initials,omitempty"`
-	DateOfBirth *string  `json:"date_of_birth,omitempty"`
-	Height      *float64 `json:"height,omitempty"`
-	Weight      *float64 `json:"weight,omitempty"`
-
-	// Measurements
-	Measurements []ArchMeasurementResponse `json:"measurements"`
-}
+type ArchDataResponse = apitypes.ArchDataResponse
 
-type ArchMeasurementResponse struct {
-	MeasurementGroupID int32   `json:"measurement_group_id"`
-	MeasurementID      *int32  `json:"measurement_id,omitempty"`
-	Discipline         *string `json:"discipline,omitempty"`
-	SessionName        *string `json:"session_name,omitempty"`
-	Place              *string `json:"place,omitempty"`
-	RaceID             *int32  `json:"race_id,omitempty"`
-	StartTime          *string `json:"start_time,omitempty"`
-	StopTime           *string `json:"stop_time,omitempty"`
-	NbSegments         *int32  `json:"nb_segments,omitempty"`
-	Comment            *string `json:"comment,omitempty"`
-}
+type ArchMeasurementResponse = apitypes.ArchMeasurementResponse
 
 func (s *DataStore) GetRaceReportSessions(ctx context.Context, sporttiID string) ([]int32, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
@@ -91,10 +69,17 @@ func (s *DataStore) UpsertRaceReport(ctx context.Context, p archsqlc.UpsertRaceR
 	return q.UpsertRaceReport(ctx, p)
 }
 
-func (s *DataStore) UpsertData(ctx context.Context, payload ArchDataPayload) error {
-	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
-	defer cancel()
-
+// Produce hands the rows of a streamed upload to write, one chunk at a time,
+// while the request body is still being read
+type Produce func(write func(chunk ArchDataPayload) error) error
+
+// UpsertDataStream upserts the rows produce writes, chunk by chunk, in one
+// transaction. The athlete of a chunk, when its NationalID is set, goes in
+// before the measurements, so a chunk may reference it or an athlete of an
+// earlier chunk. Nothing is committed unless produce and every upsert
+// succeed. The transaction lives as long as ctx (the request); each chunk
+// gets the usual query timeout.
+func (s *DataStore) UpsertDataStream(ctx context.Context, produce Produce) error {
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
@@ -102,20 +87,23 @@ func (s *DataStore) UpsertData(ctx context.Context, payload ArchDataPayload) err
 	defer tx.Rollback()
 
 	q := archsqlc.New(tx)
-
-	// 1) Athlete
-	if err := q.UpsertAthlete(ctx, payload.Athlete); err != nil {
-		return err
-	}
-
-	// 2) Measurements
-	for i := range payload.Measurements {
-		if !payload.Measurements[i].NationalID.Valid || payload.Measurements[i].NationalID.String == "" {
-			payload.Measurements[i].NationalID = utils.NullString(payload.Athlete.NationalID)
+	err = produce(func(chunk ArchDataPayload) error {
+		ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+		defer cancel()
+		if chunk.Athlete.NationalID != "" {
+			if err := q.UpsertAthlete(ctx, chunk.Athlete); err != nil {
+				return err
+			}
 		}
-		if err := q.UpsertMeasurement(ctx, payload.Measurements[i]); err != nil {
-			return err
+		for _, m := range chunk.Measurements {
+			if err := q.UpsertMeasurement(ctx, m); err != nil {
+				return err
+			}
 		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	return tx.Commit()
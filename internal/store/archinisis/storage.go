@@ -16,7 +16,7 @@ type Data interface {
 	GetRaceReportSessions(ctx context.Context, sporttiID string) ([]int32, error)
 	GetRaceReport(ctx context.Context, sporttiID string, sessionID int32) (string, error)
 	UpsertRaceReport(ctx context.Context, p archsqlc.UpsertRaceReportParams) error
-	UpsertData(ctx context.Context, payload ArchDataPayload) error
+	UpsertDataStream(ctx context.Context, produce Produce) error
 	GetDataBySporttiID(ctx context.Context, sporttiID string) (*ArchDataResponse, error)
 }
 
@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+
+	authsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+// Admin interface
+type Admin interface {
+	ListClients(ctx context.Context) ([]authsqlc.Client, error)
+	ListRecentTokenLogs(ctx context.Context, limit int32) ([]authsqlc.ListRecentTokenLogsRow, error)
+}
+
+type AdminStore struct {
+	db *sql.DB
+}
+
+func (s *AdminStore) ListClients(ctx context.Context) ([]authsqlc.Client, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+	return authsqlc.New(s.db).ListClients(ctx)
+}
+
+func (s *AdminStore) ListRecentTokenLogs(ctx context.Context, limit int32) ([]authsqlc.ListRecentTokenLogsRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+	return authsqlc.New(s.db).ListRecentTokenLogs(ctx, limit)
+}
@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+
+	authsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+// Identities interface
+type Identities interface {
+	CreateIdentity(ctx context.Context, arg authsqlc.CreateAthleteIdentityParams) (authsqlc.AthleteIdentity, error)
+	GetIdentity(ctx context.Context, id int32) (authsqlc.AthleteIdentity, error)
+	FindIdentities(ctx context.Context, arg authsqlc.FindAthleteIdentitiesParams) ([]authsqlc.AthleteIdentity, error)
+	LinkIdentifiers(ctx context.Context, arg authsqlc.LinkAthleteIdentifiersParams) (authsqlc.AthleteIdentity, error)
+	UnlinkIdentifier(ctx context.Context, id int32, kind string) (authsqlc.AthleteIdentity, error)
+	DeleteIdentity(ctx context.Context, id int32) (bool, error)
+}
+
+type IdentityStore struct {
+	db *sql.DB
+}
+
+func (s *IdentityStore) CreateIdentity(ctx context.Context, arg authsqlc.CreateAthleteIdentityParams) (authsqlc.AthleteIdentity, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+	return authsqlc.New(s.db).CreateAthleteIdentity(ctx, arg)
+}
+
+func (s *IdentityStore) GetIdentity(ctx context.Context, id int32) (authsqlc.AthleteIdentity, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+	return authsqlc.New(s.db).GetAthleteIdentity(ctx, id)
+}
+
+// FindIdentities returns every athlete holding any of the given identifiers;
+// more than one means they belong to different athletes
+func (s *IdentityStore) FindIdentities(ctx context.Context, arg authsqlc.FindAthleteIdentitiesParams) ([]authsqlc.AthleteIdentity, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+	return authsqlc.New(s.db).FindAthleteIdentities(ctx, arg)
+}
+
+// LinkIdentifiers sets the given identifiers on an athlete and keeps the others
+func (s *IdentityStore) LinkIdentifiers(ctx context.Context, arg authsqlc.LinkAthleteIdentifiersParams) (authsqlc.AthleteIdentity, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+	return authsqlc.New(s.db).LinkAthleteIdentifiers(ctx, arg)
+}
+
+// UnlinkIdentifier clears one identifier of an athlete; kind is its column name
+func (s *IdentityStore) UnlinkIdentifier(ctx context.Context, id int32, kind string) (authsqlc.AthleteIdentity, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+	return authsqlc.New(s.db).UnlinkAthleteIdentifier(ctx, authsqlc.UnlinkAthleteIdentifierParams{Kind: kind, ID: id})
+}
+
+// DeleteIdentity removes an athlete and all its links; false means there was none
+func (s *IdentityStore) DeleteIdentity(ctx context.Context, id int32) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+	n, err := authsqlc.New(s.db).DeleteAthleteIdentity(ctx, id)
+	return n > 0, err
+}
@@ -8,21 +8,45 @@ import (
 )
 
 type AuthStorage struct {
-	db      *sql.DB
-	queries *authsqlc.Queries
+	db         *sql.DB
+	queries    *authsqlc.Queries
+	usage      Usage
+	admin      Admin
+	webhooks   Webhooks
+	identities Identities
 }
 
 func (a *AuthStorage) Queries() *authsqlc.Queries {
 	return a.queries
 }
 
+func (s *AuthStorage) Usage() Usage {
+	return s.usage
+}
+
+func (s *AuthStorage) Admin() Admin {
+	return s.admin
+}
+
+func (s *AuthStorage) Webhooks() Webhooks {
+	return s.webhooks
+}
+
+func (s *AuthStorage) Identities() Identities {
+	return s.identities
+}
+
 func (s *AuthStorage) Ping(ctx context.Context) error {
 	return s.db.PingContext(ctx)
 }
 
 func NewAuthStorage(db *sql.DB) *AuthStorage {
 	return &AuthStorage{
-		db:      db,
-		queries: authsqlc.New(db),
+		db:         db,
+		queries:    authsqlc.New(db),
+		usage:      &UsageStore{db: db},
+		admin:      &AdminStore{db: db},
+		webhooks:   &WebhookStore{db: db},
+		identities: &IdentityStore{db: db},
 	}
 }
@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	authsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+// Usage interface
+type Usage interface {
+	UpsertUsageRollup(ctx context.Context, arg authsqlc.UpsertUsageRollupParams) error
+	GetUsageRollups(ctx context.Context, from, to time.Time, clientName, routeGroup *string) ([]authsqlc.GetUsageRollupsRow, error)
+}
+
+type UsageStore struct {
+	db *sql.DB
+}
+
+func (s *UsageStore) UpsertUsageRollup(ctx context.Context, arg authsqlc.UpsertUsageRollupParams) error {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+	return authsqlc.New(s.db).UpsertUsageRollup(ctx, arg)
+}
+
+func (s *UsageStore) GetUsageRollups(ctx context.Context, from, to time.Time, clientName, routeGroup *string) ([]authsqlc.GetUsageRollupsRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).GetUsageRollups(ctx, authsqlc.GetUsageRollupsParams{
+		FromDate:   from,
+		ToDate:     to,
+		ClientName: utils.NullStringPtr(clientName),
+		RouteGroup: utils.NullStringPtr(routeGroup),
+	})
+}
@@ -0,0 +1,161 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	authsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+// Webhook delivery statuses
+const (
+	DeliveryPending   = "pending"
+	DeliverySucceeded = "succeeded"
+	DeliveryDead      = "dead"
+)
+
+// Webhooks interface
+type Webhooks interface {
+	CreateWebhook(ctx context.Context, arg authsqlc.CreateWebhookParams) (authsqlc.WebhookSubscription, error)
+	GetWebhook(ctx context.Context, id int32) (authsqlc.WebhookSubscription, error)
+	ListWebhooks(ctx context.Context, clientName string) ([]authsqlc.WebhookSubscription, error)
+	UpdateWebhook(ctx context.Context, arg authsqlc.UpdateWebhookParams) (authsqlc.WebhookSubscription, error)
+	DeleteWebhook(ctx context.Context, id int32) error
+	ListWebhooksForEvent(ctx context.Context, eventType string) ([]authsqlc.WebhookSubscription, error)
+
+	CreateDelivery(ctx context.Context, arg authsqlc.CreateWebhookDeliveryParams) error
+	ClaimDueDeliveries(ctx context.Context, batchSize int32, lease time.Duration) ([]authsqlc.ClaimDueWebhookDeliveriesRow, error)
+	RecordAttempt(ctx context.Context, arg authsqlc.RecordWebhookAttemptParams) error
+	GetDelivery(ctx context.Context, id int64) (authsqlc.GetWebhookDeliveryRow, error)
+	ListDeliveries(ctx context.Context, subscriptionID int32, status *string, page utils.Page) ([]authsqlc.WebhookDelivery, string, error)
+	ListDeadDeliveries(ctx context.Context, clientName string, page utils.Page) ([]authsqlc.WebhookDelivery, string, error)
+	RequeueDelivery(ctx context.Context, id int64) (bool, error)
+	DeleteOldDeliveries(ctx context.Context, before time.Time) (int64, error)
+}
+
+type WebhookStore struct {
+	db *sql.DB
+}
+
+func (s *WebhookStore) CreateWebhook(ctx context.Context, arg authsqlc.CreateWebhookParams) (authsqlc.WebhookSubscription, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+	return authsqlc.New(s.db).CreateWebhook(ctx, arg)
+}
+
+func (s *WebhookStore) GetWebhook(ctx context.Context, id int32) (authsqlc.WebhookSubscription, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+	return authsqlc.New(s.db).GetWebhook(ctx, id)
+}
+
+func (s *WebhookStore) ListWebhooks(ctx context.Context, clientName string) ([]authsqlc.WebhookSubscription, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+	return authsqlc.New(s.db).ListWebhooksByClient(ctx, clientName)
+}
+
+func (s *WebhookStore) UpdateWebhook(ctx context.Context, arg authsqlc.UpdateWebhookParams) (authsqlc.WebhookSubscription, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+	return authsqlc.New(s.db).UpdateWebhook(ctx, arg)
+}
+
+func (s *WebhookStore) DeleteWebhook(ctx context.Context, id int32) error {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+	return authsqlc.New(s.db).DeleteWebhook(ctx, id)
+}
+
+func (s *WebhookStore) ListWebhooksForEvent(ctx context.Context, eventType string) ([]authsqlc.WebhookSubscription, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+	return authsqlc.New(s.db).ListActiveWebhooksForEvent(ctx, eventType)
+}
+
+func (s *WebhookStore) CreateDelivery(ctx context.Context, arg authsqlc.CreateWebhookDeliveryParams) error {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+	return authsqlc.New(s.db).CreateWebhookDelivery(ctx, arg)
+}
+
+// ClaimDueDeliveries picks up to batchSize pending deliveries that are due and
+// pushes their next attempt lease into the future, so another instance does
+// not send them at the same time
+func (s *WebhookStore) ClaimDueDeliveries(ctx context.Context, batchSize int32, lease time.Duration) ([]authsqlc.ClaimDueWebhookDeliveriesRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+	return authsqlc.New(s.db).ClaimDueWebhookDeliveries(ctx, authsqlc.ClaimDueWebhookDeliveriesParams{
+		LeaseSeconds: int32(lease / time.Second),
+		BatchSize:    batchSize,
+	})
+}
+
+func (s *WebhookStore) RecordAttempt(ctx context.Context, arg authsqlc.RecordWebhookAttemptParams) error {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+	return authsqlc.New(s.db).RecordWebhookAttempt(ctx, arg)
+}
+
+func (s *WebhookStore) GetDelivery(ctx context.Context, id int64) (authsqlc.GetWebhookDeliveryRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+	return authsqlc.New(s.db).GetWebhookDelivery(ctx, id)
+}
+
+// ListDeliveries returns the deliveries of a subscription, newest first
+func (s *WebhookStore) ListDeliveries(ctx context.Context, subscriptionID int32, status *string, page utils.Page) ([]authsqlc.WebhookDelivery, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	rows, err := authsqlc.New(s.db).ListWebhookDeliveries(ctx, authsqlc.ListWebhookDeliveriesParams{
+		SubscriptionID: subscriptionID,
+		Status:         utils.NullStringPtr(status),
+		CursorID:       page.AfterSeq(),
+		PageLimit:      page.FetchLimit(),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	rows, next := utils.NextPage(page, rows, deliveryCursor)
+	return rows, next, nil
+}
+
+// ListDeadDeliveries returns the dead letters of every subscription of a
+// client, newest first
+func (s *WebhookStore) ListDeadDeliveries(ctx context.Context, clientName string, page utils.Page) ([]authsqlc.WebhookDelivery, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	rows, err := authsqlc.New(s.db).ListDeadWebhookDeliveries(ctx, authsqlc.ListDeadWebhookDeliveriesParams{
+		ClientName: clientName,
+		CursorID:   page.AfterSeq(),
+		PageLimit:  page.FetchLimit(),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	rows, next := utils.NextPage(page, rows, deliveryCursor)
+	return rows, next, nil
+}
+
+// RequeueDelivery schedules a dead letter for a fresh round of attempts; false
+// means it was not dead
+func (s *WebhookStore) RequeueDelivery(ctx context.Context, id int64) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+	n, err := authsqlc.New(s.db).RequeueWebhookDelivery(ctx, id)
+	return n > 0, err
+}
+
+func (s *WebhookStore) DeleteOldDeliveries(ctx context.Context, before time.Time) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+	return authsqlc.New(s.db).DeleteOldWebhookDeliveries(ctx, before)
+}
+
+func deliveryCursor(d authsqlc.WebhookDelivery) utils.Cursor {
+	return utils.Cursor{N: d.ID}
+}
@@ -0,0 +1,66 @@
+package cache
+
+import (
+	"context"
+	"sync"
+)
+
+// Compressed variants of cached responses are stored next to the entry they
+// were produced from ("<key>:enc=<encoding>:<digest>") so they share its TTL
+// and are dropped by the same prefix invalidation. The digest of the
+// uncompressed body keeps a variant from outliving a changed entry.
+
+type entryKey struct{}
+
+// Entry remembers the last cache key read or written while serving a request
+type Entry struct {
+	mu  sync.Mutex
+	key string
+}
+
+func (e *Entry) Key() string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.key
+}
+
+func (e *Entry) set(key string) {
+	e.mu.Lock()
+	e.key = key
+	e.mu.Unlock()
+}
+
+// WithEntry starts tracking the cache entry behind the response of a request
+func WithEntry(ctx context.Context) (context.Context, *Entry) {
+	e := &Entry{}
+	return context.WithValue(ctx, entryKey{}, e), e
+}
+
+func trackEntry(ctx context.Context, key string) {
+	if e, ok := ctx.Value(entryKey{}).(*Entry); ok {
+		e.set(key)
+	}
+}
+
+func variantKey(key, encoding, digest string) string {
+	return key + ":enc=" + encoding + ":" + digest
+}
+
+// GetCompressed returns a previously stored compressed variant of key
+func (s *Storage) GetCompressed(ctx context.Context, key, encoding, digest string) ([]byte, bool) {
+	b, err := s.client.Get(ctx, variantKey(key, encoding, digest)).Bytes()
+	if err != nil || len(b) == 0 {
+		return nil, false
+	}
+	return b, true
+}
+
+// SetCompressed stores a compressed variant of key with the entry's remaining TTL
+func (s *Storage) SetCompressed(ctx context.Context, key, encoding, digest string, data []byte) error {
+	ttl, err := s.client.TTL(ctx, key).Result()
+	if err != nil || ttl <= 0 {
+		// entry is gone or never expires; don't leave a variant behind
+		return err
+	}
+	return s.client.Set(ctx, variantKey(key, encoding, digest), data, ttl).Err()
+}
@@ -2,8 +2,11 @@ package cache
 
 import (
 	"context"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/DeRuina/KUHA-REST-API/internal/logger"
 	"github.com/redis/go-redis/v9"
 )
 
@@ -16,10 +19,16 @@ func NewRedisStorage(rdb *redis.Client) *Storage {
 }
 
 func (s *Storage) Get(ctx context.Context, key string) (string, error) {
-	return s.client.Get(ctx, key).Result()
+	val, err := s.client.Get(ctx, key).Result()
+	if err == nil && val != "" {
+		logger.MarkCacheHit(ctx)
+		trackEntry(ctx, key)
+	}
+	return val, err
 }
 
 func (s *Storage) Set(ctx context.Context, key string, value string, ttl time.Duration) error {
+	trackEntry(ctx, key)
 	return s.client.Set(ctx, key, value, ttl).Err()
 }
 
@@ -63,3 +72,35 @@ func (s *Storage) DeleteByPrefixes(ctx context.Context, prefixes ...string) erro
 	}
 	return nil
 }
+
+// Stats returns the Redis keyspace counters and memory usage reported by INFO
+func (s *Storage) Stats(ctx context.Context) (map[string]string, error) {
+	info, err := s.client.Info(ctx, "stats", "memory").Result()
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := map[string]bool{
+		"keyspace_hits":     true,
+		"keyspace_misses":   true,
+		"evicted_keys":      true,
+		"expired_keys":      true,
+		"used_memory_human": true,
+	}
+
+	stats := make(map[string]string)
+	for _, line := range strings.Split(info, "\n") {
+		k, v, ok := strings.Cut(strings.TrimSpace(line), ":")
+		if ok && wanted[k] {
+			stats[k] = v
+		}
+	}
+
+	size, err := s.client.DBSize(ctx).Result()
+	if err != nil {
+		return nil, err
+	}
+	stats["keys"] = strconv.FormatInt(size, 10)
+
+	return stats, nil
+}
@@ -5,13 +5,10 @@ import (
 
 	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
-type AthleteRow struct {
-	Firstname *string `json:"Firstname,omitempty"`
-	Lastname  *string `json:"Lastname,omitempty"`
-	Fiscode   *int32  `json:"Fiscode,omitempty"`
-}
+type AthleteRow = apitypes.FISAthleteItem
 
 type InsertCompetitorClean struct {
 	Competitorid       int32
@@ -115,7 +115,8 @@ func (s *CompetitorsStore) SearchCompetitors(
 	ctx context.Context,
 	nationcode, sectorcode, gender *string,
 	birthdateMin, birthdateMax *time.Time,
-) ([]fissqlc.ACompetitor, error) {
+	page utils.Page,
+) ([]fissqlc.ACompetitor, string, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
 	defer cancel()
 
@@ -127,6 +128,8 @@ func (s *CompetitorsStore) SearchCompetitors(
 		Column3: "",
 		Column4: time.Time{},
 		Column5: time.Time{},
+		Column6: page.AfterN(),
+		Column7: page.LimitOrZero(),
 	}
 
 	if nationcode != nil {
@@ -145,7 +148,15 @@ func (s *CompetitorsStore) SearchCompetitors(
 		params.Column5 = *birthdateMax
 	}
 
-	return q.SearchCompetitors(ctx, params)
+	rows, err := q.SearchCompetitors(ctx, params)
+	if err != nil {
+		return nil, "", err
+	}
+
+	rows, next := utils.NextPage(page, rows, func(c fissqlc.ACompetitor) utils.Cursor {
+		return utils.Cursor{N: int64(c.Competitorid)}
+	})
+	return rows, next, nil
 }
 
 func (s *CompetitorsStore) GetCompetitorCountsByNation(
@@ -69,7 +69,7 @@ func (s *RaceCCStore) GetCrossCountryCategories(ctx context.Context) ([]string,
 	return out, nil
 }
 
-func (s *RaceCCStore) GetRacesCC(ctx context.Context, seasons []int32, disciplines, cats []string) ([]fissqlc.ARacecc, error) {
+func (s *RaceCCStore) GetRacesCC(ctx context.Context, seasons []int32, disciplines, cats, fields []string, page utils.Page) ([]fissqlc.ARacecc, string, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
 	defer cancel()
 
@@ -78,8 +78,39 @@ func (s *RaceCCStore) GetRacesCC(ctx context.Context, seasons []int32, disciplin
 		Column1: seasons,
 		Column2: disciplines,
 		Column3: cats,
+		Column4: page.AfterN(),
+		Column5: page.LimitOrZero(),
 	}
-	return q.GetRacesCC(ctx, params)
+	var rows []fissqlc.ARacecc
+	var err error
+	if len(fields) > 0 {
+		rows, err = q.GetRacesCCColumns(ctx, fields, params)
+	} else {
+		rows, err = q.GetRacesCC(ctx, params)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	rows, next := utils.NextPage(page, rows, func(r fissqlc.ARacecc) utils.Cursor {
+		return utils.Cursor{N: int64(r.Raceid)}
+	})
+	return rows, next, nil
+}
+
+// StreamRacesCC passes every matching race to fn without collecting them. A full
+// export can outlast QueryTimeout, so it is bound by StreamQueryTimeout, and a
+// client that stops reading is cut off by the stream's write deadline
+func (s *RaceCCStore) StreamRacesCC(ctx context.Context, seasons []int32, disciplines, cats, fields []string, fn func(fissqlc.ARacecc) error) error {
+	ctx, cancel := context.WithTimeout(ctx, utils.StreamQueryTimeout)
+	defer cancel()
+	q := fissqlc.New(s.db)
+	params := fissqlc.GetRacesCCParams{
+		Column1: seasons,
+		Column2: disciplines,
+		Column3: cats,
+	}
+	return q.StreamRacesCC(ctx, fields, params, fn)
 }
 
 func (s *RaceCCStore) GetLastRowRaceCC(ctx context.Context) (fissqlc.ARacecc, error) {
@@ -69,7 +69,7 @@ func (s *RaceJPStore) GetSkiJumpingCategories(ctx context.Context) ([]string, er
 	return out, nil
 }
 
-func (s *RaceJPStore) GetRacesJP(ctx context.Context, seasons []int32, disciplines, cats []string) ([]fissqlc.ARacejp, error) {
+func (s *RaceJPStore) GetRacesJP(ctx context.Context, seasons []int32, disciplines, cats, fields []string, page utils.Page) ([]fissqlc.ARacejp, string, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
 	defer cancel()
 
@@ -78,8 +78,38 @@ func (s *RaceJPStore) GetRacesJP(ctx context.Context, seasons []int32, disciplin
 		Column1: seasons,
 		Column2: disciplines,
 		Column3: cats,
+		Column4: page.AfterN(),
+		Column5: page.LimitOrZero(),
 	}
-	return q.GetRacesJP(ctx, params)
+	var rows []fissqlc.ARacejp
+	var err error
+	if len(fields) > 0 {
+		rows, err = q.GetRacesJPColumns(ctx, fields, params)
+	} else {
+		rows, err = q.GetRacesJP(ctx, params)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	rows, next := utils.NextPage(page, rows, func(r fissqlc.ARacejp) utils.Cursor {
+		return utils.Cursor{N: int64(r.Raceid)}
+	})
+	return rows, next, nil
+}
+
+// StreamRacesJP passes every matching race to fn without collecting them;
+// like StreamRacesCC it is bound by StreamQueryTimeout rather than QueryTimeout
+func (s *RaceJPStore) StreamRacesJP(ctx context.Context, seasons []int32, disciplines, cats, fields []string, fn func(fissqlc.ARacejp) error) error {
+	ctx, cancel := context.WithTimeout(ctx, utils.StreamQueryTimeout)
+	defer cancel()
+	q := fissqlc.New(s.db)
+	params := fissqlc.GetRacesJPParams{
+		Column1: seasons,
+		Column2: disciplines,
+		Column3: cats,
+	}
+	return q.StreamRacesJP(ctx, fields, params, fn)
 }
 
 func (s *RaceJPStore) GetLastRowRaceJP(ctx context.Context) (fissqlc.ARacejp, error) {
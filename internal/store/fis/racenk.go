@@ -69,7 +69,7 @@ func (s *RaceNKStore) GetNordicCombinedCategories(ctx context.Context) ([]string
 	return out, nil
 }
 
-func (s *RaceNKStore) GetRacesNK(ctx context.Context, seasons []int32, disciplines, cats []string) ([]fissqlc.ARacenk, error) {
+func (s *RaceNKStore) GetRacesNK(ctx context.Context, seasons []int32, disciplines, cats, fields []string, page utils.Page) ([]fissqlc.ARacenk, string, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
 	defer cancel()
 
@@ -78,8 +78,38 @@ func (s *RaceNKStore) GetRacesNK(ctx context.Context, seasons []int32, disciplin
 		Column1: seasons,
 		Column2: disciplines,
 		Column3: cats,
+		Column4: page.AfterN(),
+		Column5: page.LimitOrZero(),
 	}
-	return q.GetRacesNK(ctx, params)
+	var rows []fissqlc.ARacenk
+	var err error
+	if len(fields) > 0 {
+		rows, err = q.GetRacesNKColumns(ctx, fields, params)
+	} else {
+		rows, err = q.GetRacesNK(ctx, params)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	rows, next := utils.NextPage(page, rows, func(r fissqlc.ARacenk) utils.Cursor {
+		return utils.Cursor{N: int64(r.Raceid)}
+	})
+	return rows, next, nil
+}
+
+// StreamRacesNK passes every matching race to fn without collecting them;
+// like StreamRacesCC it is bound by StreamQueryTimeout rather than QueryTimeout
+func (s *RaceNKStore) StreamRacesNK(ctx context.Context, seasons []int32, disciplines, cats, fields []string, fn func(fissqlc.ARacenk) error) error {
+	ctx, cancel := context.WithTimeout(ctx, utils.StreamQueryTimeout)
+	defer cancel()
+	q := fissqlc.New(s.db)
+	params := fissqlc.GetRacesNKParams{
+		Column1: seasons,
+		Column2: disciplines,
+		Column3: cats,
+	}
+	return q.StreamRacesNK(ctx, fields, params, fn)
 }
 
 func (s *RaceNKStore) GetLastRowRaceNK(ctx context.Context) (fissqlc.ARacenk, error) {
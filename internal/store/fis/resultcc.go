@@ -46,12 +46,28 @@ func (s *ResultCCStore) DeleteResultCCByRecID(ctx context.Context, recid int32)
 	return err
 }
 
-func (s *ResultCCStore) GetRaceResultsCCByRaceID(ctx context.Context, raceID int32) ([]fissqlc.AResultcc, error) {
+func (s *ResultCCStore) GetRaceResultsCCByRaceID(ctx context.Context, raceID int32, fields []string) ([]fissqlc.AResultcc, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
 	defer cancel()
 
 	q := fissqlc.New(s.db)
-	return q.GetRaceResultsCCByRaceID(ctx, sql.NullInt32{Int32: raceID, Valid: true})
+	raceid := sql.NullInt32{Int32: raceID, Valid: true}
+	if len(fields) > 0 {
+		return q.GetRaceResultsCCByRaceIDColumns(ctx, fields, raceid)
+	}
+	return q.GetRaceResultsCCByRaceID(ctx, raceid)
+}
+
+func (s *ResultCCStore) GetResultsByRaceIDsCC(ctx context.Context, raceIDs []int32) ([]fissqlc.AResultcc, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	if len(raceIDs) == 0 {
+		return []fissqlc.AResultcc{}, nil
+	}
+
+	q := fissqlc.New(s.db)
+	return q.GetResultsByRaceIDsCC(ctx, raceIDs)
 }
 
 func (s *ResultCCStore) GetAthleteResultsCC(
@@ -46,12 +46,28 @@ func (s *ResultJPStore) DeleteResultJPByRecID(ctx context.Context, recid int32)
 	return err
 }
 
-func (s *ResultJPStore) GetRaceResultsJPByRaceID(ctx context.Context, raceID int32) ([]fissqlc.AResultjp, error) {
+func (s *ResultJPStore) GetRaceResultsJPByRaceID(ctx context.Context, raceID int32, fields []string) ([]fissqlc.AResultjp, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
 	defer cancel()
 
 	q := fissqlc.New(s.db)
-	return q.GetRaceResultsJPByRaceID(ctx, sql.NullInt32{Int32: raceID, Valid: true})
+	raceid := sql.NullInt32{Int32: raceID, Valid: true}
+	if len(fields) > 0 {
+		return q.GetRaceResultsJPByRaceIDColumns(ctx, fields, raceid)
+	}
+	return q.GetRaceResultsJPByRaceID(ctx, raceid)
+}
+
+func (s *ResultJPStore) GetResultsByRaceIDsJP(ctx context.Context, raceIDs []int32) ([]fissqlc.AResultjp, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	if len(raceIDs) == 0 {
+		return []fissqlc.AResultjp{}, nil
+	}
+
+	q := fissqlc.New(s.db)
+	return q.GetResultsByRaceIDsJP(ctx, raceIDs)
 }
 
 func (s *ResultJPStore) GetAthleteResultsJP(
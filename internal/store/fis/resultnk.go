@@ -46,12 +46,28 @@ func (s *ResultNKStore) DeleteResultNKByRecID(ctx context.Context, recid int32)
 	return err
 }
 
-func (s *ResultNKStore) GetRaceResultsNKByRaceID(ctx context.Context, raceID int32) ([]fissqlc.AResultnk, error) {
+func (s *ResultNKStore) GetRaceResultsNKByRaceID(ctx context.Context, raceID int32, fields []string) ([]fissqlc.AResultnk, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
 	defer cancel()
 
 	q := fissqlc.New(s.db)
-	return q.GetRaceResultsNKByRaceID(ctx, sql.NullInt32{Int32: raceID, Valid: true})
+	raceid := sql.NullInt32{Int32: raceID, Valid: true}
+	if len(fields) > 0 {
+		return q.GetRaceResultsNKByRaceIDColumns(ctx, fields, raceid)
+	}
+	return q.GetRaceResultsNKByRaceID(ctx, raceid)
+}
+
+func (s *ResultNKStore) GetResultsByRaceIDsNK(ctx context.Context, raceIDs []int32) ([]fissqlc.AResultnk, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	if len(raceIDs) == 0 {
+		return []fissqlc.AResultnk{}, nil
+	}
+
+	q := fissqlc.New(s.db)
+	return q.GetResultsByRaceIDsNK(ctx, raceIDs)
 }
 
 func (s *ResultNKStore) GetAthleteResultsNK(
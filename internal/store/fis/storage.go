@@ -6,6 +6,7 @@ import (
 	"time"
 
 	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
 )
 
 // Resultcc interface
@@ -14,7 +15,8 @@ type Resultcc interface {
 	InsertResultCC(ctx context.Context, in InsertResultCCClean) error
 	UpdateResultCCByRecID(ctx context.Context, in UpdateResultCCClean) error
 	DeleteResultCCByRecID(ctx context.Context, recid int32) error
-	GetRaceResultsCCByRaceID(ctx context.Context, raceID int32) ([]fissqlc.AResultcc, error)
+	GetRaceResultsCCByRaceID(ctx context.Context, raceID int32, fields []string) ([]fissqlc.AResultcc, error)
+	GetResultsByRaceIDsCC(ctx context.Context, raceIDs []int32) ([]fissqlc.AResultcc, error)
 	GetAthleteResultsCC(ctx context.Context, competitorID int32, seasons []int32, disciplines, cats []string) ([]fissqlc.GetAthleteResultsCCRow, error)
 	GetSeasonsCatcodesCCByCompetitor(ctx context.Context, fiscode int32) ([]fissqlc.GetSeasonsCatcodesCCByCompetitorRow, error)
 	GetLatestResultsCC(ctx context.Context, fiscode int32, seasoncode *int32, catcodes []string, limit *int32) ([]fissqlc.GetLatestResultsCCRow, error)
@@ -26,7 +28,8 @@ type Resultjp interface {
 	InsertResultJP(ctx context.Context, in InsertResultJPClean) error
 	UpdateResultJPByRecID(ctx context.Context, in UpdateResultJPClean) error
 	DeleteResultJPByRecID(ctx context.Context, recid int32) error
-	GetRaceResultsJPByRaceID(ctx context.Context, raceID int32) ([]fissqlc.AResultjp, error)
+	GetRaceResultsJPByRaceID(ctx context.Context, raceID int32, fields []string) ([]fissqlc.AResultjp, error)
+	GetResultsByRaceIDsJP(ctx context.Context, raceIDs []int32) ([]fissqlc.AResultjp, error)
 	GetAthleteResultsJP(ctx context.Context, competitorID int32, seasons []int32, disciplines, cats []string) ([]fissqlc.GetAthleteResultsJPRow, error)
 	GetSeasonsCatcodesJPByCompetitor(ctx context.Context, fiscode int32) ([]fissqlc.GetSeasonsCatcodesJPByCompetitorRow, error)
 	GetLatestResultsJP(ctx context.Context, fiscode int32, seasoncode *int32, catcodes []string, limit *int32) ([]fissqlc.GetLatestResultsJPRow, error)
@@ -38,7 +41,8 @@ type Resultnk interface {
 	InsertResultNK(ctx context.Context, in InsertResultNKClean) error
 	UpdateResultNKByRecID(ctx context.Context, in UpdateResultNKClean) error
 	DeleteResultNKByRecID(ctx context.Context, recid int32) error
-	GetRaceResultsNKByRaceID(ctx context.Context, raceID int32) ([]fissqlc.AResultnk, error)
+	GetRaceResultsNKByRaceID(ctx context.Context, raceID int32, fields []string) ([]fissqlc.AResultnk, error)
+	GetResultsByRaceIDsNK(ctx context.Context, raceIDs []int32) ([]fissqlc.AResultnk, error)
 	GetAthleteResultsNK(ctx context.Context, competitorID int32, seasons []int32, disciplines, cats []string) ([]fissqlc.GetAthleteResultsNKRow, error)
 	GetSeasonsCatcodesNKByCompetitor(ctx context.Context, fiscode int32) ([]fissqlc.GetSeasonsCatcodesNKByCompetitorRow, error)
 	GetLatestResultsNK(ctx context.Context, fiscode int32, seasoncode *int32, catcodes []string, limit *int32) ([]fissqlc.GetLatestResultsNKRow, error)
@@ -49,7 +53,8 @@ type Racecc interface {
 	GetCrossCountrySeasons(ctx context.Context) ([]int32, error)
 	GetCrossCountryDisciplines(ctx context.Context) ([]string, error)
 	GetCrossCountryCategories(ctx context.Context) ([]string, error)
-	GetRacesCC(ctx context.Context, seasons []int32, disciplines, cats []string) ([]fissqlc.ARacecc, error)
+	GetRacesCC(ctx context.Context, seasons []int32, disciplines, cats, fields []string, page utils.Page) ([]fissqlc.ARacecc, string, error)
+	StreamRacesCC(ctx context.Context, seasons []int32, disciplines, cats, fields []string, fn func(fissqlc.ARacecc) error) error
 	GetLastRowRaceCC(ctx context.Context) (fissqlc.ARacecc, error)
 	InsertRaceCC(ctx context.Context, in InsertRaceCCClean) error
 	UpdateRaceCCByID(ctx context.Context, in UpdateRaceCCClean) error
@@ -66,7 +71,8 @@ type Racejp interface {
 	GetSkiJumpingSeasons(ctx context.Context) ([]int32, error)
 	GetSkiJumpingDisciplines(ctx context.Context) ([]string, error)
 	GetSkiJumpingCategories(ctx context.Context) ([]string, error)
-	GetRacesJP(ctx context.Context, seasons []int32, disciplines, cats []string) ([]fissqlc.ARacejp, error)
+	GetRacesJP(ctx context.Context, seasons []int32, disciplines, cats, fields []string, page utils.Page) ([]fissqlc.ARacejp, string, error)
+	StreamRacesJP(ctx context.Context, seasons []int32, disciplines, cats, fields []string, fn func(fissqlc.ARacejp) error) error
 	GetLastRowRaceJP(ctx context.Context) (fissqlc.ARacejp, error)
 	InsertRaceJP(ctx context.Context, in InsertRaceJPClean) error
 	UpdateRaceJPByID(ctx context.Context, in UpdateRaceJPClean) error
@@ -83,7 +89,8 @@ type Racenk interface {
 	GetNordicCombinedSeasons(ctx context.Context) ([]int32, error)
 	GetNordicCombinedDisciplines(ctx context.Context) ([]string, error)
 	GetNordicCombinedCategories(ctx context.Context) ([]string, error)
-	GetRacesNK(ctx context.Context, seasons []int32, disciplines, cats []string) ([]fissqlc.ARacenk, error)
+	GetRacesNK(ctx context.Context, seasons []int32, disciplines, cats, fields []string, page utils.Page) ([]fissqlc.ARacenk, string, error)
+	StreamRacesNK(ctx context.Context, seasons []int32, disciplines, cats, fields []string, fn func(fissqlc.ARacenk) error) error
 	GetLastRowRaceNK(ctx context.Context) (fissqlc.ARacenk, error)
 	InsertRaceNK(ctx context.Context, in InsertRaceNKClean) error
 	UpdateRaceNKByID(ctx context.Context, in UpdateRaceNKClean) error
@@ -106,7 +113,7 @@ type Competitors interface {
 	GetCompetitorIDByFiscodeCC(ctx context.Context, fiscode int32) (int32, error)
 	GetCompetitorIDByFiscodeJP(ctx context.Context, fiscode int32) (int32, error)
 	GetCompetitorIDByFiscodeNK(ctx context.Context, fiscode int32) (int32, error)
-	SearchCompetitors(ctx context.Context, nationcode, sectorcode, gender *string, birthdateMin, birthdateMax *time.Time) ([]fissqlc.ACompetitor, error)
+	SearchCompetitors(ctx context.Context, nationcode, sectorcode, gender *string, birthdateMin, birthdateMax *time.Time, page utils.Page) ([]fissqlc.ACompetitor, string, error)
 	GetCompetitorCountsByNation(ctx context.Context, sectorcode, gender *string, birthdateMin, birthdateMax *time.Time) ([]fissqlc.GetCompetitorCountsByNationRow, error)
 	GetSectorcodeByFiscode(ctx context.Context, fiscode int32) (string, error)
 }
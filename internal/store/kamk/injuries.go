@@ -3,28 +3,17 @@ package kamk
 import (
 	"context"
 	"database/sql"
-	"time"
 
 	kamksqlc "github.com/DeRuina/KUHA-REST-API/internal/db/kamk"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 type InjuriesStore struct {
 	db *sql.DB
 }
 
-type Injury struct {
-	UserID      int32      `json:"user_id"`
-	InjuryType  int32      `json:"injury_type"`
-	Severity    int32      `json:"severity"`
-	PainLevel   int32      `json:"pain_level"`
-	Description string     `json:"description"`
-	DateStart   time.Time  `json:"date_start"`
-	Status      int32      `json:"status"`
-	DateEnd     *time.Time `json:"date_end,omitempty"`
-	InjuryID    int32      `json:"injury_id"`
-	Meta        string     `json:"meta"`
-}
+type Injury = apitypes.KamkInjuryItem
 
 type InjuryInput struct {
 	InjuryType  int32
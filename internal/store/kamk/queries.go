@@ -7,21 +7,14 @@ import (
 
 	kamksqlc "github.com/DeRuina/KUHA-REST-API/internal/db/kamk"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 )
 
 type QueriesStore struct {
 	db *sql.DB
 }
 
-type Questionnaire struct {
-	ID        int64     `json:"id"`
-	UserID    int32     `json:"user_id"`
-	QueryType int32     `json:"query_type"`
-	Answers   string    `json:"answers"`
-	Comment   string    `json:"comment"`
-	Timestamp time.Time `json:"timestamp"`
-	Meta      string    `json:"meta"`
-}
+type Questionnaire = apitypes.KamkQuestionnaireItem
 
 type QuestionnaireInput struct {
 	QueryType int32
@@ -45,16 +38,25 @@ func (s *QueriesStore) AddQuestionnaire(ctx context.Context, userID int32, in Qu
 	return q.InsertQuestionnaire(ctx, arg)
 }
 
-func (s *QueriesStore) GetQuestionnaires(ctx context.Context, userID int32) ([]Questionnaire, error) {
+func (s *QueriesStore) GetQuestionnaires(ctx context.Context, userID int32, page utils.Page) ([]Questionnaire, string, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
 	defer cancel()
 
 	q := kamksqlc.New(s.db)
-	rows, err := q.GetQuestionnairesByUser(ctx, userID)
+	rows, err := q.GetQuestionnairesByUser(ctx, kamksqlc.GetQuestionnairesByUserParams{
+		UserID:     userID,
+		CursorTime: page.AfterTime(),
+		CursorID:   page.AfterSeq(),
+		PageLimit:  page.FetchLimit(),
+	})
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
+	rows, next := utils.NextPage(page, rows, func(r kamksqlc.GetQuestionnairesByUserRow) utils.Cursor {
+		return utils.Cursor{Time: utils.TimePtr(r.Timestamp), N: r.ID}
+	})
+
 	out := make([]Questionnaire, 0, len(rows))
 	for _, r := range rows {
 		out = append(out, Questionnaire{
@@ -67,7 +69,7 @@ func (s *QueriesStore) GetQuestionnaires(ctx context.Context, userID int32) ([]Q
 			Meta:      r.Meta,
 		})
 	}
-	return out, nil
+	return out, next, nil
 }
 
 func (s *QueriesStore) IsQuizDoneToday(ctx context.Context, userID int32, queryType int32) ([]Questionnaire, error) {
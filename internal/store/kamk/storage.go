@@ -3,6 +3,8 @@ package kamk
 import (
 	"context"
 	"database/sql"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
 )
 
 type Injuries interface {
@@ -15,7 +17,7 @@ type Injuries interface {
 
 type Queries interface {
 	AddQuestionnaire(ctx context.Context, userID int32, in QuestionnaireInput) (int64, error)
-	GetQuestionnaires(ctx context.Context, userID int32) ([]Questionnaire, error)
+	GetQuestionnaires(ctx context.Context, userID int32, page utils.Page) ([]Questionnaire, string, error)
 	IsQuizDoneToday(ctx context.Context, userID int32, queryType int32) ([]Questionnaire, error)
 	UpdateQuestionnaireByID(ctx context.Context, userID int32, id int64, answers string, comment string) (int64, error)
 	DeleteQuestionnaireByID(ctx context.Context, userID int32, id int64) (int64, error)
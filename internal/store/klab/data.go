@@ -3,9 +3,11 @@ package klab
 import (
 	"context"
 	"database/sql"
+	"sort"
 
 	klabsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/klab"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/lib/pq"
 )
 
 type DataStore struct {
@@ -32,10 +34,17 @@ type KlabDataNoCustomer struct {
 	DirResults   []klabsqlc.Dirresult
 }
 
-func (s *DataStore) InsertKlabDataBulk(ctx context.Context, payloads []KlabDataPayload) error {
-	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
-	defer cancel()
+// Produce hands the rows of a streamed upload to write, one chunk at a time,
+// while the request body is still being read
+type Produce func(write func(chunk KlabDataPayload) error) error
 
+// InsertKlabDataStream inserts the rows produce writes, chunk by chunk, in one
+// transaction. The customers of a chunk go in before its measurements and the
+// measurements before the test rows, so a chunk may reference its own rows or
+// those of earlier chunks. Nothing is committed unless produce and every
+// insert succeed. The transaction lives as long as ctx (the request); each
+// chunk gets the usual query timeout.
+func (s *DataStore) InsertKlabDataStream(ctx context.Context, produce Produce) error {
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
@@ -43,51 +52,157 @@ func (s *DataStore) InsertKlabDataBulk(ctx context.Context, payloads []KlabDataP
 	defer tx.Rollback()
 
 	q := klabsqlc.New(tx)
-
-	for _, b := range payloads {
-		// 1) Customers
-		for _, c := range b.Customers {
+	err = produce(func(chunk KlabDataPayload) error {
+		ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+		defer cancel()
+		for _, c := range chunk.Customers {
 			if err := q.UpsertCustomer(ctx, c); err != nil {
 				return err
 			}
 		}
+		return insertKlabData(ctx, q, chunk)
+	})
+	if err != nil {
+		return err
+	}
 
-		// 2) Measurements
-		for _, m := range b.Measurements {
-			if err := q.InsertMeasurement(ctx, m); err != nil {
-				return err
-			}
-		}
+	return tx.Commit()
+}
 
-		// 3) Child tables
-		for _, t := range b.DirTests {
-			if err := q.InsertDirTest(ctx, t); err != nil {
-				return err
-			}
+// InsertKlabDataPartial upserts the customer rows of p and then commits every
+// measurement, together with the test rows that reference it, on its own.
+// Outcomes are returned per measurement id in ascending order.
+func (s *DataStore) InsertKlabDataPartial(ctx context.Context, p KlabDataPayload) ([]int32, []utils.ItemOutcome, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback()
+
+	q := klabsqlc.New(tx)
+
+	// every measurement depends on the customer, so a failure here fails the batch
+	for _, c := range p.Customers {
+		if err := q.UpsertCustomer(ctx, c); err != nil {
+			return nil, nil, err
 		}
-		for _, st := range b.DirTestSteps {
-			if err := q.InsertDirTestStep(ctx, st); err != nil {
-				return err
-			}
+	}
+
+	groups := map[int32]*KlabDataPayload{}
+	group := func(id int32) *KlabDataPayload {
+		if g, ok := groups[id]; ok {
+			return g
 		}
-		for _, rd := range b.DirRawData {
-			if err := q.InsertDirRawData(ctx, rd); err != nil {
-				return err
-			}
+		g := &KlabDataPayload{}
+		groups[id] = g
+		return g
+	}
+	for _, m := range p.Measurements {
+		g := group(m.Idmeasurement)
+		g.Measurements = append(g.Measurements, m)
+	}
+	for _, t := range p.DirTests {
+		g := group(t.Idmeasurement)
+		g.DirTests = append(g.DirTests, t)
+	}
+	for _, st := range p.DirTestSteps {
+		g := group(st.Idmeasurement)
+		g.DirTestSteps = append(g.DirTestSteps, st)
+	}
+	for _, rd := range p.DirRawData {
+		g := group(rd.Idmeasurement)
+		g.DirRawData = append(g.DirRawData, rd)
+	}
+	for _, rp := range p.DirReports {
+		g := group(rp.Idmeasurement)
+		g.DirReports = append(g.DirReports, rp)
+	}
+	for _, rs := range p.DirResults {
+		g := group(rs.Idmeasurement)
+		g.DirResults = append(g.DirResults, rs)
+	}
+
+	ids := make([]int32, 0, len(groups))
+	for id := range groups {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	existing := map[int32]bool{}
+	rows, err := tx.QueryContext(ctx, `SELECT idmeasurement FROM measurement_list WHERE idmeasurement = ANY($1)`, pq.Array(ids))
+	if err != nil {
+		return nil, nil, err
+	}
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, nil, err
 		}
-		for _, rp := range b.DirReports {
-			if err := q.InsertDirReport(ctx, rp); err != nil {
-				return err
-			}
+		existing[id] = true
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	out := make([]utils.ItemOutcome, len(ids))
+	for i, id := range ids {
+		itemErr, err := utils.WithSavepoint(ctx, tx, func() error {
+			return insertKlabData(ctx, q, *groups[id])
+		})
+		if err != nil {
+			return nil, nil, err
 		}
-		for _, rs := range b.DirResults {
-			if err := q.InsertDirResults(ctx, rs); err != nil {
-				return err
-			}
+		switch {
+		case itemErr != nil:
+			out[i] = utils.ItemOutcome{Status: utils.ItemError, Err: itemErr}
+		case existing[id]:
+			out[i] = utils.ItemOutcome{Status: utils.ItemUpdated}
+		default:
+			out[i] = utils.ItemOutcome{Status: utils.ItemCreated}
 		}
 	}
 
-	return tx.Commit()
+	return ids, out, tx.Commit()
+}
+
+// insertKlabData writes the measurement and test rows of b (not its customers)
+func insertKlabData(ctx context.Context, q *klabsqlc.Queries, b KlabDataPayload) error {
+	for _, m := range b.Measurements {
+		if err := q.InsertMeasurement(ctx, m); err != nil {
+			return err
+		}
+	}
+	for _, t := range b.DirTests {
+		if err := q.InsertDirTest(ctx, t); err != nil {
+			return err
+		}
+	}
+	for _, st := range b.DirTestSteps {
+		if err := q.InsertDirTestStep(ctx, st); err != nil {
+			return err
+		}
+	}
+	for _, rd := range b.DirRawData {
+		if err := q.InsertDirRawData(ctx, rd); err != nil {
+			return err
+		}
+	}
+	for _, rp := range b.DirReports {
+		if err := q.InsertDirReport(ctx, rp); err != nil {
+			return err
+		}
+	}
+	for _, rs := range b.DirResults {
+		if err := q.InsertDirResults(ctx, rs); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (s *DataStore) GetCustomerByID(ctx context.Context, idcustomer int32) (klabsqlc.Customer, error) {
@@ -98,24 +213,56 @@ func (s *DataStore) GetCustomerByID(ctx context.Context, idcustomer int32) (klab
 	return queries.GetCustomerByID(ctx, idcustomer)
 }
 
-func (s *DataStore) GetDataByCustomerIDNoCustomer(ctx context.Context, idcustomer int32) (*KlabDataNoCustomerResponse, error) {
+func (s *DataStore) GetDataByCustomerIDNoCustomer(ctx context.Context, idcustomer int32, page utils.Page) (*KlabDataNoCustomerResponse, string, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
 	defer cancel()
 
 	// Check if customer exists first
 	_, err := s.GetCustomerByID(ctx, idcustomer)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	q := klabsqlc.New(s.db)
 
-	// Get all measurements for the customer
-	meas, err := q.GetMeasurementsByCustomer(ctx, idcustomer)
+	// Get the measurements for the customer (one page when paged), child rows follow the page
+	meas, err := q.GetMeasurementsByCustomer(ctx, klabsqlc.GetMeasurementsByCustomerParams{
+		Idcustomer: idcustomer,
+		Column2:    page.AfterN(),
+		Column3:    page.LimitOrZero(),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	meas, next := utils.NextPage(page, meas, func(m klabsqlc.MeasurementList) utils.Cursor {
+		return utils.Cursor{N: int64(m.Idmeasurement)}
+	})
+
+	data, err := measurementData(ctx, q, idcustomer, meas)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, next, nil
+}
+
+// GetLatestDataByCustomerID returns the customer's most recent measurement with
+// its test rows, or sql.ErrNoRows when the customer has none
+func (s *DataStore) GetLatestDataByCustomerID(ctx context.Context, idcustomer int32) (*KlabDataNoCustomerResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	q := klabsqlc.New(s.db)
+
+	m, err := q.GetLatestMeasurementByCustomer(ctx, idcustomer)
 	if err != nil {
 		return nil, err
 	}
+	return measurementData(ctx, q, idcustomer, []klabsqlc.MeasurementList{m})
+}
 
+// measurementData loads the child rows of the given measurements
+func measurementData(ctx context.Context, q *klabsqlc.Queries, idcustomer int32, meas []klabsqlc.MeasurementList) (*KlabDataNoCustomerResponse, error) {
 	// Collect measurement IDs for bulk fetches
 	mids := make([]int32, 0, len(meas))
 	for _, m := range meas {
@@ -123,6 +270,7 @@ func (s *DataStore) GetDataByCustomerIDNoCustomer(ctx context.Context, idcustome
 	}
 
 	var (
+		err     error
 		tests   []klabsqlc.Dirtest
 		steps   []klabsqlc.Dirteststep
 		reps    []klabsqlc.Dirreport
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 
 	klabsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/klab"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
 )
 
 // Interfaces
@@ -15,8 +16,10 @@ type Users interface {
 }
 
 type Data interface {
-	InsertKlabDataBulk(ctx context.Context, payloads []KlabDataPayload) error
-	GetDataByCustomerIDNoCustomer(ctx context.Context, idcustomer int32) (*KlabDataNoCustomerResponse, error)
+	InsertKlabDataStream(ctx context.Context, produce Produce) error
+	InsertKlabDataPartial(ctx context.Context, payload KlabDataPayload) ([]int32, []utils.ItemOutcome, error)
+	GetDataByCustomerIDNoCustomer(ctx context.Context, idcustomer int32, page utils.Page) (*KlabDataNoCustomerResponse, string, error)
+	GetLatestDataByCustomerID(ctx context.Context, idcustomer int32) (*KlabDataNoCustomerResponse, error)
 	GetCustomerIDBySporttiID(ctx context.Context, sporttiID string) (int32, error)
 }
 
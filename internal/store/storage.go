@@ -48,6 +48,10 @@ type Auth interface {
 	Ping(ctx context.Context) error
 	IssueToken(ctx context.Context, clientToken, ip, userAgent string) (*auth.Tokens, error)
 	RefreshToken(ctx context.Context, refreshToken, ip, userAgent string) (string, error)
+	Usage() auth.Usage
+	Admin() auth.Admin
+	Webhooks() auth.Webhooks
+	Identities() auth.Identities
 }
 
 type Tietoevry interface {
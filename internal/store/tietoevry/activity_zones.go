@@ -67,31 +67,33 @@ func (s *ActivityZonesStore) ValidateUsersExist(ctx context.Context, userIDs []u
 	return nil
 }
 
-func (s *ActivityZonesStore) InsertActivityZonesBulk(ctx context.Context, zones []tietoevrysqlc.InsertActivityZoneParams) error {
+// InsertActivityZonesStream inserts the activity zones produce writes, chunk by chunk, in one transaction
+func (s *ActivityZonesStore) InsertActivityZonesStream(ctx context.Context, produce Produce[tietoevrysqlc.InsertActivityZoneParams]) error {
+	return insertStream(ctx, s.db, produce, func(ctx context.Context, q *tietoevrysqlc.Queries, p tietoevrysqlc.InsertActivityZoneParams) error {
+		return q.InsertActivityZone(ctx, p)
+	})
+}
+
+func (s *ActivityZonesStore) GetActivityZonesByUser(ctx context.Context, userID uuid.UUID, filter ReadFilter, page utils.Page) ([]tietoevrysqlc.ActivityZone, string, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
 	defer cancel()
 
-	tx, err := s.db.BeginTx(ctx, nil)
+	rows, err := tietoevrysqlc.New(s.db).GetActivityZonesByUser(ctx, tietoevrysqlc.GetActivityZonesByUserParams{
+		UserID:          userID,
+		FromTime:        utils.NullTimePtr(filter.From),
+		ToTime:          utils.NullTimePtr(filter.To),
+		UpdatedSince:    utils.NullTimePtr(filter.UpdatedSince),
+		CursorDate:      page.AfterTime(),
+		CursorCreatedAt: page.AfterCreated(),
+		CursorSource:    page.AfterKey(),
+		PageLimit:       page.FetchLimit(),
+	})
 	if err != nil {
-		return err
-	}
-	defer tx.Rollback()
-
-	q := tietoevrysqlc.New(tx)
-
-	for _, z := range zones {
-		if err := q.InsertActivityZone(ctx, z); err != nil {
-			return err
-		}
+		return nil, "", err
 	}
 
-	return tx.Commit()
-}
-
-func (s *ActivityZonesStore) GetActivityZonesByUser(ctx context.Context, userID uuid.UUID) ([]tietoevrysqlc.ActivityZone, error) {
-	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
-	defer cancel()
-
-	q := tietoevrysqlc.New(s.db)
-	return q.GetActivityZonesByUser(ctx, userID)
+	rows, next := utils.NextPage(page, rows, func(z tietoevrysqlc.ActivityZone) utils.Cursor {
+		return utils.Cursor{Time: utils.TimePtr(z.Date), Created: utils.TimePtr(z.CreatedAt), Key: z.Source}
+	})
+	return rows, next, nil
 }
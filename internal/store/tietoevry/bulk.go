@@ -0,0 +1,133 @@
+package tietoevry
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	tietoevrysqlc "github.com/DeRuina/KUHA-REST-API/internal/db/tietoevry"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// existingIDs returns which of ids already have a row in table; table is always a constant
+func existingIDs(ctx context.Context, tx *sql.Tx, table string, ids []uuid.UUID) (map[uuid.UUID]bool, error) {
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf(`SELECT id FROM %s WHERE id = ANY($1)`, table), pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	found := make(map[uuid.UUID]bool, len(ids))
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		found[id] = true
+	}
+	return found, rows.Err()
+}
+
+// partialChunk is how many items insertPartial commits at a time
+const partialChunk = 500
+
+// insertPartial runs insert for every item inside its own savepoint, in one
+// transaction per partialChunk items, each with the usual query timeout. Items
+// whose user is missing, or whose id already appeared earlier in the batch,
+// are not attempted. Chunks committed before an error stay committed, like
+// the chunks of an async import.
+func insertPartial(ctx context.Context, db *sql.DB, table string, ids, userIDs []uuid.UUID, insert func(tx *sql.Tx, i int) error) ([]utils.ItemOutcome, error) {
+	out := make([]utils.ItemOutcome, len(ids))
+	seen := make(map[uuid.UUID]bool, len(ids))
+	for start := 0; start < len(ids); start += partialChunk {
+		end := min(start+partialChunk, len(ids))
+		if err := insertPartialChunk(ctx, db, table, ids, userIDs, start, end, seen, out, insert); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// insertPartialChunk commits items start to end of an insertPartial batch
+func insertPartialChunk(ctx context.Context, db *sql.DB, table string, ids, userIDs []uuid.UUID, start, end int, seen map[uuid.UUID]bool, out []utils.ItemOutcome, insert func(tx *sql.Tx, i int) error) error {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	existing, err := existingIDs(ctx, tx, table, ids[start:end])
+	if err != nil {
+		return err
+	}
+	users, err := existingIDs(ctx, tx, "users", userIDs[start:end])
+	if err != nil {
+		return err
+	}
+
+	for i := start; i < end; i++ {
+		id := ids[i]
+		switch {
+		case seen[id]:
+			out[i] = utils.ItemOutcome{Status: utils.ItemSkipped, Err: fmt.Errorf("duplicate id %s earlier in the batch", id)}
+			continue
+		case !users[userIDs[i]]:
+			out[i] = utils.ItemOutcome{Status: utils.ItemError, Err: utils.RejectItem(utils.CodeReferenceNotFound, utils.ErrUserNotFound)}
+			continue
+		}
+		seen[id] = true
+
+		itemErr, err := utils.WithSavepoint(ctx, tx, func() error { return insert(tx, i) })
+		if err != nil {
+			return err
+		}
+		switch {
+		case itemErr != nil:
+			out[i] = utils.ItemOutcome{Status: utils.ItemError, Err: itemErr}
+		case existing[id]:
+			out[i] = utils.ItemOutcome{Status: utils.ItemUpdated}
+		default:
+			out[i] = utils.ItemOutcome{Status: utils.ItemCreated}
+		}
+	}
+
+	return tx.Commit()
+}
+
+// Produce hands the items of a streamed upload to write, one chunk at a time,
+// while the request body is still being read
+type Produce[P any] func(write func(chunk []P) error) error
+
+// insertStream runs produce inside one transaction and inserts every chunk as
+// soon as it is written. Nothing is committed unless produce and every insert
+// succeed. The transaction lives as long as ctx (the request); each chunk gets
+// the usual query timeout.
+func insertStream[P any](ctx context.Context, db *sql.DB, produce Produce[P], insert func(ctx context.Context, q *tietoevrysqlc.Queries, p P) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	q := tietoevrysqlc.New(tx)
+	err = produce(func(chunk []P) error {
+		ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+		defer cancel()
+		for _, p := range chunk {
+			if err := insert(ctx, q, p); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -74,54 +74,86 @@ func (s *ExercisesStore) ValidateUsersExist(ctx context.Context, userIDs []uuid.
 	return nil
 }
 
-func (s *ExercisesStore) InsertExercisesBulk(ctx context.Context, exercises []ExercisePayload) error {
-	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
-	defer cancel()
+// InsertExercisesStream inserts the exercises produce writes, chunk by chunk, in one transaction
+func (s *ExercisesStore) InsertExercisesStream(ctx context.Context, produce Produce[ExercisePayload]) error {
+	return insertStream(ctx, s.db, produce, insertExercise)
+}
 
-	// Start a transaction to ensure atomicity for ALL exercises
-	tx, err := s.db.BeginTx(ctx, nil)
-	if err != nil {
-		return err
+// InsertExercisesPartial commits each exercise bundle on its own and reports per-item outcomes
+func (s *ExercisesStore) InsertExercisesPartial(ctx context.Context, exercises []ExercisePayload) ([]utils.ItemOutcome, error) {
+	ids := make([]uuid.UUID, len(exercises))
+	userIDs := make([]uuid.UUID, len(exercises))
+	for i, e := range exercises {
+		ids[i], userIDs[i] = e.Exercise.ID, e.Exercise.UserID
 	}
-	defer tx.Rollback()
 
-	q := tietoevrysqlc.New(tx)
+	return insertPartial(ctx, s.db, "exercises", ids, userIDs, func(tx *sql.Tx, i int) error {
+		return insertExercise(ctx, tietoevrysqlc.New(tx), exercises[i])
+	})
+}
 
-	for _, exercise := range exercises {
-		// Insert base exercise
-		if err := q.InsertExercise(ctx, exercise.Exercise); err != nil {
+func insertExercise(ctx context.Context, q *tietoevrysqlc.Queries, exercise ExercisePayload) error {
+	if err := q.InsertExercise(ctx, exercise.Exercise); err != nil {
+		return err
+	}
+	for _, zone := range exercise.HRZones {
+		if err := q.InsertExerciseHRZone(ctx, zone); err != nil {
 			return err
 		}
-
-		//Insert HR zones
-		for _, zone := range exercise.HRZones {
-			if err := q.InsertExerciseHRZone(ctx, zone); err != nil {
-				return err
-			}
+	}
+	for _, sample := range exercise.Samples {
+		if err := q.InsertExerciseSample(ctx, sample); err != nil {
+			return err
 		}
-
-		// Insert samples
-		for _, sample := range exercise.Samples {
-			if err := q.InsertExerciseSample(ctx, sample); err != nil {
-				return err
-			}
+	}
+	for _, section := range exercise.Sections {
+		if err := q.InsertExerciseSection(ctx, section); err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
-		// Insert sections
-		for _, section := range exercise.Sections {
-			if err := q.InsertExerciseSection(ctx, section); err != nil {
-				return err
-			}
-		}
+func (s *ExercisesStore) GetExercisesByUser(ctx context.Context, userID uuid.UUID, filter ReadFilter, page utils.Page) ([]tietoevrysqlc.Exercise, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	afterID, err := page.AfterID()
+	if err != nil {
+		return nil, "", err
 	}
 
-	return tx.Commit()
+	rows, err := tietoevrysqlc.New(s.db).GetExercisesByUser(ctx, tietoevrysqlc.GetExercisesByUserParams{
+		UserID:       userID,
+		FromTime:     utils.NullTimePtr(filter.From),
+		ToTime:       utils.NullTimePtr(filter.To),
+		UpdatedSince: utils.NullTimePtr(filter.UpdatedSince),
+		CursorTime:   page.AfterTime(),
+		CursorID:     afterID,
+		PageLimit:    page.FetchLimit(),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	rows, next := utils.NextPage(page, rows, func(e tietoevrysqlc.Exercise) utils.Cursor {
+		return utils.Cursor{Time: utils.TimePtr(e.StartTime), ID: e.ID.String()}
+	})
+	return rows, next, nil
 }
 
-func (s *ExercisesStore) GetExercisesByUser(ctx context.Context, userID uuid.UUID) ([]tietoevrysqlc.Exercise, error) {
-	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+// StreamExercisesByUser passes every matching exercise to fn without collecting them. A full
+// export can outlast QueryTimeout, so it is bound by StreamQueryTimeout, and a
+// client that stops reading is cut off by the stream's write deadline
+func (s *ExercisesStore) StreamExercisesByUser(ctx context.Context, userID uuid.UUID, filter ReadFilter, fn func(tietoevrysqlc.Exercise) error) error {
+	ctx, cancel := context.WithTimeout(ctx, utils.StreamQueryTimeout)
 	defer cancel()
-	return tietoevrysqlc.New(s.db).GetExercisesByUser(ctx, userID)
+	return tietoevrysqlc.New(s.db).StreamExercisesByUser(ctx, tietoevrysqlc.GetExercisesByUserParams{
+		UserID:       userID,
+		FromTime:     utils.NullTimePtr(filter.From),
+		ToTime:       utils.NullTimePtr(filter.To),
+		UpdatedSince: utils.NullTimePtr(filter.UpdatedSince),
+	}, fn)
 }
 
 func (s *ExercisesStore) GetExerciseHRZones(ctx context.Context, id uuid.UUID) ([]tietoevrysqlc.ExerciseHrZone, error) {
@@ -141,3 +173,51 @@ func (s *ExercisesStore) GetExerciseSections(ctx context.Context, id uuid.UUID)
 	defer cancel()
 	return tietoevrysqlc.New(s.db).GetExerciseSections(ctx, id)
 }
+
+// ExerciseDetails are the nested rows of one exercise
+type ExerciseDetails struct {
+	HRZones  []tietoevrysqlc.ExerciseHrZone
+	Samples  []tietoevrysqlc.ExerciseSample
+	Sections []tietoevrysqlc.ExerciseSection
+}
+
+// GetExerciseDetails loads the nested rows of several exercises with one
+// query per table, keyed by exercise id
+func (s *ExercisesStore) GetExerciseDetails(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*ExerciseDetails, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	details := make(map[uuid.UUID]*ExerciseDetails, len(ids))
+	for _, id := range ids {
+		details[id] = &ExerciseDetails{}
+	}
+	if len(ids) == 0 {
+		return details, nil
+	}
+
+	q := tietoevrysqlc.New(s.db)
+	zones, err := q.GetExerciseHRZonesByExerciseIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, z := range zones {
+		details[z.ExerciseID].HRZones = append(details[z.ExerciseID].HRZones, z)
+	}
+
+	samples, err := q.GetExerciseSamplesByExerciseIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, sm := range samples {
+		details[sm.ExerciseID].Samples = append(details[sm.ExerciseID].Samples, sm)
+	}
+
+	sections, err := q.GetExerciseSectionsByExerciseIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, sec := range sections {
+		details[sec.ExerciseID].Sections = append(details[sec.ExerciseID].Sections, sec)
+	}
+	return details, nil
+}
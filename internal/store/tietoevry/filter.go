@@ -0,0 +1,13 @@
+package tietoevry
+
+import "time"
+
+// ReadFilter narrows the per-user list queries.
+// From and To are inclusive bounds on the resource's own date (start_time for
+// exercises, date, timestamp for test results, created_at for questionnaires);
+// UpdatedSince matches rows whose updated_at is at or after the given instant.
+type ReadFilter struct {
+	From         *time.Time
+	To           *time.Time
+	UpdatedSince *time.Time
+}
@@ -63,30 +63,64 @@ func (s *MeasurementsStore) ValidateUsersExist(ctx context.Context, userIDs []uu
 	return nil
 }
 
-func (s *MeasurementsStore) InsertMeasurementsBulk(ctx context.Context, measurements []tietoevrysqlc.InsertMeasurementParams) error {
+// InsertMeasurementsStream inserts the measurements produce writes, chunk by chunk, in one transaction
+func (s *MeasurementsStore) InsertMeasurementsStream(ctx context.Context, produce Produce[tietoevrysqlc.InsertMeasurementParams]) error {
+	return insertStream(ctx, s.db, produce, func(ctx context.Context, q *tietoevrysqlc.Queries, p tietoevrysqlc.InsertMeasurementParams) error {
+		return q.InsertMeasurement(ctx, p)
+	})
+}
+
+// InsertMeasurementsPartial commits each measurement on its own and reports per-item outcomes
+func (s *MeasurementsStore) InsertMeasurementsPartial(ctx context.Context, measurements []tietoevrysqlc.InsertMeasurementParams) ([]utils.ItemOutcome, error) {
+	ids := make([]uuid.UUID, len(measurements))
+	userIDs := make([]uuid.UUID, len(measurements))
+	for i, m := range measurements {
+		ids[i], userIDs[i] = m.ID, m.UserID
+	}
+
+	return insertPartial(ctx, s.db, "measurements", ids, userIDs, func(tx *sql.Tx, i int) error {
+		return tietoevrysqlc.New(tx).InsertMeasurement(ctx, measurements[i])
+	})
+}
+
+func (s *MeasurementsStore) GetMeasurementsByUser(ctx context.Context, userID uuid.UUID, filter ReadFilter, page utils.Page) ([]tietoevrysqlc.Measurement, string, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
 	defer cancel()
 
-	tx, err := s.db.BeginTx(ctx, nil)
+	afterID, err := page.AfterID()
 	if err != nil {
-		return err
+		return nil, "", err
 	}
-	defer tx.Rollback()
 
-	q := tietoevrysqlc.New(tx)
-	for _, m := range measurements {
-		if err := q.InsertMeasurement(ctx, m); err != nil {
-			return err
-		}
+	rows, err := tietoevrysqlc.New(s.db).GetMeasurementsByUser(ctx, tietoevrysqlc.GetMeasurementsByUserParams{
+		UserID:          userID,
+		FromTime:        utils.NullTimePtr(filter.From),
+		ToTime:          utils.NullTimePtr(filter.To),
+		UpdatedSince:    utils.NullTimePtr(filter.UpdatedSince),
+		CursorDate:      page.AfterTime(),
+		CursorCreatedAt: page.AfterCreated(),
+		CursorID:        afterID,
+		PageLimit:       page.FetchLimit(),
+	})
+	if err != nil {
+		return nil, "", err
 	}
 
-	return tx.Commit()
+	rows, next := utils.NextPage(page, rows, func(m tietoevrysqlc.Measurement) utils.Cursor {
+		return utils.Cursor{Time: utils.TimePtr(m.Date), Created: utils.TimePtr(m.CreatedAt), ID: m.ID.String()}
+	})
+	return rows, next, nil
 }
 
-func (s *MeasurementsStore) GetMeasurementsByUser(ctx context.Context, userID uuid.UUID) ([]tietoevrysqlc.Measurement, error) {
-	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+// StreamMeasurementsByUser passes every matching measurement to fn without collecting them;
+// like StreamExercisesByUser it is bound by StreamQueryTimeout rather than QueryTimeout
+func (s *MeasurementsStore) StreamMeasurementsByUser(ctx context.Context, userID uuid.UUID, filter ReadFilter, fn func(tietoevrysqlc.Measurement) error) error {
+	ctx, cancel := context.WithTimeout(ctx, utils.StreamQueryTimeout)
 	defer cancel()
-
-	q := tietoevrysqlc.New(s.db)
-	return q.GetMeasurementsByUser(ctx, userID)
+	return tietoevrysqlc.New(s.db).StreamMeasurementsByUser(ctx, tietoevrysqlc.GetMeasurementsByUserParams{
+		UserID:       userID,
+		FromTime:     utils.NullTimePtr(filter.From),
+		ToTime:       utils.NullTimePtr(filter.To),
+		UpdatedSince: utils.NullTimePtr(filter.UpdatedSince),
+	}, fn)
 }
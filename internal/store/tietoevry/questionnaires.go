@@ -67,31 +67,42 @@ func (s *QuestionnairesStore) ValidateUsersExist(ctx context.Context, userIDs []
 	return nil
 }
 
-func (s *QuestionnairesStore) InsertQuestionnaireAnswersBulk(ctx context.Context, answers []tietoevrysqlc.InsertQuestionnaireAnswerParams) error {
+// InsertQuestionnaireAnswersStream inserts the questionnaire answers produce writes, chunk by chunk, in one transaction
+func (s *QuestionnairesStore) InsertQuestionnaireAnswersStream(ctx context.Context, produce Produce[tietoevrysqlc.InsertQuestionnaireAnswerParams]) error {
+	return insertStream(ctx, s.db, produce, func(ctx context.Context, q *tietoevrysqlc.Queries, p tietoevrysqlc.InsertQuestionnaireAnswerParams) error {
+		return q.InsertQuestionnaireAnswer(ctx, p)
+	})
+}
+
+func (s *QuestionnairesStore) GetQuestionnairesByUser(ctx context.Context, userID uuid.UUID, filter ReadFilter, page utils.Page) ([]tietoevrysqlc.QuestionAnswer, string, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
 	defer cancel()
 
-	tx, err := s.db.BeginTx(ctx, nil)
+	afterID, err := page.AfterID()
 	if err != nil {
-		return err
+		return nil, "", err
 	}
-	defer tx.Rollback()
-
-	q := tietoevrysqlc.New(tx)
-
-	for _, a := range answers {
-		if err := q.InsertQuestionnaireAnswer(ctx, a); err != nil {
-			return err
-		}
+	afterKey, err := page.AfterKeyUUID()
+	if err != nil {
+		return nil, "", err
 	}
 
-	return tx.Commit()
-}
-
-func (s *QuestionnairesStore) GetQuestionnairesByUser(ctx context.Context, userID uuid.UUID) ([]tietoevrysqlc.QuestionAnswer, error) {
-	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
-	defer cancel()
+	rows, err := tietoevrysqlc.New(s.db).GetQuestionnairesByUser(ctx, tietoevrysqlc.GetQuestionnairesByUserParams{
+		UserID:           userID,
+		FromTime:         utils.NullTimePtr(filter.From),
+		ToTime:           utils.NullTimePtr(filter.To),
+		UpdatedSince:     utils.NullTimePtr(filter.UpdatedSince),
+		CursorCreatedAt:  page.AfterCreated(),
+		CursorInstanceID: afterID,
+		CursorQuestionID: afterKey,
+		PageLimit:        page.FetchLimit(),
+	})
+	if err != nil {
+		return nil, "", err
+	}
 
-	q := tietoevrysqlc.New(s.db)
-	return q.GetQuestionnairesByUser(ctx, userID)
+	rows, next := utils.NextPage(page, rows, func(a tietoevrysqlc.QuestionAnswer) utils.Cursor {
+		return utils.Cursor{Created: utils.TimePtr(a.CreatedAt), ID: a.QuestionnaireInstanceID.String(), Key: a.QuestionID.String()}
+	})
+	return rows, next, nil
 }
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 
 	tietoevrysqlc "github.com/DeRuina/KUHA-REST-API/internal/db/tietoevry"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
 	"github.com/google/uuid"
 )
 
@@ -20,41 +21,46 @@ type Users interface {
 
 type Exercises interface {
 	ValidateUsersExist(ctx context.Context, userIDs []uuid.UUID) error
-	InsertExercisesBulk(ctx context.Context, exercises []ExercisePayload) error
-	GetExercisesByUser(ctx context.Context, userID uuid.UUID) ([]tietoevrysqlc.Exercise, error)
+	InsertExercisesStream(ctx context.Context, produce Produce[ExercisePayload]) error
+	InsertExercisesPartial(ctx context.Context, exercises []ExercisePayload) ([]utils.ItemOutcome, error)
+	GetExercisesByUser(ctx context.Context, userID uuid.UUID, filter ReadFilter, page utils.Page) ([]tietoevrysqlc.Exercise, string, error)
+	StreamExercisesByUser(ctx context.Context, userID uuid.UUID, filter ReadFilter, fn func(tietoevrysqlc.Exercise) error) error
 	GetExerciseHRZones(ctx context.Context, id uuid.UUID) ([]tietoevrysqlc.ExerciseHrZone, error)
 	GetExerciseSamples(ctx context.Context, id uuid.UUID) ([]tietoevrysqlc.ExerciseSample, error)
 	GetExerciseSections(ctx context.Context, id uuid.UUID) ([]tietoevrysqlc.ExerciseSection, error)
+	GetExerciseDetails(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*ExerciseDetails, error)
 }
 
 type Symptoms interface {
 	ValidateUsersExist(ctx context.Context, userIDs []uuid.UUID) error
-	InsertSymptomsBulk(ctx context.Context, symptoms []tietoevrysqlc.InsertSymptomParams) error
-	GetSymptomsByUser(ctx context.Context, userID uuid.UUID) ([]tietoevrysqlc.Symptom, error)
+	InsertSymptomsStream(ctx context.Context, produce Produce[tietoevrysqlc.InsertSymptomParams]) error
+	GetSymptomsByUser(ctx context.Context, userID uuid.UUID, filter ReadFilter, page utils.Page) ([]tietoevrysqlc.Symptom, string, error)
 }
 
 type Measurements interface {
 	ValidateUsersExist(ctx context.Context, userIDs []uuid.UUID) error
-	InsertMeasurementsBulk(ctx context.Context, measurements []tietoevrysqlc.InsertMeasurementParams) error
-	GetMeasurementsByUser(ctx context.Context, userID uuid.UUID) ([]tietoevrysqlc.Measurement, error)
+	InsertMeasurementsStream(ctx context.Context, produce Produce[tietoevrysqlc.InsertMeasurementParams]) error
+	InsertMeasurementsPartial(ctx context.Context, measurements []tietoevrysqlc.InsertMeasurementParams) ([]utils.ItemOutcome, error)
+	GetMeasurementsByUser(ctx context.Context, userID uuid.UUID, filter ReadFilter, page utils.Page) ([]tietoevrysqlc.Measurement, string, error)
+	StreamMeasurementsByUser(ctx context.Context, userID uuid.UUID, filter ReadFilter, fn func(tietoevrysqlc.Measurement) error) error
 }
 
 type TestResults interface {
 	ValidateUsersExist(ctx context.Context, userIDs []uuid.UUID) error
-	InsertTestResultsBulk(ctx context.Context, results []tietoevrysqlc.InsertTestResultParams) error
-	GetTestResultsByUser(ctx context.Context, userID uuid.UUID) ([]tietoevrysqlc.TestResult, error)
+	InsertTestResultsStream(ctx context.Context, produce Produce[tietoevrysqlc.InsertTestResultParams]) error
+	GetTestResultsByUser(ctx context.Context, userID uuid.UUID, filter ReadFilter, page utils.Page) ([]tietoevrysqlc.TestResult, string, error)
 }
 
 type Questionnaires interface {
 	ValidateUsersExist(ctx context.Context, userIDs []uuid.UUID) error
-	InsertQuestionnaireAnswersBulk(ctx context.Context, answers []tietoevrysqlc.InsertQuestionnaireAnswerParams) error
-	GetQuestionnairesByUser(ctx context.Context, userID uuid.UUID) ([]tietoevrysqlc.QuestionAnswer, error)
+	InsertQuestionnaireAnswersStream(ctx context.Context, produce Produce[tietoevrysqlc.InsertQuestionnaireAnswerParams]) error
+	GetQuestionnairesByUser(ctx context.Context, userID uuid.UUID, filter ReadFilter, page utils.Page) ([]tietoevrysqlc.QuestionAnswer, string, error)
 }
 
 type ActivityZones interface {
 	ValidateUsersExist(ctx context.Context, userIDs []uuid.UUID) error
-	InsertActivityZonesBulk(ctx context.Context, zones []tietoevrysqlc.InsertActivityZoneParams) error
-	GetActivityZonesByUser(ctx context.Context, userID uuid.UUID) ([]tietoevrysqlc.ActivityZone, error)
+	InsertActivityZonesStream(ctx context.Context, produce Produce[tietoevrysqlc.InsertActivityZoneParams]) error
+	GetActivityZonesByUser(ctx context.Context, userID uuid.UUID, filter ReadFilter, page utils.Page) ([]tietoevrysqlc.ActivityZone, string, error)
 }
 
 // TietoevryStorage
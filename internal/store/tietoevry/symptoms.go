@@ -67,30 +67,38 @@ func (s *SymptomsStore) ValidateUsersExist(ctx context.Context, userIDs []uuid.U
 	return nil
 }
 
-func (s *SymptomsStore) InsertSymptomsBulk(ctx context.Context, symptoms []tietoevrysqlc.InsertSymptomParams) error {
+// InsertSymptomsStream inserts the symptoms produce writes, chunk by chunk, in one transaction
+func (s *SymptomsStore) InsertSymptomsStream(ctx context.Context, produce Produce[tietoevrysqlc.InsertSymptomParams]) error {
+	return insertStream(ctx, s.db, produce, func(ctx context.Context, q *tietoevrysqlc.Queries, p tietoevrysqlc.InsertSymptomParams) error {
+		return q.InsertSymptom(ctx, p)
+	})
+}
+
+func (s *SymptomsStore) GetSymptomsByUser(ctx context.Context, userID uuid.UUID, filter ReadFilter, page utils.Page) ([]tietoevrysqlc.Symptom, string, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
 	defer cancel()
 
-	tx, err := s.db.BeginTx(ctx, nil)
+	afterID, err := page.AfterID()
 	if err != nil {
-		return err
+		return nil, "", err
 	}
-	defer tx.Rollback()
-
-	q := tietoevrysqlc.New(tx)
 
-	for _, symptom := range symptoms {
-		if err := q.InsertSymptom(ctx, symptom); err != nil {
-			return err
-		}
+	rows, err := tietoevrysqlc.New(s.db).GetSymptomsByUser(ctx, tietoevrysqlc.GetSymptomsByUserParams{
+		UserID:          userID,
+		FromTime:        utils.NullTimePtr(filter.From),
+		ToTime:          utils.NullTimePtr(filter.To),
+		UpdatedSince:    utils.NullTimePtr(filter.UpdatedSince),
+		CursorDate:      page.AfterTime(),
+		CursorCreatedAt: page.AfterCreated(),
+		CursorID:        afterID,
+		PageLimit:       page.FetchLimit(),
+	})
+	if err != nil {
+		return nil, "", err
 	}
 
-	return tx.Commit()
-}
-
-func (s *SymptomsStore) GetSymptomsByUser(ctx context.Context, userID uuid.UUID) ([]tietoevrysqlc.Symptom, error) {
-	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
-	defer cancel()
-
-	return tietoevrysqlc.New(s.db).GetSymptomsByUser(ctx, userID)
+	rows, next := utils.NextPage(page, rows, func(sy tietoevrysqlc.Symptom) utils.Cursor {
+		return utils.Cursor{Time: utils.TimePtr(sy.Date), Created: utils.TimePtr(sy.CreatedAt), ID: sy.ID.String()}
+	})
+	return rows, next, nil
 }
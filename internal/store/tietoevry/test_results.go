@@ -67,31 +67,38 @@ func (s *TestResultsStore) ValidateUsersExist(ctx context.Context, userIDs []uui
 	return nil
 }
 
-func (s *TestResultsStore) InsertTestResultsBulk(ctx context.Context, results []tietoevrysqlc.InsertTestResultParams) error {
+// InsertTestResultsStream inserts the test results produce writes, chunk by chunk, in one transaction
+func (s *TestResultsStore) InsertTestResultsStream(ctx context.Context, produce Produce[tietoevrysqlc.InsertTestResultParams]) error {
+	return insertStream(ctx, s.db, produce, func(ctx context.Context, q *tietoevrysqlc.Queries, p tietoevrysqlc.InsertTestResultParams) error {
+		return q.InsertTestResult(ctx, p)
+	})
+}
+
+func (s *TestResultsStore) GetTestResultsByUser(ctx context.Context, userID uuid.UUID, filter ReadFilter, page utils.Page) ([]tietoevrysqlc.TestResult, string, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
 	defer cancel()
 
-	tx, err := s.db.BeginTx(ctx, nil)
+	afterID, err := page.AfterID()
 	if err != nil {
-		return err
+		return nil, "", err
 	}
-	defer tx.Rollback()
-
-	q := tietoevrysqlc.New(tx)
 
-	for _, r := range results {
-		if err := q.InsertTestResult(ctx, r); err != nil {
-			return err
-		}
+	rows, err := tietoevrysqlc.New(s.db).GetTestResultsByUser(ctx, tietoevrysqlc.GetTestResultsByUserParams{
+		UserID:          userID,
+		FromTime:        utils.NullTimePtr(filter.From),
+		ToTime:          utils.NullTimePtr(filter.To),
+		UpdatedSince:    utils.NullTimePtr(filter.UpdatedSince),
+		CursorTime:      page.AfterTime(),
+		CursorCreatedAt: page.AfterCreated(),
+		CursorID:        afterID,
+		PageLimit:       page.FetchLimit(),
+	})
+	if err != nil {
+		return nil, "", err
 	}
 
-	return tx.Commit()
-}
-
-func (s *TestResultsStore) GetTestResultsByUser(ctx context.Context, userID uuid.UUID) ([]tietoevrysqlc.TestResult, error) {
-	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
-	defer cancel()
-
-	q := tietoevrysqlc.New(s.db)
-	return q.GetTestResultsByUser(ctx, userID)
+	rows, next := utils.NextPage(page, rows, func(t tietoevrysqlc.TestResult) utils.Cursor {
+		return utils.Cursor{Time: utils.TimePtr(t.Timestamp), Created: utils.TimePtr(t.CreatedAt), ID: t.ID.String()}
+	})
+	return rows, next, nil
 }
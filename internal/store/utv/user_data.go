@@ -7,6 +7,7 @@ import (
 
 	utvsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/utv"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
 	"github.com/google/uuid"
 )
 
@@ -14,17 +15,10 @@ type UserDataStore struct {
 	db *sql.DB
 }
 
-type DeviceInfo struct {
-	Connected  bool `json:"connected"`
-	DataExists bool `json:"data_exists"`
-}
-
-type DeviceStatus struct {
-	Garmin DeviceInfo `json:"garmin"`
-	Oura   DeviceInfo `json:"oura"`
-	Polar  DeviceInfo `json:"polar"`
-	Suunto DeviceInfo `json:"suunto"`
-}
+type (
+	DeviceInfo   = apitypes.DeviceInfo
+	DeviceStatus = apitypes.DeviceStatusResponse
+)
 
 func (s *UserDataStore) GetUserData(ctx context.Context, userID uuid.UUID) (json.RawMessage, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
@@ -0,0 +1,120 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// MemoryBackend is the single-instance fallback used when Redis is not
+// available; streams only see events written through this instance
+type MemoryBackend struct {
+	sync.Mutex
+	history int
+	ttl     time.Duration
+
+	athletes  map[string]*memoryHistory
+	lastMs    int64
+	seq       uint64
+	listeners map[int]func(Message)
+	nextL     int
+}
+
+type memoryHistory struct {
+	msgs    []Message
+	expires time.Time
+}
+
+func NewMemoryBackend(cfg Config) *MemoryBackend {
+	b := &MemoryBackend{
+		history:   cfg.History,
+		ttl:       cfg.HistoryTTL,
+		athletes:  make(map[string]*memoryHistory),
+		listeners: make(map[int]func(Message)),
+	}
+	go b.sweep(time.Minute)
+	return b
+}
+
+func (b *MemoryBackend) Append(_ context.Context, m *Message) error {
+	b.Lock()
+	m.ID = b.nextID()
+	if b.history > 0 {
+		h := b.athletes[m.Athlete]
+		if h == nil {
+			h = &memoryHistory{}
+			b.athletes[m.Athlete] = h
+		}
+		h.msgs = append(h.msgs, *m)
+		if len(h.msgs) > b.history {
+			h.msgs = append(h.msgs[:0:0], h.msgs[len(h.msgs)-b.history:]...)
+		}
+		h.expires = time.Now().Add(b.ttl)
+	}
+	listeners := make([]func(Message), 0, len(b.listeners))
+	for _, fn := range b.listeners {
+		listeners = append(listeners, fn)
+	}
+	b.Unlock()
+
+	for _, fn := range listeners {
+		fn(*m)
+	}
+	return nil
+}
+
+func (b *MemoryBackend) Since(_ context.Context, athlete, id string) ([]Message, error) {
+	b.Lock()
+	defer b.Unlock()
+	h := b.athletes[athlete]
+	if h == nil || time.Now().After(h.expires) {
+		return nil, nil
+	}
+	var out []Message
+	for _, m := range h.msgs {
+		if After(m.ID, id) {
+			out = append(out, m)
+		}
+	}
+	return out, nil
+}
+
+func (b *MemoryBackend) Listen(ctx context.Context, fn func(Message)) {
+	b.Lock()
+	k := b.nextL
+	b.nextL++
+	b.listeners[k] = fn
+	b.Unlock()
+
+	<-ctx.Done()
+
+	b.Lock()
+	delete(b.listeners, k)
+	b.Unlock()
+}
+
+// nextID numbers messages like Redis stream entries, so ids stay comparable
+// when an instance switches backends
+func (b *MemoryBackend) nextID() string {
+	ms := time.Now().UnixMilli()
+	if ms > b.lastMs {
+		b.lastMs, b.seq = ms, 0
+	} else {
+		b.seq++
+	}
+	return fmt.Sprintf("%d-%d", b.lastMs, b.seq)
+}
+
+func (b *MemoryBackend) sweep(every time.Duration) {
+	for range time.Tick(every) {
+		now := time.Now()
+		b.Lock()
+		for k, h := range b.athletes {
+			if now.After(h.expires) {
+				delete(b.athletes, k)
+			}
+		}
+		b.Unlock()
+	}
+}
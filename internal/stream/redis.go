@@ -0,0 +1,100 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/logger"
+	"github.com/redis/go-redis/v9"
+)
+
+// every instance subscribes to this channel and passes the messages on to its own streams
+const redisChannel = "stream:athletes"
+
+// RedisBackend keeps each athlete's history in a Redis stream, whose entry
+// ids are the message ids, and fans new messages out over pub/sub
+type RedisBackend struct {
+	Client  *redis.Client
+	history int
+	ttl     time.Duration
+}
+
+func NewRedisBackend(client *redis.Client, cfg Config) *RedisBackend {
+	return &RedisBackend{Client: client, history: cfg.History, ttl: cfg.HistoryTTL}
+}
+
+func redisKey(athlete string) string {
+	return "stream:athlete:" + athlete
+}
+
+func (b *RedisBackend) Append(ctx context.Context, m *Message) error {
+	key := redisKey(m.Athlete)
+	id, err := b.Client.XAdd(ctx, &redis.XAddArgs{
+		Stream: key,
+		MaxLen: int64(max(b.history, 1)),
+		Approx: true,
+		Values: map[string]any{"type": m.Type, "data": []byte(m.Data)},
+	}).Result()
+	if err != nil {
+		return err
+	}
+	m.ID = id
+
+	val, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	pipe := b.Client.Pipeline()
+	pipe.Expire(ctx, key, b.ttl)
+	pipe.Publish(ctx, redisChannel, val)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+func (b *RedisBackend) Since(ctx context.Context, athlete, id string) ([]Message, error) {
+	if b.history <= 0 {
+		return nil, nil
+	}
+	// the range starts at id itself; that entry was already seen
+	entries, err := b.Client.XRangeN(ctx, redisKey(athlete), id, "+", int64(b.history)+1).Result()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Message, 0, len(entries))
+	for _, e := range entries {
+		if e.ID == id {
+			continue
+		}
+		typ, _ := e.Values["type"].(string)
+		data, _ := e.Values["data"].(string)
+		out = append(out, Message{ID: e.ID, Athlete: athlete, Type: typ, Data: json.RawMessage(data)})
+	}
+	return out, nil
+}
+
+// Listen follows the pub/sub channel. The client resubscribes by itself after a
+// lost connection; messages published in between only reach clients that
+// reconnect with their last event id.
+func (b *RedisBackend) Listen(ctx context.Context, fn func(Message)) {
+	ps := b.Client.Subscribe(ctx, redisChannel)
+	defer ps.Close()
+
+	ch := ps.Channel()
+	for {
+		select {
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			var m Message
+			if err := json.Unmarshal([]byte(msg.Payload), &m); err != nil {
+				logger.Logger.Warnw("invalid stream message", "error", err)
+				continue
+			}
+			fn(m)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,247 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
+	"github.com/DeRuina/KUHA-REST-API/internal/logger"
+)
+
+// messages a subscriber may fall behind by before it is disconnected
+const subscriberBuffer = 64
+
+type Config struct {
+	Enabled bool
+	// messages kept per athlete for Last-Event-ID resume
+	History int
+	// how long an athlete's history is kept after its last message
+	HistoryTTL time.Duration
+	// published events waiting to be stored; more are dropped
+	QueueSize int
+	// interval of the keep-alive comments on an open stream
+	Heartbeat time.Duration
+}
+
+// Message is one event in an athlete's stream
+type Message struct {
+	// position in the athlete's stream, "<unix ms>-<seq>"; sent as the SSE id
+	ID      string          `json:"id"`
+	Athlete string          `json:"athlete"`
+	Type    string          `json:"type"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// Backend keeps the recent messages of every athlete and carries new ones to
+// every instance
+type Backend interface {
+	// Append assigns m its ID, adds it to the athlete's history and passes it
+	// to every Listen, on this instance and the others
+	Append(ctx context.Context, m *Message) error
+	// Since returns the kept messages of athlete after id, oldest first
+	Since(ctx context.Context, athlete, id string) ([]Message, error)
+	// Listen calls fn with every appended message until ctx is done
+	Listen(ctx context.Context, fn func(Message))
+}
+
+// Hub turns published events into athlete stream messages and hands the
+// messages to the open streams of this instance
+type Hub struct {
+	backend Backend
+	queue   chan events.Event
+
+	mu     sync.Mutex
+	subs   map[string]map[*Subscription]struct{}
+	closed bool
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewHub(backend Backend, cfg Config) *Hub {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Hub{
+		backend: backend,
+		queue:   make(chan events.Event, cfg.QueueSize),
+		subs:    make(map[string]map[*Subscription]struct{}),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+// Start subscribes to published events and delivers messages in the
+// background until Stop is called
+func (h *Hub) Start() {
+	events.Subscribe(h.enqueue)
+
+	h.wg.Add(2)
+	go h.publish()
+	go func() {
+		defer h.wg.Done()
+		h.backend.Listen(h.ctx, h.deliver)
+	}()
+}
+
+// Stop ends every open subscription, so their streams return
+func (h *Hub) Stop() {
+	if h == nil {
+		return
+	}
+	h.cancel()
+	h.wg.Wait()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closed = true
+	for _, subs := range h.subs {
+		for s := range subs {
+			close(s.c)
+		}
+	}
+	h.subs = nil
+}
+
+// Subscribe returns the live messages of athlete from now on. The channel is
+// closed when the subscriber falls too far behind or the hub stops; the
+// client then resumes from its last event id.
+func (h *Hub) Subscribe(athlete string) *Subscription {
+	s := &Subscription{hub: h, athlete: athlete, c: make(chan Message, subscriberBuffer)}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		close(s.c)
+		return s
+	}
+	if h.subs[athlete] == nil {
+		h.subs[athlete] = make(map[*Subscription]struct{})
+	}
+	h.subs[athlete][s] = struct{}{}
+	return s
+}
+
+// Since returns the kept messages of athlete after id, oldest first
+func (h *Hub) Since(ctx context.Context, athlete, id string) ([]Message, error) {
+	return h.backend.Since(ctx, athlete, id)
+}
+
+func (h *Hub) enqueue(e events.Event) {
+	if e.Athlete() == "" {
+		return
+	}
+	select {
+	case h.queue <- e:
+	default:
+		logger.Logger.Warnw("stream event dropped: queue full", "event_id", e.ID, "event_type", e.Type)
+	}
+}
+
+func (h *Hub) publish() {
+	defer h.wg.Done()
+	for {
+		select {
+		case e := <-h.queue:
+			data, err := json.Marshal(e)
+			if err != nil {
+				logger.Logger.Errorw("failed to encode stream event", "event_id", e.ID, "event_type", e.Type, "error", err)
+				continue
+			}
+			m := Message{Athlete: e.Athlete(), Type: e.Type, Data: data}
+			if err := h.backend.Append(h.ctx, &m); err != nil {
+				logger.Logger.Warnw("failed to publish stream event", "event_id", e.ID, "event_type", e.Type, "error", err)
+			}
+		case <-h.ctx.Done():
+			return
+		}
+	}
+}
+
+// deliver hands m to the subscribers of its athlete; one that is not keeping
+// up is dropped rather than holding up the others
+func (h *Hub) deliver(m Message) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for s := range h.subs[m.Athlete] {
+		select {
+		case s.c <- m:
+		default:
+			h.removeLocked(s)
+		}
+	}
+}
+
+func (h *Hub) removeLocked(s *Subscription) {
+	subs, ok := h.subs[s.athlete]
+	if !ok {
+		return
+	}
+	if _, ok := subs[s]; !ok {
+		return
+	}
+	delete(subs, s)
+	if len(subs) == 0 {
+		delete(h.subs, s.athlete)
+	}
+	close(s.c)
+}
+
+// Subscription is the live feed of one open stream
+type Subscription struct {
+	hub     *Hub
+	athlete string
+	c       chan Message
+}
+
+func (s *Subscription) C() <-chan Message {
+	return s.c
+}
+
+// Close stops the subscription; it is safe to call after the hub closed it
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	s.hub.removeLocked(s)
+}
+
+// ValidID reports whether id has the form of a message id
+func ValidID(id string) bool {
+	_, _, ok := parseID(id)
+	return ok
+}
+
+// After reports whether message id a comes after b; ids that do not parse
+// count as coming first
+func After(a, b string) bool {
+	ams, aseq, aok := parseID(a)
+	bms, bseq, bok := parseID(b)
+	switch {
+	case !aok:
+		return false
+	case !bok:
+		return true
+	case ams != bms:
+		return ams > bms
+	}
+	return aseq > bseq
+}
+
+func parseID(id string) (ms, seq uint64, ok bool) {
+	a, b, found := strings.Cut(id, "-")
+	if !found {
+		return 0, 0, false
+	}
+	ms, err := strconv.ParseUint(a, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	seq, err = strconv.ParseUint(b, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return ms, seq, true
+}
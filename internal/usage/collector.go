@@ -0,0 +1,208 @@
+package usage
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	authsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/logger"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/auth"
+)
+
+// LatencyBucketsMs are the upper bounds of the latency histogram; the last bucket is unbounded
+var LatencyBucketsMs = []float64{5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000, 30000}
+
+type rollupKey struct {
+	day    string
+	client string
+	group  string
+}
+
+type counters struct {
+	requests     int64
+	clientErrors int64
+	serverErrors int64
+	bytesIn      int64
+	bytesOut     int64
+	latencySumMs float64
+	buckets      []int64
+}
+
+func newCounters() *counters {
+	return &counters{buckets: make([]int64, len(LatencyBucketsMs)+1)}
+}
+
+func (c *counters) merge(o *counters) {
+	c.requests += o.requests
+	c.clientErrors += o.clientErrors
+	c.serverErrors += o.serverErrors
+	c.bytesIn += o.bytesIn
+	c.bytesOut += o.bytesOut
+	c.latencySumMs += o.latencySumMs
+	for i := range c.buckets {
+		c.buckets[i] += o.buckets[i]
+	}
+}
+
+// Collector aggregates requests in memory and periodically adds them to the daily rollup table
+type Collector struct {
+	store    auth.Usage
+	interval time.Duration
+
+	mu      sync.Mutex
+	pending map[rollupKey]*counters
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func NewCollector(store auth.Usage, interval time.Duration) *Collector {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	return &Collector{
+		store:    store,
+		interval: interval,
+		pending:  make(map[rollupKey]*counters),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Record adds a single request to the current rollup
+func (c *Collector) Record(client, group string, status int, latency time.Duration, bytesIn, bytesOut int64) {
+	if c == nil {
+		return
+	}
+
+	ms := float64(latency) / float64(time.Millisecond)
+	bucket := len(LatencyBucketsMs)
+	for i, upper := range LatencyBucketsMs {
+		if ms <= upper {
+			bucket = i
+			break
+		}
+	}
+
+	k := rollupKey{
+		day:    time.Now().UTC().Format("2006-01-02"),
+		client: client,
+		group:  group,
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cnt, ok := c.pending[k]
+	if !ok {
+		cnt = newCounters()
+		c.pending[k] = cnt
+	}
+	cnt.requests++
+	switch {
+	case status >= 500:
+		cnt.serverErrors++
+	case status >= 400:
+		cnt.clientErrors++
+	}
+	cnt.bytesIn += bytesIn
+	cnt.bytesOut += bytesOut
+	cnt.latencySumMs += ms
+	cnt.buckets[bucket]++
+}
+
+// Start flushes the rollups in the background until Stop is called
+func (c *Collector) Start() {
+	go func() {
+		defer close(c.done)
+
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				c.Flush(context.Background())
+			case <-c.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop ends the background loop and writes whatever is still pending
+func (c *Collector) Stop() {
+	if c == nil {
+		return
+	}
+	close(c.stop)
+	<-c.done
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	c.Flush(ctx)
+}
+
+// Flush writes the pending rollups; failed ones are kept for the next attempt
+func (c *Collector) Flush(ctx context.Context) {
+	c.mu.Lock()
+	batch := c.pending
+	c.pending = make(map[rollupKey]*counters)
+	c.mu.Unlock()
+
+	for k, cnt := range batch {
+		day, _ := time.Parse("2006-01-02", k.day)
+
+		err := c.store.UpsertUsageRollup(ctx, authsqlc.UpsertUsageRollupParams{
+			BucketDate:       day,
+			ClientName:       k.client,
+			RouteGroup:       k.group,
+			RequestCount:     cnt.requests,
+			ClientErrorCount: cnt.clientErrors,
+			ServerErrorCount: cnt.serverErrors,
+			BytesIn:          cnt.bytesIn,
+			BytesOut:         cnt.bytesOut,
+			LatencySumMs:     cnt.latencySumMs,
+			LatencyBuckets:   cnt.buckets,
+		})
+		if err == nil {
+			continue
+		}
+
+		logger.Logger.Warnw("failed to flush usage rollup", "client", k.client, "route_group", k.group, "error", err)
+
+		c.mu.Lock()
+		if cur, ok := c.pending[k]; ok {
+			cur.merge(cnt)
+		} else {
+			c.pending[k] = cnt
+		}
+		c.mu.Unlock()
+	}
+}
+
+// RouteGroup maps a chi route pattern to its data domain, e.g.
+// /v1/fis/racecc -> fis. It is given the pattern rather than the request path
+// so the groups stay the set of mounted routes; requests that matched no
+// route, or only a wildcard or parameter segment, count as unknown.
+func RouteGroup(pattern string) string {
+	if pattern == "" {
+		return "unknown"
+	}
+	pattern = strings.TrimPrefix(pattern, "/")
+	parts := strings.SplitN(pattern, "/", 3)
+
+	group := parts[0]
+	if len(parts) >= 2 && strings.HasPrefix(parts[0], "v") && parts[1] != "" {
+		group = parts[1]
+	}
+	switch {
+	case group == "":
+		return "root"
+	case strings.ContainsAny(group, "{*"):
+		return "unknown"
+	}
+	return group
+}
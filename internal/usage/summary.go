@@ -0,0 +1,105 @@
+package usage
+
+import (
+	"sort"
+
+	authsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/auth"
+)
+
+// Summary is the usage of one client on one route group over a date range
+type Summary struct {
+	ClientName   string  `json:"client_name"`
+	RouteGroup   string  `json:"route_group"`
+	Requests     int64   `json:"requests"`
+	ClientErrors int64   `json:"client_errors"`
+	ServerErrors int64   `json:"server_errors"`
+	ErrorRate    float64 `json:"error_rate"`
+	BytesIn      int64   `json:"bytes_in"`
+	BytesOut     int64   `json:"bytes_out"`
+	AvgMs        float64 `json:"avg_ms"`
+	P50Ms        float64 `json:"p50_ms"`
+	P95Ms        float64 `json:"p95_ms"`
+	P99Ms        float64 `json:"p99_ms"`
+}
+
+// Summarize folds the daily rollups into one row per client and route group
+func Summarize(rows []authsqlc.GetUsageRollupsRow) []Summary {
+	type groupKey struct{ client, group string }
+	totals := make(map[groupKey]*counters)
+
+	for _, r := range rows {
+		k := groupKey{r.ClientName, r.RouteGroup}
+		cnt, ok := totals[k]
+		if !ok {
+			cnt = newCounters()
+			totals[k] = cnt
+		}
+		cnt.requests += r.RequestCount
+		cnt.clientErrors += r.ClientErrorCount
+		cnt.serverErrors += r.ServerErrorCount
+		cnt.bytesIn += r.BytesIn
+		cnt.bytesOut += r.BytesOut
+		cnt.latencySumMs += r.LatencySumMs
+		for i := 0; i < len(cnt.buckets) && i < len(r.LatencyBuckets); i++ {
+			cnt.buckets[i] += r.LatencyBuckets[i]
+		}
+	}
+
+	out := make([]Summary, 0, len(totals))
+	for k, cnt := range totals {
+		s := Summary{
+			ClientName:   k.client,
+			RouteGroup:   k.group,
+			Requests:     cnt.requests,
+			ClientErrors: cnt.clientErrors,
+			ServerErrors: cnt.serverErrors,
+			BytesIn:      cnt.bytesIn,
+			BytesOut:     cnt.bytesOut,
+			P50Ms:        Percentile(cnt.buckets, 0.50),
+			P95Ms:        Percentile(cnt.buckets, 0.95),
+			P99Ms:        Percentile(cnt.buckets, 0.99),
+		}
+		if cnt.requests > 0 {
+			s.ErrorRate = float64(cnt.clientErrors+cnt.serverErrors) / float64(cnt.requests)
+			s.AvgMs = cnt.latencySumMs / float64(cnt.requests)
+		}
+		out = append(out, s)
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].ClientName != out[j].ClientName {
+			return out[i].ClientName < out[j].ClientName
+		}
+		return out[i].RouteGroup < out[j].RouteGroup
+	})
+	return out
+}
+
+// Percentile estimates the p-th latency percentile as the upper bound of the bucket that reaches it.
+// Requests slower than the last bound are reported at that bound.
+func Percentile(buckets []int64, p float64) float64 {
+	var total int64
+	for _, b := range buckets {
+		total += b
+	}
+	if total == 0 {
+		return 0
+	}
+
+	target := int64(float64(total)*p + 0.5)
+	if target < 1 {
+		target = 1
+	}
+
+	var seen int64
+	for i, b := range buckets {
+		seen += b
+		if seen >= target {
+			if i < len(LatencyBucketsMs) {
+				return LatencyBucketsMs[i]
+			}
+			break
+		}
+	}
+	return LatencyBucketsMs[len(LatencyBucketsMs)-1]
+}
@@ -0,0 +1,117 @@
+package utils
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
+	"github.com/go-playground/validator/v10"
+)
+
+// Bulk upload modes. Atomic (the default) commits the whole batch or nothing;
+// partial commits every valid item and reports the rest per item; async runs
+// a partial upload as a background job.
+const (
+	BulkModeParam   = "mode"
+	BulkModeAtomic  = "atomic"
+	BulkModePartial = "partial"
+	BulkModeAsync   = "async"
+)
+
+// ParseBulkMode reads ?mode=atomic|partial|async
+func ParseBulkMode(r *http.Request) (string, error) {
+	switch m := strings.ToLower(strings.TrimSpace(r.URL.Query().Get(BulkModeParam))); m {
+	case "", BulkModeAtomic:
+		return BulkModeAtomic, nil
+	case BulkModePartial, BulkModeAsync:
+		return m, nil
+	default:
+		return "", fmt.Errorf("invalid mode: must be one of atomic, partial, async")
+	}
+}
+
+type ItemStatus = apitypes.ItemStatus
+
+const (
+	ItemCreated ItemStatus = "created"
+	ItemUpdated ItemStatus = "updated"
+	ItemSkipped ItemStatus = "skipped"
+	ItemError   ItemStatus = "error"
+)
+
+// ItemResult is the outcome of one item of a partial bulk upload
+type ItemResult = apitypes.BulkItemResponse
+
+type BulkCounts = apitypes.BulkCounts
+
+// BulkResult is the response body of a partial bulk upload
+type BulkResult apitypes.BulkResultResponse
+
+// Add records an item outcome and bumps the matching counter
+func (b *BulkResult) Add(item ItemResult) {
+	switch item.Status {
+	case ItemCreated:
+		b.Counts.Created++
+	case ItemUpdated:
+		b.Counts.Updated++
+	case ItemSkipped:
+		b.Counts.Skipped++
+	case ItemError:
+		b.Counts.Errors++
+	}
+	b.Items = append(b.Items, item)
+}
+
+// ItemFailed builds the error outcome for an item rejected before or during the insert
+func ItemFailed(index int, id string, err error) ItemResult {
+	item := ItemResult{Index: index, ID: id, Status: ItemError}
+	if verrs, ok := err.(validator.ValidationErrors); ok {
+		item.Code = string(CodeValidationFailed)
+		item.Reason = "one or more fields are invalid"
+		item.Errors = fieldErrors(FormatValidationErrors(verrs))
+		return item
+	}
+	code, reason, errs := ClassifyDatabaseError(err)
+	item.Code, item.Reason, item.Errors = string(code), reason, errs
+	return item
+}
+
+// ItemOutcome is what a store reports for one item of a partial insert
+type ItemOutcome struct {
+	Status ItemStatus
+	Err    error
+}
+
+// WriteBulkResult answers a partial upload: 201 when every item went in, otherwise 207
+func WriteBulkResult(w http.ResponseWriter, res BulkResult) error {
+	sort.SliceStable(res.Items, func(i, j int) bool { return res.Items[i].Index < res.Items[j].Index })
+	if res.Items == nil {
+		res.Items = []ItemResult{}
+	}
+	status := http.StatusCreated
+	if res.Counts.Errors > 0 {
+		status = http.StatusMultiStatus
+	}
+	return WriteJSON(w, status, res)
+}
+
+// WithSavepoint runs fn inside a savepoint of tx. When fn fails only its work
+// is rolled back and its error comes back as itemErr; err is reserved for
+// failures that leave the transaction unusable.
+func WithSavepoint(ctx context.Context, tx *sql.Tx, fn func() error) (itemErr, err error) {
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT bulk_item"); err != nil {
+		return nil, err
+	}
+	if itemErr := fn(); itemErr != nil {
+		if _, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT bulk_item"); err != nil {
+			return nil, err
+		}
+		return itemErr, nil
+	}
+	_, err = tx.ExecContext(ctx, "RELEASE SAVEPOINT bulk_item")
+	return nil, err
+}
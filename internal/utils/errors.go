@@ -4,8 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/logger"
 	"github.com/go-chi/chi/v5/middleware"
@@ -236,6 +239,20 @@ func (e *InvalidFieldTypeError) Error() string {
 	return fmt.Sprintf("value must be %s", e.ExpectedType)
 }
 
+// ContractError is a request that breaks the OpenAPI contract. Query is set
+// when every offending field is a query parameter.
+type ContractError struct {
+	Query  bool
+	Fields []FieldError
+}
+
+func (e *ContractError) Error() string {
+	if e.Query {
+		return "one or more query parameters are invalid"
+	}
+	return "one or more fields are invalid"
+}
+
 func logError(r *http.Request, msg string, err error, status int) {
 	requestID := middleware.GetReqID(r.Context())
 	logger.Logger.Warnw(msg,
@@ -245,24 +262,28 @@ func logError(r *http.Request, msg string, err error, status int) {
 	)
 }
 
+func writeProblem(w http.ResponseWriter, r *http.Request, code ErrorCode, detail string) {
+	WriteProblem(w, NewProblem(r, code, detail))
+}
+
 // 500 Internal Server Error
 func InternalServerError(w http.ResponseWriter, r *http.Request, err error) {
 	if errors.Is(err, context.DeadlineExceeded) {
 		logError(r, "Database timeout", err, http.StatusGatewayTimeout)
-		WriteJSONError(w, http.StatusGatewayTimeout, map[string]string{"error": ErrQueryTimeOut.Error()})
+		writeProblem(w, r, CodeQueryTimeout, ErrQueryTimeOut.Error())
 		return
 	}
 
 	logError(r, "Internal server error", err, http.StatusInternalServerError)
-	WriteJSONError(w, http.StatusInternalServerError, map[string]string{"error": "the server encountered a problem"})
+	writeProblem(w, r, CodeInternalError, "the server encountered a problem")
 }
 
 // 400 Bad Request
 func BadRequestResponse(w http.ResponseWriter, r *http.Request, err error) {
-	if err != nil && strings.Contains(err.Error(), "request body too large") {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) || (err != nil && strings.Contains(err.Error(), "request body too large")) {
 		logError(r, "Request body too large", err, http.StatusRequestEntityTooLarge)
-		status := http.StatusRequestEntityTooLarge
-		WriteJSONError(w, status, map[string]string{"error": ErrRequestBodyTooLarge.Error()})
+		writeProblem(w, r, CodePayloadTooLarge, ErrRequestBodyTooLarge.Error())
 		return
 	}
 
@@ -270,152 +291,257 @@ func BadRequestResponse(w http.ResponseWriter, r *http.Request, err error) {
 
 	// Handle validator validation errors
 	if validationErrs, ok := err.(validator.ValidationErrors); ok {
-		formattedErrors := FormatValidationErrors(validationErrs)
-		WriteJSONError(w, http.StatusBadRequest, formattedErrors)
+		p := NewProblem(r, CodeValidationFailed, "one or more fields are invalid")
+		p.Errors = fieldErrors(FormatValidationErrors(validationErrs))
+		WriteProblem(w, p)
 		return
 	}
 
 	// Handle JSON decoding errors
-	if fieldErr, ok := err.(*InvalidFieldTypeError); ok {
-		WriteJSONError(w, http.StatusBadRequest, map[string]string{
-			toSnakeCase(fieldErr.Field): fieldErr.Error(),
-		})
+	var fieldErr *InvalidFieldTypeError
+	if errors.As(err, &fieldErr) {
+		p := NewProblem(r, CodeValidationFailed, "one or more fields are invalid")
+		p.Errors = []FieldError{{Field: toSnakeCase(fieldErr.Field), Message: fieldErr.Error()}}
+		WriteProblem(w, p)
+		return
+	}
+
+	var contractErr *ContractError
+	if errors.As(err, &contractErr) {
+		code := CodeValidationFailed
+		if contractErr.Query {
+			code = CodeInvalidQueryParameter
+		}
+		p := NewProblem(r, code, contractErr.Error())
+		p.Errors = contractErr.Fields
+		WriteProblem(w, p)
+		return
+	}
+
+	var paramsErr *InvalidParamsError
+	if errors.As(err, &paramsErr) {
+		p := NewProblem(r, CodeInvalidQueryParameter, err.Error())
+		for _, param := range paramsErr.Params {
+			p.Errors = append(p.Errors, FieldError{Field: param, Message: "unknown query parameter"})
+		}
+		WriteProblem(w, p)
 		return
 	}
 
-	WriteJSONError(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+	if errors.Is(err, ErrInvalidCursor) {
+		writeProblem(w, r, CodeInvalidCursor, err.Error())
+		return
+	}
+
+	writeProblem(w, r, CodeBadRequest, err.Error())
+}
+
+// ItemRejection is a client-facing reason an item of a bulk upload was rejected
+type ItemRejection struct {
+	Code ErrorCode
+	Err  error
+}
+
+func (e *ItemRejection) Error() string { return e.Err.Error() }
+func (e *ItemRejection) Unwrap() error { return e.Err }
+
+// RejectItem wraps err as a client error with the given code
+func RejectItem(code ErrorCode, err error) error {
+	return &ItemRejection{Code: code, Err: err}
+}
+
+// InvalidInputError marks a failed bulk insert as the fault of the data sent
+// rather than of the store. Index is the position of the offending item,
+// or -1 when the error is not about a single item.
+type InvalidInputError struct {
+	Index int
+	Err   error
+}
+
+func (e *InvalidInputError) Error() string {
+	if e.Index < 0 {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("item %d: %v", e.Index, e.Err)
+}
+
+func (e *InvalidInputError) Unwrap() error {
+	return e.Err
 }
 
 // 404 Not Found
 func NotFoundResponse(w http.ResponseWriter, r *http.Request, err error) {
 	logError(r, "Not found error", err, http.StatusNotFound)
-	WriteJSONError(w, http.StatusNotFound, map[string]string{"error": "Not found"})
+	writeProblem(w, r, CodeNotFound, "")
+}
+
+// 405 Method Not Allowed
+func MethodNotAllowedResponse(w http.ResponseWriter, r *http.Request) {
+	logError(r, "Method not allowed", fmt.Errorf("%s %s", r.Method, logger.RedactPath(r)), http.StatusMethodNotAllowed)
+	writeProblem(w, r, CodeMethodNotAllowed, "")
 }
 
 // 422 Unprocessable Entity
 func UnprocessableEntityResponse(w http.ResponseWriter, r *http.Request, err error) {
 	logError(r, "Unprocessable Entity", err, http.StatusUnprocessableEntity)
-	WriteJSONError(w, http.StatusUnprocessableEntity, map[string]string{"error": err.Error()})
+	writeProblem(w, r, CodeUnprocessableEntity, err.Error())
 }
 
 // 401 Unauthorized (JWT or client token)
 func UnauthorizedResponse(w http.ResponseWriter, r *http.Request, err error) {
 	logError(r, "Unauthorized", err, http.StatusUnauthorized)
-	WriteJSONError(w, http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	writeProblem(w, r, CodeUnauthorized, "")
 }
 
 // 401 Unauthorized (Basic Auth)
 func UnauthorizedBasicErrorResponse(w http.ResponseWriter, r *http.Request, err error) {
 	logError(r, "Unauthorized (Basic Auth)", err, http.StatusUnauthorized)
 	w.Header().Set("WWW-Authenticate", `Basic realm="restricted", charset="UTF-8"`)
-	WriteJSONError(w, http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	writeProblem(w, r, CodeUnauthorized, "")
 }
 
 // 403 Forbidden
 func ForbiddenResponse(w http.ResponseWriter, r *http.Request, err error) {
 	logError(r, "Forbidden", err, http.StatusForbidden)
-	WriteJSONError(w, http.StatusForbidden, map[string]string{"error": "forbidden"})
+	writeProblem(w, r, CodeForbidden, "")
 }
 
 // 409 Conflict
 func ConflictResponse(w http.ResponseWriter, r *http.Request, err error) {
 	logError(r, "Conflict", err, http.StatusConflict)
-	WriteJSONError(w, http.StatusConflict, map[string]string{"error": err.Error()})
+	writeProblem(w, r, CodeConflict, err.Error())
+}
+
+// 409 Conflict: the first request with this Idempotency-Key is still running
+func IdempotencyInProgressResponse(w http.ResponseWriter, r *http.Request, err error) {
+	logError(r, "Idempotent request in progress", err, http.StatusConflict)
+	w.Header().Set("Retry-After", "1")
+	writeProblem(w, r, CodeIdempotencyInProgress, err.Error())
+}
+
+// 422 Unprocessable Entity: an Idempotency-Key reused with a different request
+func IdempotencyKeyReusedResponse(w http.ResponseWriter, r *http.Request, err error) {
+	logError(r, "Idempotency key reused", err, http.StatusUnprocessableEntity)
+	writeProblem(w, r, CodeIdempotencyKeyReused, err.Error())
+}
+
+// 503 Service Unavailable: no room to queue another import job
+func JobQueueFullResponse(w http.ResponseWriter, r *http.Request, err error) {
+	logError(r, "Job queue full", err, http.StatusServiceUnavailable)
+	w.Header().Set("Retry-After", "30")
+	writeProblem(w, r, CodeJobQueueFull, err.Error())
 }
 
 // 503 Service Unavailable for a specific database
 func ServiceUnavailableDBResponse(w http.ResponseWriter, r *http.Request, dbName string) {
 	err := fmt.Errorf("%s database is unavailable", dbName)
 	logError(r, "Service unavailable", err, http.StatusServiceUnavailable)
-	WriteJSONError(w, http.StatusServiceUnavailable, map[string]string{
-		"error": err.Error(),
-	})
+	writeProblem(w, r, CodeDatabaseUnavailable, err.Error())
 }
 
+// jsonColumns are the JSON columns named in invalid-JSON database errors
+var jsonColumns = []string{"data", "test_event_template_test_limits", "raw_data", "additional_info", "additional_data"}
+
 // HandleDatabaseError analyzes database errors and returns appropriate HTTP responses - default 500 Internal Server Error
 func HandleDatabaseError(w http.ResponseWriter, r *http.Request, err error) {
+	code, detail, fields := ClassifyDatabaseError(err)
+	switch code {
+	case CodeInternalError:
+		InternalServerError(w, r, err)
+		return
+	case CodeQueryTimeout:
+		InternalServerError(w, r, ErrQueryTimeOut)
+		return
+	}
+
+	logError(r, "Database error", err, code.Status())
+	p := NewProblem(r, code, detail)
+	p.Errors = fields
+	WriteProblem(w, p)
+}
+
+// ClassifyDatabaseError maps a database error to a problem code, a client-safe
+// detail and, where the offending column is known, a field error
+func ClassifyDatabaseError(err error) (ErrorCode, string, []FieldError) {
 	// Check if it's a PostgreSQL error
 	if pqErr, ok := err.(*pq.Error); ok {
 		switch pqErr.Code {
 		case "22P02": // invalid_text_representation (includes JSON syntax errors)
 			if strings.Contains(pqErr.Message, "json") || strings.Contains(pqErr.Message, "JSON") {
 				// Try to identify which field has the JSON error
-				if strings.Contains(pqErr.Message, "data") {
-					BadRequestResponse(w, r, errors.New("invalid JSON format in 'data' field - check for missing braces, quotes, or commas"))
-				} else if strings.Contains(pqErr.Message, "test_event_template_test_limits") {
-					BadRequestResponse(w, r, errors.New("invalid JSON format in 'test_event_template_test_limits' field - check for missing braces, quotes, or commas"))
-				} else if strings.Contains(pqErr.Message, "raw_data") {
-					BadRequestResponse(w, r, errors.New("invalid JSON format in 'raw_data' field - check for missing braces, quotes, or commas"))
-				} else if strings.Contains(pqErr.Message, "additional_info") {
-					BadRequestResponse(w, r, errors.New("invalid JSON format in 'additional_info' field - check for missing braces, quotes, or commas"))
-				} else if strings.Contains(pqErr.Message, "additional_data") {
-					BadRequestResponse(w, r, errors.New("invalid JSON format in 'additional_data' field - check for missing braces, quotes, or commas"))
-				} else {
-					BadRequestResponse(w, r, errors.New("invalid JSON format in one of the JSON fields - check for missing braces, quotes, or commas"))
+				for _, col := range jsonColumns {
+					if strings.Contains(pqErr.Message, col) {
+						return CodeInvalidJSON,
+							fmt.Sprintf("invalid JSON format in '%s' field - check for missing braces, quotes, or commas", col),
+							[]FieldError{{Field: col, Message: "invalid JSON"}}
+					}
 				}
-				return
+				return CodeInvalidJSON, "invalid JSON format in one of the JSON fields - check for missing braces, quotes, or commas", nil
 			}
-			BadRequestResponse(w, r, fmt.Errorf("invalid data format: %s", pqErr.Message))
-			return
+			return CodeInvalidDataFormat, fmt.Sprintf("invalid data format: %s", pqErr.Message), nil
 		case "23503": // foreign_key_violation
 			if strings.Contains(pqErr.Message, "user_id") || strings.Contains(pqErr.Detail, "user_id") {
 				// Try to extract the specific user_id from the error detail
 				if pqErr.Detail != "" {
-					BadRequestResponse(w, r, fmt.Errorf("user does not exist. Details: %s", pqErr.Detail))
-				} else {
-					BadRequestResponse(w, r, ErrUserNotFound)
+					return CodeReferenceNotFound, fmt.Sprintf("user does not exist. Details: %s", pqErr.Detail), nil
 				}
-				return
+				return CodeReferenceNotFound, ErrUserNotFound.Error(), nil
 			} else if strings.Contains(pqErr.Message, "exercise_id") || strings.Contains(pqErr.Detail, "exercise_id") {
 				// This usually means the main exercise insert was skipped due to conflict
 				if pqErr.Detail != "" {
-					BadRequestResponse(w, r, fmt.Errorf("exercise insert was skipped due to a conflict (duplicated raw_id, exercise_id, user_id for example), Details: %s", pqErr.Detail))
-				} else {
-					BadRequestResponse(w, r, ErrInvalidExerciseData)
+					return CodeReferenceNotFound, fmt.Sprintf("exercise insert was skipped due to a conflict (duplicated raw_id, exercise_id, user_id for example), Details: %s", pqErr.Detail), nil
 				}
-				return
+				return CodeReferenceNotFound, ErrInvalidExerciseData.Error(), nil
 			}
 			// Generic foreign key violation with details if available
 			if pqErr.Detail != "" {
-				BadRequestResponse(w, r, fmt.Errorf("referenced record does not exist. Details: %s", pqErr.Detail))
-			} else {
-				BadRequestResponse(w, r, ErrForeignKeyViolation)
+				return CodeReferenceNotFound, fmt.Sprintf("referenced record does not exist. Details: %s", pqErr.Detail), nil
 			}
-			return
+			return CodeReferenceNotFound, ErrForeignKeyViolation.Error(), nil
 		case "23505": // unique_violation
 			if pqErr.Detail != "" {
-				ConflictResponse(w, r, fmt.Errorf("record already exists. Details: %s", pqErr.Detail))
-			} else {
-				ConflictResponse(w, r, errors.New("record already exists"))
+				return CodeDuplicateRecord, fmt.Sprintf("record already exists. Details: %s", pqErr.Detail), nil
 			}
-			return
+			return CodeDuplicateRecord, "record already exists", nil
 		case "23514": // check_violation
 			if pqErr.Detail != "" {
-				BadRequestResponse(w, r, fmt.Errorf("data violates database constraints. Details: %s", pqErr.Detail))
-			} else {
-				BadRequestResponse(w, r, errors.New("data violates database constraints"))
+				return CodeConstraintViolation, fmt.Sprintf("data violates database constraints. Details: %s", pqErr.Detail), nil
 			}
-			return
+			return CodeConstraintViolation, "data violates database constraints", nil
 		}
 	}
 
 	// Check for context timeout
 	if errors.Is(err, context.DeadlineExceeded) {
-		InternalServerError(w, r, ErrQueryTimeOut)
-		return
+		return CodeQueryTimeout, ErrQueryTimeOut.Error(), nil
+	}
+
+	// Errors raised by the API itself (parsing, missing references) are safe to show
+	var fieldErr *InvalidFieldTypeError
+	if errors.As(err, &fieldErr) {
+		return CodeValidationFailed, "one or more fields are invalid",
+			[]FieldError{{Field: toSnakeCase(fieldErr.Field), Message: fieldErr.Error()}}
+	}
+	var rejected *ItemRejection
+	if errors.As(err, &rejected) {
+		return rejected.Code, rejected.Error(), nil
+	}
+	if errors.Is(err, ErrInvalidCursor) {
+		return CodeInvalidCursor, err.Error(), nil
 	}
 
 	// Default to internal server error
-	InternalServerError(w, r, err)
+	return CodeInternalError, "the server encountered a problem", nil
 }
 
-// 429 Too Many Requests
-func RateLimitExceededResponse(w http.ResponseWriter, r *http.Request, retryAfter string) {
+// 429 Too Many Requests. Retry-After is sent in whole seconds, rounded up,
+// as RFC 9110 requires.
+func RateLimitExceededResponse(w http.ResponseWriter, r *http.Request, wait time.Duration) {
 	logError(r, "Rate limit", errors.New("rate limit exceeded"), http.StatusTooManyRequests)
+	retryAfter := strconv.Itoa(max(1, int(math.Ceil(wait.Seconds()))))
 	w.Header().Set("Retry-After", retryAfter)
 
-	WriteJSONError(w, http.StatusTooManyRequests, map[string]string{
-		"error":       "rate limit exceeded",
-		"retry_after": retryAfter,
-	})
+	p := NewProblem(r, CodeRateLimited, "rate limit exceeded")
+	p.RetryAfter = retryAfter
+	WriteProblem(w, p)
 }
@@ -1,11 +1,14 @@
 package utils
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 )
 
 func WriteJSON(w http.ResponseWriter, status int, data any) error {
@@ -28,59 +31,208 @@ func goTypeToFriendlyType(goType string) string {
 	}
 }
 
-func ReadJSON(w http.ResponseWriter, r *http.Request, data any) error {
-	if r.Header.Get("X-Was-Gzipped") != "true" {
+type decompressedKey struct{}
+
+// MarkDecompressed flags the request body as decoded by the decompression
+// middleware, which already caps its size
+func MarkDecompressed(r *http.Request) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), decompressedKey{}, true))
+}
+
+// LimitBody caps plain request bodies at 50 MB; decompressed ones have their own, larger cap
+func LimitBody(w http.ResponseWriter, r *http.Request) {
+	if decompressed, _ := r.Context().Value(decompressedKey{}).(bool); !decompressed {
 		maxBytes := int64(50 * 1024 * 1024) // 50MB for regular content only
 		r.Body = http.MaxBytesReader(w, r.Body, maxBytes)
 	}
+}
+
+func ReadJSON(w http.ResponseWriter, r *http.Request, data any) error {
+	LimitBody(w, r)
 
 	decoder := json.NewDecoder(r.Body)
 	decoder.DisallowUnknownFields()
 
-	if err := decoder.Decode(data); err != nil {
-		switch e := err.(type) {
-		case *json.UnmarshalTypeError:
-			return &InvalidFieldTypeError{
-				Field:        e.Field,
-				ExpectedType: goTypeToFriendlyType(e.Type.Name()),
-				ActualType:   e.Value,
-			}
+	return jsonError(decoder.Decode(data))
+}
 
-		case *json.InvalidUnmarshalError:
-			return ErrInvalidValue
-		default:
-			if errors.Is(err, io.EOF) {
-				return fmt.Errorf("request body is required")
-			}
+// ReadJSONObject reads a JSON object from the request body into data like
+// ReadJSON, except that the values under the keys of stream are handed to
+// their function as they arrive instead of being decoded into data. Use it
+// for objects whose bulk is one or two large arrays. read decodes the other
+// keys read so far into a value like data, for streams that depend on them.
+func ReadJSONObject(w http.ResponseWriter, r *http.Request, data any, stream map[string]func(s *JSONReader, read func(v any) error) error) error {
+	LimitBody(w, r)
+
+	s := NewJSONReader(r.Body)
+	rest := map[string]json.RawMessage{}
+	read := func(v any) error { return decodeFields(rest, v) }
+	err := s.Object(func(key string) error {
+		if fn, ok := stream[key]; ok {
+			return fn(s, read)
+		}
+		var raw json.RawMessage
+		if err := s.Decode(&raw); err != nil {
 			return err
 		}
+		rest[key] = raw
+		return nil
+	})
+	if err != nil {
+		return err
 	}
+	return read(data)
+}
 
-	return nil
+// decodeFields decodes the fields of an object into data, rejecting unknown ones
+func decodeFields(fields map[string]json.RawMessage, data any) error {
+	buf, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(buf))
+	decoder.DisallowUnknownFields()
+	return jsonError(decoder.Decode(data))
 }
 
-func WriteJSONError(w http.ResponseWriter, statusCode int, message interface{}) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCode)
-
-	switch msg := message.(type) {
-	case string:
-		_ = json.NewEncoder(w).Encode(map[string]interface{}{
-			"errors": []map[string]string{
-				{"error": msg},
-			},
-		})
-	case map[string]string:
-		var errorList []map[string]string
-		for key, val := range msg {
-			errorList = append(errorList, map[string]string{key: val})
+// jsonError turns decoder errors into the API's error types
+func jsonError(err error) error {
+	switch e := err.(type) {
+	case nil:
+		return nil
+	case *json.UnmarshalTypeError:
+		return &InvalidFieldTypeError{
+			Field:        e.Field,
+			ExpectedType: goTypeToFriendlyType(e.Type.Name()),
+			ActualType:   e.Value,
 		}
-		_ = json.NewEncoder(w).Encode(map[string]interface{}{"errors": errorList})
+
+	case *json.InvalidUnmarshalError:
+		return ErrInvalidValue
 	default:
-		_ = json.NewEncoder(w).Encode(map[string]interface{}{
-			"errors": []map[string]string{
-				{"error": "An unexpected error occurred"},
-			},
-		})
+		if errors.Is(err, io.EOF) {
+			return fmt.Errorf("request body is required")
+		}
+		return err
+	}
+}
+
+// JSONReader decodes a JSON document piece by piece, so only the value being
+// decoded has to be in memory. Objects and arrays are walked with Object and
+// Array; their members are read with Decode, DecodeItem or a nested walk.
+type JSONReader struct {
+	dec *json.Decoder
+	key string
+}
+
+func NewJSONReader(r io.Reader) *JSONReader {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	return &JSONReader{dec: dec}
+}
+
+// Object walks the next value, an object or null, calling fn with each key;
+// fn must consume the key's value
+func (s *JSONReader) Object(fn func(key string) error) error {
+	ok, err := s.open('{', "an object")
+	if err != nil || !ok {
+		return err
+	}
+	for s.dec.More() {
+		tok, err := s.dec.Token()
+		if err != nil {
+			return jsonError(err)
+		}
+		s.key = tok.(string)
+		if err := fn(s.key); err != nil {
+			return err
+		}
+	}
+	return s.close()
+}
+
+// Array walks the next value, an array or null, calling fn with the index of
+// each element; fn must consume the element
+func (s *JSONReader) Array(fn func(i int) error) error {
+	ok, err := s.open('[', "an array")
+	if err != nil || !ok {
+		return err
+	}
+	for i := 0; s.dec.More(); i++ {
+		if err := fn(i); err != nil {
+			return err
+		}
 	}
+	return s.close()
+}
+
+// Decode reads the next value into v
+func (s *JSONReader) Decode(v any) error {
+	return jsonError(s.dec.Decode(v))
+}
+
+// DecodeItem reads the next value into v. A value of the wrong shape is
+// consumed and reported as itemErr, so the stream can go on with the next
+// one; err means the JSON itself is broken.
+func (s *JSONReader) DecodeItem(v any) (itemErr, err error) {
+	err = s.dec.Decode(v)
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case err == nil:
+		return nil, nil
+	case errors.As(err, &typeErr):
+		return jsonError(typeErr), nil
+	case strings.HasPrefix(err.Error(), "json: unknown field"):
+		return RejectItem(CodeInvalidJSON, err), nil
+	default:
+		return nil, jsonError(err)
+	}
+}
+
+// UnknownField rejects a key the stream does not expect, as DisallowUnknownFields would
+func UnknownField(key string) error {
+	return fmt.Errorf("json: unknown field %q", key)
+}
+
+func (s *JSONReader) open(want json.Delim, kind string) (bool, error) {
+	tok, err := s.dec.Token()
+	if err != nil {
+		return false, jsonError(err)
+	}
+	if tok == nil {
+		return false, nil
+	}
+	if d, ok := tok.(json.Delim); ok && d == want {
+		return true, nil
+	}
+	return false, &InvalidFieldTypeError{Field: s.key, ExpectedType: kind, ActualType: fmt.Sprint(tok)}
+}
+
+func (s *JSONReader) close() error {
+	_, err := s.dec.Token()
+	return jsonError(err)
+}
+
+// StreamJSONArray decodes the array under field of a JSON object one element
+// at a time. fn gets each element with its index; an element of the wrong
+// shape comes with itemErr set instead of stopping the stream. Malformed
+// JSON, other top-level fields and errors from fn end the stream.
+func StreamJSONArray[T any](r io.Reader, field string, fn func(i int, item T, itemErr error) error) error {
+	s := NewJSONReader(r)
+	i := 0
+	return s.Object(func(key string) error {
+		if key != field {
+			return UnknownField(key)
+		}
+		return s.Array(func(int) error {
+			var item T
+			itemErr, err := s.DecodeItem(&item)
+			if err != nil {
+				return err
+			}
+			err = fn(i, item, itemErr)
+			i++
+			return err
+		})
+	})
 }
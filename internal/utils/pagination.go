@@ -0,0 +1,252 @@
+package utils
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Page size limits for cursor-paginated list endpoints
+const (
+	DefaultPageLimit int32 = 100
+	MaxPageLimit     int32 = 1000
+)
+
+// Query parameters accepted by every paginated list endpoint
+var PageParams = []string{"limit", "cursor"}
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor is the ordering key of the last row of a page. Each store fills the
+// fields that make up its own sort key; clients only ever see the encoded form.
+type Cursor struct {
+	Time    *time.Time `json:"t,omitempty"`  // primary sort column
+	Created *time.Time `json:"c,omitempty"`  // secondary sort column
+	ID      string     `json:"id,omitempty"` // unique tie-breaker (uuid)
+	Key     string     `json:"k,omitempty"`  // extra tie-breaker for composite keys
+	N       int64      `json:"n,omitempty"`  // unique tie-breaker (integer id)
+}
+
+// Page is a parsed pagination request. A zero Page means "no pagination"
+// so existing clients keep receiving the full list.
+type Page struct {
+	Limit  int32
+	Cursor *Cursor
+	raw    string
+}
+
+// Paged reports whether the client asked for a page
+func (p Page) Paged() bool {
+	return p.Limit > 0
+}
+
+// FetchLimit is the LIMIT passed to the store query: one row more than the
+// page so the store can tell whether a next page exists. NULL means no limit.
+func (p Page) FetchLimit() sql.NullInt32 {
+	if !p.Paged() {
+		return sql.NullInt32{}
+	}
+	return sql.NullInt32{Int32: p.Limit + 1, Valid: true}
+}
+
+// CacheKey returns the suffix that distinguishes pages in cache keys
+func (p Page) CacheKey() string {
+	if !p.Paged() {
+		return ""
+	}
+	return fmt.Sprintf(":page=%d:%s", p.Limit, p.raw)
+}
+
+// ParsePage reads the limit and cursor query parameters
+func ParsePage(r *http.Request) (Page, error) {
+	q := r.URL.Query()
+	limitStr := strings.TrimSpace(q.Get("limit"))
+	cursorStr := strings.TrimSpace(q.Get("cursor"))
+
+	if limitStr == "" && cursorStr == "" {
+		return Page{}, nil
+	}
+
+	limit := DefaultPageLimit
+	if limitStr != "" {
+		n, err := strconv.ParseInt(limitStr, 10, 32)
+		if err != nil || n <= 0 {
+			return Page{}, fmt.Errorf("invalid limit: must be a positive integer")
+		}
+		limit = int32(n)
+	}
+	return NewPage(limit, cursorStr)
+}
+
+// NewPage builds a page from an already parsed limit and a cursor ("" for
+// the first page)
+func NewPage(limit int32, cursor string) (Page, error) {
+	if limit <= 0 {
+		return Page{}, fmt.Errorf("invalid limit: must be a positive integer")
+	}
+	if limit > MaxPageLimit {
+		return Page{}, fmt.Errorf("invalid limit: must not exceed %d", MaxPageLimit)
+	}
+
+	p := Page{Limit: limit}
+	if cursor != "" {
+		c, err := DecodeCursor(cursor)
+		if err != nil {
+			return Page{}, err
+		}
+		p.Cursor = c
+		p.raw = cursor
+	}
+	return p, nil
+}
+
+// EncodeCursor turns a cursor into the opaque string handed to clients
+func EncodeCursor(c Cursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeCursor parses a cursor previously produced by EncodeCursor
+func DecodeCursor(s string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var c Cursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if c.ID != "" {
+		if _, err := uuid.Parse(c.ID); err != nil {
+			return nil, ErrInvalidCursor
+		}
+	}
+	return &c, nil
+}
+
+// NextPage drops the look-ahead row fetched by FetchLimit and returns the
+// cursor for the following page ("" when this is the last page)
+func NextPage[T any](p Page, items []T, key func(T) Cursor) ([]T, string) {
+	if !p.Paged() || len(items) <= int(p.Limit) {
+		return items, ""
+	}
+	items = items[:p.Limit]
+	return items, EncodeCursor(key(items[len(items)-1]))
+}
+
+// AddPageInfo adds next_cursor to a paged list response
+func AddPageInfo(body map[string]any, p Page, next string) {
+	if !p.Paged() {
+		return
+	}
+	if next == "" {
+		body["next_cursor"] = nil
+		return
+	}
+	body["next_cursor"] = next
+}
+
+// SetNextLink advertises the next page in a Link header (RFC 8288)
+func SetNextLink(w http.ResponseWriter, r *http.Request, next string) {
+	if next == "" {
+		return
+	}
+	u := *PublicURL(r)
+	q := u.Query()
+	q.Set("cursor", next)
+	u.RawQuery = q.Encode()
+	w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="next"`, u.RequestURI()))
+}
+
+// CachedNextCursor extracts next_cursor from a cached list response
+func CachedNextCursor(raw string) string {
+	var body struct {
+		NextCursor *string `json:"next_cursor"`
+	}
+	if err := json.Unmarshal([]byte(raw), &body); err != nil || body.NextCursor == nil {
+		return ""
+	}
+	return *body.NextCursor
+}
+
+// TimePtr returns a pointer to t, for building cursors
+func TimePtr(t time.Time) *time.Time {
+	return &t
+}
+
+// Cursor accessors used by stores to fill keyset query parameters
+
+func (p Page) AfterTime() sql.NullTime {
+	if p.Cursor == nil || p.Cursor.Time == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *p.Cursor.Time, Valid: true}
+}
+
+func (p Page) AfterCreated() sql.NullTime {
+	if p.Cursor == nil || p.Cursor.Created == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *p.Cursor.Created, Valid: true}
+}
+
+// AfterID returns the uuid tie-breaker of the cursor. A cursor whose id is
+// not a uuid is ErrInvalidCursor rather than a restart from the first row.
+func (p Page) AfterID() (uuid.NullUUID, error) {
+	if p.Cursor == nil || p.Cursor.ID == "" {
+		return uuid.NullUUID{}, nil
+	}
+	return cursorUUID(p.Cursor.ID)
+}
+
+func (p Page) AfterKey() sql.NullString {
+	if p.Cursor == nil || p.Cursor.Key == "" {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: p.Cursor.Key, Valid: true}
+}
+
+// AfterKeyUUID is AfterID for stores whose extra tie-breaker is a uuid
+func (p Page) AfterKeyUUID() (uuid.NullUUID, error) {
+	if p.Cursor == nil || p.Cursor.Key == "" {
+		return uuid.NullUUID{}, nil
+	}
+	return cursorUUID(p.Cursor.Key)
+}
+
+func cursorUUID(v string) (uuid.NullUUID, error) {
+	id, err := uuid.Parse(v)
+	if err != nil {
+		return uuid.NullUUID{}, ErrInvalidCursor
+	}
+	return uuid.NullUUID{UUID: id, Valid: true}, nil
+}
+
+// AfterN returns the integer tie-breaker of the cursor, 0 when starting from the first row
+func (p Page) AfterN() int32 {
+	if p.Cursor == nil {
+		return 0
+	}
+	return int32(p.Cursor.N)
+}
+
+// AfterSeq returns the integer tie-breaker as a nullable value for keyset queries
+func (p Page) AfterSeq() sql.NullInt64 {
+	if p.Cursor == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: p.Cursor.N, Valid: true}
+}
+
+// LimitOrZero is FetchLimit for queries that treat 0 as "no limit"
+func (p Page) LimitOrZero() int32 {
+	return p.FetchLimit().Int32
+}
@@ -0,0 +1,129 @@
+package utils
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// Error responses follow RFC 7807 (application/problem+json). Clients should
+// branch on code (or type, which is derived from it), never on detail.
+
+const ProblemContentType = "application/problem+json"
+
+// ProblemTypeBase prefixes every problem type URI
+const ProblemTypeBase = "urn:kuha:problem:"
+
+// ErrorCode is the stable, machine-readable identifier of a problem
+type ErrorCode string
+
+const (
+	CodeBadRequest            ErrorCode = "bad_request"
+	CodeValidationFailed      ErrorCode = "validation_failed"
+	CodeInvalidQueryParameter ErrorCode = "invalid_query_parameter"
+	CodeInvalidCursor         ErrorCode = "invalid_cursor"
+	CodeInvalidJSON           ErrorCode = "invalid_json"
+	CodeInvalidDataFormat     ErrorCode = "invalid_data_format"
+	CodeReferenceNotFound     ErrorCode = "reference_not_found"
+	CodeConstraintViolation   ErrorCode = "constraint_violation"
+	CodeUnauthorized          ErrorCode = "unauthorized"
+	CodeForbidden             ErrorCode = "forbidden"
+	CodeNotFound              ErrorCode = "not_found"
+	CodeMethodNotAllowed      ErrorCode = "method_not_allowed"
+	CodeConflict              ErrorCode = "conflict"
+	CodeDuplicateRecord       ErrorCode = "duplicate_record"
+	CodeIdempotencyInProgress ErrorCode = "idempotency_key_in_progress"
+	CodeIdempotencyKeyReused  ErrorCode = "idempotency_key_reused"
+	CodePayloadTooLarge       ErrorCode = "payload_too_large"
+	CodeUnprocessableEntity   ErrorCode = "unprocessable_entity"
+	CodeRateLimited           ErrorCode = "rate_limited"
+	CodeInternalError         ErrorCode = "internal_error"
+	CodeDatabaseUnavailable   ErrorCode = "database_unavailable"
+	CodeQueryTimeout          ErrorCode = "query_timeout"
+	CodeJobQueueFull          ErrorCode = "job_queue_full"
+)
+
+type problemType struct {
+	status int
+	title  string
+}
+
+var problemTypes = map[ErrorCode]problemType{
+	CodeBadRequest:            {http.StatusBadRequest, "Bad request"},
+	CodeValidationFailed:      {http.StatusBadRequest, "Validation failed"},
+	CodeInvalidQueryParameter: {http.StatusBadRequest, "Invalid query parameter"},
+	CodeInvalidCursor:         {http.StatusBadRequest, "Invalid cursor"},
+	CodeInvalidJSON:           {http.StatusBadRequest, "Invalid JSON"},
+	CodeInvalidDataFormat:     {http.StatusBadRequest, "Invalid data format"},
+	CodeReferenceNotFound:     {http.StatusBadRequest, "Referenced record does not exist"},
+	CodeConstraintViolation:   {http.StatusBadRequest, "Constraint violation"},
+	CodeUnauthorized:          {http.StatusUnauthorized, "Unauthorized"},
+	CodeForbidden:             {http.StatusForbidden, "Forbidden"},
+	CodeNotFound:              {http.StatusNotFound, "Not found"},
+	CodeMethodNotAllowed:      {http.StatusMethodNotAllowed, "Method not allowed"},
+	CodeConflict:              {http.StatusConflict, "Conflict"},
+	CodeDuplicateRecord:       {http.StatusConflict, "Record already exists"},
+	CodeIdempotencyInProgress: {http.StatusConflict, "Idempotent request in progress"},
+	CodeIdempotencyKeyReused:  {http.StatusUnprocessableEntity, "Idempotency key reused"},
+	CodePayloadTooLarge:       {http.StatusRequestEntityTooLarge, "Request body too large"},
+	CodeUnprocessableEntity:   {http.StatusUnprocessableEntity, "Unprocessable entity"},
+	CodeRateLimited:           {http.StatusTooManyRequests, "Rate limit exceeded"},
+	CodeInternalError:         {http.StatusInternalServerError, "Internal server error"},
+	CodeDatabaseUnavailable:   {http.StatusServiceUnavailable, "Database unavailable"},
+	CodeQueryTimeout:          {http.StatusGatewayTimeout, "Database query timed out"},
+	CodeJobQueueFull:          {http.StatusServiceUnavailable, "Import queue full"},
+}
+
+// Status returns the HTTP status that goes with the code
+func (c ErrorCode) Status() int {
+	if t, ok := problemTypes[c]; ok {
+		return t.status
+	}
+	return http.StatusInternalServerError
+}
+
+// Problem is an RFC 7807 problem details object with the API's extension members
+type Problem = apitypes.Problem
+
+// FieldError is one field-level validation failure
+type FieldError = apitypes.FieldError
+
+// NewProblem fills in type, title, status, instance and request ID for code
+func NewProblem(r *http.Request, code ErrorCode, detail string) *Problem {
+	t, ok := problemTypes[code]
+	if !ok {
+		code, t = CodeInternalError, problemTypes[CodeInternalError]
+	}
+	p := &Problem{
+		Type:   ProblemTypeBase + string(code),
+		Title:  t.title,
+		Status: t.status,
+		Detail: detail,
+		Code:   string(code),
+	}
+	if r != nil {
+		p.Instance = PublicURL(r).Path
+		p.RequestID = middleware.GetReqID(r.Context())
+	}
+	return p
+}
+
+// WriteProblem sends p with the problem+json media type
+func WriteProblem(w http.ResponseWriter, p *Problem) {
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(p.Status)
+	_ = json.NewEncoder(w).Encode(p)
+}
+
+// fieldErrors turns a field → message map into a list sorted by field
+func fieldErrors(m map[string]string) []FieldError {
+	out := make([]FieldError, 0, len(m))
+	for field, msg := range m {
+		out = append(out, FieldError{Field: field, Message: msg})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Field < out[j].Field })
+	return out
+}
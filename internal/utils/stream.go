@@ -0,0 +1,153 @@
+package utils
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// StreamParam asks a list endpoint to stream its full result instead of building it in memory
+const StreamParam = "stream"
+
+// rows between flushes of a streamed JSON response
+const streamFlushEvery = 500
+
+// StreamWriteTimeout is how long a streamed response may wait on the client.
+// Streams are exempt from the request timeout, so this is what ends an
+// export nobody reads any more.
+const StreamWriteTimeout = 30 * time.Second
+
+// WriteDeadline pushes the connection's write deadline ahead while a stream
+// makes progress, so a long export outlives the server's WriteTimeout but a
+// stalled client is still noticed
+type WriteDeadline struct {
+	rc *http.ResponseController
+	at time.Time
+}
+
+func NewWriteDeadline(rc *http.ResponseController) *WriteDeadline {
+	return &WriteDeadline{rc: rc}
+}
+
+// Extend is called before each write; the deadline is only moved once half
+// of it has passed, which keeps the per-row cost negligible
+func (d *WriteDeadline) Extend() {
+	now := time.Now()
+	if d.at.Sub(now) > StreamWriteTimeout/2 {
+		return
+	}
+	d.at = now.Add(StreamWriteTimeout)
+	// not every writer in the chain supports deadlines
+	_ = d.rc.SetWriteDeadline(d.at)
+}
+
+// ExtendReads wraps body so the connection's read deadline moves ahead
+// while the client keeps sending, like WriteDeadline does for writes: a
+// large upload outlives the server's ReadTimeout but a stalled one does not
+func ExtendReads(rc *http.ResponseController, body io.Reader) io.Reader {
+	return &deadlineReader{rc: rc, r: body}
+}
+
+type deadlineReader struct {
+	rc *http.ResponseController
+	r  io.Reader
+	at time.Time
+}
+
+func (d *deadlineReader) Read(p []byte) (int, error) {
+	if now := time.Now(); d.at.Sub(now) <= StreamWriteTimeout/2 {
+		d.at = now.Add(StreamWriteTimeout)
+		_ = d.rc.SetReadDeadline(d.at)
+	}
+	return d.r.Read(p)
+}
+
+// WantsStream reports whether the client set stream=true
+func WantsStream(r *http.Request) bool {
+	v, err := strconv.ParseBool(strings.TrimSpace(r.URL.Query().Get(StreamParam)))
+	return err == nil && v
+}
+
+// JSONStream writes {"<key>":[item,item,...]} one item at a time, flushing as it
+// goes, so the response keeps the shape of the buffered endpoint
+type JSONStream struct {
+	w       http.ResponseWriter
+	rc      *http.ResponseController
+	dl      *WriteDeadline
+	bw      *bufio.Writer
+	enc     *json.Encoder
+	key     string
+	n       int
+	started bool
+}
+
+func NewJSONStream(w http.ResponseWriter, key string) *JSONStream {
+	bw := bufio.NewWriter(w)
+	rc := http.NewResponseController(w)
+	return &JSONStream{
+		w:   w,
+		rc:  rc,
+		dl:  NewWriteDeadline(rc),
+		bw:  bw,
+		enc: json.NewEncoder(bw),
+		key: key,
+	}
+}
+
+func (s *JSONStream) start() {
+	if s.started {
+		return
+	}
+	s.started = true
+	s.w.Header().Set("Content-Type", "application/json")
+	s.w.WriteHeader(http.StatusOK)
+	k, _ := json.Marshal(s.key)
+	s.bw.WriteByte('{')
+	s.bw.Write(k)
+	s.bw.WriteString(":[")
+}
+
+// Write appends one item to the array
+func (s *JSONStream) Write(v any) error {
+	s.dl.Extend()
+	s.start()
+	if s.n > 0 {
+		s.bw.WriteByte(',')
+	}
+	if err := s.enc.Encode(v); err != nil {
+		return err
+	}
+	s.n++
+	if s.n%streamFlushEvery == 0 {
+		if err := s.bw.Flush(); err != nil {
+			return err
+		}
+		_ = s.rc.Flush()
+	}
+	return nil
+}
+
+// Close terminates the array and the object; an empty stream yields {"<key>":[]}
+func (s *JSONStream) Close() error {
+	s.dl.Extend()
+	s.start()
+	s.bw.WriteString("]}\n")
+	return s.bw.Flush()
+}
+
+// Started reports whether the status line has been sent; errors after that
+// can no longer be turned into an error response
+func (s *JSONStream) Started() bool {
+	return s.started
+}
+
+// AbortStream logs an error that happened after a streamed response started and
+// drops the connection, so the client sees a truncated body rather than a complete-looking one
+func AbortStream(r *http.Request, err error) {
+	logError(r, "Stream aborted", err, http.StatusInternalServerError)
+	panic(http.ErrAbortHandler)
+}
@@ -1,11 +1,14 @@
 package utils
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -20,6 +23,10 @@ import (
 // query timeout duration
 const QueryTimeout = 30 * time.Second
 
+// StreamQueryTimeout bounds streamed exports, which outlast QueryTimeout
+// but not the request timeout they are exempt from
+const StreamQueryTimeout = 10 * time.Minute
+
 // Validator to be initialized once
 var validate *validator.Validate
 var once sync.Once
@@ -94,6 +101,18 @@ func NullString(s string) sql.NullString {
 	return sql.NullString{String: s, Valid: true}
 }
 
+// InvalidParamsError lists the query parameters an endpoint does not accept
+type InvalidParamsError struct {
+	Params  []string
+	Allowed []string
+}
+
+func (e *InvalidParamsError) Error() string {
+	return fmt.Sprintf("invalid query parameters: %s. Allowed parameters are: %s",
+		strings.Join(e.Params, ", "),
+		strings.Join(e.Allowed, ", "))
+}
+
 // Checks if only allowed parameters are used in the request.
 func ValidateParams(r *http.Request, allowedParams []string) error {
 	allowed := make(map[string]bool)
@@ -109,9 +128,8 @@ func ValidateParams(r *http.Request, allowedParams []string) error {
 	}
 
 	if len(invalidParams) > 0 {
-		return fmt.Errorf("invalid query parameters: %s. Allowed parameters are: %s",
-			strings.Join(invalidParams, ", "),
-			strings.Join(allowedParams, ", "))
+		sort.Strings(invalidParams)
+		return &InvalidParamsError{Params: invalidParams, Allowed: allowedParams}
 	}
 
 	return nil
@@ -475,3 +493,19 @@ func ParsePositiveInt64(s string) (int64, error) {
 	}
 	return v, nil
 }
+
+type publicURLKey struct{}
+
+// WithPublicURL keeps the URL the client called when r is handed to a route
+// under another path, so links and problem instances still point at it
+func WithPublicURL(r *http.Request, u *url.URL) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), publicURLKey{}, u))
+}
+
+// PublicURL is the URL the client called
+func PublicURL(r *http.Request) *url.URL {
+	if u, ok := r.Context().Value(publicURLKey{}).(*url.URL); ok {
+		return u
+	}
+	return r.URL
+}
@@ -0,0 +1,315 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	authsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
+	"github.com/DeRuina/KUHA-REST-API/internal/logger"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/auth"
+)
+
+// how much of a response body is read so the connection can be reused; the
+// body itself is never kept
+const maxDrainBody = 4096
+
+type Config struct {
+	Enabled bool
+	Workers int
+	// events waiting to be turned into deliveries; more are dropped
+	QueueSize int
+	// attempts before a delivery becomes a dead letter
+	MaxAttempts int
+	// delay after the first failed attempt, doubled after each further one up to MaxBackoff
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// per-attempt request timeout
+	Timeout time.Duration
+	// how often due retries are looked for
+	PollInterval time.Duration
+	// how long succeeded deliveries stay in the log; 0 keeps them
+	Retention time.Duration
+}
+
+// Dispatcher turns published events into deliveries for the matching
+// subscriptions and sends them. Deliveries live in the auth database, so
+// retries survive restarts and several instances share the work.
+type Dispatcher struct {
+	store  auth.Webhooks
+	cfg    Config
+	client *http.Client
+
+	events chan events.Event
+	wake   chan struct{}
+	stop   chan struct{}
+	wg     sync.WaitGroup
+}
+
+func NewDispatcher(store auth.Webhooks, cfg Config) *Dispatcher {
+	if cfg.Workers <= 0 {
+		cfg.Workers = 1
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 1
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = 10 * time.Second
+	}
+	return &Dispatcher{
+		store:  store,
+		cfg:    cfg,
+		client: newClient(cfg.Timeout),
+		events: make(chan events.Event, cfg.QueueSize),
+		wake:   make(chan struct{}, 1),
+		stop:   make(chan struct{}),
+	}
+}
+
+// Start subscribes to published events and sends deliveries in the
+// background until Stop is called
+func (d *Dispatcher) Start() {
+	events.Subscribe(d.enqueue)
+
+	d.wg.Add(2)
+	go d.fanOut()
+	go d.deliverLoop()
+}
+
+// Stop stores the events still queued and waits for attempts in flight;
+// pending deliveries are sent after the next start
+func (d *Dispatcher) Stop() {
+	if d == nil {
+		return
+	}
+	close(d.stop)
+	d.wg.Wait()
+}
+
+// Wake makes the dispatcher look for due deliveries now, e.g. after a
+// dead letter is requeued
+func (d *Dispatcher) Wake() {
+	if d == nil {
+		return
+	}
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (d *Dispatcher) enqueue(e events.Event) {
+	if !slices.Contains(events.Types, e.Type) {
+		return
+	}
+	select {
+	case d.events <- e:
+	default:
+		logger.Logger.Warnw("webhook event dropped: queue full", "event_id", e.ID, "event_type", e.Type)
+	}
+}
+
+func (d *Dispatcher) fanOut() {
+	defer d.wg.Done()
+	for {
+		select {
+		case e := <-d.events:
+			d.createDeliveries(e)
+		case <-d.stop:
+			for {
+				select {
+				case e := <-d.events:
+					d.createDeliveries(e)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// createDeliveries queues e for every active subscription to its type
+func (d *Dispatcher) createDeliveries(e events.Event) {
+	ctx := context.Background()
+	subs, err := d.store.ListWebhooksForEvent(ctx, e.Type)
+	if err != nil {
+		logger.Logger.Warnw("failed to look up webhook subscriptions", "event_id", e.ID, "event_type", e.Type, "error", err)
+		return
+	}
+	if len(subs) == 0 {
+		return
+	}
+
+	payload, err := json.Marshal(e)
+	if err != nil {
+		logger.Logger.Errorw("failed to encode webhook event", "event_id", e.ID, "event_type", e.Type, "error", err)
+		return
+	}
+	for _, sub := range subs {
+		err := d.store.CreateDelivery(ctx, authsqlc.CreateWebhookDeliveryParams{
+			SubscriptionID: sub.ID,
+			EventID:        e.ID,
+			EventType:      e.Type,
+			Payload:        payload,
+		})
+		if err != nil {
+			logger.Logger.Warnw("failed to queue webhook delivery", "subscription_id", sub.ID, "event_id", e.ID, "error", err)
+		}
+	}
+	d.Wake()
+}
+
+func (d *Dispatcher) deliverLoop() {
+	defer d.wg.Done()
+
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+	cleanup := time.NewTicker(time.Hour)
+	defer cleanup.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			d.deliverDue()
+		case <-d.wake:
+			d.deliverDue()
+		case <-cleanup.C:
+			d.deleteOld()
+		case <-d.stop:
+			return
+		}
+	}
+}
+
+// deliverDue sends every due delivery, Workers at a time
+func (d *Dispatcher) deliverDue() {
+	// long enough for one attempt, so a claim is only taken over when its instance died
+	lease := 2*d.cfg.Timeout + 30*time.Second
+	batch := int32(d.cfg.Workers * 4)
+
+	for {
+		rows, err := d.store.ClaimDueDeliveries(context.Background(), batch, lease)
+		if err != nil {
+			logger.Logger.Warnw("failed to claim webhook deliveries", "error", err)
+			return
+		}
+
+		sem := make(chan struct{}, d.cfg.Workers)
+		var wg sync.WaitGroup
+		for _, row := range rows {
+			sem <- struct{}{}
+			wg.Add(1)
+			go func(row authsqlc.ClaimDueWebhookDeliveriesRow) {
+				defer func() { <-sem; wg.Done() }()
+				d.attempt(row)
+			}(row)
+		}
+		wg.Wait()
+
+		if int32(len(rows)) < batch {
+			return
+		}
+		select {
+		case <-d.stop:
+			return
+		default:
+		}
+	}
+}
+
+// attempt sends one delivery and records the outcome: success, another try
+// after a backoff, or a dead letter once the attempts run out
+func (d *Dispatcher) attempt(row authsqlc.ClaimDueWebhookDeliveriesRow) {
+	code, err := d.send(row)
+
+	rec := authsqlc.RecordWebhookAttemptParams{ID: row.ID, Status: auth.DeliverySucceeded}
+	if code != 0 {
+		rec.LastStatusCode = sql.NullInt32{Int32: int32(code), Valid: true}
+	}
+	if err != nil {
+		rec.LastError = sql.NullString{String: err.Error(), Valid: true}
+		attempts := int(row.Attempts) + 1
+		if attempts >= d.cfg.MaxAttempts {
+			rec.Status = auth.DeliveryDead
+			logger.Logger.Warnw("webhook delivery dead-lettered", "delivery_id", row.ID, "event_type", row.EventType, "attempts", attempts, "error", err)
+		} else {
+			rec.Status = auth.DeliveryPending
+			rec.RetryInSeconds = int32(Backoff(d.cfg.BaseBackoff, d.cfg.MaxBackoff, attempts) / time.Second)
+		}
+	}
+
+	// record even while shutting down, or the attempt is repeated after the lease
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := d.store.RecordAttempt(ctx, rec); err != nil {
+		logger.Logger.Warnw("failed to record webhook attempt", "delivery_id", row.ID, "error", err)
+	}
+}
+
+// send posts the signed payload; any 2xx status is a success. Only the status
+// of a failed attempt is recorded: the delivery log is readable by the
+// subscriber, and response bodies would let it read whatever the URL serves.
+func (d *Dispatcher) send(row authsqlc.ClaimDueWebhookDeliveriesRow) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, row.Url, bytes.NewReader(row.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "KUHA-Webhooks/1")
+	req.Header.Set(EventHeader, row.EventType)
+	req.Header.Set(DeliveryHeader, strconv.FormatInt(row.ID, 10))
+	req.Header.Set(SignatureHeader, Sign(row.Secret, time.Now(), row.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrainBody))
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp.StatusCode, nil
+	}
+	return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+}
+
+func (d *Dispatcher) deleteOld() {
+	if d.cfg.Retention <= 0 {
+		return
+	}
+	n, err := d.store.DeleteOldDeliveries(context.Background(), time.Now().UTC().Add(-d.cfg.Retention))
+	if err != nil {
+		logger.Logger.Warnw("failed to delete old webhook deliveries", "error", err)
+		return
+	}
+	if n > 0 {
+		logger.Logger.Infow("deleted old webhook deliveries", "count", n)
+	}
+}
+
+// Backoff is the delay before the next attempt after attempts failed ones:
+// base doubled per attempt, capped at max, with up to 10% jitter so retries
+// of many deliveries do not arrive together
+func Backoff(base, max time.Duration, attempts int) time.Duration {
+	d := base
+	for i := 1; i < attempts && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+	if d > 0 {
+		d += rand.N(d/10 + 1)
+	}
+	return d
+}
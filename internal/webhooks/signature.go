@@ -0,0 +1,78 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Headers sent with every delivery
+const (
+	SignatureHeader = "X-Kuha-Signature"
+	EventHeader     = "X-Kuha-Event"
+	DeliveryHeader  = "X-Kuha-Delivery"
+)
+
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// NewSecret generates the signing secret of a subscription
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// Sign returns the X-Kuha-Signature value for body sent at t: the timestamp
+// and the hex HMAC-SHA256 of "<t>.<body>" under secret
+func Sign(secret string, t time.Time, body []byte) string {
+	return fmt.Sprintf("t=%d,v1=%s", t.Unix(), mac(secret, t.Unix(), body))
+}
+
+// Verify checks a X-Kuha-Signature header against body, rejecting signatures
+// older than tolerance so a captured delivery cannot be replayed later
+func Verify(secret, header string, body []byte, tolerance time.Duration) error {
+	var ts int64
+	var sigs []string
+	for _, part := range strings.Split(header, ",") {
+		k, v, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch k {
+		case "t":
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return ErrInvalidSignature
+			}
+			ts = n
+		case "v1":
+			sigs = append(sigs, v)
+		}
+	}
+	if ts == 0 || len(sigs) == 0 {
+		return ErrInvalidSignature
+	}
+	if tolerance > 0 && time.Since(time.Unix(ts, 0)).Abs() > tolerance {
+		return ErrInvalidSignature
+	}
+
+	want := mac(secret, ts, body)
+	for _, sig := range sigs {
+		if hmac.Equal([]byte(sig), []byte(want)) {
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
+
+func mac(secret string, ts int64, body []byte) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(h, "%d.", ts)
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,87 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// ErrBlockedTarget is returned for subscription URLs that point into the
+// network the API runs in
+var ErrBlockedTarget = errors.New("url must not point to a loopback, private, link-local or unspecified address")
+
+// carrier-grade NAT space is as internal as the private ranges
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// blockedAddr reports whether deliveries to addr could reach internal services
+func blockedAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return !addr.IsValid() ||
+		addr.IsLoopback() ||
+		addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() ||
+		addr.IsUnspecified() ||
+		sharedAddressSpace.Contains(addr)
+}
+
+// CheckHost resolves the host of a subscription URL and rejects it when any
+// of its addresses is blocked. Deliveries check the address again when they
+// connect, so a name that later resolves elsewhere is still refused.
+func CheckHost(ctx context.Context, host string) error {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		if blockedAddr(addr) {
+			return ErrBlockedTarget
+		}
+		return nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return fmt.Errorf("url host cannot be resolved: %s", host)
+	}
+	for _, addr := range addrs {
+		if blockedAddr(addr) {
+			return ErrBlockedTarget
+		}
+	}
+	return nil
+}
+
+// dialControl refuses connections to blocked addresses. It runs after name
+// resolution, on the address actually dialled, which closes the DNS
+// rebinding gap between CheckHost and the delivery.
+func dialControl(network, address string, _ syscall.RawConn) error {
+	ap, err := netip.ParseAddrPort(address)
+	if err != nil || blockedAddr(ap.Addr()) {
+		return ErrBlockedTarget
+	}
+	return nil
+}
+
+// newClient is the HTTP client deliveries are sent with: no proxy, which
+// would dial on the client's behalf, and only public addresses
+func newClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   timeout,
+		KeepAlive: 30 * time.Second,
+		Control:   dialControl,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		// a redirect counts as a failed attempt; the subscription URL should be updated instead
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}
+}
@@ -1,4 +1,4 @@
-package swagger
+package apitypes
 
 type UserDataArchinisisResponse struct {
 	Users []string `json:"sportti_ids" example:"12345,67890,54321"`
@@ -9,25 +9,25 @@ type RaceReportSessionsResponse struct {
 }
 
 type ArchRaceReportUpsertRequest struct {
-	SporttiID  string `json:"sportti_id" example:"27578816"`
-	SessionID  int32  `json:"session_id" example:"1842"`
-	RaceReport string `json:"race_report" example:"<!DOCTYPE html><html><head><title>Race</title></head><body><h1>Report</h1></body></html>"`
+	SporttiID  string `json:"sportti_id" validate:"required,numeric" example:"27578816"`
+	SessionID  int32  `json:"session_id" validate:"required,gt=0" example:"1842"`
+	RaceReport string `json:"race_report" validate:"required" example:"<!DOCTYPE html><html><head><title>Race</title></head><body><h1>Report</h1></body></html>"`
 }
 
 type ArchDataUpsertRequest struct {
-	NationalID   string                  `json:"national_id" example:"27353728"`
+	NationalID   string                  `json:"national_id" validate:"required,numeric" example:"27353728"`
 	FirstName    *string                 `json:"first_name,omitempty" example:"Jane"`
 	LastName     *string                 `json:"last_name,omitempty" example:"Doe"`
 	Initials     *string                 `json:"initials,omitempty" example:"JD"`
 	DateOfBirth  *string                 `json:"date_of_birth,omitempty" example:"1995-07-21"`
 	Height       *float64                `json:"height,omitempty" example:"1.72"`
 	Weight       *float64                `json:"weight,omitempty" example:"65.4"`
-	Measurements []ArchMeasurementUpsert `json:"measurements,omitempty"`
+	Measurements []ArchMeasurementUpsert `json:"measurements,omitempty" validate:"omitempty,dive"`
 }
 
 type ArchMeasurementUpsert struct {
-	MeasurementGroupID int32   `json:"measurement_group_id" example:"2024010101"`
-	MeasurementID      int32   `json:"measurement_id" example:"42"`
+	MeasurementGroupID int32   `json:"measurement_group_id" validate:"required,gt=0" example:"2024010101"`
+	MeasurementID      int32   `json:"measurement_id" validate:"required,gt=0" example:"42"`
 	Discipline         *string `json:"discipline,omitempty" example:"Sprint 100m"`
 	SessionName        *string `json:"session_name,omitempty" example:"Morning session"`
 	Place              *string `json:"place,omitempty" example:"Helsinki"`
@@ -0,0 +1,28 @@
+package apitypes
+
+// Auth: a client token is exchanged for a short-lived JWT and a refresh token
+
+type TokenRequest struct {
+	ClientToken string `json:"client_token" validate:"required" example:"c0ffee00-1234-5678-9abc-def012345678"`
+}
+
+type Tokens struct {
+	JWT          string `json:"jwt" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+	RefreshToken string `json:"refresh_token" example:"3b1f5c2e9d..."`
+}
+
+type TokenResponse struct {
+	Tokens Tokens `json:"tokens"`
+}
+
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required" example:"3b1f5c2e9d..."`
+}
+
+type RefreshedToken struct {
+	JWT string `json:"jwt" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+}
+
+type RefreshResponse struct {
+	Tokens RefreshedToken `json:"tokens"`
+}
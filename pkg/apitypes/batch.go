@@ -0,0 +1,27 @@
+package apitypes
+
+// Batched GET requests (POST /batch)
+
+type BatchRequest struct {
+	Requests []BatchSubRequest `json:"requests"`
+}
+
+type BatchSubRequest struct {
+	ID      string            `json:"id,omitempty" example:"latest-sleep"`
+	Method  string            `json:"method,omitempty" example:"GET" enums:"GET"`
+	Path    string            `json:"path" example:"/v1/utv/latest?user_id=7cffe6e0-3f28-43b6-b511-d836d3a9f7b5&type=sleep"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+type BatchResponse struct {
+	Responses []BatchSubResponse `json:"responses"`
+}
+
+type BatchSubResponse struct {
+	ID      string            `json:"id,omitempty" example:"latest-sleep"`
+	Status  int               `json:"status" example:"200"`
+	Headers map[string]string `json:"headers"`
+	// JSON bodies are embedded as is, text as a string and anything else base64 encoded
+	Body         any    `json:"body,omitempty"`
+	BodyEncoding string `json:"body_encoding,omitempty" example:"" enums:"base64"`
+}
@@ -0,0 +1,29 @@
+package apitypes
+
+// Partial bulk uploads (?mode=partial)
+
+// BulkResultResponse is the response body of a partial bulk upload
+type BulkResultResponse struct {
+	Counts BulkCounts         `json:"counts"`
+	Items  []BulkItemResponse `json:"items"`
+}
+
+type BulkCounts struct {
+	Created int `json:"created" example:"9998"`
+	Updated int `json:"updated" example:"0"`
+	Skipped int `json:"skipped" example:"1"`
+	Errors  int `json:"errors" example:"1"`
+}
+
+// ItemStatus is the outcome of one item: created, updated, skipped or error
+type ItemStatus string
+
+// BulkItemResponse is the outcome of one item of a partial bulk upload
+type BulkItemResponse struct {
+	Index  int          `json:"index" example:"41"`
+	ID     string       `json:"id,omitempty" example:"dcabe48a-3578-4743-93ba-001409c82a82"`
+	Status ItemStatus   `json:"status" example:"error" enums:"created,updated,skipped,error" swaggertype:"string"`
+	Code   string       `json:"code,omitempty" example:"reference_not_found"`
+	Reason string       `json:"reason,omitempty" example:"user does not exist. Please create the user first"`
+	Errors []FieldError `json:"errors,omitempty"`
+}
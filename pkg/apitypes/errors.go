@@ -0,0 +1,130 @@
+package apitypes
+
+// Error responses are RFC 7807 problem details, sent as application/problem+json.
+// code is stable and machine-readable; type is urn:kuha:problem:<code>.
+// Catalogue: bad_request, validation_failed, invalid_query_parameter,
+// invalid_cursor, invalid_json, invalid_data_format, reference_not_found and
+// constraint_violation (400); unauthorized (401); forbidden (403); not_found
+// (404); method_not_allowed (405); conflict, duplicate_record and
+// idempotency_key_in_progress (409); payload_too_large (413);
+// unprocessable_entity and idempotency_key_reused (422); rate_limited (429);
+// internal_error (500); database_unavailable and job_queue_full (503);
+// query_timeout (504).
+
+// 400 - validation_failed carries one entry per invalid field; other 400 codes may omit errors
+type ValidationErrorResponse struct {
+	Type      string       `json:"type" example:"urn:kuha:problem:validation_failed"`
+	Title     string       `json:"title" example:"Validation failed"`
+	Status    int          `json:"status" example:"400"`
+	Detail    string       `json:"detail,omitempty" example:"one or more fields are invalid"`
+	Instance  string       `json:"instance,omitempty" example:"/v1/tietoevry/exercises"`
+	Code      string       `json:"code" example:"validation_failed" enums:"bad_request,validation_failed,invalid_query_parameter,invalid_cursor,invalid_json,invalid_data_format,reference_not_found,constraint_violation,unauthorized,forbidden,not_found,method_not_allowed,conflict,duplicate_record,idempotency_key_in_progress,idempotency_key_reused,payload_too_large,unprocessable_entity,rate_limited,internal_error,database_unavailable,query_timeout,job_queue_full"`
+	RequestID string       `json:"request_id,omitempty" example:"host/AbCdEf1234-000042"`
+	Errors    []FieldError `json:"errors,omitempty"`
+}
+
+// FieldError is one field-level validation failure
+type FieldError struct {
+	Field   string `json:"field" example:"user_id"`
+	Message string `json:"message" example:"user_id is required"`
+}
+
+// Problem is the problem details object every error response carries; the
+// per-status types document its examples
+type Problem struct {
+	Type      string       `json:"type" example:"urn:kuha:problem:validation_failed"`
+	Title     string       `json:"title" example:"Validation failed"`
+	Status    int          `json:"status" example:"400"`
+	Detail    string       `json:"detail,omitempty" example:"one or more fields are invalid"`
+	Instance  string       `json:"instance,omitempty" example:"/v1/tietoevry/exercises"`
+	Code      string       `json:"code" example:"validation_failed"`
+	RequestID string       `json:"request_id,omitempty" example:"host/AbCdEf1234-000042"`
+	Errors    []FieldError `json:"errors,omitempty"`
+	// the wait of a 429, also sent in the Retry-After header
+	RetryAfter string `json:"retry_after,omitempty" example:"5"`
+}
+
+// 401
+type UnauthorizedResponse struct {
+	Type      string `json:"type" example:"urn:kuha:problem:unauthorized"`
+	Title     string `json:"title" example:"Unauthorized"`
+	Status    int    `json:"status" example:"401"`
+	Instance  string `json:"instance,omitempty" example:"/v1/tietoevry/exercises"`
+	Code      string `json:"code" example:"unauthorized" enums:"bad_request,validation_failed,invalid_query_parameter,invalid_cursor,invalid_json,invalid_data_format,reference_not_found,constraint_violation,unauthorized,forbidden,not_found,method_not_allowed,conflict,duplicate_record,idempotency_key_in_progress,idempotency_key_reused,payload_too_large,unprocessable_entity,rate_limited,internal_error,database_unavailable,query_timeout,job_queue_full"`
+	RequestID string `json:"request_id,omitempty" example:"host/AbCdEf1234-000042"`
+}
+
+// 403
+type ForbiddenResponse struct {
+	Type      string `json:"type" example:"urn:kuha:problem:forbidden"`
+	Title     string `json:"title" example:"Forbidden"`
+	Status    int    `json:"status" example:"403"`
+	Instance  string `json:"instance,omitempty" example:"/v1/tietoevry/exercises"`
+	Code      string `json:"code" example:"forbidden" enums:"bad_request,validation_failed,invalid_query_parameter,invalid_cursor,invalid_json,invalid_data_format,reference_not_found,constraint_violation,unauthorized,forbidden,not_found,method_not_allowed,conflict,duplicate_record,idempotency_key_in_progress,idempotency_key_reused,payload_too_large,unprocessable_entity,rate_limited,internal_error,database_unavailable,query_timeout,job_queue_full"`
+	RequestID string `json:"request_id,omitempty" example:"host/AbCdEf1234-000042"`
+}
+
+// 404
+type NotFoundResponse struct {
+	Type      string `json:"type" example:"urn:kuha:problem:not_found"`
+	Title     string `json:"title" example:"Not found"`
+	Status    int    `json:"status" example:"404"`
+	Instance  string `json:"instance,omitempty" example:"/v1/tietoevry/exercises"`
+	Code      string `json:"code" example:"not_found" enums:"bad_request,validation_failed,invalid_query_parameter,invalid_cursor,invalid_json,invalid_data_format,reference_not_found,constraint_violation,unauthorized,forbidden,not_found,method_not_allowed,conflict,duplicate_record,idempotency_key_in_progress,idempotency_key_reused,payload_too_large,unprocessable_entity,rate_limited,internal_error,database_unavailable,query_timeout,job_queue_full"`
+	RequestID string `json:"request_id,omitempty" example:"host/AbCdEf1234-000042"`
+}
+
+// 409 - Tietoevry
+type ConflictResponse struct {
+	Type      string `json:"type" example:"urn:kuha:problem:duplicate_record"`
+	Title     string `json:"title" example:"Record already exists"`
+	Status    int    `json:"status" example:"409"`
+	Detail    string `json:"detail,omitempty" example:"record already exists"`
+	Instance  string `json:"instance,omitempty" example:"/v1/tietoevry/exercises"`
+	Code      string `json:"code" example:"duplicate_record" enums:"bad_request,validation_failed,invalid_query_parameter,invalid_cursor,invalid_json,invalid_data_format,reference_not_found,constraint_violation,unauthorized,forbidden,not_found,method_not_allowed,conflict,duplicate_record,idempotency_key_in_progress,idempotency_key_reused,payload_too_large,unprocessable_entity,rate_limited,internal_error,database_unavailable,query_timeout,job_queue_full"`
+	RequestID string `json:"request_id,omitempty" example:"host/AbCdEf1234-000042"`
+}
+
+// 422 - OURA, Polar, Suunto, Garmin
+type InvalidDateRange struct {
+	Type      string `json:"type" example:"urn:kuha:problem:unprocessable_entity"`
+	Title     string `json:"title" example:"Unprocessable entity"`
+	Status    int    `json:"status" example:"422"`
+	Detail    string `json:"detail,omitempty" example:"invalid date range"`
+	Instance  string `json:"instance,omitempty" example:"/v1/tietoevry/exercises"`
+	Code      string `json:"code" example:"unprocessable_entity" enums:"bad_request,validation_failed,invalid_query_parameter,invalid_cursor,invalid_json,invalid_data_format,reference_not_found,constraint_violation,unauthorized,forbidden,not_found,method_not_allowed,conflict,duplicate_record,idempotency_key_in_progress,idempotency_key_reused,payload_too_large,unprocessable_entity,rate_limited,internal_error,database_unavailable,query_timeout,job_queue_full"`
+	RequestID string `json:"request_id,omitempty" example:"host/AbCdEf1234-000042"`
+}
+
+// 422 - Idempotency-Key reused with a different request body
+type IdempotencyKeyReusedResponse struct {
+	Type      string `json:"type" example:"urn:kuha:problem:idempotency_key_reused"`
+	Title     string `json:"title" example:"Idempotency key reused"`
+	Status    int    `json:"status" example:"422"`
+	Detail    string `json:"detail,omitempty" example:"idempotency key was already used with a different request body"`
+	Instance  string `json:"instance,omitempty" example:"/v1/tietoevry/exercises"`
+	Code      string `json:"code" example:"idempotency_key_reused" enums:"bad_request,validation_failed,invalid_query_parameter,invalid_cursor,invalid_json,invalid_data_format,reference_not_found,constraint_violation,unauthorized,forbidden,not_found,method_not_allowed,conflict,duplicate_record,idempotency_key_in_progress,idempotency_key_reused,payload_too_large,unprocessable_entity,rate_limited,internal_error,database_unavailable,query_timeout,job_queue_full"`
+	RequestID string `json:"request_id,omitempty" example:"host/AbCdEf1234-000042"`
+}
+
+// 500 (504 with code query_timeout when a database query times out)
+type InternalServerErrorResponse struct {
+	Type      string `json:"type" example:"urn:kuha:problem:internal_error"`
+	Title     string `json:"title" example:"Internal server error"`
+	Status    int    `json:"status" example:"500"`
+	Detail    string `json:"detail,omitempty" example:"the server encountered a problem"`
+	Instance  string `json:"instance,omitempty" example:"/v1/tietoevry/exercises"`
+	Code      string `json:"code" example:"internal_error" enums:"bad_request,validation_failed,invalid_query_parameter,invalid_cursor,invalid_json,invalid_data_format,reference_not_found,constraint_violation,unauthorized,forbidden,not_found,method_not_allowed,conflict,duplicate_record,idempotency_key_in_progress,idempotency_key_reused,payload_too_large,unprocessable_entity,rate_limited,internal_error,database_unavailable,query_timeout,job_queue_full"`
+	RequestID string `json:"request_id,omitempty" example:"host/AbCdEf1234-000042"`
+}
+
+// 503 - Service Unavailable
+type ServiceUnavailableResponse struct {
+	Type      string `json:"type" example:"urn:kuha:problem:database_unavailable"`
+	Title     string `json:"title" example:"Database unavailable"`
+	Status    int    `json:"status" example:"503"`
+	Detail    string `json:"detail,omitempty" example:"FIS database is unavailable"`
+	Instance  string `json:"instance,omitempty" example:"/v1/tietoevry/exercises"`
+	Code      string `json:"code" example:"database_unavailable" enums:"bad_request,validation_failed,invalid_query_parameter,invalid_cursor,invalid_json,invalid_data_format,reference_not_found,constraint_violation,unauthorized,forbidden,not_found,method_not_allowed,conflict,duplicate_record,idempotency_key_in_progress,idempotency_key_reused,payload_too_large,unprocessable_entity,rate_limited,internal_error,database_unavailable,query_timeout,job_queue_full"`
+	RequestID string `json:"request_id,omitempty" example:"host/AbCdEf1234-000042"`
+}
@@ -0,0 +1,1507 @@
+package apitypes
+
+type FISAthleteItem struct {
+	Firstname *string `json:"Firstname,omitempty" example:"Iivo"`
+	Lastname  *string `json:"Lastname,omitempty" example:"Niskanen"`
+	Fiscode   *int32  `json:"Fiscode,omitempty" example:"342001"`
+}
+
+type FISAthletesResponse struct {
+	Athletes []FISAthleteItem `json:"athletes"`
+}
+
+type FISNationsBySectorResponse struct {
+	Nations []string `json:"nations" example:"FIN,NOR,SWE"`
+}
+
+type FISInsertCompetitorExample struct {
+	Competitorid       int32   `json:"competitorid" validate:"required" example:"123456"`
+	Personid           *int32  `json:"personid,omitempty" example:"98765"`
+	Ipcid              *int32  `json:"ipcid,omitempty" example:"0"`
+	Fiscode            *int32  `json:"fiscode,omitempty" example:"342001"`
+	Birthdate          *string `json:"birthdate,omitempty" example:"1992-01-12"`             // YYYY-MM-DD
+	StatusDate         *string `json:"status_date,omitempty" example:"2025-10-27T08:30:00Z"` // RFC3339
+	Fee                *string `json:"fee,omitempty" example:"10.00000"`
+	Dateofcreation     *string `json:"dateofcreation,omitempty" example:"2025-10-20"` // YYYY-MM-DD
+	Injury             *int32  `json:"injury,omitempty" example:"0"`
+	Version            *int32  `json:"version,omitempty" example:"1"`
+	Compidmssql        *int32  `json:"compidmssql,omitempty" example:"0"`
+	Carving            *int32  `json:"carving,omitempty" example:"0"`
+	Photo              *int32  `json:"photo,omitempty" example:"0"`
+	Notallowed         *int32  `json:"notallowed,omitempty" example:"0"`
+	Published          *int32  `json:"published,omitempty" example:"1"`
+	Team               *int32  `json:"team,omitempty" example:"0"`
+	PhotoBig           *int32  `json:"photo_big,omitempty" example:"0"`
+	Lastupdate         *string `json:"lastupdate,omitempty" example:"2025-10-27T08:30:00Z"` // RFC3339
+	Statusnextlist     *string `json:"statusnextlist,omitempty" example:"A"`
+	Alternatenamecheck *string `json:"alternatenamecheck,omitempty" example:"OK"`
+	Deletedat          *string `json:"deletedat,omitempty" example:""`
+	Doped              *string `json:"doped,omitempty" example:"NO"`
+	Createdby          *string `json:"createdby,omitempty" example:"admin@fis.int"`
+	Categorycode       *string `json:"categorycode,omitempty" example:"SEN"`
+	Classname          *string `json:"classname,omitempty" example:"Senior"`
+	Data               *string `json:"data,omitempty" example:"{}"`
+	Lastupdateby       *string `json:"lastupdateby,omitempty" example:"system"`
+	Disciplines        *string `json:"disciplines,omitempty" example:"DISTANCE,SPRINT"`
+	Type               *string `json:"type,omitempty" example:"ATHLETE"`
+	Sectorcode         *string `json:"sectorcode,omitempty" example:"CC"` // JP/NK/CC
+	Classcode          *string `json:"classcode,omitempty" example:"A"`
+	Lastname           *string `json:"lastname,omitempty" example:"Niskanen"`
+	Firstname          *string `json:"firstname,omitempty" example:"Iivo"`
+	Gender             *string `json:"gender,omitempty" example:"M"`
+	Natteam            *string `json:"natteam,omitempty" example:"FIN-A"`
+	Nationcode         *string `json:"nationcode,omitempty" example:"FIN"`
+	Nationalcode       *string `json:"nationalcode,omitempty" example:"246"`
+	Skiclub            *string `json:"skiclub,omitempty" example:"Ounasvaara Hiihtoseura"`
+	Association        *string `json:"association,omitempty" example:"FIN"`
+	Status             *string `json:"status,omitempty" example:"ACTIVE"`
+	StatusOld          *string `json:"status_old,omitempty" example:""`
+	StatusBy           *string `json:"status_by,omitempty" example:"FIS"`
+	Tragroup           *string `json:"tragroup,omitempty" example:"A"`
+}
+
+type FISUpdateCompetitorExample struct {
+	Competitorid       int32   `json:"competitorid" validate:"required" example:"123456"`
+	Personid           *int32  `json:"personid,omitempty" example:"98765"`
+	Ipcid              *int32  `json:"ipcid,omitempty" example:"0"`
+	Type               *string `json:"type,omitempty" example:"ATHLETE"`
+	Sectorcode         *string `json:"sectorcode,omitempty" example:"DD"` // JP/NK/CC
+	Fiscode            *int32  `json:"fiscode,omitempty" example:"342001"`
+	Lastname           *string `json:"lastname,omitempty" example:"Niskanen"`
+	Firstname          *string `json:"firstname,omitempty" example:"Iivo"`
+	Gender             *string `json:"gender,omitempty" example:"M"`
+	Birthdate          *string `json:"birthdate,omitempty" example:"1992-01-12"` // YYYY-MM-DD
+	Nationcode         *string `json:"nationcode,omitempty" example:"FIN"`
+	Nationalcode       *string `json:"nationalcode,omitempty" example:"246"`
+	Skiclub            *string `json:"skiclub,omitempty" example:"Ounasvaara Hiihtoseura"`
+	Association        *string `json:"association,omitempty" example:"FIN"`
+	Status             *string `json:"status,omitempty" example:"ACTIVE"`
+	StatusOld          *string `json:"status_old,omitempty" example:""`
+	StatusBy           *string `json:"status_by,omitempty" example:"FIS"`
+	StatusDate         *string `json:"status_date,omitempty" example:"2025-10-27T08:30:00Z"` // RFC3339
+	Statusnextlist     *string `json:"statusnextlist,omitempty" example:"A"`
+	Alternatenamecheck *string `json:"alternatenamecheck,omitempty" example:"OK"`
+	Fee                *string `json:"fee,omitempty" example:"10.00000"`
+	Dateofcreation     *string `json:"dateofcreation,omitempty" example:"2025-10-20"` // YYYY-MM-DD
+	Createdby          *string `json:"createdby,omitempty" example:"admin@fis.int"`
+	Injury             *int32  `json:"injury,omitempty" example:"0"`
+	Version            *int32  `json:"version,omitempty" example:"1"`
+	Compidmssql        *int32  `json:"compidmssql,omitempty" example:"0"`
+	Carving            *int32  `json:"carving,omitempty" example:"0"`
+	Photo              *int32  `json:"photo,omitempty" example:"0"`
+	Notallowed         *int32  `json:"notallowed,omitempty" example:"0"`
+	Natteam            *string `json:"natteam,omitempty" example:"FIN-A"`
+	Tragroup           *string `json:"tragroup,omitempty" example:"A"`
+	Published          *int32  `json:"published,omitempty" example:"1"`
+	Doped              *string `json:"doped,omitempty" example:"NO"`
+	Team               *int32  `json:"team,omitempty" example:"0"`
+	PhotoBig           *int32  `json:"photo_big,omitempty" example:"0"`
+	Data               *string `json:"data,omitempty" example:"{}"`
+	Lastupdateby       *string `json:"lastupdateby,omitempty" example:"system"`
+	Disciplines        *string `json:"disciplines,omitempty" example:"DISTANCE,SPRINT"`
+	Lastupdate         *string `json:"lastupdate,omitempty" example:"2025-10-27T08:30:00Z"` // RFC3339
+	Deletedat          *string `json:"deletedat,omitempty" example:""`
+	Categorycode       *string `json:"categorycode,omitempty" example:"SEN"`
+	Classname          *string `json:"classname,omitempty" example:"Senior"`
+	Classcode          *string `json:"classcode,omitempty" example:"A"`
+}
+
+type FISLastCompetitorResponse struct {
+	Competitor FISCompetitorFull `json:"competitor"`
+}
+
+type FISSeasonsCCResponse struct {
+	Seasons []int32 `json:"seasons" swaggertype:"array,integer" example:"2026,2025,2024"`
+}
+
+type FISDisciplinesCCResponse struct {
+	Disciplines []string `json:"disciplines" swaggertype:"array,string" example:"SP,DI,PU"`
+}
+
+type FISCategoriesCCResponse struct {
+	Categories []string `json:"categories" swaggertype:"array,string" example:"WC,WSC,COC"`
+}
+
+type FISRaceCC struct {
+	Raceid            int32   `json:"raceid" example:"123456"`
+	Eventid           *int32  `json:"eventid" example:"7890"`
+	Seasoncode        *int32  `json:"seasoncode" example:"2025"`
+	Racecodex         *int32  `json:"racecodex" example:"1001"`
+	Disciplineid      *string `json:"disciplineid" example:"DIST"`
+	Disciplinecode    *string `json:"disciplinecode" example:"SP"`
+	Catcode           *string `json:"catcode" example:"WC"`
+	Catcode2          *string `json:"catcode2"`
+	Catcode3          *string `json:"catcode3"`
+	Catcode4          *string `json:"catcode4"`
+	Gender            *string `json:"gender" example:"M"`
+	Racedate          *string `json:"racedate" format:"date" example:"2025-02-14"`
+	Starteventdate    *string `json:"starteventdate" format:"date" example:"2025-02-13"`
+	Description       *string `json:"description" example:"World Cup Sprint"`
+	Place             *string `json:"place" example:"Lahti"`
+	Nationcode        *string `json:"nationcode" example:"FIN"`
+	Td1id             *int32  `json:"td1id"`
+	Td1name           *string `json:"td1name"`
+	Td1nation         *string `json:"td1nation"`
+	Td1code           *int32  `json:"td1code"`
+	Td2id             *int32  `json:"td2id"`
+	Td2name           *string `json:"td2name"`
+	Td2nation         *string `json:"td2nation"`
+	Td2code           *int32  `json:"td2code"`
+	Calstatuscode     *string `json:"calstatuscode"`
+	Procstatuscode    *string `json:"procstatuscode"`
+	Receiveddate      *string `json:"receiveddate" format:"date-time"`
+	Pursuit           *string `json:"pursuit"`
+	Masse             *string `json:"masse"`
+	Relay             *string `json:"relay"`
+	Distance          *string `json:"distance"`
+	Hill              *string `json:"hill"`
+	Style             *string `json:"style"`
+	Qualif            *string `json:"qualif"`
+	Finale            *string `json:"finale"`
+	Homol             *string `json:"homol"`
+	Webcomment        *string `json:"webcomment"`
+	Displaystatus     *string `json:"displaystatus"`
+	Fisinterncomment  *string `json:"fisinterncomment"`
+	Published         *int32  `json:"published" example:"1"`
+	Validforfispoints *int32  `json:"validforfispoints" example:"1"`
+	Usedfislist       *string `json:"usedfislist"`
+	Tolist            *string `json:"tolist"`
+	Discforlistcode   *string `json:"discforlistcode"`
+	Calculatedpenalty *string `json:"calculatedpenalty"`
+	Appliedpenalty    *string `json:"appliedpenalty"`
+	Appliedscala      *string `json:"appliedscala"`
+	Penscafixed       *string `json:"penscafixed"`
+	Version           *int32  `json:"version"`
+	Nationraceid      *int32  `json:"nationraceid"`
+	Provraceid        *int32  `json:"provraceid"`
+	Msql7evid         *int32  `json:"msql7evid"`
+	Mssql7id          *int32  `json:"mssql7id"`
+	Results           *int32  `json:"results"`
+	Pdf               *int32  `json:"pdf"`
+	Topbanner         *string `json:"topbanner"`
+	Bottombanner      *string `json:"bottombanner"`
+	Toplogo           *string `json:"toplogo"`
+	Bottomlogo        *string `json:"bottomlogo"`
+	Gallery           *string `json:"gallery"`
+	Indi              *int32  `json:"indi"`
+	Team              *int32  `json:"team"`
+	Tabcount          *int32  `json:"tabcount"`
+	Columncount       *int32  `json:"columncount"`
+	Level             *string `json:"level"`
+	Hloc1             *string `json:"hloc1"`
+	Hloc2             *string `json:"hloc2"`
+	Hloc3             *string `json:"hloc3"`
+	Hcet1             *string `json:"hcet1"`
+	Hcet2             *string `json:"hcet2"`
+	Hcet3             *string `json:"hcet3"`
+	Live              *int32  `json:"live"`
+	Livestatus1       *string `json:"livestatus1"`
+	Livestatus2       *string `json:"livestatus2"`
+	Livestatus3       *string `json:"livestatus3"`
+	Liveinfo1         *string `json:"liveinfo1"`
+	Liveinfo2         *string `json:"liveinfo2"`
+	Liveinfo3         *string `json:"liveinfo3"`
+	Passwd            *string `json:"passwd"`
+	Timinglogo        *string `json:"timinglogo"`
+	Validdate         *string `json:"validdate" format:"date-time"`
+	Noepr             *int32  `json:"noepr"`
+	Tddoc             *int32  `json:"tddoc"`
+	Timingreport      *int32  `json:"timingreport"`
+	SpecialCupPoints  *int32  `json:"special_cup_points"`
+	SkipWcsl          *int32  `json:"skip_wcsl"`
+	Validforowg       *int32  `json:"validforowg"`
+	Lastupdate        *string `json:"lastupdate" format:"date-time" example:"2025-02-14T18:30:00Z"`
+}
+
+type FISRacesCCResponse struct {
+	Races      []FISRaceCC `json:"races"`
+	NextCursor *string     `json:"next_cursor,omitempty" example:"eyJuIjoxMjM0NX0"`
+}
+
+type FISLastRaceCCResponse struct {
+	Race FISRaceCC `json:"race"`
+}
+
+type FISInsertRaceCCExample struct {
+	Raceid            int32   `json:"raceid" validate:"required" example:"123456"`
+	Eventid           *int32  `json:"eventid,omitempty" example:"7890"`
+	Seasoncode        *int32  `json:"seasoncode,omitempty" example:"2025"`
+	Racecodex         *int32  `json:"racecodex,omitempty" example:"1001"`
+	Disciplineid      *string `json:"disciplineid,omitempty" example:"DIST"`
+	Disciplinecode    *string `json:"disciplinecode,omitempty" example:"SP"`
+	Catcode           *string `json:"catcode,omitempty" example:"WC"`
+	Catcode2          *string `json:"catcode2,omitempty"`
+	Catcode3          *string `json:"catcode3,omitempty"`
+	Catcode4          *string `json:"catcode4,omitempty"`
+	Gender            *string `json:"gender,omitempty" example:"M"`
+	Racedate          *string `json:"racedate,omitempty" format:"date" example:"2025-02-14"`       // YYYY-MM-DD
+	Starteventdate    *string `json:"starteventdate,omitempty" format:"date" example:"2025-02-13"` // YYYY-MM-DD
+	Description       *string `json:"description,omitempty" example:"World Cup Sprint"`
+	Place             *string `json:"place,omitempty" example:"Lahti"`
+	Nationcode        *string `json:"nationcode,omitempty" example:"FIN"`
+	Receiveddate      *string `json:"receiveddate,omitempty" format:"date-time"` // RFC3339 or date
+	Validdate         *string `json:"validdate,omitempty" format:"date-time"`    // RFC3339 or date
+	Td1id             *int32  `json:"td1id,omitempty"`
+	Td1name           *string `json:"td1name,omitempty"`
+	Td1nation         *string `json:"td1nation,omitempty"`
+	Td1code           *int32  `json:"td1code,omitempty"`
+	Td2id             *int32  `json:"td2id,omitempty"`
+	Td2name           *string `json:"td2name,omitempty"`
+	Td2nation         *string `json:"td2nation,omitempty"`
+	Td2code           *int32  `json:"td2code,omitempty"`
+	Calstatuscode     *string `json:"calstatuscode,omitempty"`
+	Procstatuscode    *string `json:"procstatuscode,omitempty"`
+	Displaystatus     *string `json:"displaystatus,omitempty"`
+	Fisinterncomment  *string `json:"fisinterncomment,omitempty"`
+	Webcomment        *string `json:"webcomment,omitempty"`
+	Pursuit           *string `json:"pursuit,omitempty"`
+	Masse             *string `json:"masse,omitempty"`
+	Relay             *string `json:"relay,omitempty"`
+	Distance          *string `json:"distance,omitempty"`
+	Hill              *string `json:"hill,omitempty"`
+	Style             *string `json:"style,omitempty"`
+	Qualif            *string `json:"qualif,omitempty"`
+	Finale            *string `json:"finale,omitempty"`
+	Homol             *string `json:"homol,omitempty"`
+	Published         *int32  `json:"published,omitempty" example:"1"`
+	Validforfispoints *int32  `json:"validforfispoints,omitempty" example:"1"`
+	Usedfislist       *string `json:"usedfislist,omitempty"`
+	Tolist            *string `json:"tolist,omitempty"`
+	Discforlistcode   *string `json:"discforlistcode,omitempty"`
+	Calculatedpenalty *string `json:"calculatedpenalty,omitempty"`
+	Appliedpenalty    *string `json:"appliedpenalty,omitempty"`
+	Appliedscala      *string `json:"appliedscala,omitempty"`
+	Penscafixed       *string `json:"penscafixed,omitempty"`
+	Version           *int32  `json:"version,omitempty"`
+	Nationraceid      *int32  `json:"nationraceid,omitempty"`
+	Provraceid        *int32  `json:"provraceid,omitempty"`
+	Msql7evid         *int32  `json:"msql7evid,omitempty"`
+	Mssql7id          *int32  `json:"mssql7id,omitempty"`
+	Topbanner         *string `json:"topbanner,omitempty"`
+	Bottombanner      *string `json:"bottombanner,omitempty"`
+	Toplogo           *string `json:"toplogo,omitempty"`
+	Bottomlogo        *string `json:"bottomlogo,omitempty"`
+	Gallery           *string `json:"gallery,omitempty"`
+	Indi              *int32  `json:"indi,omitempty"`
+	Team              *int32  `json:"team,omitempty"`
+	Tabcount          *int32  `json:"tabcount,omitempty"`
+	Columncount       *int32  `json:"columncount,omitempty"`
+	Level             *string `json:"level,omitempty"`
+	Hloc1             *string `json:"hloc1,omitempty"`
+	Hloc2             *string `json:"hloc2,omitempty"`
+	Hloc3             *string `json:"hloc3,omitempty"`
+	Hcet1             *string `json:"hcet1,omitempty"`
+	Hcet2             *string `json:"hcet2,omitempty"`
+	Hcet3             *string `json:"hcet3,omitempty"`
+	Live              *int32  `json:"live,omitempty"`
+	Livestatus1       *string `json:"livestatus1,omitempty"`
+	Livestatus2       *string `json:"livestatus2,omitempty"`
+	Livestatus3       *string `json:"livestatus3,omitempty"`
+	Liveinfo1         *string `json:"liveinfo1,omitempty"`
+	Liveinfo2         *string `json:"liveinfo2,omitempty"`
+	Liveinfo3         *string `json:"liveinfo3,omitempty"`
+	Passwd            *string `json:"passwd,omitempty"`
+	Timinglogo        *string `json:"timinglogo,omitempty"`
+	Results           *int32  `json:"results,omitempty"`
+	Pdf               *int32  `json:"pdf,omitempty"`
+	Noepr             *int32  `json:"noepr,omitempty"`
+	Tddoc             *int32  `json:"tddoc,omitempty"`
+	Timingreport      *int32  `json:"timingreport,omitempty"`
+	SpecialCupPoints  *int32  `json:"special_cup_points,omitempty"`
+	SkipWcsl          *int32  `json:"skip_wcsl,omitempty"`
+	Validforowg       *int32  `json:"validforowg,omitempty"`
+	Lastupdate        *string `json:"lastupdate,omitempty" format:"date-time" example:"2025-02-14T18:30:00Z"` // RFC3339
+}
+
+type FISUpdateRaceCCExample = FISInsertRaceCCExample
+
+type FISSeasonsJPResponse struct {
+	Seasons []int32 `json:"seasons" swaggertype:"array,integer" example:"2026,2025,2024"`
+}
+
+type FISDisciplinesJPResponse struct {
+	Disciplines []string `json:"disciplines" swaggertype:"array,string" example:"NH,LH,FH"`
+}
+
+type FISCategoriesJPResponse struct {
+	Categories []string `json:"categories" swaggertype:"array,string" example:"WC,COC,WSC"`
+}
+
+type FISRaceJP struct {
+	Raceid            int32   `json:"raceid" example:"234567"`
+	Eventid           *int32  `json:"eventid" example:"8901"`
+	Seasoncode        *int32  `json:"seasoncode" example:"2025"`
+	Racecodex         *int32  `json:"racecodex" example:"2001"`
+	Disciplineid      *string `json:"disciplineid" example:"HS140"`
+	Disciplinecode    *string `json:"disciplinecode" example:"LH"`
+	Catcode           *string `json:"catcode" example:"WC"`
+	Catcode2          *string `json:"catcode2"`
+	Catcode3          *string `json:"catcode3"`
+	Catcode4          *string `json:"catcode4"`
+	Gender            *string `json:"gender" example:"M"`
+	Racedate          *string `json:"racedate" format:"date" example:"2025-01-25"`
+	Starteventdate    *string `json:"starteventdate" format:"date" example:"2025-01-24"`
+	Description       *string `json:"description" example:"World Cup HS140 Individual"`
+	Place             *string `json:"place" example:"Planica"`
+	Nationcode        *string `json:"nationcode" example:"SLO"`
+	Td1id             *int32  `json:"td1id"`
+	Td1name           *string `json:"td1name"`
+	Td1nation         *string `json:"td1nation"`
+	Td1code           *int32  `json:"td1code"`
+	Td2id             *int32  `json:"td2id"`
+	Td2name           *string `json:"td2name"`
+	Td2nation         *string `json:"td2nation"`
+	Td2code           *int32  `json:"td2code"`
+	Calstatuscode     *string `json:"calstatuscode"`
+	Procstatuscode    *string `json:"procstatuscode"`
+	Receiveddate      *string `json:"receiveddate" format:"date-time"`
+	Pursuit           *string `json:"pursuit"`
+	Masse             *string `json:"masse"`
+	Relay             *string `json:"relay"`
+	Distance          *string `json:"distance"`
+	Hill              *int32  `json:"hill" example:"140"`
+	Style             *string `json:"style" example:"CLASSIC"`
+	Qualif            *string `json:"qualif" example:"Q"`
+	Finale            *string `json:"finale"`
+	Homol             *string `json:"homol"`
+	Webcomment        *string `json:"webcomment"`
+	Displaystatus     *string `json:"displaystatus"`
+	Fisinterncomment  *string `json:"fisinterncomment"`
+	Published         *int32  `json:"published" example:"1"`
+	Validforfispoints *int32  `json:"validforfispoints" example:"1"`
+	Usedfislist       *string `json:"usedfislist"`
+	Tolist            *string `json:"tolist"`
+	Discforlistcode   *string `json:"discforlistcode"`
+	Calculatedpenalty *string `json:"calculatedpenalty"`
+	Appliedpenalty    *string `json:"appliedpenalty"`
+	Appliedscala      *string `json:"appliedscala"`
+	Penscafixed       *string `json:"penscafixed"`
+	Version           *int32  `json:"version"`
+	Nationraceid      *int32  `json:"nationraceid"`
+	Provraceid        *int32  `json:"provraceid"`
+	Msql7evid         *int32  `json:"msql7evid"`
+	Mssql7id          *int32  `json:"mssql7id"`
+	Results           *int32  `json:"results"`
+	Pdf               *int32  `json:"pdf"`
+	Topbanner         *string `json:"topbanner"`
+	Bottombanner      *string `json:"bottombanner"`
+	Toplogo           *string `json:"toplogo"`
+	Bottomlogo        *string `json:"bottomlogo"`
+	Gallery           *string `json:"gallery"`
+	Indi              *int32  `json:"indi"`
+	Team              *int32  `json:"team"`
+	Tabcount          *int32  `json:"tabcount"`
+	Columncount       *int32  `json:"columncount"`
+	Level             *string `json:"level"`
+	Hloc1             *string `json:"hloc1" format:"date-time" example:"2025-01-25T15:00:00Z"`
+	Hloc2             *string `json:"hloc2" format:"date-time" example:"2025-01-25T15:30:00Z"`
+	Hloc3             *string `json:"hloc3" format:"date-time" example:"2025-01-25T16:00:00Z"`
+	Hcet1             *string `json:"hcet1" format:"date-time" example:"2025-01-25T15:00:00Z"`
+	Hcet2             *string `json:"hcet2" format:"date-time" example:"2025-01-25T15:30:00Z"`
+	Hcet3             *string `json:"hcet3" format:"date-time" example:"2025-01-25T16:00:00Z"`
+	Live              *int32  `json:"live"`
+	Livestatus1       *string `json:"livestatus1"`
+	Livestatus2       *string `json:"livestatus2"`
+	Livestatus3       *string `json:"livestatus3"`
+	Liveinfo1         *string `json:"liveinfo1"`
+	Liveinfo2         *string `json:"liveinfo2"`
+	Liveinfo3         *string `json:"liveinfo3"`
+	Passwd            *string `json:"passwd"`
+	Timinglogo        *string `json:"timinglogo"`
+	Validdate         *string `json:"validdate" format:"date-time"`
+	Noepr             *int32  `json:"noepr"`
+	Tddoc             *int32  `json:"tddoc"`
+	Timingreport      *int32  `json:"timingreport"`
+	SpecialCupPoints  *int32  `json:"special_cup_points"`
+	SkipWcsl          *int32  `json:"skip_wcsl"`
+	Lastupdate        *string `json:"lastupdate" format:"date-time" example:"2025-01-25T18:30:00Z"`
+	Validforowg       *string `json:"validforowg" example:"Y"`
+}
+
+type FISRacesJPResponse struct {
+	Races      []FISRaceJP `json:"races"`
+	NextCursor *string     `json:"next_cursor,omitempty" example:"eyJuIjoxMjM0NX0"`
+}
+
+type FISLastRaceJPResponse struct {
+	Race FISRaceJP `json:"race"`
+}
+
+type FISInsertRaceJPExample struct {
+	Raceid            int32   `json:"raceid" validate:"required" example:"234567"`
+	Eventid           *int32  `json:"eventid,omitempty" example:"8901"`
+	Seasoncode        *int32  `json:"seasoncode,omitempty" example:"2025"`
+	Racecodex         *int32  `json:"racecodex,omitempty" example:"2001"`
+	Disciplineid      *string `json:"disciplineid,omitempty" example:"HS140"`
+	Disciplinecode    *string `json:"disciplinecode,omitempty" example:"LH"`
+	Catcode           *string `json:"catcode,omitempty" example:"WC"`
+	Catcode2          *string `json:"catcode2,omitempty"`
+	Catcode3          *string `json:"catcode3,omitempty"`
+	Catcode4          *string `json:"catcode4,omitempty"`
+	Gender            *string `json:"gender,omitempty" example:"M"`
+	Racedate          *string `json:"racedate,omitempty" format:"date" example:"2025-01-25"`       // YYYY-MM-DD
+	Starteventdate    *string `json:"starteventdate,omitempty" format:"date" example:"2025-01-24"` // YYYY-MM-DD
+	Description       *string `json:"description,omitempty" example:"World Cup HS140 Individual"`
+	Place             *string `json:"place,omitempty" example:"Planica"`
+	Nationcode        *string `json:"nationcode,omitempty" example:"SLO"`
+	Receiveddate      *string `json:"receiveddate,omitempty" format:"date-time" example:"2025-01-20T10:00:00Z"` // RFC3339
+	Td1id             *int32  `json:"td1id,omitempty"`
+	Td1name           *string `json:"td1name,omitempty" example:"John Doe"`
+	Td1nation         *string `json:"td1nation,omitempty" example:"AUT"`
+	Td1code           *int32  `json:"td1code,omitempty" example:"101"`
+	Td2id             *int32  `json:"td2id,omitempty"`
+	Td2name           *string `json:"td2name,omitempty" example:"Jane Doe"`
+	Td2nation         *string `json:"td2nation,omitempty" example:"GER"`
+	Td2code           *int32  `json:"td2code,omitempty" example:"102"`
+	Calstatuscode     *string `json:"calstatuscode,omitempty" example:"OFF"`
+	Procstatuscode    *string `json:"procstatuscode,omitempty" example:"P"`
+	Pursuit           *string `json:"pursuit,omitempty"`
+	Masse             *string `json:"masse,omitempty"`
+	Relay             *string `json:"relay,omitempty"`
+	Distance          *string `json:"distance,omitempty"`
+	Hill              *int32  `json:"hill,omitempty" example:"140"`
+	Style             *string `json:"style,omitempty" example:"CLASSIC"`
+	Qualif            *string `json:"qualif,omitempty" example:"Q"`
+	Finale            *string `json:"finale,omitempty"`
+	Homol             *string `json:"homol,omitempty"`
+	Webcomment        *string `json:"webcomment,omitempty"`
+	Displaystatus     *string `json:"displaystatus,omitempty" example:"OK"`
+	Fisinterncomment  *string `json:"fisinterncomment,omitempty"`
+	Published         *int32  `json:"published,omitempty" example:"1"`
+	Validforfispoints *int32  `json:"validforfispoints,omitempty" example:"1"`
+	Usedfislist       *string `json:"usedfislist,omitempty"`
+	Tolist            *string `json:"tolist,omitempty"`
+	Discforlistcode   *string `json:"discforlistcode,omitempty"`
+	Calculatedpenalty *string `json:"calculatedpenalty,omitempty"`
+	Appliedpenalty    *string `json:"appliedpenalty,omitempty"`
+	Appliedscala      *string `json:"appliedscala,omitempty"`
+	Penscafixed       *string `json:"penscafixed,omitempty"`
+	Version           *int32  `json:"version,omitempty" example:"1"`
+	Nationraceid      *int32  `json:"nationraceid,omitempty"`
+	Provraceid        *int32  `json:"provraceid,omitempty"`
+	Msql7evid         *int32  `json:"msql7evid,omitempty"`
+	Mssql7id          *int32  `json:"mssql7id,omitempty"`
+	Results           *int32  `json:"results"`
+	Pdf               *int32  `json:"pdf"`
+	Topbanner         *string `json:"topbanner,omitempty"`
+	Bottombanner      *string `json:"bottombanner,omitempty"`
+	Toplogo           *string `json:"toplogo,omitempty"`
+	Bottomlogo        *string `json:"bottomlogo,omitempty"`
+	Gallery           *string `json:"gallery,omitempty"`
+	Indi              *int32  `json:"indi,omitempty" example:"1"`
+	Team              *int32  `json:"team,omitempty" example:"0"`
+	Tabcount          *int32  `json:"tabcount,omitempty"`
+	Columncount       *int32  `json:"columncount,omitempty"`
+	Level             *string `json:"level,omitempty" example:"WC"`
+	Hloc1             *string `json:"hloc1,omitempty" format:"date-time" example:"2025-01-25T15:00:00Z"` // RFC3339 time-of-day
+	Hloc2             *string `json:"hloc2,omitempty" format:"date-time" example:"2025-01-25T15:30:00Z"`
+	Hloc3             *string `json:"hloc3,omitempty" format:"date-time" example:"2025-01-25T16:00:00Z"`
+	Hcet1             *string `json:"hcet1,omitempty" format:"date-time" example:"2025-01-25T15:00:00Z"`
+	Hcet2             *string `json:"hcet2,omitempty" format:"date-time" example:"2025-01-25T15:30:00Z"`
+	Hcet3             *string `json:"hcet3,omitempty" format:"date-time" example:"2025-01-25T16:00:00Z"`
+	Live              *int32  `json:"live,omitempty" example:"1"`
+	Livestatus1       *string `json:"livestatus1,omitempty" example:"LIVE"`
+	Livestatus2       *string `json:"livestatus2,omitempty"`
+	Livestatus3       *string `json:"livestatus3,omitempty"`
+	Liveinfo1         *string `json:"liveinfo1,omitempty"`
+	Liveinfo2         *string `json:"liveinfo2,omitempty"`
+	Liveinfo3         *string `json:"liveinfo3,omitempty"`
+	Passwd            *string `json:"passwd,omitempty"`
+	Timinglogo        *string `json:"timinglogo,omitempty"`
+	Validdate         *string `json:"validdate,omitempty" format:"date-time"` // RFC3339
+	Noepr             *int32  `json:"noepr,omitempty"`
+	Tddoc             *int32  `json:"tddoc,omitempty"`
+	Timingreport      *int32  `json:"timingreport,omitempty"`
+	SpecialCupPoints  *int32  `json:"special_cup_points,omitempty"`
+	SkipWcsl          *int32  `json:"skip_wcsl,omitempty"`
+	Lastupdate        *string `json:"lastupdate,omitempty" format:"date-time" example:"2025-01-25T18:30:00Z"` // RFC3339
+	Validforowg       *string `json:"validforowg,omitempty" example:"Y"`                                      // varchar
+}
+
+type FISUpdateRaceJPExample = FISInsertRaceJPExample
+
+type FISSeasonsNKResponse struct {
+	Seasons []int32 `json:"seasons" swaggertype:"array,integer" example:"2026,2025,2024"`
+}
+
+type FISDisciplinesNKResponse struct {
+	Disciplines []string `json:"disciplines" swaggertype:"array,string" example:"NH,LH,GH"`
+}
+
+type FISCategoriesNKResponse struct {
+	Categories []string `json:"categories" swaggertype:"array,string" example:"WC,WSC,COC"`
+}
+
+type FISRaceNK struct {
+	Raceid            int32   `json:"raceid" example:"8801"`
+	Eventid           *int32  `json:"eventid" example:"9001"`
+	Seasoncode        *int32  `json:"seasoncode" example:"2025"`
+	Racecodex         *int32  `json:"racecodex" example:"3001"`
+	Disciplineid      *string `json:"disciplineid" example:"NH10K"`
+	Disciplinecode    *string `json:"disciplinecode" example:"NC"`
+	Catcode           *string `json:"catcode" example:"WC"`
+	Catcode2          *string `json:"catcode2"`
+	Catcode3          *string `json:"catcode3"`
+	Catcode4          *string `json:"catcode4"`
+	Gender            *string `json:"gender" example:"M"`
+	Racedate          *string `json:"racedate" format:"date" example:"2025-02-15"`
+	Starteventdate    *string `json:"starteventdate" format:"date" example:"2025-02-14"`
+	Description       *string `json:"description" example:"World Cup NH 10 km"`
+	Place             *string `json:"place" example:"Seefeld"`
+	Nationcode        *string `json:"nationcode" example:"AUT"`
+	Td1id             *int32  `json:"td1id" example:"101"`
+	Td1name           *string `json:"td1name" example:"John Doe"`
+	Td1nation         *string `json:"td1nation" example:"AUT"`
+	Td1code           *int32  `json:"td1code" example:"1001"`
+	Td2id             *int32  `json:"td2id" example:"102"`
+	Td2name           *string `json:"td2name" example:"Jane Doe"`
+	Td2nation         *string `json:"td2nation" example:"GER"`
+	Td2code           *int32  `json:"td2code" example:"1002"`
+	Calstatuscode     *string `json:"calstatuscode" example:"OFF"`
+	Procstatuscode    *string `json:"procstatuscode" example:"P"`
+	Receiveddate      *string `json:"receiveddate" format:"date-time" example:"2025-02-10T10:00:00Z"`
+	Pursuit           *string `json:"pursuit"`
+	Masse             *string `json:"masse"`
+	Relay             *string `json:"relay"`
+	Distance          *string `json:"distance" example:"10km"`
+	Hill              *int32  `json:"hill" example:"109"`
+	Style             *string `json:"style" example:"FREE"`
+	Qualif            *string `json:"qualif" example:"Q"`
+	Finale            *string `json:"finale"`
+	Homol             *string `json:"homol"`
+	Webcomment        *string `json:"webcomment"`
+	Displaystatus     *string `json:"displaystatus" example:"OK"`
+	Fisinterncomment  *string `json:"fisinterncomment"`
+	Published         *int32  `json:"published" example:"1"`
+	Validforfispoints *int32  `json:"validforfispoints" example:"1"`
+	Usedfislist       *string `json:"usedfislist"`
+	Tolist            *string `json:"tolist"`
+	Discforlistcode   *string `json:"discforlistcode"`
+	Calculatedpenalty *string `json:"calculatedpenalty"`
+	Appliedpenalty    *string `json:"appliedpenalty"`
+	Appliedscala      *string `json:"appliedscala"`
+	Penscafixed       *string `json:"penscafixed"`
+	Version           *int32  `json:"version" example:"1"`
+	Nationraceid      *int32  `json:"nationraceid" example:"0"`
+	Provraceid        *int32  `json:"provraceid" example:"0"`
+	Msql7evid         *int32  `json:"msql7evid" example:"0"`
+	Mssql7id          *int32  `json:"mssql7id" example:"0"`
+	Results           *int32  `json:"results" example:"0"`
+	Pdf               *int32  `json:"pdf" example:"0"`
+	Topbanner         *string `json:"topbanner"`
+	Bottombanner      *string `json:"bottombanner"`
+	Toplogo           *string `json:"toplogo"`
+	Bottomlogo        *string `json:"bottomlogo"`
+	Gallery           *string `json:"gallery"`
+	Indi              *int32  `json:"indi" example:"1"`
+	Team              *int32  `json:"team" example:"0"`
+	Tabcount          *int32  `json:"tabcount" example:"0"`
+	Columncount       *int32  `json:"columncount" example:"0"`
+	Level             *string `json:"level" example:"WC"`
+	Hloc1             *string `json:"hloc1" format:"date-time" example:"2025-02-15T09:30:00Z"`
+	Hloc2             *string `json:"hloc2" format:"date-time" example:"2025-02-15T10:00:00Z"`
+	Hloc3             *string `json:"hloc3" format:"date-time" example:"2025-02-15T10:30:00Z"`
+	Hcet1             *string `json:"hcet1" format:"date-time" example:"2025-02-15T11:00:00Z"`
+	Hcet2             *string `json:"hcet2" format:"date-time" example:"2025-02-15T11:30:00Z"`
+	Hcet3             *string `json:"hcet3" format:"date-time" example:"2025-02-15T12:00:00Z"`
+	Live              *int32  `json:"live" example:"1"`
+	Livestatus1       *string `json:"livestatus1" example:"LIVE"`
+	Livestatus2       *string `json:"livestatus2"`
+	Livestatus3       *string `json:"livestatus3"`
+	Liveinfo1         *string `json:"liveinfo1"`
+	Liveinfo2         *string `json:"liveinfo2"`
+	Liveinfo3         *string `json:"liveinfo3"`
+	Passwd            *string `json:"passwd"`
+	Timinglogo        *string `json:"timinglogo"`
+	Validdate         *string `json:"validdate" format:"date-time" example:"2025-02-16T00:00:00Z"`
+	Noepr             *int32  `json:"noepr" example:"0"`
+	Tddoc             *int32  `json:"tddoc" example:"0"`
+	Timingreport      *int32  `json:"timingreport" example:"0"`
+	SpecialCupPoints  *int32  `json:"special_cup_points" example:"0"`
+	SkipWcsl          *int32  `json:"skip_wcsl" example:"0"`
+	Validforowg       *int32  `json:"validforowg" example:"1"`
+	Lastupdate        *string `json:"lastupdate" format:"date-time" example:"2025-02-15T18:30:00Z"`
+}
+
+type FISRacesNKResponse struct {
+	Races      []FISRaceNK `json:"races"`
+	NextCursor *string     `json:"next_cursor,omitempty" example:"eyJuIjoxMjM0NX0"`
+}
+
+type FISLastRaceNKResponse struct {
+	Race FISRaceNK `json:"race"`
+}
+
+type FISInsertRaceNKExample struct {
+	Raceid            int32   `json:"raceid" validate:"required" example:"8801"`
+	Eventid           *int32  `json:"eventid,omitempty" example:"9001"`
+	Seasoncode        *int32  `json:"seasoncode,omitempty" example:"2025"`
+	Racecodex         *int32  `json:"racecodex,omitempty" example:"3001"`
+	Disciplineid      *string `json:"disciplineid,omitempty" example:"NH10K"`
+	Disciplinecode    *string `json:"disciplinecode,omitempty" example:"NC"`
+	Catcode           *string `json:"catcode,omitempty" example:"WC"`
+	Catcode2          *string `json:"catcode2,omitempty"`
+	Catcode3          *string `json:"catcode3,omitempty"`
+	Catcode4          *string `json:"catcode4,omitempty"`
+	Gender            *string `json:"gender,omitempty" example:"M"`
+	Racedate          *string `json:"racedate,omitempty" format:"date" example:"2025-02-15"`       // YYYY-MM-DD
+	Starteventdate    *string `json:"starteventdate,omitempty" format:"date" example:"2025-02-14"` // YYYY-MM-DD
+	Description       *string `json:"description,omitempty" example:"World Cup NH 10 km"`
+	Place             *string `json:"place,omitempty" example:"Seefeld"`
+	Nationcode        *string `json:"nationcode,omitempty" example:"AUT"`
+	Receiveddate      *string `json:"receiveddate,omitempty" format:"date-time" example:"2025-02-10T10:00:00Z"` // RFC3339
+	Validdate         *string `json:"validdate,omitempty" format:"date-time" example:"2025-02-16T00:00:00Z"`    // RFC3339
+	Td1id             *int32  `json:"td1id,omitempty" example:"101"`
+	Td1name           *string `json:"td1name,omitempty" example:"John Doe"`
+	Td1nation         *string `json:"td1nation,omitempty" example:"AUT"`
+	Td1code           *int32  `json:"td1code,omitempty" example:"1001"`
+	Td2id             *int32  `json:"td2id,omitempty" example:"102"`
+	Td2name           *string `json:"td2name,omitempty" example:"Jane Doe"`
+	Td2nation         *string `json:"td2nation,omitempty" example:"GER"`
+	Td2code           *int32  `json:"td2code,omitempty" example:"1002"`
+	Calstatuscode     *string `json:"calstatuscode,omitempty" example:"OFF"`
+	Procstatuscode    *string `json:"procstatuscode,omitempty" example:"P"`
+	Pursuit           *string `json:"pursuit,omitempty"`
+	Masse             *string `json:"masse,omitempty"`
+	Relay             *string `json:"relay,omitempty"`
+	Distance          *string `json:"distance,omitempty" example:"10km"`
+	Hill              *int32  `json:"hill,omitempty" example:"109"`
+	Style             *string `json:"style,omitempty" example:"FREE"`
+	Qualif            *string `json:"qualif,omitempty" example:"Q"`
+	Finale            *string `json:"finale,omitempty"`
+	Homol             *string `json:"homol,omitempty"`
+	Webcomment        *string `json:"webcomment,omitempty"`
+	Displaystatus     *string `json:"displaystatus,omitempty" example:"OK"`
+	Fisinterncomment  *string `json:"fisinterncomment,omitempty"`
+	Published         *int32  `json:"published,omitempty" example:"1"`
+	Validforfispoints *int32  `json:"validforfispoints,omitempty" example:"1"`
+	Usedfislist       *string `json:"usedfislist,omitempty"`
+	Tolist            *string `json:"tolist,omitempty"`
+	Discforlistcode   *string `json:"discforlistcode,omitempty"`
+	Calculatedpenalty *string `json:"calculatedpenalty,omitempty"`
+	Appliedpenalty    *string `json:"appliedpenalty,omitempty"`
+	Appliedscala      *string `json:"appliedscala,omitempty"`
+	Penscafixed       *string `json:"penscafixed,omitempty"`
+	Version           *int32  `json:"version,omitempty" example:"1"`
+	Nationraceid      *int32  `json:"nationraceid,omitempty" example:"0"`
+	Provraceid        *int32  `json:"provraceid,omitempty" example:"0"`
+	Msql7evid         *int32  `json:"msql7evid,omitempty" example:"0"`
+	Mssql7id          *int32  `json:"mssql7id,omitempty" example:"0"`
+	Results           *int32  `json:"results"`
+	Pdf               *int32  `json:"pdf"`
+	Topbanner         *string `json:"topbanner,omitempty"`
+	Bottombanner      *string `json:"bottombanner,omitempty"`
+	Toplogo           *string `json:"toplogo,omitempty"`
+	Bottomlogo        *string `json:"bottomlogo,omitempty"`
+	Gallery           *string `json:"gallery,omitempty"`
+	Indi              *int32  `json:"indi,omitempty" example:"1"`
+	Team              *int32  `json:"team,omitempty" example:"0"`
+	Tabcount          *int32  `json:"tabcount,omitempty" example:"0"`
+	Columncount       *int32  `json:"columncount,omitempty" example:"0"`
+	Level             *string `json:"level,omitempty" example:"WC"`
+	Hloc1             *string `json:"hloc1,omitempty" format:"date-time" example:"2025-02-15T09:30:00Z"` // RFC3339
+	Hloc2             *string `json:"hloc2,omitempty" format:"date-time" example:"2025-02-15T10:00:00Z"`
+	Hloc3             *string `json:"hloc3,omitempty" format:"date-time" example:"2025-02-15T10:30:00Z"`
+	Hcet1             *string `json:"hcet1,omitempty" format:"date-time" example:"2025-02-15T11:00:00Z"`
+	Hcet2             *string `json:"hcet2,omitempty" format:"date-time" example:"2025-02-15T11:30:00Z"`
+	Hcet3             *string `json:"hcet3,omitempty" format:"date-time" example:"2025-02-15T12:00:00Z"`
+	Live              *int32  `json:"live,omitempty" example:"1"`
+	Livestatus1       *string `json:"livestatus1,omitempty" example:"LIVE"`
+	Livestatus2       *string `json:"livestatus2,omitempty"`
+	Livestatus3       *string `json:"livestatus3,omitempty"`
+	Liveinfo1         *string `json:"liveinfo1,omitempty"`
+	Liveinfo2         *string `json:"liveinfo2,omitempty"`
+	Liveinfo3         *string `json:"liveinfo3,omitempty"`
+	Passwd            *string `json:"passwd,omitempty"`
+	Timinglogo        *string `json:"timinglogo,omitempty"`
+	Noepr             *int32  `json:"noepr,omitempty" example:"0"`
+	Tddoc             *int32  `json:"tddoc,omitempty" example:"0"`
+	Timingreport      *int32  `json:"timingreport,omitempty" example:"0"`
+	SpecialCupPoints  *int32  `json:"special_cup_points,omitempty" example:"0"`
+	SkipWcsl          *int32  `json:"skip_wcsl,omitempty" example:"0"`
+	Validforowg       *int32  `json:"validforowg,omitempty" example:"1"`
+	Lastupdate        *string `json:"lastupdate,omitempty" format:"date-time" example:"2025-02-15T18:30:00Z"` // RFC3339
+}
+
+type FISUpdateRaceNKExample = FISInsertRaceNKExample
+
+type FISResultCC struct {
+	Recid          int32   `json:"recid" example:"12345"`
+	Raceid         *int32  `json:"raceid" example:"98765"`
+	Competitorid   *int32  `json:"competitorid" example:"11111"`
+	Status         *string `json:"status" example:"OK"`
+	Reason         *string `json:"reason" example:""`
+	Position       *string `json:"position" example:"1.00000"`
+	Pf             *int32  `json:"pf" example:"0"`
+	Status2        *string `json:"status2" example:""`
+	Bib            *string `json:"bib" example:"10.00000"`
+	Bibcolor       *string `json:"bibcolor" example:"RED"`
+	Fiscode        *int32  `json:"fiscode" example:"1234567"`
+	Competitorname *string `json:"competitorname" example:"DOE John"`
+	Nationcode     *string `json:"nationcode" example:"NOR"`
+	Stage          *string `json:"stage" example:"F"`
+	Level          *string `json:"level" example:"WC"`
+	Heat           *string `json:"heat" example:"1"`
+	Timer1         *string `json:"timer1" example:"03:10.5"`
+	Timer2         *string `json:"timer2" example:"06:22.0"`
+	Timer3         *string `json:"timer3" example:""`
+	Timetot        *string `json:"timetot" example:"26:30.2"`
+	Valid          *string `json:"valid" example:"1.00000"`
+	Racepoints     *string `json:"racepoints" example:"2.34"`
+	Cuppoints      *string `json:"cuppoints" example:"100.00000"`
+	Bonustime      *string `json:"bonustime" example:"00:10.0"`
+	Bonuscuppoints *string `json:"bonuscuppoints" example:"15"`
+	Version        *string `json:"version" example:"1"`
+	Rg1            *string `json:"rg1" example:""`
+	Rg2            *string `json:"rg2" example:""`
+	Lastupdate     *string `json:"lastupdate" example:"2025-01-01T12:00:00Z"`
+}
+
+type FISAthleteResultCC struct {
+	Recid          int32   `json:"recid" example:"12345"`
+	Raceid         *int32  `json:"raceid" example:"98765"`
+	Position       *string `json:"position" example:"1.00000"`
+	Timetot        *string `json:"timetot" example:"26:30.2"`
+	Competitorid   *int32  `json:"competitorid" example:"11111"`
+	Racedate       *string `json:"racedate" example:"2025-02-15"`
+	Seasoncode     *int32  `json:"seasoncode" example:"2025"`
+	Disciplinecode *string `json:"disciplinecode" example:"DSPR"`
+	Catcode        *string `json:"catcode" example:"WC"`
+	Place          *string `json:"place" example:"Oslo"`
+}
+
+type FISLastResultCCResponse struct {
+	Result FISResultCC `json:"result"`
+}
+
+type FISRaceResultsCCResponse struct {
+	Results []FISResultCC `json:"results"`
+}
+
+type FISAthleteResultsCCResponse struct {
+	Results []FISAthleteResultCC `json:"results"`
+}
+
+type FISInsertResultCCExample struct {
+	Recid          int32   `json:"recid" validate:"required" example:"12345"`
+	Raceid         *int32  `json:"raceid" example:"98765"`
+	Competitorid   *int32  `json:"competitorid" example:"11111"`
+	Status         *string `json:"status" example:"OK"`
+	Reason         *string `json:"reason" example:""`
+	Position       *string `json:"position" example:"1.00000"`
+	Pf             *int32  `json:"pf" example:"0"`
+	Status2        *string `json:"status2" example:""`
+	Bib            *string `json:"bib" example:"10.00000"`
+	Bibcolor       *string `json:"bibcolor" example:"RED"`
+	Fiscode        *int32  `json:"fiscode" example:"1234567"`
+	Competitorname *string `json:"competitorname" example:"DOE John"`
+	Nationcode     *string `json:"nationcode" example:"NOR"`
+	Stage          *string `json:"stage" example:"F"`
+	Level          *string `json:"level" example:"WC"`
+	Heat           *string `json:"heat" example:"1"`
+	Timer1         *string `json:"timer1" example:"03:10.5"`
+	Timer2         *string `json:"timer2" example:"06:22.0"`
+	Timer3         *string `json:"timer3" example:""`
+	Timetot        *string `json:"timetot" example:"26:30.2"`
+	Valid          *string `json:"valid" example:"1.00000"`
+	Racepoints     *string `json:"racepoints" example:"2.34"`
+	Cuppoints      *string `json:"cuppoints" example:"100.00000"`
+	Bonustime      *string `json:"bonustime" example:"00:10.0"`
+	Bonuscuppoints *string `json:"bonuscuppoints" example:"15"`
+	Version        *string `json:"version" example:"1"`
+	Rg1            *string `json:"rg1" example:""`
+	Rg2            *string `json:"rg2" example:""`
+	Lastupdate     *string `json:"lastupdate" example:"2025-01-01T12:00:00Z"` // RFC3339
+}
+
+type FISUpdateResultCCExample = FISInsertResultCCExample
+
+type FISResultJP struct {
+	Recid          int32   `json:"recid" example:"50001"`
+	Raceid         *int32  `json:"raceid" example:"234567"`
+	Competitorid   *int32  `json:"competitorid" example:"345678"`
+	Status         *string `json:"status" example:"OK"`
+	Status2        *string `json:"status2" example:""`
+	Position       *int32  `json:"position" example:"1"`
+	Bib            *int32  `json:"bib" example:"15"`
+	Fiscode        *int32  `json:"fiscode" example:"1234567"`
+	Competitorname *string `json:"competitorname" example:"KOBAYASHI Ryoyu"`
+	Nationcode     *string `json:"nationcode" example:"JPN"`
+	Level          *string `json:"level" example:"WC"`
+	Heat           *string `json:"heat" example:"1"`
+	Stage          *string `json:"stage" example:"F"`
+	J1r1           *string `json:"j1r1"`
+	J2r1           *string `json:"j2r1"`
+	J3r1           *string `json:"j3r1"`
+	J4r1           *string `json:"j4r1"`
+	J5r1           *string `json:"j5r1"`
+	Speedr1        *string `json:"speedr1" example:"88.5"`
+	Distr1         *string `json:"distr1" example:"138.0"`
+	Disptsr1       *string `json:"disptsr1" example:"78.5"`
+	Judptsr1       *string `json:"judptsr1" example:"57.0"`
+	Totrun1        *string `json:"totrun1" example:"135.5"`
+	Posr1          *string `json:"posr1" example:"1"`
+	Statusr1       *string `json:"statusr1" example:"OK"`
+	J1r2           *string `json:"j1r2"`
+	J2r2           *string `json:"j2r2"`
+	J3r2           *string `json:"j3r2"`
+	J4r2           *string `json:"j4r2"`
+	J5r2           *string `json:"j5r2"`
+	Speedr2        *string `json:"speedr2" example:"88.0"`
+	Distr2         *string `json:"distr2" example:"137.0"`
+	Disptsr2       *string `json:"disptsr2" example:"77.9"`
+	Judptsr2       *string `json:"judptsr2" example:"56.5"`
+	Totrun2        *string `json:"totrun2" example:"134.4"`
+	Posr2          *string `json:"posr2" example:"1"`
+	Statusr2       *string `json:"statusr2" example:"OK"`
+	J1r3           *string `json:"j1r3"`
+	J2r3           *string `json:"j2r3"`
+	J3r3           *string `json:"j3r3"`
+	J4r3           *string `json:"j4r3"`
+	J5r3           *string `json:"j5r3"`
+	Speedr3        *string `json:"speedr3"`
+	Distr3         *string `json:"distr3"`
+	Disptsr3       *string `json:"disptsr3"`
+	Judptsr3       *string `json:"judptsr3"`
+	Totrun3        *string `json:"totrun3"`
+	Posr3          *string `json:"posr3"`
+	Statusr3       *string `json:"statusr3"`
+	J1r4           *string `json:"j1r4"`
+	J2r4           *string `json:"j2r4"`
+	J3r4           *string `json:"j3r4"`
+	J4r4           *string `json:"j4r4"`
+	J5r4           *string `json:"j5r4"`
+	Speedr4        *string `json:"speedr4"`
+	Distr4         *string `json:"distr4"`
+	Disptsr4       *string `json:"disptsr4"`
+	Judptsr4       *string `json:"judptsr4"`
+	Gater1         *string `json:"gater1" example:"8"`
+	Gater2         *string `json:"gater2" example:"8"`
+	Gater3         *string `json:"gater3"`
+	Gater4         *string `json:"gater4"`
+	Gateptsr1      *string `json:"gateptsr1" example:"0.0"`
+	Gateptsr2      *string `json:"gateptsr2" example:"0.0"`
+	Gateptsr3      *string `json:"gateptsr3"`
+	Gateptsr4      *string `json:"gateptsr4"`
+	Windr1         *string `json:"windr1" example:"-0.5"`
+	Windr2         *string `json:"windr2" example:"-0.3"`
+	Windr3         *string `json:"windr3"`
+	Windr4         *string `json:"windr4"`
+	Windptsr1      *string `json:"windptsr1" example:"1.2"`
+	Windptsr2      *string `json:"windptsr2" example:"0.8"`
+	Windptsr3      *string `json:"windptsr3"`
+	Windptsr4      *string `json:"windptsr4"`
+	Reason         *string `json:"reason" example:""`
+	Totrun4        *string `json:"totrun4"`
+	Tot            *string `json:"tot" example:"269.9"`
+	Valid          *int32  `json:"valid" example:"1"`
+	Racepoints     *string `json:"racepoints" example:"0.0"`
+	Cuppoints      *string `json:"cuppoints" example:"100"`
+	Version        *string `json:"version" example:"1"`
+	Lastupdate     *string `json:"lastupdate" example:"2025-01-25T18:30:00Z"`
+	Posr4          *string `json:"posr4"`
+	Statusr4       *string `json:"statusr4"`
+}
+
+type FISAthleteResultJP struct {
+	Raceid         *int32  `json:"raceid" example:"234567"`
+	Position       *int32  `json:"position" example:"1"`
+	Racedate       *string `json:"racedate" example:"2025-01-25"`
+	Seasoncode     *int32  `json:"seasoncode" example:"2025"`
+	Disciplinecode *string `json:"disciplinecode" example:"LH"`
+	Catcode        *string `json:"catcode" example:"WC"`
+	Place          *string `json:"place" example:"Planica"`
+	Posr1          *string `json:"posr1" example:"1"`
+	Speedr1        *string `json:"speedr1" example:"88.5"`
+	Distr1         *string `json:"distr1" example:"138.0"`
+	Judptsr1       *string `json:"judptsr1" example:"57.0"`
+	Windr1         *string `json:"windr1" example:"-0.5"`
+	Windptsr1      *string `json:"windptsr1" example:"1.2"`
+	Gater1         *string `json:"gater1" example:"8"`
+	Posr2          *string `json:"posr2" example:"1"`
+	Speedr2        *string `json:"speedr2" example:"88.0"`
+	Distr2         *string `json:"distr2" example:"137.0"`
+	Judptsr2       *string `json:"judptsr2" example:"56.5"`
+	Windr2         *string `json:"windr2" example:"-0.3"`
+	Windptsr2      *string `json:"windptsr2" example:"0.8"`
+	Gater2         *string `json:"gater2" example:"8"`
+	Totrun1        *string `json:"totrun1" example:"135.5"`
+	Totrun2        *string `json:"totrun2" example:"134.4"`
+}
+
+type FISLastResultJPResponse struct {
+	Result FISResultJP `json:"result"`
+}
+
+type FISRaceResultsJPResponse struct {
+	Results []FISResultJP `json:"results"`
+}
+
+type FISAthleteResultsJPResponse struct {
+	Results []FISAthleteResultJP `json:"results"`
+}
+
+type FISInsertResultJPExample struct {
+	Recid          int32   `json:"recid" validate:"required" example:"50001"`
+	Raceid         *int32  `json:"raceid" example:"234567"`
+	Competitorid   *int32  `json:"competitorid" example:"345678"`
+	Status         *string `json:"status" example:"OK"`
+	Status2        *string `json:"status2" example:""`
+	Position       *int32  `json:"position" example:"1"`
+	Bib            *int32  `json:"bib" example:"15"`
+	Fiscode        *int32  `json:"fiscode" example:"1234567"`
+	Competitorname *string `json:"competitorname" example:"KOBAYASHI Ryoyu"`
+	Nationcode     *string `json:"nationcode" example:"JPN"`
+	Level          *string `json:"level" example:"WC"`
+	Heat           *string `json:"heat" example:"1"`
+	Stage          *string `json:"stage" example:"F"`
+	J1r1           *string `json:"j1r1,omitempty" example:"19.0"`
+	J2r1           *string `json:"j2r1,omitempty" example:"19.0"`
+	J3r1           *string `json:"j3r1,omitempty" example:"19.0"`
+	J4r1           *string `json:"j4r1,omitempty" example:"19.0"`
+	J5r1           *string `json:"j5r1,omitempty" example:"19.0"`
+	Speedr1        *string `json:"speedr1" example:"88.5"`
+	Distr1         *string `json:"distr1" example:"138.0"`
+	Disptsr1       *string `json:"disptsr1" example:"78.5"`
+	Judptsr1       *string `json:"judptsr1" example:"57.0"`
+	Totrun1        *string `json:"totrun1" example:"135.5"`
+	Posr1          *string `json:"posr1" example:"1"`
+	Statusr1       *string `json:"statusr1" example:"OK"`
+	J1r2           *string `json:"j1r2,omitempty" example:"18.5"`
+	J2r2           *string `json:"j2r2,omitempty" example:"18.5"`
+	J3r2           *string `json:"j3r2,omitempty" example:"18.5"`
+	J4r2           *string `json:"j4r2,omitempty" example:"18.5"`
+	J5r2           *string `json:"j5r2,omitempty" example:"18.5"`
+	Speedr2        *string `json:"speedr2" example:"88.0"`
+	Distr2         *string `json:"distr2" example:"137.0"`
+	Disptsr2       *string `json:"disptsr2" example:"77.9"`
+	Judptsr2       *string `json:"judptsr2" example:"56.5"`
+	Totrun2        *string `json:"totrun2" example:"134.4"`
+	Posr2          *string `json:"posr2" example:"1"`
+	Statusr2       *string `json:"statusr2" example:"OK"`
+	J1r3           *string `json:"j1r3,omitempty"`
+	J2r3           *string `json:"j2r3,omitempty"`
+	J3r3           *string `json:"j3r3,omitempty"`
+	J4r3           *string `json:"j4r3,omitempty"`
+	J5r3           *string `json:"j5r3,omitempty"`
+	Speedr3        *string `json:"speedr3,omitempty"`
+	Distr3         *string `json:"distr3,omitempty"`
+	Disptsr3       *string `json:"disptsr3,omitempty"`
+	Judptsr3       *string `json:"judptsr3,omitempty"`
+	Totrun3        *string `json:"totrun3,omitempty"`
+	Posr3          *string `json:"posr3,omitempty"`
+	Statusr3       *string `json:"statusr3,omitempty"`
+	J1r4           *string `json:"j1r4,omitempty"`
+	J2r4           *string `json:"j2r4,omitempty"`
+	J3r4           *string `json:"j3r4,omitempty"`
+	J4r4           *string `json:"j4r4,omitempty"`
+	J5r4           *string `json:"j5r4,omitempty"`
+	Speedr4        *string `json:"speedr4,omitempty"`
+	Distr4         *string `json:"distr4,omitempty"`
+	Disptsr4       *string `json:"disptsr4,omitempty"`
+	Judptsr4       *string `json:"judptsr4,omitempty"`
+	Gater1         *string `json:"gater1,omitempty" example:"8"`
+	Gater2         *string `json:"gater2,omitempty" example:"8"`
+	Gater3         *string `json:"gater3,omitempty"`
+	Gater4         *string `json:"gater4,omitempty"`
+	Gateptsr1      *string `json:"gateptsr1,omitempty" example:"0.0"`
+	Gateptsr2      *string `json:"gateptsr2,omitempty" example:"0.0"`
+	Gateptsr3      *string `json:"gateptsr3,omitempty"`
+	Gateptsr4      *string `json:"gateptsr4,omitempty"`
+	Windr1         *string `json:"windr1,omitempty" example:"-0.5"`
+	Windr2         *string `json:"windr2,omitempty" example:"-0.3"`
+	Windr3         *string `json:"windr3,omitempty"`
+	Windr4         *string `json:"windr4,omitempty"`
+	Windptsr1      *string `json:"windptsr1,omitempty" example:"1.2"`
+	Windptsr2      *string `json:"windptsr2,omitempty" example:"0.8"`
+	Windptsr3      *string `json:"windptsr3,omitempty"`
+	Windptsr4      *string `json:"windptsr4,omitempty"`
+	Reason         *string `json:"reason,omitempty" example:""`
+	Totrun4        *string `json:"totrun4,omitempty"`
+	Tot            *string `json:"tot" example:"269.9"`
+	Valid          *int32  `json:"valid" example:"1"`
+	Racepoints     *string `json:"racepoints" example:"0.0"`
+	Cuppoints      *string `json:"cuppoints" example:"100"`
+	Version        *string `json:"version" example:"1"`
+	Lastupdate     *string `json:"lastupdate" example:"2025-01-25T18:30:00Z"` // RFC3339
+	Posr4          *string `json:"posr4,omitempty"`
+	Statusr4       *string `json:"statusr4,omitempty"`
+}
+
+type FISUpdateResultJPExample = FISInsertResultJPExample
+
+type FISResultNK struct {
+	Recid          int32   `json:"recid" example:"70001"`
+	Raceid         *int32  `json:"raceid" example:"8801"`
+	Competitorid   *int32  `json:"competitorid" example:"123456"`
+	Status         *string `json:"status" example:"OK"`
+	Status2        *string `json:"status2" example:""`
+	Reason         *string `json:"reason" example:""`
+	Position       *int32  `json:"position" example:"1"`
+	Pf             *int32  `json:"pf" example:"0"`
+	Bib            *int32  `json:"bib" example:"15"`
+	Bibcolor       *string `json:"bibcolor" example:"RED"`
+	Fiscode        *int32  `json:"fiscode" example:"3456789"`
+	Competitorname *string `json:"competitorname" example:"RIIBER Jarl Magnus"`
+	Nationcode     *string `json:"nationcode" example:"NOR"`
+	Level          *string `json:"level" example:"WC"`
+	Heat           *string `json:"heat" example:"1"`
+	Stage          *string `json:"stage" example:"F"`
+	// Jump round 1
+	J1r1      *string `json:"j1r1" example:"18.5"`
+	J2r1      *string `json:"j2r1" example:"18.5"`
+	J3r1      *string `json:"j3r1" example:"18.5"`
+	J4r1      *string `json:"j4r1" example:"18.5"`
+	J5r1      *string `json:"j5r1" example:"18.5"`
+	Speedr1   *string `json:"speedr1" example:"89.5"`
+	Distr1    *string `json:"distr1" example:"135.0"`
+	Disptsr1  *string `json:"disptsr1" example:"76.5"`
+	Judptsr1  *string `json:"judptsr1" example:"56.0"`
+	Gater1    *string `json:"gater1" example:"10"`
+	Gateptsr1 *string `json:"gateptsr1" example:"-3.0"`
+	Windr1    *string `json:"windr1" example:"-0.8"`
+	Windptsr1 *string `json:"windptsr1" example:"2.5"`
+	Totrun1   *string `json:"totrun1" example:"134.0"`
+	Posr1     *string `json:"posr1" example:"1"`
+	Statusr1  *string `json:"statusr1" example:"OK"`
+	// Jump round 2
+	J1r2      *string `json:"j1r2" example:"18.0"`
+	J2r2      *string `json:"j2r2" example:"18.0"`
+	J3r2      *string `json:"j3r2" example:"18.0"`
+	J4r2      *string `json:"j4r2" example:"18.0"`
+	J5r2      *string `json:"j5r2" example:"18.0"`
+	Speedr2   *string `json:"speedr2" example:"90.0"`
+	Distr2    *string `json:"distr2" example:"134.0"`
+	Disptsr2  *string `json:"disptsr2" example:"75.0"`
+	Judptsr2  *string `json:"judptsr2" example:"55.0"`
+	Gater2    *string `json:"gater2" example:"11"`
+	Gateptsr2 *string `json:"gateptsr2" example:"-3.5"`
+	Windr2    *string `json:"windr2" example:"-0.3"`
+	Windptsr2 *string `json:"windptsr2" example:"1.5"`
+	Totrun2   *string `json:"totrun2" example:"132.5"`
+	Posr2     *string `json:"posr2" example:"1"`
+	Statusr2  *string `json:"statusr2" example:"OK"`
+	// Jump summary
+	Pointsjump *string `json:"pointsjump" example:"135.0"`
+	Behindjump *string `json:"behindjump" example:"0.0"`
+	Posjump    *string `json:"posjump" example:"1"`
+	// Cross-country part
+	Timecc    *string `json:"timecc" example:"00:23:15.6"`
+	Timeccint *string `json:"timeccint" example:"1395"`
+	Poscc     *string `json:"poscc" example:"2"`
+	Starttime *string `json:"starttime" example:"12:30:00"`
+	Statuscc  *string `json:"statuscc" example:"OK"`
+	Totbehind *string `json:"totbehind" example:"5.6"`
+	// Total result
+	Timetot    *string `json:"timetot" example:"00:24:10.2"`
+	Timetotint *int32  `json:"timetotint" example:"1450"`
+	Valid      *int32  `json:"valid" example:"1"`
+	Racepoints *string `json:"racepoints" example:"100.0"`
+	Cuppoints  *string `json:"cuppoints" example:"100"`
+	Version    *string `json:"version" example:"1"`
+	Lastupdate *string `json:"lastupdate" example:"2025-02-15T18:30:00Z"`
+}
+
+type FISAthleteResultNK struct {
+	Recid          int32   `json:"recid" example:"70001"`
+	Raceid         *int32  `json:"raceid" example:"8801"`
+	Position       *int32  `json:"position" example:"1"`
+	Racedate       *string `json:"racedate" example:"2025-02-15"`
+	Seasoncode     *int32  `json:"seasoncode" example:"2025"`
+	Distance       *string `json:"distance" example:"10km"`
+	Hill           *int32  `json:"hill" example:"109"`
+	Disciplinecode *string `json:"disciplinecode" example:"NC"`
+	Catcode        *string `json:"catcode" example:"WC"`
+	Place          *string `json:"place" example:"Seefeld"`
+	Posr1          *string `json:"posr1" example:"1"`
+	Speedr1        *string `json:"speedr1" example:"89.5"`
+	Distr1         *string `json:"distr1" example:"135.0"`
+	Judptsr1       *string `json:"judptsr1" example:"56.0"`
+	Windr1         *string `json:"windr1" example:"-0.8"`
+	Windptsr1      *string `json:"windptsr1" example:"2.5"`
+	Gater1         *string `json:"gater1" example:"10"`
+	Totrun1        *string `json:"totrun1" example:"134.0"`
+	Poscc          *string `json:"poscc" example:"2"`
+	Timetot        *string `json:"timetot" example:"00:24:10.2"`
+	Timetotint     *int32  `json:"timetotint" example:"1450"`
+	Pointsjump     *string `json:"pointsjump" example:"135.0"`
+}
+
+type FISLastResultNKResponse struct {
+	Result FISResultNK `json:"result"`
+}
+
+type FISRaceResultsNKResponse struct {
+	Results []FISResultNK `json:"results"`
+}
+
+type FISAthleteResultsNKResponse struct {
+	Results []FISAthleteResultNK `json:"results"`
+}
+
+type FISInsertResultNKExample struct {
+	Recid          int32   `json:"recid" validate:"required" example:"70001"`
+	Raceid         *int32  `json:"raceid" example:"8801"`
+	Competitorid   *int32  `json:"competitorid" example:"123456"`
+	Status         *string `json:"status" example:"OK"`
+	Status2        *string `json:"status2" example:""`
+	Reason         *string `json:"reason" example:""`
+	Position       *int32  `json:"position" example:"1"`
+	Pf             *int32  `json:"pf" example:"0"`
+	Bib            *int32  `json:"bib" example:"15"`
+	Bibcolor       *string `json:"bibcolor" example:"RED"`
+	Fiscode        *int32  `json:"fiscode" example:"3456789"`
+	Competitorname *string `json:"competitorname" example:"RIIBER Jarl Magnus"`
+	Nationcode     *string `json:"nationcode" example:"NOR"`
+	Level          *string `json:"level" example:"WC"`
+	Heat           *string `json:"heat" example:"1"`
+	Stage          *string `json:"stage" example:"F"`
+	J1r1           *string `json:"j1r1" example:"18.5"`
+	J2r1           *string `json:"j2r1" example:"18.5"`
+	J3r1           *string `json:"j3r1" example:"18.5"`
+	J4r1           *string `json:"j4r1" example:"18.5"`
+	J5r1           *string `json:"j5r1" example:"18.5"`
+	Speedr1        *string `json:"speedr1" example:"89.5"`
+	Distr1         *string `json:"distr1" example:"135.0"`
+	Disptsr1       *string `json:"disptsr1" example:"76.5"`
+	Judptsr1       *string `json:"judptsr1" example:"56.0"`
+	Gater1         *string `json:"gater1" example:"10"`
+	Gateptsr1      *string `json:"gateptsr1" example:"-3.0"`
+	Windr1         *string `json:"windr1" example:"-0.8"`
+	Windptsr1      *string `json:"windptsr1" example:"2.5"`
+	Totrun1        *string `json:"totrun1" example:"134.0"`
+	Posr1          *string `json:"posr1" example:"1"`
+	Statusr1       *string `json:"statusr1" example:"OK"`
+	J1r2           *string `json:"j1r2" example:"18.0"`
+	J2r2           *string `json:"j2r2" example:"18.0"`
+	J3r2           *string `json:"j3r2" example:"18.0"`
+	J4r2           *string `json:"j4r2" example:"18.0"`
+	J5r2           *string `json:"j5r2" example:"18.0"`
+	Speedr2        *string `json:"speedr2" example:"90.0"`
+	Distr2         *string `json:"distr2" example:"134.0"`
+	Disptsr2       *string `json:"disptsr2" example:"75.0"`
+	Judptsr2       *string `json:"judptsr2" example:"55.0"`
+	Gater2         *string `json:"gater2" example:"11"`
+	Gateptsr2      *string `json:"gateptsr2" example:"-3.5"`
+	Windr2         *string `json:"windr2" example:"-0.3"`
+	Windptsr2      *string `json:"windptsr2" example:"1.5"`
+	Totrun2        *string `json:"totrun2" example:"132.5"`
+	Posr2          *string `json:"posr2" example:"1"`
+	Statusr2       *string `json:"statusr2" example:"OK"`
+	Pointsjump     *string `json:"pointsjump" example:"135.0"`
+	Behindjump     *string `json:"behindjump" example:"0.0"`
+	Posjump        *string `json:"posjump" example:"1"`
+	Timecc         *string `json:"timecc" example:"00:23:15.6"`
+	Timeccint      *string `json:"timeccint" example:"1395"`
+	Poscc          *string `json:"poscc" example:"2"`
+	Starttime      *string `json:"starttime" example:"12:30:00"`
+	Statuscc       *string `json:"statuscc" example:"OK"`
+	Totbehind      *string `json:"totbehind" example:"5.6"`
+	Timetot        *string `json:"timetot" example:"00:24:10.2"`
+	Timetotint     *int32  `json:"timetotint" example:"1450"`
+	Valid          *int32  `json:"valid" example:"1"`
+	Racepoints     *string `json:"racepoints" example:"100.0"`
+	Cuppoints      *string `json:"cuppoints" example:"100"`
+	Version        *string `json:"version" example:"1"`
+	Lastupdate     *string `json:"lastupdate" example:"2025-02-15T18:30:00Z"` // RFC3339
+}
+
+type FISUpdateResultNKExample = FISInsertResultNKExample
+
+type FISAthlete struct {
+	Fiscode   *int32  `json:"fiscode,omitempty" example:"342001"`
+	Sporttiid *int32  `json:"sporttiid,omitempty" example:"123456"`
+	Firstname *string `json:"firstname,omitempty" example:"Iivo"`
+	Lastname  *string `json:"lastname,omitempty" example:"Niskanen"`
+}
+
+type FISInsertAthleteExample struct {
+	Fiscode   int32   `json:"fiscode" validate:"required" example:"342001"`
+	Sporttiid *int32  `json:"sporttiid,omitempty" example:"123456"`
+	Firstname *string `json:"firstname,omitempty" example:"Iivo"`
+	Lastname  *string `json:"lastname,omitempty" example:"Niskanen"`
+}
+
+type FISUpdateAthleteExample struct {
+	Fiscode   int32   `json:"fiscode" validate:"required" example:"342001"`
+	Sporttiid *int32  `json:"sporttiid,omitempty" example:"123456"`
+	Firstname *string `json:"firstname,omitempty" example:"Iivo"`
+	Lastname  *string `json:"lastname,omitempty" example:"Niskanen"`
+}
+
+type FISRacesSearchItem struct {
+	Sectorcode     string  `json:"sectorcode" example:"CC"`
+	Gender         *string `json:"gender,omitempty" example:"M"`
+	Raceid         int32   `json:"raceid" example:"123456"`
+	Racedate       *string `json:"racedate,omitempty" example:"2025-02-15"` // YYYY-MM-DD
+	Catcode        *string `json:"catcode,omitempty" example:"WC"`
+	Description    *string `json:"description,omitempty" example:"World Cup Sprint"`
+	Place          *string `json:"place,omitempty" example:"Lahti"`
+	Nationcode     *string `json:"nationcode,omitempty" example:"FIN"`
+	Disciplinecode *string `json:"disciplinecode,omitempty" example:"DSPR"`
+}
+
+type FISRacesSearchResponse struct {
+	Races []FISRacesSearchItem `json:"races"`
+}
+
+type FISRaceDetailItem struct {
+	Sectorcode string `json:"sectorcode" example:"CC"`
+
+	Raceid         int32   `json:"raceid" example:"123456"`
+	Eventid        *int32  `json:"eventid,omitempty" example:"98765"`
+	Seasoncode     *int32  `json:"seasoncode,omitempty" example:"2025"`
+	Racecodex      *int32  `json:"racecodex,omitempty" example:"1001"`
+	Disciplineid   *string `json:"disciplineid,omitempty" example:"DSPR"`
+	Disciplinecode *string `json:"disciplinecode,omitempty" example:"DSPR"`
+	Catcode        *string `json:"catcode,omitempty" example:"WC"`
+	Catcode2       *string `json:"catcode2,omitempty"`
+	Catcode3       *string `json:"catcode3,omitempty"`
+	Catcode4       *string `json:"catcode4,omitempty"`
+	Gender         *string `json:"gender,omitempty" example:"M"`
+	Racedate       *string `json:"racedate,omitempty" example:"2025-02-15"`       // YYYY-MM-DD
+	Starteventdate *string `json:"starteventdate,omitempty" example:"2025-02-14"` // YYYY-MM-DD
+	Description    *string `json:"description,omitempty" example:"World Cup 10 km"`
+	Place          *string `json:"place,omitempty" example:"Lahti"`
+	Nationcode     *string `json:"nationcode,omitempty" example:"FIN"`
+
+	Td1id     *int32  `json:"td1id,omitempty" example:"1111"`
+	Td1name   *string `json:"td1name,omitempty" example:"TD One"`
+	Td1nation *string `json:"td1nation,omitempty" example:"NOR"`
+	Td1code   *int32  `json:"td1code,omitempty" example:"2222"`
+	Td2id     *int32  `json:"td2id,omitempty"`
+	Td2name   *string `json:"td2name,omitempty"`
+	Td2nation *string `json:"td2nation,omitempty"`
+	Td2code   *int32  `json:"td2code,omitempty"`
+
+	Calstatuscode  *string `json:"calstatuscode,omitempty" example:"O"`
+	Procstatuscode *string `json:"procstatuscode,omitempty"`
+	Receiveddate   *string `json:"receiveddate,omitempty" example:"2025-01-10"` // YYYY-MM-DD
+
+	Pursuit  *string `json:"pursuit,omitempty"`
+	Masse    *string `json:"masse,omitempty"`
+	Relay    *string `json:"relay,omitempty"`
+	Distance *string `json:"distance,omitempty" example:"10 km"` // CC: varchar, JP/NK: same column
+	Hill     *string `json:"hill,omitempty"`                     // CC: varchar, JP/NK: integer -> string here
+	Style    *string `json:"style,omitempty" example:"CL"`
+	Qualif   *string `json:"qualif,omitempty"`
+	Finale   *string `json:"finale,omitempty"`
+	Homol    *string `json:"homol,omitempty"`
+
+	Webcomment       *string `json:"webcomment,omitempty"`
+	Displaystatus    *string `json:"displaystatus,omitempty"`
+	Fisinterncomment *string `json:"fisinterncomment,omitempty"`
+
+	Published         *int32  `json:"published,omitempty" example:"1"`
+	Validforfispoints *int32  `json:"validforfispoints,omitempty" example:"1"`
+	Usedfislist       *string `json:"usedfislist,omitempty"`
+	Tolist            *string `json:"tolist,omitempty"`
+	Discforlistcode   *string `json:"discforlistcode,omitempty"`
+	Calculatedpenalty *string `json:"calculatedpenalty,omitempty"`
+	Appliedpenalty    *string `json:"appliedpenalty,omitempty"`
+	Appliedscala      *string `json:"appliedscala,omitempty"`
+	Penscafixed       *string `json:"penscafixed,omitempty"`
+
+	Version      *int32 `json:"version,omitempty" example:"1"`
+	Nationraceid *int32 `json:"nationraceid,omitempty"`
+	Provraceid   *int32 `json:"provraceid,omitempty"`
+	Msql7evid    *int32 `json:"msql7evid,omitempty"`
+	Mssql7id     *int32 `json:"mssql7id,omitempty"`
+	Results      *int32 `json:"results,omitempty"`
+	Pdf          *int32 `json:"pdf,omitempty"`
+
+	Topbanner    *string `json:"topbanner,omitempty"`
+	Bottombanner *string `json:"bottombanner,omitempty"`
+	Toplogo      *string `json:"toplogo,omitempty"`
+	Bottomlogo   *string `json:"bottomlogo,omitempty"`
+	Gallery      *string `json:"gallery,omitempty"`
+
+	Indi        *int32  `json:"indi,omitempty"`
+	Team        *int32  `json:"team,omitempty"`
+	Tabcount    *int32  `json:"tabcount,omitempty"`
+	Columncount *int32  `json:"columncount,omitempty"`
+	Level       *string `json:"level,omitempty"`
+
+	Hloc1 *string `json:"hloc1,omitempty" example:"10:00:00"`
+	Hloc2 *string `json:"hloc2,omitempty" example:"10:30:00"`
+	Hloc3 *string `json:"hloc3,omitempty" example:"11:00:00"`
+	Hcet1 *string `json:"hcet1,omitempty" example:"12:00:00"`
+	Hcet2 *string `json:"hcet2,omitempty" example:"12:30:00"`
+	Hcet3 *string `json:"hcet3,omitempty" example:"13:00:00"`
+
+	Live        *int32  `json:"live,omitempty" example:"1"`
+	Livestatus1 *string `json:"livestatus1,omitempty"`
+	Livestatus2 *string `json:"livestatus2,omitempty"`
+	Livestatus3 *string `json:"livestatus3,omitempty"`
+	Liveinfo1   *string `json:"liveinfo1,omitempty"`
+	Liveinfo2   *string `json:"liveinfo2,omitempty"`
+	Liveinfo3   *string `json:"liveinfo3,omitempty"`
+
+	Passwd     *string `json:"passwd,omitempty"`
+	Timinglogo *string `json:"timinglogo,omitempty"`
+
+	Validdate    *string `json:"validdate,omitempty" example:"2025-02-10"`
+	Noepr        *int32  `json:"noepr,omitempty"`
+	Tddoc        *int32  `json:"tddoc,omitempty"`
+	Timingreport *int32  `json:"timingreport,omitempty"`
+
+	SpecialCupPoints *int32  `json:"special_cup_points,omitempty"`
+	SkipWcsl         *int32  `json:"skip_wcsl,omitempty"`
+	Validforowg      *string `json:"validforowg,omitempty" example:"1"`
+
+	Lastupdate *string `json:"lastupdate,omitempty" example:"2025-02-15T12:34:56Z"`
+}
+
+type FISRacesByIDsResponse struct {
+	Sector string              `json:"sector" example:"CC"`
+	Races  []FISRaceDetailItem `json:"races"`
+}
+
+type FISRacesCategoryCountItem struct {
+	Catcode *string `json:"catcode,omitempty" example:"WC"`
+	Total   int64   `json:"total" example:"42"`
+}
+
+type FISRacesCategoryCountsResponse struct {
+	Seasoncode int32                       `json:"seasoncode" example:"2025"`
+	Sectors    []string                    `json:"sectors" example:"CC,JP"`
+	Nationcode *string                     `json:"nationcode,omitempty" example:"FIN"`
+	Gender     *string                     `json:"gender,omitempty" example:"M"`
+	Categories []FISRacesCategoryCountItem `json:"categories"`
+}
+
+type FISRacesNationCountItem struct {
+	Nationcode string `json:"nationcode" example:"FIN"`
+	Total      int64  `json:"total" example:"42"`
+}
+
+type FISRacesNationCountsResponse struct {
+	Seasoncode int32                     `json:"seasoncode" example:"2025"`
+	Sectors    []string                  `json:"sectors" example:"CC,JP"`
+	Gender     *string                   `json:"gender,omitempty" example:"M"`
+	Catcode    *string                   `json:"catcode,omitempty" example:"WC"`
+	Nations    []FISRacesNationCountItem `json:"nations"`
+}
+
+type FISRacesTotalsResponse struct {
+	Seasoncode int32    `json:"seasoncode" example:"2025"`
+	Sectors    []string `json:"sectors" example:"CC,JP"`
+	Catcode    *string  `json:"catcode,omitempty" example:"WC"`
+	Gender     *string  `json:"gender,omitempty" example:"M"`
+	Total      int64    `json:"total" example:"123"`
+}
+
+type FISCompetitorSeasonCatcodeItem struct {
+	Seasoncode int32   `json:"seasoncode" example:"2025"`
+	Catcode    *string `json:"catcode,omitempty" example:"WC"`
+}
+
+type FISCompetitorSeasonsCatcodesResponse struct {
+	Competitorid int32                            `json:"competitorid" example:"123456"`
+	Sector       string                           `json:"sector" example:"CC"`
+	Items        []FISCompetitorSeasonCatcodeItem `json:"items"`
+}
+
+type FISLatestResultItem struct {
+	Raceid         *int32  `json:"raceid,omitempty" example:"123456"`
+	Racedate       *string `json:"racedate,omitempty" example:"2025-02-15"`
+	Seasoncode     *int32  `json:"seasoncode,omitempty" example:"2025"`
+	Disciplinecode *string `json:"disciplinecode,omitempty" example:"DSPR"`
+	Catcode        *string `json:"catcode,omitempty" example:"WC"`
+	Place          *string `json:"place,omitempty" example:"Lahti"`
+	Nationcode     *string `json:"nationcode,omitempty" example:"FIN"`
+	Position       *string `json:"position,omitempty" example:"1"`
+	Timetot        *string `json:"timetot,omitempty" example:"25:32.4"`
+	// Sector-specific examples (all optional)
+	Distance   *string `json:"distance,omitempty" example:"10km"`
+	Hill       *int32  `json:"hill,omitempty" example:"140"`
+	Posr1      *string `json:"posr1,omitempty" example:"1"`
+	Speedr1    *string `json:"speedr1,omitempty" example:"93.5"`
+	Distr1     *string `json:"distr1,omitempty" example:"137.0"`
+	Judptsr1   *string `json:"judptsr1,omitempty" example:"57.5"`
+	Windr1     *string `json:"windr1,omitempty" example:"0.6"`
+	Windptsr1  *string `json:"windptsr1,omitempty" example:"-3.0"`
+	Gater1     *string `json:"gater1,omitempty" example:"11"`
+	Poscc      *string `json:"poscc,omitempty" example:"3"`
+	Timetotint *int32  `json:"timetotint,omitempty" example:"1534"`
+	Pointsjump *string `json:"pointsjump,omitempty" example:"135.5"`
+}
+
+type FISLatestResultsResponse struct {
+	Competitorid int32                 `json:"competitorid" example:"123456"`
+	Sector       string                `json:"sector" example:"CC"`
+	Seasoncode   *int32                `json:"seasoncode,omitempty" example:"2025"`
+	Catcodes     []string              `json:"catcodes,omitempty" example:"WC,COC"`
+	Limit        int32                 `json:"limit" example:"50"`
+	Results      []FISLatestResultItem `json:"results"`
+}
+
+// FISCompetitorFull represents a full competitor record as exposed by the API.
+type FISCompetitorFull struct {
+	Competitorid       int32   `json:"competitorid" example:"123456"`
+	Personid           *int32  `json:"personid" example:"98765"`
+	Ipcid              *int32  `json:"ipcid" example:"0"`
+	Type               *string `json:"type" example:"ATH"`
+	Sectorcode         *string `json:"sectorcode" example:"CC"`
+	Fiscode            *int32  `json:"fiscode" example:"342001"`
+	Lastname           *string `json:"lastname" example:"Niskanen"`
+	Firstname          *string `json:"firstname" example:"Iivo"`
+	Gender             *string `json:"gender" example:"M"`
+	Birthdate          *string `json:"birthdate" example:"1992-01-12"`             // YYYY-MM-DD
+	StatusDate         *string `json:"status_date" example:"2024-05-01T00:00:00Z"` // RFC3339
+	Dateofcreation     *string `json:"dateofcreation" example:"2010-11-05"`        // YYYY-MM-DD
+	Lastupdate         *string `json:"lastupdate" example:"2024-10-01T12:34:56Z"`  // RFC3339
+	Nationcode         *string `json:"nationcode" example:"FIN"`
+	Nationalcode       *string `json:"nationalcode" example:"FIN"`
+	Skiclub            *string `json:"skiclub" example:"Lahti Ski Club"`
+	Association        *string `json:"association" example:"Finnish Ski Association"`
+	Status             *string `json:"status" example:"ACTIVE"`
+	StatusOld          *string `json:"status_old"`
+	StatusBy           *string `json:"status_by" example:"FIS"`
+	Statusnextlist     *string `json:"statusnextlist"`
+	Alternatenamecheck *string `json:"alternatenamecheck"`
+	Fee                *string `json:"fee" example:"0"`
+	Createdby          *string `json:"createdby" example:"admin"`
+	Injury             *int32  `json:"injury" example:"0"`
+	Version            *int32  `json:"version" example:"1"`
+	Compidmssql        *int32  `json:"compidmssql"`
+	Carving            *int32  `json:"carving"`
+	Photo              *int32  `json:"photo"`
+	Notallowed         *int32  `json:"notallowed"`
+	Natteam            *string `json:"natteam" example:"FIN-A"`
+	Tragroup           *string `json:"tragroup"`
+	Published          *int32  `json:"published" example:"1"`
+	Doped              *string `json:"doped"`
+	Team               *int32  `json:"team"`
+	PhotoBig           *int32  `json:"photo_big"`
+	Data               *string `json:"data"`
+	Lastupdateby       *string `json:"lastupdateby"`
+	Disciplines        *string `json:"disciplines"`
+	Deletedat          *string `json:"deletedat"`
+	Categorycode       *string `json:"categorycode"`
+	Classname          *string `json:"classname"`
+	Classcode          *string `json:"classcode"`
+}
+
+type FISCompetitorSearchResponse struct {
+	Competitors []FISCompetitorFull `json:"competitors"`
+	NextCursor  *string             `json:"next_cursor,omitempty" example:"eyJuIjoxMjM0NX0"`
+}
+
+type FISCompetitorNationCountItem struct {
+	Nationcode  string `json:"nationcode" example:"FIN"`
+	Competitors int64  `json:"competitors" example:"123"`
+}
+
+type FISCompetitorNationCountsResponse struct {
+	Sectorcode *string                        `json:"sectorcode,omitempty" example:"CC"`
+	Gender     *string                        `json:"gender,omitempty" example:"M"`
+	Agemin     *int32                         `json:"agemin,omitempty" example:"18"`
+	Agemax     *int32                         `json:"agemax,omitempty" example:"35"`
+	Nations    []FISCompetitorNationCountItem `json:"nations"`
+}
+
+type FISSectorcodeByFiscodeResponse struct {
+	Fiscode    int32  `json:"fiscode" example:"342001"`
+	Sectorcode string `json:"sectorcode" example:"CC"`
+}
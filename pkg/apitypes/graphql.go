@@ -0,0 +1,26 @@
+package apitypes
+
+// GraphQL queries (/graphql)
+
+type GraphQLRequest struct {
+	Query         string         `json:"query" example:"query($id: ID!) { tietoevryUser(id: $id) { sportti_id exercises(first: 5) { items { start_time sport_type } } } }"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+	// sent by some clients; not used
+	Extensions map[string]any `json:"extensions,omitempty" swaggerignore:"true"`
+}
+
+type GraphQLResponse struct {
+	Data   any            `json:"data"`
+	Errors []GraphQLError `json:"errors,omitempty"`
+}
+
+type GraphQLError struct {
+	Message    string              `json:"message" example:"access denied: requires read access to /v1/tietoevry/exercises"`
+	Path       []any               `json:"path,omitempty"`
+	Extensions GraphQLErrorDetails `json:"extensions"`
+}
+
+type GraphQLErrorDetails struct {
+	Code string `json:"code" example:"forbidden"`
+}
@@ -1,4 +1,4 @@
-package swagger
+package apitypes
 
 // Health
 type HealthStatusResponse struct {
@@ -0,0 +1,48 @@
+package apitypes
+
+import "time"
+
+// Athlete identity registry (/identities)
+
+type IdentitiesInput struct {
+	// links to this athlete instead of the one the identifiers point to
+	AthleteID       *int32  `json:"athlete_id,omitempty" validate:"omitempty,gt=0" example:"12"`
+	SporttiID       *string `json:"sportti_id,omitempty" validate:"omitempty,numeric" example:"27353728"`
+	UTVUserID       *string `json:"utv_user_id,omitempty" validate:"omitempty,uuid" example:"6f1d3c5a-2b4e-4f7a-9c1d-3e5f7a9b1c2d"`
+	TietoevryUserID *string `json:"tietoevry_user_id,omitempty" validate:"omitempty,uuid" example:"0b6a1f0e-5d2c-4f1a-9a3e-7c4d2b1e8f90"`
+	Fiscode         *int32  `json:"fiscode,omitempty" validate:"omitempty,gt=0" example:"3422619"`
+	FISCompetitorID *int32  `json:"fis_competitorid,omitempty" validate:"omitempty,gt=0" example:"201236"`
+	KAMKUserID      *int32  `json:"kamk_user_id,omitempty" validate:"omitempty,gt=0" example:"42"`
+}
+
+type IdentityResponse struct {
+	ID              int32     `json:"id" example:"12"`
+	SporttiID       *string   `json:"sportti_id" example:"27353728"`
+	UTVUserID       *string   `json:"utv_user_id" example:"6f1d3c5a-2b4e-4f7a-9c1d-3e5f7a9b1c2d"`
+	TietoevryUserID *string   `json:"tietoevry_user_id" example:"0b6a1f0e-5d2c-4f1a-9a3e-7c4d2b1e8f90"`
+	Fiscode         *int32    `json:"fiscode" example:"3422619"`
+	FISCompetitorID *int32    `json:"fis_competitorid" example:"201236"`
+	KAMKUserID      *int32    `json:"kamk_user_id" example:"42"`
+	CreatedAt       time.Time `json:"created_at" example:"2025-01-14T08:00:00Z"`
+	UpdatedAt       time.Time `json:"updated_at" example:"2025-01-14T08:00:00Z"`
+}
+
+// Athlete overview (/athletes/{id}/overview)
+
+type AthleteOverviewSectionError struct {
+	Code    string `json:"code" example:"database_unavailable"`
+	Message string `json:"message" example:"KLAB database is unavailable"`
+}
+
+type AthleteOverviewSection struct {
+	// ok, not_linked or error
+	Status string                       `json:"status" example:"ok"`
+	Error  *AthleteOverviewSectionError `json:"error,omitempty"`
+	Data   any                          `json:"data,omitempty" swaggertype:"object"`
+}
+
+type AthleteOverviewResponse struct {
+	AthleteID int32 `json:"athlete_id" example:"12"`
+	// keyed by fis, utv, tietoevry, klab, archinisis and kamk
+	Sections map[string]*AthleteOverviewSection `json:"sections"`
+}
@@ -0,0 +1,33 @@
+package apitypes
+
+import "time"
+
+// Async import jobs (?mode=async, GET /jobs/{id})
+
+// JobStatus is queued, running, succeeded or failed
+type JobStatus string
+
+// JobResponse is the pollable state of one asynchronous bulk import
+type JobResponse struct {
+	ID              string             `json:"id" example:"5f0c8a3e-2b1d-4c7e-9a61-0d2f3b4c5e6f"`
+	Kind            string             `json:"kind" example:"tietoevry.exercises" enums:"tietoevry.exercises,tietoevry.measurements"`
+	Client          string             `json:"client" example:"tietoevry-sync"`
+	Status          JobStatus          `json:"status" example:"running" enums:"queued,running,succeeded,failed" swaggertype:"string"`
+	CreatedAt       time.Time          `json:"created_at" example:"2025-01-14T08:00:00Z"`
+	StartedAt       *time.Time         `json:"started_at,omitempty" example:"2025-01-14T08:00:01Z"`
+	FinishedAt      *time.Time         `json:"finished_at,omitempty" example:"2025-01-14T08:03:12Z"`
+	Progress        JobProgress        `json:"progress"`
+	Counts          BulkCounts         `json:"counts"`
+	Errors          []BulkItemResponse `json:"errors"`
+	ErrorsTruncated bool               `json:"errors_truncated,omitempty" example:"false"`
+	Error           string             `json:"error,omitempty" example:""`
+}
+
+// JobProgress is measured on the spooled payload, so it is known before the
+// items are counted
+type JobProgress struct {
+	Items      int     `json:"items" example:"12000"`
+	BytesRead  int64   `json:"bytes_read" example:"402653184"`
+	BytesTotal int64   `json:"bytes_total" example:"1073741824"`
+	Percent    float64 `json:"percent" example:"37.5"`
+}
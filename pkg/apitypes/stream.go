@@ -0,0 +1,20 @@
+package apitypes
+
+import "time"
+
+// Athlete event streams (GET /stream/athletes/{id}, text/event-stream) and
+// webhook deliveries
+
+// Event is a change to stored data. Streams send it as the data line of an
+// event whose SSE id is its position in the athlete's stream (e.g.
+// 1736841600000-0) and whose SSE event name is its type; webhooks POST it to
+// the subscription URL. Data depends on the type: utv.data.inserted
+// {user_id, device, date}, tietoevry.exercise.created {user_id},
+// kamk.injury.added {user_id, injury_id}, fis.result.updated {sector, recid,
+// raceid, competitorid}.
+type Event struct {
+	ID   string    `json:"id" example:"0b6a1f0e-5d2c-4f1a-9a3e-7c4d2b1e8f90"`
+	Type string    `json:"type" example:"utv.data.inserted" enums:"utv.data.inserted,tietoevry.exercise.created,tietoevry.data.created,kamk.injury.added,kamk.injury.updated,kamk.questionnaire.updated,fis.result.updated,klab.data.inserted,archinisis.data.inserted"`
+	Time time.Time `json:"time" example:"2025-01-14T08:00:00Z"`
+	Data any       `json:"data" swaggertype:"object"`
+}
@@ -1,9 +1,9 @@
-package swagger
+package apitypes
 
 // TietoevryUserUpsertInput represents the POST body schema for upserting a user
 type TietoevryUserUpsertInput struct {
-	ID                        string   `json:"id" example:"7cffe6e0-3f28-43b6-b511-d836d3a9f7b5"`
-	SporttiID                 int32    `json:"sportti_id" example:"12345"`
+	ID                        string   `json:"id" validate:"required,uuid4" example:"7cffe6e0-3f28-43b6-b511-d836d3a9f7b5"`
+	SporttiID                 int32    `json:"sportti_id" validate:"required" example:"12345"`
 	ProfileGender             *string  `json:"profile_gender" example:"male"`
 	ProfileBirthdate          *string  `json:"profile_birthdate" example:"1990-08-15"` // ISO 8601
 	ProfileWeight             *float64 `json:"profile_weight" example:"72.5"`
@@ -52,12 +52,12 @@ type Section struct {
 }
 
 type TietoevryExerciseUpsertInput struct {
-	ID                string   `json:"id" example:"2d4f6aee-b62c-408e-85e1-07bd78f383a7"`
-	CreatedAt         string   `json:"created_at" example:"2024-07-21T08:00:00Z"`
-	UpdatedAt         string   `json:"updated_at" example:"2024-07-21T08:00:00Z"`
-	UserID            string   `json:"user_id" example:"7cffe6e0-3f28-43b6-b511-d836d3a9f7b5"`
-	StartTime         string   `json:"start_time" example:"2024-07-21T08:10:00Z"`
-	Duration          string   `json:"duration" example:"PT1H30M45S"` // ISO 8601 duration format
+	ID                string   `json:"id" validate:"required,uuid4" example:"2d4f6aee-b62c-408e-85e1-07bd78f383a7"`
+	CreatedAt         string   `json:"created_at" validate:"required" example:"2024-07-21T08:00:00Z"`
+	UpdatedAt         string   `json:"updated_at" validate:"required" example:"2024-07-21T08:00:00Z"`
+	UserID            string   `json:"user_id" validate:"required,uuid4" example:"7cffe6e0-3f28-43b6-b511-d836d3a9f7b5"`
+	StartTime         string   `json:"start_time" validate:"required" example:"2024-07-21T08:10:00Z"`
+	Duration          string   `json:"duration" validate:"required" example:"PT1H30M45S"` // ISO 8601 duration format
 	Comment           *string  `json:"comment" example:"Morning run"`
 	SportType         *string  `json:"sport_type" example:"running"`
 	DetailedSportType *string  `json:"detailed_sport_type" example:"trail"`
@@ -68,7 +68,7 @@ type TietoevryExerciseUpsertInput struct {
 	SprintCount       *int32   `json:"sprint_count" example:"2"`
 	AvgSpeed          *float64 `json:"avg_speed" example:"3.2"`
 	MaxSpeed          *float64 `json:"max_speed" example:"4.8"`
-	Source            string   `json:"source" example:"garmin"`
+	Source            string   `json:"source" validate:"required" example:"garmin"`
 	Status            *string  `json:"status" example:"completed"`
 	Calories          *int32   `json:"calories" example:"450"`
 	TrainingLoad      *int32   `json:"training_load" example:"55"`
@@ -112,15 +112,15 @@ type TietoevrySymptomsBulkInput struct {
 }
 
 type TietoevryMeasurementInput struct {
-	ID             string   `json:"id" example:"b872a58e-1234-4baf-8c19-5f2ad34d9ef1"`
-	CreatedAt      string   `json:"created_at" example:"2024-07-23T08:00:00Z"`
-	UpdatedAt      string   `json:"updated_at" example:"2024-07-23T08:10:00Z"`
-	UserID         string   `json:"user_id" example:"7cffe6e0-3f28-43b6-b511-d836d3a9f7b5"`
-	Date           string   `json:"date" example:"2024-07-22"`
-	Name           string   `json:"name" example:"weight"`
-	NameType       string   `json:"name_type" example:"numeric"`
-	Source         string   `json:"source" example:"oura"`
-	Value          string   `json:"value" example:"72.5"`
+	ID             string   `json:"id" validate:"required,uuid4" example:"b872a58e-1234-4baf-8c19-5f2ad34d9ef1"`
+	CreatedAt      string   `json:"created_at" validate:"required" example:"2024-07-23T08:00:00Z"`
+	UpdatedAt      string   `json:"updated_at" validate:"required" example:"2024-07-23T08:10:00Z"`
+	UserID         string   `json:"user_id" validate:"required,uuid4" example:"7cffe6e0-3f28-43b6-b511-d836d3a9f7b5"`
+	Date           string   `json:"date" validate:"required" example:"2024-07-22"`
+	Name           string   `json:"name" validate:"required" example:"weight"`
+	NameType       string   `json:"name_type" validate:"required" example:"numeric"`
+	Source         string   `json:"source" validate:"required" example:"oura"`
+	Value          string   `json:"value" validate:"required" example:"72.5"`
 	ValueNumeric   *float64 `json:"value_numeric" example:"72.5"`
 	Comment        *string  `json:"comment" example:"Post training"`
 	RawID          *string  `json:"raw_id" example:"ouraid-xyz-123"`
@@ -133,18 +133,18 @@ type TietoevryMeasurementsBulkInput struct {
 }
 
 type TietoevryTestResultInput struct {
-	ID                          string  `json:"id" example:"d19f2c63-fc5a-4aeb-8b90-5fc7df5d1c0c"`
-	UserID                      string  `json:"user_id" example:"7cffe6e0-3f28-43b6-b511-d836d3a9f7b5"`
-	TypeID                      string  `json:"type_id" example:"23d9453b-8d43-46f5-bb27-1db29a88456a"`
+	ID                          string  `json:"id" validate:"required,uuid4" example:"d19f2c63-fc5a-4aeb-8b90-5fc7df5d1c0c"`
+	UserID                      string  `json:"user_id" validate:"required,uuid4" example:"7cffe6e0-3f28-43b6-b511-d836d3a9f7b5"`
+	TypeID                      string  `json:"type_id" validate:"required,uuid4" example:"23d9453b-8d43-46f5-bb27-1db29a88456a"`
 	TypeType                    *string `json:"type_type" example:"threshold"`
-	TypeResultType              string  `json:"type_result_type" example:"float"`
+	TypeResultType              string  `json:"type_result_type" validate:"required" example:"float"`
 	TypeName                    *string `json:"type_name" example:"Aerobic Threshold Test"`
-	Timestamp                   string  `json:"timestamp" example:"2024-07-23T08:00:00Z"`
+	Timestamp                   string  `json:"timestamp" validate:"required" example:"2024-07-23T08:00:00Z"`
 	Name                        *string `json:"name" example:"Threshold Test 1"`
 	Comment                     *string `json:"comment" example:"User reached fatigue quickly"`
-	Data                        string  `json:"data" example:"{\"vo2\": 45.5, \"lactate\": 2.1}"`
-	CreatedAt                   string  `json:"created_at" example:"2024-07-23T08:10:00Z"`
-	UpdatedAt                   string  `json:"updated_at" example:"2024-07-23T08:10:01Z"`
+	Data                        string  `json:"data" validate:"required" example:"{\"vo2\": 45.5, \"lactate\": 2.1}"`
+	CreatedAt                   string  `json:"created_at" validate:"required" example:"2024-07-23T08:10:00Z"`
+	UpdatedAt                   string  `json:"updated_at" validate:"required" example:"2024-07-23T08:10:01Z"`
 	TestEventID                 *string `json:"test_event_id" example:"8abf0123-4567-89ab-cdef-1234567890ab"`
 	TestEventName               *string `json:"test_event_name" example:"Summer Test Event"`
 	TestEventDate               *string `json:"test_event_date" example:"2024-07-22"`
@@ -158,22 +158,22 @@ type TietoevryTestResultsBulkInput struct {
 }
 
 type TietoevryQuestionnaireAnswerInput struct {
-	UserID                  string  `json:"user_id" example:"7cffe6e0-3f28-43b6-b511-d836d3a9f7b5"`
-	QuestionnaireInstanceID string  `json:"questionnaire_instance_id" example:"8c94dfeb-8f3e-4e61-9a66-64559eb354d2"`
+	UserID                  string  `json:"user_id" validate:"required,uuid4" example:"7cffe6e0-3f28-43b6-b511-d836d3a9f7b5"`
+	QuestionnaireInstanceID string  `json:"questionnaire_instance_id" validate:"required,uuid4" example:"8c94dfeb-8f3e-4e61-9a66-64559eb354d2"`
 	QuestionnaireNameFi     *string `json:"questionnaire_name_fi" example:"Unikysely"`
 	QuestionnaireNameEn     *string `json:"questionnaire_name_en" example:"Sleep Survey"`
-	QuestionnaireKey        string  `json:"questionnaire_key" example:"sleep_quality"`
-	QuestionID              string  `json:"question_id" example:"123e4567-e89b-4d3a-a456-426614174000"`
+	QuestionnaireKey        string  `json:"questionnaire_key" validate:"required" example:"sleep_quality"`
+	QuestionID              string  `json:"question_id" validate:"required,uuid4" example:"123e4567-e89b-4d3a-a456-426614174000"`
 	QuestionLabelFi         *string `json:"question_label_fi" example:"Kuinka hyvin nukuit?"`
 	QuestionLabelEn         *string `json:"question_label_en" example:"How well did you sleep?"`
-	QuestionType            string  `json:"question_type" example:"scale"`
+	QuestionType            string  `json:"question_type" validate:"required" example:"scale"`
 	OptionID                *string `json:"option_id" example:"c3e8d954-bb0a-4d77-9885-f9e7cabc1234"`
 	OptionValue             *int32  `json:"option_value" example:"4"`
 	OptionLabelFi           *string `json:"option_label_fi" example:"Hyvin"`
 	OptionLabelEn           *string `json:"option_label_en" example:"Well"`
 	FreeText                *string `json:"free_text" example:"Had vivid dreams"`
-	CreatedAt               string  `json:"created_at" example:"2024-07-23T09:00:00Z"`
-	UpdatedAt               string  `json:"updated_at" example:"2024-07-23T09:00:10Z"`
+	CreatedAt               string  `json:"created_at" validate:"required" example:"2024-07-23T09:00:00Z"`
+	UpdatedAt               string  `json:"updated_at" validate:"required" example:"2024-07-23T09:00:10Z"`
 	Value                   *string `json:"value" example:"{\"scale\": 4, \"note\": \"slightly tired\"}"`
 }
 
@@ -182,17 +182,17 @@ type TietoevryQuestionnaireAnswersBulkInput struct {
 }
 
 type TietoevryActivityZoneInput struct {
-	UserID         string   `json:"user_id" example:"7cffe6e0-3f28-43b6-b511-d836d3a9f7b5"`
-	Date           string   `json:"date" example:"2024-07-22"`
-	CreatedAt      string   `json:"created_at" example:"2024-07-22T08:00:00Z"`
-	UpdatedAt      string   `json:"updated_at" example:"2024-07-22T08:01:00Z"`
+	UserID         string   `json:"user_id" validate:"required,uuid4" example:"7cffe6e0-3f28-43b6-b511-d836d3a9f7b5"`
+	Date           string   `json:"date" validate:"required" example:"2024-07-22"`
+	CreatedAt      string   `json:"created_at" validate:"required" example:"2024-07-22T08:00:00Z"`
+	UpdatedAt      string   `json:"updated_at" validate:"required" example:"2024-07-22T08:01:00Z"`
 	SecondsInZone0 *float64 `json:"seconds_in_zone_0" example:"120.0"`
 	SecondsInZone1 *float64 `json:"seconds_in_zone_1" example:"300.0"`
 	SecondsInZone2 *float64 `json:"seconds_in_zone_2" example:"450.0"`
 	SecondsInZone3 *float64 `json:"seconds_in_zone_3" example:"180.0"`
 	SecondsInZone4 *float64 `json:"seconds_in_zone_4" example:"60.0"`
 	SecondsInZone5 *float64 `json:"seconds_in_zone_5" example:"15.0"`
-	Source         string   `json:"source" example:"polar"`
+	Source         string   `json:"source" validate:"required" example:"polar"`
 	RawData        *string  `json:"raw_data" example:"{\"intensity_distribution\": \"balanced\"}"`
 }
 
@@ -215,25 +215,31 @@ type TietoevryDeletedUsersResponse struct {
 }
 
 type TietoevryExerciseResponse struct {
-	Exercise TietoevryExerciseUpsertInput `json:"exercises"`
+	Exercises  []TietoevryExerciseUpsertInput `json:"exercises"`
+	NextCursor *string                        `json:"next_cursor,omitempty" example:"eyJuIjoxMjM0NX0"`
 }
 
 type TietoevrySymptomResponse struct {
-	Symptom TietoevrySymptomInput `json:"symptoms"`
+	Symptoms   []TietoevrySymptomInput `json:"symptoms"`
+	NextCursor *string                 `json:"next_cursor,omitempty" example:"eyJuIjoxMjM0NX0"`
 }
 
 type TietoevryMeasurementResponse struct {
-	Measurement TietoevryMeasurementInput `json:"measurements"`
+	Measurements []TietoevryMeasurementInput `json:"measurements"`
+	NextCursor   *string                     `json:"next_cursor,omitempty" example:"eyJuIjoxMjM0NX0"`
 }
 
 type TietoevryTestResultResponse struct {
-	TestResult TietoevryTestResultInput `json:"test_results"`
+	TestResults []TietoevryTestResultInput `json:"test_results"`
+	NextCursor  *string                    `json:"next_cursor,omitempty" example:"eyJuIjoxMjM0NX0"`
 }
 
 type TietoevryQuestionnaireAnswerResponse struct {
-	QuestionnaireAnswer TietoevryQuestionnaireAnswerInput `json:"questionnaires"`
+	QuestionnaireAnswers []TietoevryQuestionnaireAnswerInput `json:"questionnaires"`
+	NextCursor           *string                             `json:"next_cursor,omitempty" example:"eyJuIjoxMjM0NX0"`
 }
 
 type TietoevryActivityZoneResponse struct {
-	ActivityZone TietoevryActivityZoneInput `json:"activity_zones"`
+	ActivityZones []TietoevryActivityZoneInput `json:"activity_zones"`
+	NextCursor    *string                      `json:"next_cursor,omitempty" example:"eyJuIjoxMjM0NX0"`
 }
@@ -1,4 +1,6 @@
-package swagger
+package apitypes
+
+import "encoding/json"
 
 // Oura
 type DatesResponse struct {
@@ -150,89 +152,33 @@ type GarminDataResponse struct {
 }
 
 type GarminPostDataInput struct {
-	UserID string               `json:"user_id" example:"f47ac10b-58cc-4372-a567-0e02b2c3d479"`
-	Date   string               `json:"date" example:"2025-06-12"`
-	Data   GarminPayloadExample `json:"data"`
-}
-
-type GarminPayloadExample struct {
-	Dailies []GarminDailyExample `json:"dailies"`
-}
-
-type GarminDailyExample struct {
-	Steps        int    `json:"steps" example:"5000"`
-	CalendarDate string `json:"calendarDate" example:"2025-06-12"`
+	UserID string          `json:"user_id" validate:"required,uuid4" example:"f47ac10b-58cc-4372-a567-0e02b2c3d479"`
+	Date   string          `json:"date" validate:"required,datetime=2006-01-02" example:"2025-06-12"`
+	Data   json.RawMessage `json:"data" validate:"required" swaggertype:"object"` // the Garmin payload of the day, e.g. {"dailies": [...]}
 }
 
 type OuraPostDataInput struct {
-	UserID string             `json:"user_id" example:"f47ac10b-58cc-4372-a567-0e02b2c3d479"`
-	Date   string             `json:"date" example:"2025-06-12"`
-	Data   OuraPayloadExample `json:"data"`
-}
-
-type OuraPayloadExample struct {
-	DailyActivity []OuraDailyExample `json:"daily_activity"`
-}
-
-type OuraDailyExample struct {
-	ID    string `json:"id" example:"232b8559-9175-43f5-88c2-7f727b1f3ced"`
-	Day   string `json:"day" example:"2021-12-10"`
-	Steps int    `json:"steps" example:"1074"`
-	Score int    `json:"score" example:"93"`
+	UserID string          `json:"user_id" validate:"required,uuid4" example:"f47ac10b-58cc-4372-a567-0e02b2c3d479"`
+	Date   string          `json:"date" validate:"required,datetime=2006-01-02" example:"2025-06-12"`
+	Data   json.RawMessage `json:"data" validate:"required" swaggertype:"object"` // the Oura payload of the day, e.g. {"daily_activity": [...]}
 }
 
 type SuuntoPostDataInput struct {
-	UserID string               `json:"user_id" example:"f47ac10b-58cc-4372-a567-0e02b2c3d479"`
-	Date   string               `json:"date" example:"2025-06-12"`
-	Data   SuuntoPayloadExample `json:"data"`
-}
-
-type SuuntoPayloadExample struct {
-	Workout map[string]SuuntoWorkout `json:"workout"`
-}
-
-type SuuntoWorkout struct {
-	WorkoutContent string                `json:"workout_content" example:"65ed97d4dddc530a5389f8f9"`
-	WorkoutSummary SuuntoWorkoutSummary1 `json:"workout_summary"`
-}
-
-type SuuntoWorkoutSummary1 struct {
-	Steps         int     `json:"stepCount" example:"4469"`
-	TotalTime     float64 `json:"totalTime" example:"3707.57"`
-	TotalDistance float64 `json:"totalDistance" example:"14927.06"`
+	UserID string          `json:"user_id" validate:"required,uuid4" example:"f47ac10b-58cc-4372-a567-0e02b2c3d479"`
+	Date   string          `json:"date" validate:"required,datetime=2006-01-02" example:"2025-06-12"`
+	Data   json.RawMessage `json:"data" validate:"required" swaggertype:"object"` // the Suunto payload of the day, e.g. {"workout": {...}}
 }
 
 type PolarPostDataInput struct {
-	UserID string              `json:"user_id" example:"f47ac10b-58cc-4372-a567-0e02b2c3d479"`
-	Date   string              `json:"date" example:"2025-06-12"`
-	Data   PolarPayloadExample `json:"data"`
-}
-
-type PolarPayloadExample struct {
-	Alertness PolarAlertness `json:"alertness"`
-}
-
-type PolarAlertness struct {
-	Grade      float64 `json:"grade" example:"5.1"`
-	GradeType  string  `json:"grade_type" example:"GRADE_TYPE_PRIMARY"`
-	SleepType  string  `json:"sleep_type" example:"SLEEP_TYPE_PRIMARY"`
-	ResultType string  `json:"result_type" example:"ALERTNESS_TYPE_HISTORY"`
-}
-
-type SleepSample struct {
-	ID   string `json:"id" example:"123"`
-	Day  string `json:"day" example:"2025-06-12"`
-	Type string `json:"type" example:"long_sleep"`
-}
-
-type DeviceData struct {
-	Sleep []SleepSample `json:"sleep"`
+	UserID string          `json:"user_id" validate:"required,uuid4" example:"f47ac10b-58cc-4372-a567-0e02b2c3d479"`
+	Date   string          `json:"date" validate:"required,datetime=2006-01-02" example:"2025-06-12"`
+	Data   json.RawMessage `json:"data" validate:"required" swaggertype:"object"` // the Polar payload of the day, e.g. {"alertness": {...}}
 }
 
 type LatestDataResponse struct {
-	Device string     `json:"device" example:"garmin"`
-	Date   string     `json:"date" example:"2025-06-12"`
-	Data   DeviceData `json:"data"`
+	Device string          `json:"device" example:"garmin"`
+	Date   string          `json:"date" example:"2025-06-12"`
+	Data   json.RawMessage `json:"data" swaggertype:"object"`
 }
 
 type PolarTokenDetails struct {
@@ -245,8 +191,8 @@ type PolarTokenDetails struct {
 }
 
 type PolarTokenInput struct {
-	UserID string            `json:"user_id" example:"208e2ffb-ac68-4980-a8a6-b7e0136e0798"`
-	Data   PolarTokenDetails `json:"data"`
+	UserID  string          `json:"user_id" validate:"required,uuid4" example:"208e2ffb-ac68-4980-a8a6-b7e0136e0798"`
+	Details json.RawMessage `json:"details" validate:"required" swaggertype:"object"` // shaped like PolarTokenDetails
 }
 
 type PolarStatusResponse struct {
@@ -282,8 +228,8 @@ type OuraTokenDetails struct {
 }
 
 type OuraTokenInput struct {
-	UserID  string           `json:"user_id" example:"d5e1c624-2f39-4410-b9d6-842fc3226b7e"`
-	Details OuraTokenDetails `json:"details"`
+	UserID  string          `json:"user_id" validate:"required,uuid4" example:"d5e1c624-2f39-4410-b9d6-842fc3226b7e"`
+	Details json.RawMessage `json:"details" validate:"required" swaggertype:"object"` // shaped like OuraTokenDetails
 }
 
 type OuraTokenByIDResponse struct {
@@ -297,8 +243,8 @@ type SuuntoStatusResponse struct {
 }
 
 type SuuntoTokenInput struct {
-	UserID  string             `json:"user_id" example:"7cffe6e0-3f28-43b6-b511-d836d3a9f7b5"`
-	Details SuuntoTokenDetails `json:"details"`
+	UserID  string          `json:"user_id" validate:"required,uuid4" example:"7cffe6e0-3f28-43b6-b511-d836d3a9f7b5"`
+	Details json.RawMessage `json:"details" validate:"required" swaggertype:"object"` // shaped like SuuntoTokenDetails
 }
 
 type SuuntoTokenDetails struct {
@@ -332,8 +278,8 @@ type GarminTokenDetails struct {
 }
 
 type GarminTokenInput struct {
-	UserID  string             `json:"user_id" example:"e19c1832-d7f3-4d65-90ea-33a3d7f6d6df"`
-	Details GarminTokenDetails `json:"details"`
+	UserID  string          `json:"user_id" validate:"required,uuid4" example:"e19c1832-d7f3-4d65-90ea-33a3d7f6d6df"`
+	Details json.RawMessage `json:"details" validate:"required" swaggertype:"object"` // shaped like GarminTokenDetails
 }
 
 type GarminUserIDResponse struct {
@@ -363,13 +309,13 @@ type ArchinisisTokenDetails struct {
 }
 
 type KlabTokenInput struct {
-	UserID  string           `json:"user_id" example:"208e2ffb-ac68-4980-a8b6-b7e0136e4172"`
-	Details KlabTokenDetails `json:"details"`
+	UserID  string          `json:"user_id" validate:"required,uuid4" example:"208e2ffb-ac68-4980-a8b6-b7e0136e4172"`
+	Details json.RawMessage `json:"details" validate:"required" swaggertype:"object"` // shaped like KlabTokenDetails
 }
 
 type ArchinisisTokenInput struct {
-	UserID  string                 `json:"user_id" example:"208e2ffb-ac68-4980-a8b6-b7e0136e4172"`
-	Details ArchinisisTokenDetails `json:"details"`
+	UserID  string          `json:"user_id" validate:"required,uuid4" example:"208e2ffb-ac68-4980-a8b6-b7e0136e4172"`
+	Details json.RawMessage `json:"details" validate:"required" swaggertype:"object"` // shaped like ArchinisisTokenDetails
 }
 
 type UserDataDetails struct {
@@ -396,65 +342,50 @@ type UserDataDetails struct {
 }
 
 type UserDataInput struct {
-	Data UserDataDetails `json:"data"`
+	Data json.RawMessage `json:"data" validate:"required" swaggertype:"object"` // shaped like UserDataDetails
 }
 
 type UserDataResponse struct {
-	Data UserDataDetails `json:"data"`
+	Data json.RawMessage `json:"data" swaggertype:"object"` // shaped like UserDataDetails
 }
 
-type UserIDResponse struct {
-	UserID string `json:"user_id" example:"dcabe48a-3578-4743-93ba-001409c82a82"`
+// SourceUserDataResponse is a user's token or data row of a source, as
+// listed by the tokens and data due for update
+type SourceUserDataResponse struct {
+	UserID string          `json:"user_id" example:"dcabe48a-3578-4743-93ba-001409c82a82"`
+	Data   json.RawMessage `json:"data" swaggertype:"object"`
 }
 
-type NotFoundResponse struct {
-	Error string `json:"error" example:"resource not found"`
+type UserIDResponse struct {
+	UserID string `json:"user_id" example:"dcabe48a-3578-4743-93ba-001409c82a82"`
 }
 
 type CoachtechStatusResponse struct {
 	Data bool `json:"data" example:"true"`
 }
 
-type CoachtechData struct {
-	Example  string `json:"example" example:"example_data"`
-	Example1 string `json:"example1" example:"example_data_1"`
-	Example2 string `json:"example2" example:"example_data_2"`
-	Example3 string `json:"example3" example:"example_data_3"`
-	Example4 string `json:"example4" example:"example_data_4"`
-}
-
 type CoachtechInsertInput struct {
-	UserID      string        `json:"user_id" example:"1c2f6ad2-dc8c-4c44-85e2-381d70b093ef"`
-	CoachtechID int32         `json:"coachtech_id" example:"123"`
-	SummaryDate string        `json:"summary_date" example:"2025-07-14"`
-	TestID      string        `json:"test_id" example:"endurance_test_1"`
-	Data        CoachtechData `json:"data"`
+	UserID      string          `json:"user_id" validate:"required,uuid4" example:"1c2f6ad2-dc8c-4c44-85e2-381d70b093ef"`
+	CoachtechID int32           `json:"coachtech_id" validate:"required" example:"123"`
+	SummaryDate string          `json:"summary_date" validate:"required,datetime=2006-01-02" example:"2025-07-14"`
+	TestID      string          `json:"test_id" validate:"required" example:"endurance_test_1"`
+	Data        json.RawMessage `json:"data" validate:"required" swaggertype:"object"`
 }
-type DeviceInfoConnectedWithData struct {
+type DeviceInfo struct {
 	Connected  bool `json:"connected" example:"true"`
 	DataExists bool `json:"data_exists" example:"true"`
 }
 
-type DeviceInfoConnectedNoData struct {
-	Connected  bool `json:"connected" example:"true"`
-	DataExists bool `json:"data_exists" example:"false"`
-}
-
-type DeviceInfoNotConnectedNoData struct {
-	Connected  bool `json:"connected" example:"false"`
-	DataExists bool `json:"data_exists" example:"false"`
-}
-
 type DeviceStatusResponse struct {
-	Garmin DeviceInfoConnectedWithData  `json:"garmin"`
-	Oura   DeviceInfoConnectedNoData    `json:"oura"`
-	Polar  DeviceInfoNotConnectedNoData `json:"polar"`
-	Suunto DeviceInfoConnectedWithData  `json:"suunto"`
+	Garmin DeviceInfo `json:"garmin"`
+	Oura   DeviceInfo `json:"oura"`
+	Polar  DeviceInfo `json:"polar"`
+	Suunto DeviceInfo `json:"suunto"`
 }
 
 type SourceCacheUpsertInput struct {
-	Source string   `json:"source" example:"garmin"`
-	Data   []string `json:"data" example:"hr,steps"`
+	Source string   `json:"source" validate:"required" example:"garmin"`
+	Data   []string `json:"data" validate:"required,min=1,dive,required" example:"hr,steps"`
 }
 
 type SourceCacheSingleResponse struct {
@@ -471,6 +402,13 @@ type SportIDsResponse struct {
 	SporttiIDs []string `json:"sportti_ids" example:"12345,67890,54321"`
 }
 
+// SourceTokenResponse is a user's stored token of one source; its shape
+// depends on the source, see OuraTokenResponse, SuuntoTokenResponse,
+// PolarTokenResponse and GarminTokenResponse
+type SourceTokenResponse struct {
+	Token json.RawMessage `json:"token" swaggertype:"object"`
+}
+
 type OuraToken struct {
 	AccessToken string `json:"access_token" example:"abc123token"`
 }
@@ -0,0 +1,50 @@
+package apitypes
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Webhook subscriptions (/webhooks)
+
+type WebhookInput struct {
+	URL    string   `json:"url" validate:"required,url,max=2048" example:"https://example.com/kuha/webhooks"`
+	Events []string `json:"events" validate:"required,min=1,unique,dive,oneof=utv.data.inserted tietoevry.exercise.created kamk.injury.added fis.result.updated" example:"utv.data.inserted,kamk.injury.added" enums:"utv.data.inserted,tietoevry.exercise.created,kamk.injury.added,fis.result.updated"`
+	Active *bool    `json:"active,omitempty" example:"true"`
+}
+
+type WebhookResponse struct {
+	ID     int32    `json:"id" example:"12"`
+	URL    string   `json:"url" example:"https://example.com/kuha/webhooks"`
+	Events []string `json:"events" example:"utv.data.inserted,kamk.injury.added"`
+	Active bool     `json:"active" example:"true"`
+	// only returned when the subscription is created
+	Secret    string    `json:"secret,omitempty" example:"whsec_3f9a0c1d5e7b2a4c6d8e0f1a3b5c7d9e1f2a4b6c8d0e2f4a6b8c0d2e4f6a8b0c"`
+	CreatedAt time.Time `json:"created_at" example:"2025-01-14T08:00:00Z"`
+	UpdatedAt time.Time `json:"updated_at" example:"2025-01-14T08:00:00Z"`
+}
+
+type WebhookListResponse struct {
+	Webhooks []WebhookResponse `json:"webhooks"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID             int64      `json:"id" example:"4711"`
+	SubscriptionID int32      `json:"subscription_id" example:"12"`
+	EventID        string     `json:"event_id" example:"0b6a1f0e-5d2c-4f1a-9a3e-7c4d2b1e8f90"`
+	EventType      string     `json:"event_type" example:"utv.data.inserted" enums:"utv.data.inserted,tietoevry.exercise.created,kamk.injury.added,fis.result.updated"`
+	Status         string     `json:"status" example:"pending" enums:"pending,succeeded,dead"`
+	Attempts       int32      `json:"attempts" example:"2"`
+	NextAttemptAt  *time.Time `json:"next_attempt_at,omitempty" example:"2025-01-14T08:02:00Z"`
+	LastAttemptAt  *time.Time `json:"last_attempt_at" example:"2025-01-14T08:01:00Z"`
+	LastStatusCode *int32     `json:"last_status_code" example:"503"`
+	LastError      *string    `json:"last_error" example:"unexpected status 503"`
+	CreatedAt      time.Time  `json:"created_at" example:"2025-01-14T08:00:00Z"`
+	// the Event as it was sent
+	Payload json.RawMessage `json:"payload" swaggertype:"object"`
+}
+
+type WebhookDeliveryListResponse struct {
+	Deliveries []WebhookDeliveryResponse `json:"deliveries"`
+	NextCursor *string                   `json:"next_cursor,omitempty" example:"eyJuIjoxMjM0NX0"`
+}
@@ -0,0 +1,42 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
+)
+
+// ArchinisisService covers the Archinisis endpoints. Users are keyed by
+// Sportti ID.
+type ArchinisisService struct{ c *Client }
+
+// UpsertData uploads a user's Archinisis data
+func (s *ArchinisisService) UpsertData(ctx context.Context, data *apitypes.ArchDataUpsertRequest) error {
+	return s.c.exec(ctx, &request{method: http.MethodPost, path: "/v2/archinisis/data", body: data, gzip: true})
+}
+
+// UpsertRaceReport uploads a race report
+func (s *ArchinisisService) UpsertRaceReport(ctx context.Context, report *apitypes.ArchRaceReportUpsertRequest) error {
+	return s.c.exec(ctx, &request{method: http.MethodPost, path: "/v2/archinisis/race-reports", body: report, gzip: true})
+}
+
+// DeleteUser deletes a user's Archinisis data and race reports
+func (s *ArchinisisService) DeleteUser(ctx context.Context, sporttiID string) error {
+	return s.c.exec(ctx, &request{method: http.MethodDelete, path: path("/v2/archinisis/users/%s", sporttiID)})
+}
+
+// GetData returns a user's Archinisis data
+func (s *ArchinisisService) GetData(ctx context.Context, sporttiID string) (*apitypes.ArchDataResponse, error) {
+	return call[apitypes.ArchDataResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/archinisis/users/%s/data", sporttiID)})
+}
+
+// GetRaceReportSessions lists the sessions a user has race reports for
+func (s *ArchinisisService) GetRaceReportSessions(ctx context.Context, sporttiID string) (*apitypes.RaceReportSessionsResponse, error) {
+	return call[apitypes.RaceReportSessionsResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/archinisis/users/%s/race-reports", sporttiID)})
+}
+
+// GetRaceReport returns the HTML race report of a session
+func (s *ArchinisisService) GetRaceReport(ctx context.Context, sporttiID, sessionID string) (string, error) {
+	return s.c.text(ctx, &request{method: http.MethodGet, path: path("/v2/archinisis/users/%s/race-reports/%s", sporttiID, sessionID)})
+}
@@ -0,0 +1,140 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
+)
+
+// a JWT this close to expiry is refreshed before it is used
+const tokenLeeway = 30 * time.Second
+
+// ErrNoCredentials is returned when a token is needed but the client has
+// neither a client token nor a refresh token to get one with
+var ErrNoCredentials = errors.New("kuha: no client token or refresh token configured")
+
+// tokenSource hands out a valid JWT, issuing one from the client token or
+// refreshing it as needed
+type tokenSource struct {
+	c           *Client
+	clientToken string
+
+	mu      sync.Mutex
+	jwt     string
+	refresh string
+	expires time.Time
+}
+
+func (ts *tokenSource) configured() bool {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	return ts.clientToken != "" || ts.jwt != "" || ts.refresh != ""
+}
+
+func (ts *tokenSource) set(jwt, refresh string) {
+	ts.jwt = jwt
+	if refresh != "" {
+		ts.refresh = refresh
+	}
+	ts.expires = jwtExpiry(jwt)
+}
+
+// token returns a JWT that is valid for at least tokenLeeway
+func (ts *tokenSource) token(ctx context.Context) (string, error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if ts.jwt != "" && (ts.expires.IsZero() || time.Until(ts.expires) > tokenLeeway) {
+		return ts.jwt, nil
+	}
+
+	if ts.refresh != "" {
+		res, err := call[apitypes.RefreshResponse](ctx, ts.c, &request{
+			method: http.MethodPost,
+			path:   "/v2/auth/tokens/refresh",
+			body:   apitypes.RefreshRequest{RefreshToken: ts.refresh},
+			noAuth: true,
+		})
+		switch {
+		case err == nil && res != nil:
+			ts.set(res.Tokens.JWT, "")
+			return ts.jwt, nil
+		case errors.Is(err, ErrUnauthorized) && ts.clientToken != "":
+			// the refresh token expired or was revoked: start over
+			ts.refresh = ""
+		case err != nil:
+			return "", err
+		}
+	}
+
+	if ts.clientToken == "" {
+		return "", ErrNoCredentials
+	}
+	res, err := call[apitypes.TokenResponse](ctx, ts.c, &request{
+		method: http.MethodPost,
+		path:   "/v2/auth/tokens",
+		body:   apitypes.TokenRequest{ClientToken: ts.clientToken},
+		noAuth: true,
+	})
+	if err != nil {
+		return "", err
+	}
+	if res == nil {
+		return "", errors.New("kuha: empty token response")
+	}
+	ts.set(res.Tokens.JWT, res.Tokens.RefreshToken)
+	return ts.jwt, nil
+}
+
+// invalidate drops jwt after the API rejected it, unless another request
+// already replaced it
+func (ts *tokenSource) invalidate(jwt string) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if ts.jwt == jwt {
+		ts.jwt = ""
+		ts.expires = time.Time{}
+	}
+}
+
+// jwtExpiry reads the exp claim without verifying the token; the zero time
+// when there is none
+func jwtExpiry(jwt string) time.Time {
+	parts := strings.Split(jwt, ".")
+	if len(parts) != 3 {
+		return time.Time{}
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if json.Unmarshal(payload, &claims) != nil || claims.Exp == 0 {
+		return time.Time{}
+	}
+	return time.Unix(claims.Exp, 0)
+}
+
+// Authenticate gets a token right away instead of on the first request, so
+// a wrong client token shows up early
+func (c *Client) Authenticate(ctx context.Context) error {
+	_, err := c.tokens.token(ctx)
+	return err
+}
+
+// Tokens returns the current JWT and refresh token, e.g. to hand them to
+// another client with WithTokens
+func (c *Client) Tokens() (jwt, refreshToken string) {
+	c.tokens.mu.Lock()
+	defer c.tokens.mu.Unlock()
+	return c.tokens.jwt, c.tokens.refresh
+}
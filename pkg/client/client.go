@@ -0,0 +1,425 @@
+// Package client is the Go client of the KUHA REST API. It talks to the v2
+// endpoints, issues and refreshes tokens by itself, gzips bulk uploads,
+// retries rate-limited and unavailable requests after the Retry-After the
+// server sends, and returns API errors as *Error. Request and response bodies
+// are the docs/swagger types the handlers use.
+//
+//	c, err := client.New("https://kuha.example.fi", client.WithClientToken(token))
+//	races, err := c.FIS.GetRacesCC(ctx, &client.RacesParams{SeasonCodes: []int32{2025}})
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
+)
+
+const (
+	defaultMaxRetries   = 3
+	defaultMaxRetryWait = 30 * time.Second
+	// bulk bodies at least this large are sent gzip-compressed
+	defaultGzipMinSize = 64 << 10
+	// first backoff when the server gives no Retry-After; doubled per attempt
+	baseBackoff = 500 * time.Millisecond
+)
+
+// Client calls the KUHA REST API. It is safe for concurrent use.
+type Client struct {
+	baseURL      *url.URL
+	httpClient   *http.Client
+	userAgent    string
+	maxRetries   int
+	maxRetryWait time.Duration
+	gzipMinSize  int
+	tokens       *tokenSource
+
+	FIS        *FISService
+	UTV        *UTVService
+	Tietoevry  *TietoevryService
+	KAMK       *KAMKService
+	KLAB       *KLABService
+	Archinisis *ArchinisisService
+	Webhooks   *WebhooksService
+	Identities *IdentitiesService
+}
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithHTTPClient sets the underlying HTTP client (timeouts, transport)
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) { c.httpClient = hc }
+}
+
+// WithClientToken authenticates with a client token: a JWT and refresh token
+// are issued on first use and renewed before they expire
+func WithClientToken(token string) Option {
+	return func(c *Client) { c.tokens.clientToken = token }
+}
+
+// WithTokens starts from an already issued JWT and refresh token
+func WithTokens(jwt, refreshToken string) Option {
+	return func(c *Client) { c.tokens.set(jwt, refreshToken) }
+}
+
+// WithUserAgent sets the User-Agent header
+func WithUserAgent(ua string) Option {
+	return func(c *Client) { c.userAgent = ua }
+}
+
+// WithMaxRetries sets how often a request is retried (default 3; 0 disables)
+func WithMaxRetries(n int) Option {
+	return func(c *Client) { c.maxRetries = n }
+}
+
+// WithMaxRetryWait caps the wait before a retry (default 30s). A Retry-After
+// longer than this is not waited for; the error is returned instead.
+func WithMaxRetryWait(d time.Duration) Option {
+	return func(c *Client) { c.maxRetryWait = d }
+}
+
+// WithGzipMinSize sets the size from which bulk uploads are gzip-compressed
+// (default 64 KiB; a negative size disables compression)
+func WithGzipMinSize(n int) Option {
+	return func(c *Client) { c.gzipMinSize = n }
+}
+
+// New returns a client for the API at baseURL (scheme and host, without /v2)
+func New(baseURL string, opts ...Option) (*Client, error) {
+	u, err := url.Parse(strings.TrimRight(baseURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("kuha: invalid base URL: %w", err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("kuha: base URL %q needs a scheme and host", baseURL)
+	}
+
+	c := &Client{
+		baseURL:      u,
+		httpClient:   &http.Client{Timeout: 2 * time.Minute},
+		userAgent:    "kuha-go-client",
+		maxRetries:   defaultMaxRetries,
+		maxRetryWait: defaultMaxRetryWait,
+		gzipMinSize:  defaultGzipMinSize,
+	}
+	c.tokens = &tokenSource{c: c}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	c.FIS = &FISService{c}
+	c.UTV = &UTVService{c}
+	c.Tietoevry = &TietoevryService{c}
+	c.KAMK = &KAMKService{c}
+	c.KLAB = &KLABService{c}
+	c.Archinisis = &ArchinisisService{c}
+	c.Webhooks = &WebhooksService{c}
+	c.Identities = &IdentitiesService{c}
+	return c, nil
+}
+
+// request describes one API call
+type request struct {
+	method string
+	path   string
+	// params is a struct with query and header tags, see encodeParams
+	params any
+	body   any
+	// gzip compresses the body once it reaches the configured size
+	gzip bool
+	// noAuth skips the Authorization header (token endpoints)
+	noAuth bool
+}
+
+// send runs req, retrying and refreshing the token as needed, and returns the
+// successful response with its body read
+func (c *Client) send(ctx context.Context, req *request) (*http.Response, []byte, error) {
+	u := *c.baseURL
+	u.Path += req.path
+	query, header := encodeParams(req.params)
+	u.RawQuery = query.Encode()
+
+	var payload []byte
+	var encoding string
+	if req.body != nil {
+		b, err := json.Marshal(req.body)
+		if err != nil {
+			return nil, nil, fmt.Errorf("kuha: encode request body: %w", err)
+		}
+		payload = b
+		if req.gzip && c.gzipMinSize >= 0 && len(b) >= c.gzipMinSize {
+			if payload, err = gzipBytes(b); err != nil {
+				return nil, nil, err
+			}
+			encoding = "gzip"
+		}
+	}
+
+	// a retry is safe when the server did not run the request (429, 503) or
+	// when running it twice does no harm
+	idempotent := req.method != http.MethodPost || header.Get("Idempotency-Key") != ""
+	refreshed := false
+
+	for attempt := 0; ; attempt++ {
+		hr, err := http.NewRequestWithContext(ctx, req.method, u.String(), bytes.NewReader(payload))
+		if err != nil {
+			return nil, nil, err
+		}
+		for k, v := range header {
+			hr.Header[k] = v
+		}
+		hr.Header.Set("Accept", "application/json")
+		hr.Header.Set("User-Agent", c.userAgent)
+		if req.body != nil {
+			hr.Header.Set("Content-Type", "application/json")
+		}
+		if encoding != "" {
+			hr.Header.Set("Content-Encoding", encoding)
+		}
+
+		var jwt string
+		if !req.noAuth && c.tokens.configured() {
+			if jwt, err = c.tokens.token(ctx); err != nil {
+				return nil, nil, err
+			}
+			hr.Header.Set("Authorization", "Bearer "+jwt)
+		}
+
+		resp, err := c.httpClient.Do(hr)
+		if err != nil {
+			if ctx.Err() != nil || !idempotent || attempt >= c.maxRetries {
+				return nil, nil, err
+			}
+			if err := c.wait(ctx, c.backoff(attempt)); err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, nil, fmt.Errorf("kuha: read response: %w", err)
+		}
+
+		if resp.StatusCode < 400 {
+			return resp, body, nil
+		}
+
+		apiErr := newError(resp, body)
+		// an expired or revoked JWT: get a new one and try once more
+		if resp.StatusCode == http.StatusUnauthorized && jwt != "" && !refreshed {
+			refreshed = true
+			c.tokens.invalidate(jwt)
+			attempt--
+			continue
+		}
+		if attempt >= c.maxRetries || !retryable(apiErr, idempotent) {
+			return nil, nil, apiErr
+		}
+		delay := apiErr.RetryAfter
+		if delay == 0 {
+			delay = c.backoff(attempt)
+		}
+		if delay > c.maxRetryWait {
+			return nil, nil, apiErr
+		}
+		if err := c.wait(ctx, delay); err != nil {
+			return nil, nil, err
+		}
+	}
+}
+
+// retryable reports whether a failed request may be sent again
+func retryable(e *Error, idempotent bool) bool {
+	switch e.Status {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusConflict:
+		return e.Code == CodeIdempotencyInProgress
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return idempotent
+	}
+	return false
+}
+
+func (c *Client) backoff(attempt int) time.Duration {
+	d := baseBackoff << attempt
+	d += time.Duration(rand.Int63n(int64(d) / 2))
+	if d > c.maxRetryWait {
+		d = c.maxRetryWait
+	}
+	return d
+}
+
+func (c *Client) wait(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+func gzipBytes(b []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(b); err != nil {
+		return nil, fmt.Errorf("kuha: gzip request body: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("kuha: gzip request body: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// call runs req and decodes the JSON response into a T. It returns nil
+// without an error when the API answers 204 No Content.
+func call[T any](ctx context.Context, c *Client, req *request) (*T, error) {
+	resp, body, err := c.send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNoContent || len(bytes.TrimSpace(body)) == 0 {
+		return nil, nil
+	}
+	out := new(T)
+	if err := json.Unmarshal(body, out); err != nil {
+		return nil, fmt.Errorf("kuha: decode %s %s response: %w", req.method, req.path, err)
+	}
+	return out, nil
+}
+
+// list runs req and decodes a JSON array response. It returns nil without
+// an error when the API answers 204 No Content.
+func list[T any](ctx context.Context, c *Client, req *request) ([]T, error) {
+	out, err := call[[]T](ctx, c, req)
+	if out == nil || err != nil {
+		return nil, err
+	}
+	return *out, nil
+}
+
+// exec runs req and discards the response body
+func (c *Client) exec(ctx context.Context, req *request) error {
+	_, _, err := c.send(ctx, req)
+	return err
+}
+
+// text runs req and returns the response body as is (HTML reports, SDL)
+func (c *Client) text(ctx context.Context, req *request) (string, error) {
+	_, body, err := c.send(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+// path builds an API path, escaping each argument as a path segment
+func path(format string, args ...any) string {
+	escaped := make([]any, len(args))
+	for i, a := range args {
+		escaped[i] = url.PathEscape(fmt.Sprint(a))
+	}
+	return fmt.Sprintf(format, escaped...)
+}
+
+// BulkParams are the options of the bulk upload endpoints that support them
+type BulkParams struct {
+	// Mode is atomic (default), partial or async; see BulkModeAtomic etc.
+	Mode string `query:"mode"`
+	// IdempotencyKey makes the upload safe to retry: a retry with the same key
+	// and body gets the first response back. It also lets the client retry
+	// uploads after network errors.
+	IdempotencyKey string `header:"Idempotency-Key"`
+}
+
+// Bulk upload modes
+const (
+	BulkModeAtomic  = "atomic"
+	BulkModePartial = "partial"
+	BulkModeAsync   = "async"
+)
+
+// UploadResult is the answer to a bulk upload: only the status for an atomic
+// upload, the per-item report for partial mode (207) or the queued job for
+// async mode (202)
+type UploadResult struct {
+	Status int
+	Report *apitypes.BulkResultResponse
+	Job    *apitypes.JobResponse
+	// Replayed is set when the response was replayed for a reused Idempotency-Key
+	Replayed bool
+}
+
+func (c *Client) upload(ctx context.Context, req *request) (*UploadResult, error) {
+	req.gzip = true
+	resp, body, err := c.send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res := &UploadResult{Status: resp.StatusCode, Replayed: resp.Header.Get("Idempotent-Replayed") == "true"}
+	switch resp.StatusCode {
+	case http.StatusMultiStatus:
+		res.Report = new(apitypes.BulkResultResponse)
+		err = json.Unmarshal(body, res.Report)
+	case http.StatusAccepted:
+		res.Job = new(apitypes.JobResponse)
+		err = json.Unmarshal(body, res.Job)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("kuha: decode %s %s response: %w", req.method, req.path, err)
+	}
+	return res, nil
+}
+
+// PageParams select a page of a cursor-paginated listing. Without a limit
+// the whole listing is returned.
+type PageParams struct {
+	Limit  int    `query:"limit"`
+	Cursor string `query:"cursor"`
+}
+
+// Health reports the status of the API and its databases
+func (c *Client) Health(ctx context.Context) (*apitypes.HealthStatusResponse, error) {
+	return call[apitypes.HealthStatusResponse](ctx, c, &request{method: http.MethodGet, path: "/v2/health", noAuth: true})
+}
+
+// GetJob returns an async import job
+func (c *Client) GetJob(ctx context.Context, id string) (*apitypes.JobResponse, error) {
+	return call[apitypes.JobResponse](ctx, c, &request{method: http.MethodGet, path: path("/v2/jobs/%s", id)})
+}
+
+// ErrJobFailed is returned by WaitJob when the job ends in failure
+var ErrJobFailed = errors.New("kuha: job failed")
+
+// WaitJob polls an async import job every interval until it has finished
+func (c *Client) WaitJob(ctx context.Context, id string, interval time.Duration) (*apitypes.JobResponse, error) {
+	for {
+		job, err := c.GetJob(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		switch job.Status {
+		case "succeeded":
+			return job, nil
+		case "failed":
+			return job, fmt.Errorf("%w: %s", ErrJobFailed, job.Error)
+		}
+		if err := c.wait(ctx, interval); err != nil {
+			return job, err
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testServer answers every request with the next of responses, repeating the
+// last one, and counts the requests it saw
+func testServer(t *testing.T, responses ...func(w http.ResponseWriter)) (*Client, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(calls.Add(1)) - 1
+		responses[min(n, len(responses)-1)](w)
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := New(srv.URL, WithMaxRetryWait(time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c, &calls
+}
+
+func rateLimited(header, retry string) func(w http.ResponseWriter) {
+	return func(w http.ResponseWriter) {
+		if header != "" {
+			w.Header().Set("Retry-After", header)
+		}
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"status":429,"code":"rate_limited","retry_after":"` + retry + `"}`))
+	}
+}
+
+func status(code int) func(w http.ResponseWriter) {
+	return func(w http.ResponseWriter) { w.WriteHeader(code) }
+}
+
+func TestSendRetriesAfterRateLimit(t *testing.T) {
+	c, calls := testServer(t, rateLimited("", "50ms"), rateLimited("", "50ms"), status(http.StatusNoContent))
+
+	start := time.Now()
+	if err := c.exec(context.Background(), &request{method: http.MethodGet, path: "/v2/test", noAuth: true}); err != nil {
+		t.Fatalf("exec: %v", err)
+	}
+	if n := calls.Load(); n != 3 {
+		t.Errorf("requests = %d, want 3", n)
+	}
+	if d := time.Since(start); d < 100*time.Millisecond {
+		t.Errorf("retried after %v, want the 2 x 50ms the server asked for", d)
+	}
+}
+
+func TestSendGivesUpAfterMaxRetries(t *testing.T) {
+	c, calls := testServer(t, rateLimited("", "10ms"))
+
+	err := c.exec(context.Background(), &request{method: http.MethodGet, path: "/v2/test", noAuth: true})
+	if !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("err = %v, want ErrRateLimited", err)
+	}
+	if n := calls.Load(); n != defaultMaxRetries+1 {
+		t.Errorf("requests = %d, want %d", n, defaultMaxRetries+1)
+	}
+}
+
+func TestSendDoesNotWaitPastMaxRetryWait(t *testing.T) {
+	c, calls := testServer(t, rateLimited("60", "60s"))
+
+	err := c.exec(context.Background(), &request{method: http.MethodGet, path: "/v2/test", noAuth: true})
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.RetryAfter != time.Minute {
+		t.Fatalf("err = %v, want a 429 with RetryAfter 1m", err)
+	}
+	if n := calls.Load(); n != 1 {
+		t.Errorf("requests = %d, want 1", n)
+	}
+}
+
+func TestSendRetriesPostOnlyWhenSafe(t *testing.T) {
+	c, calls := testServer(t, status(http.StatusBadGateway), status(http.StatusCreated))
+
+	err := c.exec(context.Background(), &request{method: http.MethodPost, path: "/v2/test", noAuth: true})
+	if !errors.Is(err, ErrServer) {
+		t.Fatalf("err = %v, want the 502 without a retry", err)
+	}
+	if n := calls.Load(); n != 1 {
+		t.Errorf("requests = %d, want 1", n)
+	}
+
+	// a 429 means the server did not run the request, so a POST is retried
+	c, calls = testServer(t, rateLimited("", "10ms"), status(http.StatusCreated))
+	if err := c.exec(context.Background(), &request{method: http.MethodPost, path: "/v2/test", noAuth: true}); err != nil {
+		t.Fatalf("exec: %v", err)
+	}
+	if n := calls.Load(); n != 2 {
+		t.Errorf("requests = %d, want 2", n)
+	}
+}
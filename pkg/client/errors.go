@@ -0,0 +1,195 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
+)
+
+// Problem codes the API sends in the code member of its error responses
+const (
+	CodeBadRequest            = "bad_request"
+	CodeValidationFailed      = "validation_failed"
+	CodeInvalidQueryParameter = "invalid_query_parameter"
+	CodeInvalidCursor         = "invalid_cursor"
+	CodeInvalidJSON           = "invalid_json"
+	CodeInvalidDataFormat     = "invalid_data_format"
+	CodeReferenceNotFound     = "reference_not_found"
+	CodeConstraintViolation   = "constraint_violation"
+	CodeUnauthorized          = "unauthorized"
+	CodeForbidden             = "forbidden"
+	CodeNotFound              = "not_found"
+	CodeMethodNotAllowed      = "method_not_allowed"
+	CodeConflict              = "conflict"
+	CodeDuplicateRecord       = "duplicate_record"
+	CodeIdempotencyInProgress = "idempotency_key_in_progress"
+	CodeIdempotencyKeyReused  = "idempotency_key_reused"
+	CodePayloadTooLarge       = "payload_too_large"
+	CodeUnprocessableEntity   = "unprocessable_entity"
+	CodeRateLimited           = "rate_limited"
+	CodeInternalError         = "internal_error"
+	CodeDatabaseUnavailable   = "database_unavailable"
+	CodeQueryTimeout          = "query_timeout"
+	CodeJobQueueFull          = "job_queue_full"
+)
+
+// Sentinel errors an *Error matches with errors.Is, by status class
+var (
+	ErrBadRequest    = errors.New("kuha: bad request")
+	ErrUnauthorized  = errors.New("kuha: unauthorized")
+	ErrForbidden     = errors.New("kuha: forbidden")
+	ErrNotFound      = errors.New("kuha: not found")
+	ErrConflict      = errors.New("kuha: conflict")
+	ErrTooLarge      = errors.New("kuha: payload too large")
+	ErrUnprocessable = errors.New("kuha: unprocessable entity")
+	ErrRateLimited   = errors.New("kuha: rate limited")
+	ErrServer        = errors.New("kuha: server error")
+	ErrUnavailable   = errors.New("kuha: service unavailable")
+)
+
+// Error is an API error response: the RFC 7807 problem document, branch on
+// Code. Responses that were not problem documents (a proxy's 502, say) are
+// filled in from the status.
+type Error struct {
+	apitypes.Problem
+	// RetryAfter is the wait the server asked for (429, 503 and in-progress
+	// 409); it shadows the raw retry_after member of the problem
+	RetryAfter time.Duration
+	// Body is the raw response body
+	Body []byte
+}
+
+func (e *Error) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "kuha: %d %s", e.Status, e.Code)
+	if e.Detail != "" {
+		b.WriteString(": " + e.Detail)
+	}
+	for _, f := range e.Errors {
+		fmt.Fprintf(&b, "; %s: %s", f.Field, f.Message)
+	}
+	if e.RequestID != "" {
+		b.WriteString(" (request " + e.RequestID + ")")
+	}
+	return b.String()
+}
+
+// Is matches the sentinel error of the status
+func (e *Error) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.Status == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.Status == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.Status == http.StatusForbidden
+	case ErrNotFound:
+		return e.Status == http.StatusNotFound
+	case ErrConflict:
+		return e.Status == http.StatusConflict
+	case ErrTooLarge:
+		return e.Status == http.StatusRequestEntityTooLarge
+	case ErrUnprocessable:
+		return e.Status == http.StatusUnprocessableEntity
+	case ErrRateLimited:
+		return e.Status == http.StatusTooManyRequests
+	case ErrUnavailable:
+		return e.Status == http.StatusServiceUnavailable
+	case ErrServer:
+		return e.Status >= 500
+	}
+	return false
+}
+
+// IsCode reports whether err is an API error with the given problem code
+func IsCode(err error, code string) bool {
+	var e *Error
+	return errors.As(err, &e) && e.Code == code
+}
+
+// newError reads the problem document of a failed response
+func newError(resp *http.Response, body []byte) *Error {
+	e := &Error{Body: body}
+	if strings.Contains(resp.Header.Get("Content-Type"), "json") {
+		_ = json.Unmarshal(body, &e.Problem)
+	}
+	if e.Status == 0 {
+		e.Status = resp.StatusCode
+	}
+	if e.Title == "" {
+		e.Title = http.StatusText(resp.StatusCode)
+	}
+	if e.Code == "" {
+		e.Code = statusCode(resp.StatusCode)
+	}
+	if e.Detail == "" && !strings.Contains(resp.Header.Get("Content-Type"), "json") {
+		e.Detail = strings.TrimSpace(string(body))
+	}
+	e.RetryAfter = retryAfter(resp.Header.Get("Retry-After"))
+	if e.RetryAfter == 0 {
+		// older servers sent a Go duration ("4.87s"), which the problem
+		// document repeats in retry_after
+		e.RetryAfter = retryAfter(e.Problem.RetryAfter)
+	}
+	return e
+}
+
+// statusCode is the problem code the API uses for a bare status
+func statusCode(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return CodeBadRequest
+	case http.StatusUnauthorized:
+		return CodeUnauthorized
+	case http.StatusForbidden:
+		return CodeForbidden
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusMethodNotAllowed:
+		return CodeMethodNotAllowed
+	case http.StatusConflict:
+		return CodeConflict
+	case http.StatusRequestEntityTooLarge:
+		return CodePayloadTooLarge
+	case http.StatusUnprocessableEntity:
+		return CodeUnprocessableEntity
+	case http.StatusTooManyRequests:
+		return CodeRateLimited
+	case http.StatusServiceUnavailable:
+		return CodeDatabaseUnavailable
+	case http.StatusGatewayTimeout:
+		return CodeQueryTimeout
+	}
+	return CodeInternalError
+}
+
+// retryAfter parses a Retry-After value: delay seconds, an HTTP date or a Go
+// duration
+func retryAfter(v string) time.Duration {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0
+		}
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+		return 0
+	}
+	if d, err := time.ParseDuration(v); err == nil && d > 0 {
+		return d
+	}
+	return 0
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Duration
+	}{
+		{"", 0},
+		{"5", 5 * time.Second},
+		{" 2 ", 2 * time.Second},
+		{"-1", 0},
+		{"4.87s", 4870 * time.Millisecond},
+		{"1m30s", 90 * time.Second},
+		{"-3s", 0},
+		{"soon", 0},
+		{time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), 0},
+	}
+	for _, tt := range tests {
+		if got := retryAfter(tt.in); got != tt.want {
+			t.Errorf("retryAfter(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+
+	date := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+	if got := retryAfter(date); got <= 0 || got > time.Minute {
+		t.Errorf("retryAfter(%q) = %v, want up to 1m", date, got)
+	}
+}
+
+func TestNewErrorRetryAfter(t *testing.T) {
+	problem := func(header, retry string) *Error {
+		resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+		resp.Header.Set("Content-Type", "application/problem+json")
+		if header != "" {
+			resp.Header.Set("Retry-After", header)
+		}
+		body := `{"status":429,"code":"rate_limited","retry_after":"` + retry + `"}`
+		return newError(resp, []byte(body))
+	}
+
+	if got := problem("3", "2.5s").RetryAfter; got != 3*time.Second {
+		t.Errorf("header: RetryAfter = %v, want 3s", got)
+	}
+	// the header wins; the body is only read when the header is unusable
+	if got := problem("2.5s", "9s").RetryAfter; got != 2500*time.Millisecond {
+		t.Errorf("duration header: RetryAfter = %v, want 2.5s", got)
+	}
+	if got := problem("", "1.2s").RetryAfter; got != 1200*time.Millisecond {
+		t.Errorf("body fallback: RetryAfter = %v, want 1.2s", got)
+	}
+
+	e := problem("", "")
+	if e.RetryAfter != 0 {
+		t.Errorf("no retry_after: RetryAfter = %v, want 0", e.RetryAfter)
+	}
+	if e.Code != CodeRateLimited || !strings.Contains(e.Error(), "429") {
+		t.Errorf("error = %v, want a 429 rate_limited error", e)
+	}
+}
@@ -0,0 +1,416 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
+)
+
+// FISService covers the FIS endpoints: races, results, competitors and
+// athletes of the cross-country (CC), ski jumping (JP) and Nordic combined
+// (NK) sectors
+type FISService struct{ c *Client }
+
+// RacesParams filter a race listing. Without a limit all matching races are
+// returned.
+type RacesParams struct {
+	SeasonCodes     []int32  `query:"seasoncode"`
+	DisciplineCodes []string `query:"disciplinecode"`
+	CatCodes        []string `query:"catcode"`
+	// Fields is a comma-separated column list or a preset (summary, full)
+	Fields string `query:"fields"`
+	PageParams
+}
+
+// AthleteResultsParams filter the results of one athlete
+type AthleteResultsParams struct {
+	SeasonCodes     []int32  `query:"seasoncode"`
+	DisciplineCodes []string `query:"disciplinecode"`
+	CatCodes        []string `query:"catcode"`
+}
+
+// RaceResultsParams select the columns of a race's results
+type RaceResultsParams struct {
+	// Fields is a comma-separated column list or a preset (summary, full)
+	Fields string `query:"fields"`
+}
+
+// RaceSearchParams filter SearchRaces
+type RaceSearchParams struct {
+	Sectors    []string `query:"sector"`
+	SeasonCode *int32   `query:"seasoncode"`
+	NationCode string   `query:"nationcode"`
+	Gender     string   `query:"gender"`
+	CatCode    string   `query:"catcode"`
+}
+
+// RaceCountParams filter the race counts. SeasonCode and Sectors are
+// required; GetRaceTotals and GetRaceCountsByNation take CatCode,
+// GetRaceCategoryCounts takes NationCode.
+type RaceCountParams struct {
+	SeasonCode int32    `query:"seasoncode"`
+	Sectors    []string `query:"sector"`
+	NationCode string   `query:"nationcode"`
+	CatCode    string   `query:"catcode"`
+	Gender     string   `query:"gender"`
+}
+
+// CompetitorSearchParams filter SearchCompetitors and
+// GetCompetitorCountsByNation; the counts ignore the page
+type CompetitorSearchParams struct {
+	NationCode string `query:"nationcode"`
+	SectorCode string `query:"sectorcode"`
+	Gender     string `query:"gender"`
+	AgeMin     *int   `query:"agemin"`
+	AgeMax     *int   `query:"agemax"`
+	PageParams
+}
+
+// LatestResultsParams filter GetCompetitorLatestResults. Sector is required.
+type LatestResultsParams struct {
+	Sector     string   `query:"sector"`
+	SeasonCode *int32   `query:"seasoncode"`
+	CatCodes   []string `query:"catcode"`
+	Limit      int32    `query:"limit"`
+}
+
+// SearchRaces lists races across sectors
+func (s *FISService) SearchRaces(ctx context.Context, params *RaceSearchParams) (*apitypes.FISRacesSearchResponse, error) {
+	return call[apitypes.FISRacesSearchResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/races", params: params})
+}
+
+// GetRacesByIDs returns the races of a sector with the given IDs
+func (s *FISService) GetRacesByIDs(ctx context.Context, sector string, raceIDs []int32) (*apitypes.FISRacesByIDsResponse, error) {
+	params := struct {
+		Sector  string  `query:"sector"`
+		RaceIDs []int32 `query:"raceid"`
+	}{sector, raceIDs}
+	return call[apitypes.FISRacesByIDsResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/races/by-ids", params: params})
+}
+
+// GetRaceTotals counts the races of a season
+func (s *FISService) GetRaceTotals(ctx context.Context, params *RaceCountParams) (*apitypes.FISRacesTotalsResponse, error) {
+	return call[apitypes.FISRacesTotalsResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/races/counts", params: params})
+}
+
+// GetRaceCategoryCounts counts the races of a season per category
+func (s *FISService) GetRaceCategoryCounts(ctx context.Context, params *RaceCountParams) (*apitypes.FISRacesCategoryCountsResponse, error) {
+	return call[apitypes.FISRacesCategoryCountsResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/races/counts/by-category", params: params})
+}
+
+// GetRaceCountsByNation counts the races of a season per nation
+func (s *FISService) GetRaceCountsByNation(ctx context.Context, params *RaceCountParams) (*apitypes.FISRacesNationCountsResponse, error) {
+	return call[apitypes.FISRacesNationCountsResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/races/counts/by-nation", params: params})
+}
+
+// GetAthletesBySporttiID returns the FIS athletes linked to a Sportti ID
+func (s *FISService) GetAthletesBySporttiID(ctx context.Context, sporttiID int) (*apitypes.FISAthletesResponse, error) {
+	params := struct {
+		SporttiID int `query:"sporttiid"`
+	}{sporttiID}
+	return call[apitypes.FISAthletesResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/athletes", params: params})
+}
+
+// InsertAthlete adds an athlete
+func (s *FISService) InsertAthlete(ctx context.Context, athlete *apitypes.FISInsertAthleteExample) error {
+	return s.c.exec(ctx, &request{method: http.MethodPost, path: "/v2/fis/athletes", body: athlete})
+}
+
+// UpdateAthlete updates an athlete
+func (s *FISService) UpdateAthlete(ctx context.Context, athlete *apitypes.FISUpdateAthleteExample) error {
+	return s.c.exec(ctx, &request{method: http.MethodPut, path: "/v2/fis/athletes", body: athlete})
+}
+
+// DeleteAthlete deletes an athlete
+func (s *FISService) DeleteAthlete(ctx context.Context, fiscode int32) error {
+	return s.c.exec(ctx, &request{method: http.MethodDelete, path: path("/v2/fis/athletes/%s", fiscode)})
+}
+
+// GetSectorcodeByFiscode returns the sector of a competitor
+func (s *FISService) GetSectorcodeByFiscode(ctx context.Context, fiscode int32) (*apitypes.FISSectorcodeByFiscodeResponse, error) {
+	return call[apitypes.FISSectorcodeByFiscodeResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/fis/athletes/%s/sector", fiscode)})
+}
+
+// GetCompetitorSeasonsCatcodes lists the seasons and categories a competitor raced in
+func (s *FISService) GetCompetitorSeasonsCatcodes(ctx context.Context, fiscode int32, sector string) (*apitypes.FISCompetitorSeasonsCatcodesResponse, error) {
+	params := struct {
+		Sector string `query:"sector"`
+	}{sector}
+	return call[apitypes.FISCompetitorSeasonsCatcodesResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/fis/athletes/%s/seasons", fiscode), params: params})
+}
+
+// GetCompetitorLatestResults returns a competitor's most recent results
+func (s *FISService) GetCompetitorLatestResults(ctx context.Context, fiscode int32, params *LatestResultsParams) (*apitypes.FISLatestResultsResponse, error) {
+	return call[apitypes.FISLatestResultsResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/fis/athletes/%s/latest-results", fiscode), params: params})
+}
+
+// SearchCompetitors lists competitors
+func (s *FISService) SearchCompetitors(ctx context.Context, params *CompetitorSearchParams) (*apitypes.FISCompetitorSearchResponse, error) {
+	return call[apitypes.FISCompetitorSearchResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/competitors", params: params})
+}
+
+// InsertCompetitor adds a competitor
+func (s *FISService) InsertCompetitor(ctx context.Context, competitor *apitypes.FISInsertCompetitorExample) error {
+	return s.c.exec(ctx, &request{method: http.MethodPost, path: "/v2/fis/competitors", body: competitor})
+}
+
+// UpdateCompetitor updates a competitor
+func (s *FISService) UpdateCompetitor(ctx context.Context, competitor *apitypes.FISUpdateCompetitorExample) error {
+	return s.c.exec(ctx, &request{method: http.MethodPut, path: "/v2/fis/competitors", body: competitor})
+}
+
+// GetLastRowCompetitor returns the competitor with the highest ID
+func (s *FISService) GetLastRowCompetitor(ctx context.Context) (*apitypes.FISLastCompetitorResponse, error) {
+	return call[apitypes.FISLastCompetitorResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/competitors/latest"})
+}
+
+// GetCompetitorCountsByNation counts competitors per nation
+func (s *FISService) GetCompetitorCountsByNation(ctx context.Context, params *CompetitorSearchParams) (*apitypes.FISCompetitorNationCountsResponse, error) {
+	return call[apitypes.FISCompetitorNationCountsResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/competitors/counts/by-nation", params: params})
+}
+
+// DeleteCompetitor deletes a competitor
+func (s *FISService) DeleteCompetitor(ctx context.Context, id int32) error {
+	return s.c.exec(ctx, &request{method: http.MethodDelete, path: path("/v2/fis/competitors/%s", id)})
+}
+
+// GetSeasonCodesCC lists the cross-country season codes
+func (s *FISService) GetSeasonCodesCC(ctx context.Context) (*apitypes.FISSeasonsCCResponse, error) {
+	return call[apitypes.FISSeasonsCCResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/cc/seasons"})
+}
+
+// GetDisciplineCodesCC lists the cross-country discipline codes
+func (s *FISService) GetDisciplineCodesCC(ctx context.Context) (*apitypes.FISDisciplinesCCResponse, error) {
+	return call[apitypes.FISDisciplinesCCResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/cc/disciplines"})
+}
+
+// GetCategoryCodesCC lists the cross-country category codes
+func (s *FISService) GetCategoryCodesCC(ctx context.Context) (*apitypes.FISCategoriesCCResponse, error) {
+	return call[apitypes.FISCategoriesCCResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/cc/categories"})
+}
+
+// GetAthletesCC lists the cross-country athletes
+func (s *FISService) GetAthletesCC(ctx context.Context) (*apitypes.FISAthletesResponse, error) {
+	return call[apitypes.FISAthletesResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/cc/athletes"})
+}
+
+// GetNationsCC lists the nations with cross-country athletes
+func (s *FISService) GetNationsCC(ctx context.Context) (*apitypes.FISNationsBySectorResponse, error) {
+	return call[apitypes.FISNationsBySectorResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/cc/nations"})
+}
+
+// GetAthleteResultsCC returns the cross-country results of an athlete
+func (s *FISService) GetAthleteResultsCC(ctx context.Context, fiscode int32, params *AthleteResultsParams) (*apitypes.FISAthleteResultsCCResponse, error) {
+	return call[apitypes.FISAthleteResultsCCResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/fis/cc/athletes/%s/results", fiscode), params: params})
+}
+
+// GetRacesCC lists cross-country races
+func (s *FISService) GetRacesCC(ctx context.Context, params *RacesParams) (*apitypes.FISRacesCCResponse, error) {
+	return call[apitypes.FISRacesCCResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/cc/races", params: params})
+}
+
+// InsertRaceCC adds a cross-country race
+func (s *FISService) InsertRaceCC(ctx context.Context, race *apitypes.FISInsertRaceCCExample) error {
+	return s.c.exec(ctx, &request{method: http.MethodPost, path: "/v2/fis/cc/races", body: race})
+}
+
+// UpdateRaceCC updates a cross-country race
+func (s *FISService) UpdateRaceCC(ctx context.Context, race *apitypes.FISUpdateRaceCCExample) error {
+	return s.c.exec(ctx, &request{method: http.MethodPut, path: "/v2/fis/cc/races", body: race})
+}
+
+// GetLastRowRaceCC returns the cross-country race with the highest ID
+func (s *FISService) GetLastRowRaceCC(ctx context.Context) (*apitypes.FISLastRaceCCResponse, error) {
+	return call[apitypes.FISLastRaceCCResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/cc/races/latest"})
+}
+
+// DeleteRaceCC deletes a cross-country race
+func (s *FISService) DeleteRaceCC(ctx context.Context, raceID int32) error {
+	return s.c.exec(ctx, &request{method: http.MethodDelete, path: path("/v2/fis/cc/races/%s", raceID)})
+}
+
+// GetRaceResultsCC returns the results of a cross-country race
+func (s *FISService) GetRaceResultsCC(ctx context.Context, raceID int32, params *RaceResultsParams) (*apitypes.FISRaceResultsCCResponse, error) {
+	return call[apitypes.FISRaceResultsCCResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/fis/cc/races/%s/results", raceID), params: params})
+}
+
+// InsertResultCC adds a cross-country result
+func (s *FISService) InsertResultCC(ctx context.Context, result *apitypes.FISInsertResultCCExample) error {
+	return s.c.exec(ctx, &request{method: http.MethodPost, path: "/v2/fis/cc/results", body: result})
+}
+
+// UpdateResultCC updates a cross-country result
+func (s *FISService) UpdateResultCC(ctx context.Context, result *apitypes.FISUpdateResultCCExample) error {
+	return s.c.exec(ctx, &request{method: http.MethodPut, path: "/v2/fis/cc/results", body: result})
+}
+
+// GetLastRowResultCC returns the cross-country result with the highest ID
+func (s *FISService) GetLastRowResultCC(ctx context.Context) (*apitypes.FISLastResultCCResponse, error) {
+	return call[apitypes.FISLastResultCCResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/cc/results/latest"})
+}
+
+// DeleteResultCC deletes a cross-country result
+func (s *FISService) DeleteResultCC(ctx context.Context, resultID int32) error {
+	return s.c.exec(ctx, &request{method: http.MethodDelete, path: path("/v2/fis/cc/results/%s", resultID)})
+}
+
+// GetSeasonCodesJP lists the ski jumping season codes
+func (s *FISService) GetSeasonCodesJP(ctx context.Context) (*apitypes.FISSeasonsJPResponse, error) {
+	return call[apitypes.FISSeasonsJPResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/jp/seasons"})
+}
+
+// GetDisciplineCodesJP lists the ski jumping discipline codes
+func (s *FISService) GetDisciplineCodesJP(ctx context.Context) (*apitypes.FISDisciplinesJPResponse, error) {
+	return call[apitypes.FISDisciplinesJPResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/jp/disciplines"})
+}
+
+// GetCategoryCodesJP lists the ski jumping category codes
+func (s *FISService) GetCategoryCodesJP(ctx context.Context) (*apitypes.FISCategoriesJPResponse, error) {
+	return call[apitypes.FISCategoriesJPResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/jp/categories"})
+}
+
+// GetAthletesJP lists the ski jumping athletes
+func (s *FISService) GetAthletesJP(ctx context.Context) (*apitypes.FISAthletesResponse, error) {
+	return call[apitypes.FISAthletesResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/jp/athletes"})
+}
+
+// GetNationsJP lists the nations with ski jumping athletes
+func (s *FISService) GetNationsJP(ctx context.Context) (*apitypes.FISNationsBySectorResponse, error) {
+	return call[apitypes.FISNationsBySectorResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/jp/nations"})
+}
+
+// GetAthleteResultsJP returns the ski jumping results of an athlete
+func (s *FISService) GetAthleteResultsJP(ctx context.Context, fiscode int32, params *AthleteResultsParams) (*apitypes.FISAthleteResultsJPResponse, error) {
+	return call[apitypes.FISAthleteResultsJPResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/fis/jp/athletes/%s/results", fiscode), params: params})
+}
+
+// GetRacesJP lists ski jumping races
+func (s *FISService) GetRacesJP(ctx context.Context, params *RacesParams) (*apitypes.FISRacesJPResponse, error) {
+	return call[apitypes.FISRacesJPResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/jp/races", params: params})
+}
+
+// InsertRaceJP adds a ski jumping race
+func (s *FISService) InsertRaceJP(ctx context.Context, race *apitypes.FISInsertRaceJPExample) error {
+	return s.c.exec(ctx, &request{method: http.MethodPost, path: "/v2/fis/jp/races", body: race})
+}
+
+// UpdateRaceJP updates a ski jumping race
+func (s *FISService) UpdateRaceJP(ctx context.Context, race *apitypes.FISUpdateRaceJPExample) error {
+	return s.c.exec(ctx, &request{method: http.MethodPut, path: "/v2/fis/jp/races", body: race})
+}
+
+// GetLastRowRaceJP returns the ski jumping race with the highest ID
+func (s *FISService) GetLastRowRaceJP(ctx context.Context) (*apitypes.FISLastRaceJPResponse, error) {
+	return call[apitypes.FISLastRaceJPResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/jp/races/latest"})
+}
+
+// DeleteRaceJP deletes a ski jumping race
+func (s *FISService) DeleteRaceJP(ctx context.Context, raceID int32) error {
+	return s.c.exec(ctx, &request{method: http.MethodDelete, path: path("/v2/fis/jp/races/%s", raceID)})
+}
+
+// GetRaceResultsJP returns the results of a ski jumping race
+func (s *FISService) GetRaceResultsJP(ctx context.Context, raceID int32, params *RaceResultsParams) (*apitypes.FISRaceResultsJPResponse, error) {
+	return call[apitypes.FISRaceResultsJPResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/fis/jp/races/%s/results", raceID), params: params})
+}
+
+// InsertResultJP adds a ski jumping result
+func (s *FISService) InsertResultJP(ctx context.Context, result *apitypes.FISInsertResultJPExample) error {
+	return s.c.exec(ctx, &request{method: http.MethodPost, path: "/v2/fis/jp/results", body: result})
+}
+
+// UpdateResultJP updates a ski jumping result
+func (s *FISService) UpdateResultJP(ctx context.Context, result *apitypes.FISUpdateResultJPExample) error {
+	return s.c.exec(ctx, &request{method: http.MethodPut, path: "/v2/fis/jp/results", body: result})
+}
+
+// GetLastRowResultJP returns the ski jumping result with the highest ID
+func (s *FISService) GetLastRowResultJP(ctx context.Context) (*apitypes.FISLastResultJPResponse, error) {
+	return call[apitypes.FISLastResultJPResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/jp/results/latest"})
+}
+
+// DeleteResultJP deletes a ski jumping result
+func (s *FISService) DeleteResultJP(ctx context.Context, resultID int32) error {
+	return s.c.exec(ctx, &request{method: http.MethodDelete, path: path("/v2/fis/jp/results/%s", resultID)})
+}
+
+// GetSeasonCodesNK lists the Nordic combined season codes
+func (s *FISService) GetSeasonCodesNK(ctx context.Context) (*apitypes.FISSeasonsNKResponse, error) {
+	return call[apitypes.FISSeasonsNKResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/nk/seasons"})
+}
+
+// GetDisciplineCodesNK lists the Nordic combined discipline codes
+func (s *FISService) GetDisciplineCodesNK(ctx context.Context) (*apitypes.FISDisciplinesNKResponse, error) {
+	return call[apitypes.FISDisciplinesNKResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/nk/disciplines"})
+}
+
+// GetCategoryCodesNK lists the Nordic combined category codes
+func (s *FISService) GetCategoryCodesNK(ctx context.Context) (*apitypes.FISCategoriesNKResponse, error) {
+	return call[apitypes.FISCategoriesNKResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/nk/categories"})
+}
+
+// GetAthletesNK lists the Nordic combined athletes
+func (s *FISService) GetAthletesNK(ctx context.Context) (*apitypes.FISAthletesResponse, error) {
+	return call[apitypes.FISAthletesResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/nk/athletes"})
+}
+
+// GetNationsNK lists the nations with Nordic combined athletes
+func (s *FISService) GetNationsNK(ctx context.Context) (*apitypes.FISNationsBySectorResponse, error) {
+	return call[apitypes.FISNationsBySectorResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/nk/nations"})
+}
+
+// GetAthleteResultsNK returns the Nordic combined results of an athlete
+func (s *FISService) GetAthleteResultsNK(ctx context.Context, fiscode int32, params *AthleteResultsParams) (*apitypes.FISAthleteResultsNKResponse, error) {
+	return call[apitypes.FISAthleteResultsNKResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/fis/nk/athletes/%s/results", fiscode), params: params})
+}
+
+// GetRacesNK lists Nordic combined races
+func (s *FISService) GetRacesNK(ctx context.Context, params *RacesParams) (*apitypes.FISRacesNKResponse, error) {
+	return call[apitypes.FISRacesNKResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/nk/races", params: params})
+}
+
+// InsertRaceNK adds a Nordic combined race
+func (s *FISService) InsertRaceNK(ctx context.Context, race *apitypes.FISInsertRaceNKExample) error {
+	return s.c.exec(ctx, &request{method: http.MethodPost, path: "/v2/fis/nk/races", body: race})
+}
+
+// UpdateRaceNK updates a Nordic combined race
+func (s *FISService) UpdateRaceNK(ctx context.Context, race *apitypes.FISUpdateRaceNKExample) error {
+	return s.c.exec(ctx, &request{method: http.MethodPut, path: "/v2/fis/nk/races", body: race})
+}
+
+// GetLastRowRaceNK returns the Nordic combined race with the highest ID
+func (s *FISService) GetLastRowRaceNK(ctx context.Context) (*apitypes.FISLastRaceNKResponse, error) {
+	return call[apitypes.FISLastRaceNKResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/nk/races/latest"})
+}
+
+// DeleteRaceNK deletes a Nordic combined race
+func (s *FISService) DeleteRaceNK(ctx context.Context, raceID int32) error {
+	return s.c.exec(ctx, &request{method: http.MethodDelete, path: path("/v2/fis/nk/races/%s", raceID)})
+}
+
+// GetRaceResultsNK returns the results of a Nordic combined race
+func (s *FISService) GetRaceResultsNK(ctx context.Context, raceID int32, params *RaceResultsParams) (*apitypes.FISRaceResultsNKResponse, error) {
+	return call[apitypes.FISRaceResultsNKResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/fis/nk/races/%s/results", raceID), params: params})
+}
+
+// InsertResultNK adds a Nordic combined result
+func (s *FISService) InsertResultNK(ctx context.Context, result *apitypes.FISInsertResultNKExample) error {
+	return s.c.exec(ctx, &request{method: http.MethodPost, path: "/v2/fis/nk/results", body: result})
+}
+
+// UpdateResultNK updates a Nordic combined result
+func (s *FISService) UpdateResultNK(ctx context.Context, result *apitypes.FISUpdateResultNKExample) error {
+	return s.c.exec(ctx, &request{method: http.MethodPut, path: "/v2/fis/nk/results", body: result})
+}
+
+// GetLastRowResultNK returns the Nordic combined result with the highest ID
+func (s *FISService) GetLastRowResultNK(ctx context.Context) (*apitypes.FISLastResultNKResponse, error) {
+	return call[apitypes.FISLastResultNKResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/fis/nk/results/latest"})
+}
+
+// DeleteResultNK deletes a Nordic combined result
+func (s *FISService) DeleteResultNK(ctx context.Context, resultID int32) error {
+	return s.c.exec(ctx, &request{method: http.MethodDelete, path: path("/v2/fis/nk/results/%s", resultID)})
+}
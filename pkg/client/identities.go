@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
+)
+
+// Athlete identifier kinds, for Ref
+const (
+	KindAthlete         = "athlete"
+	KindSporttiID       = "sportti_id"
+	KindUTVUserID       = "utv_user_id"
+	KindTietoevryUserID = "tietoevry_user_id"
+	KindFiscode         = "fiscode"
+	KindFISCompetitorID = "fis_competitorid"
+	KindKAMKUserID      = "kamk_user_id"
+)
+
+// Ref is an athlete reference, which the API accepts in place of any athlete
+// id it takes: Ref(KindSporttiID, "27353728") works as a UTV user id, a FIS
+// code and so on, as long as the athlete registry links them
+func Ref(kind, value string) string {
+	return kind + ":" + value
+}
+
+// IdentitiesService manages the athlete registry that links the ids an
+// athlete has in each source system
+type IdentitiesService struct{ c *Client }
+
+// Link records that the given identifiers belong to one athlete, creating it
+// when none of them is known. Conflicting links fail with ErrConflict, the
+// conflicting identifiers listed in the error's Errors.
+func (s *IdentitiesService) Link(ctx context.Context, input *apitypes.IdentitiesInput) (*apitypes.IdentityResponse, error) {
+	return call[apitypes.IdentityResponse](ctx, s.c, &request{method: http.MethodPost, path: "/v2/identities", body: input})
+}
+
+// Get returns an athlete's identifiers; id is the registry id or a Ref
+func (s *IdentitiesService) Get(ctx context.Context, id string) (*apitypes.IdentityResponse, error) {
+	return call[apitypes.IdentityResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/identities/%s", id)})
+}
+
+// Unlink removes one identifier of the given kind from an athlete
+func (s *IdentitiesService) Unlink(ctx context.Context, id, kind string) (*apitypes.IdentityResponse, error) {
+	return call[apitypes.IdentityResponse](ctx, s.c, &request{method: http.MethodDelete, path: path("/v2/identities/%s/identifiers/%s", id, kind)})
+}
+
+// Delete removes an athlete from the registry
+func (s *IdentitiesService) Delete(ctx context.Context, id string) error {
+	return s.c.exec(ctx, &request{method: http.MethodDelete, path: path("/v2/identities/%s", id)})
+}
+
+// OverviewParams tune Overview. Limit caps the entries per section (default
+// 5) and UTVType picks the UTV data type (default sleep).
+type OverviewParams struct {
+	Limit   int    `query:"limit"`
+	UTVType string `query:"utv_type"`
+}
+
+// Overview returns the latest data of an athlete from every domain; id is the
+// registry id or a Ref. A section the client may not read or whose database
+// is down comes back with status error instead of failing the call.
+func (s *IdentitiesService) Overview(ctx context.Context, id string, params *OverviewParams) (*apitypes.AthleteOverviewResponse, error) {
+	return call[apitypes.AthleteOverviewResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/athletes/%s/overview", id), params: params})
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
+)
+
+// KAMKService covers the KAMK injury and questionnaire endpoints. User IDs
+// are Sportti IDs.
+type KAMKService struct{ c *Client }
+
+type kamkUser struct {
+	UserID int32 `query:"user_id"`
+}
+
+// AddInjury records an injury
+func (s *KAMKService) AddInjury(ctx context.Context, injury *apitypes.KamkAddInjuryRequest) error {
+	return s.c.exec(ctx, &request{method: http.MethodPost, path: "/v2/kamk/injuries", body: injury})
+}
+
+// MarkInjuryRecovered closes an injury
+func (s *KAMKService) MarkInjuryRecovered(ctx context.Context, input *apitypes.KamkMarkRecoveredRequest) error {
+	return s.c.exec(ctx, &request{method: http.MethodPost, path: "/v2/kamk/injuries/recovered", body: input})
+}
+
+// DeleteInjury deletes one of a user's injuries
+func (s *KAMKService) DeleteInjury(ctx context.Context, userID, injuryID int32) error {
+	return s.c.exec(ctx, &request{method: http.MethodDelete, path: path("/v2/kamk/injuries/%s", injuryID), params: kamkUser{userID}})
+}
+
+// GetActiveInjuries lists a user's active injuries; nil when there are none
+func (s *KAMKService) GetActiveInjuries(ctx context.Context, userID int32) (*apitypes.KamkInjuriesListResponse, error) {
+	return call[apitypes.KamkInjuriesListResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/kamk/users/%s/injuries", userID)})
+}
+
+// GetMaxInjuryID returns the highest injury ID of a user
+func (s *KAMKService) GetMaxInjuryID(ctx context.Context, userID int32) (*apitypes.KamkMaxInjuryIDResponse, error) {
+	return call[apitypes.KamkMaxInjuryIDResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/kamk/users/%s/injuries/latest-id", userID)})
+}
+
+// AddQuestionnaire records a questionnaire
+func (s *KAMKService) AddQuestionnaire(ctx context.Context, input *apitypes.KamkAddQuestionnaireRequest) (*apitypes.KamkCreateQuestionnaireResponse, error) {
+	return call[apitypes.KamkCreateQuestionnaireResponse](ctx, s.c, &request{method: http.MethodPost, path: "/v2/kamk/questionnaires", body: input})
+}
+
+// UpdateQuestionnaire changes the answers and comment of a user's questionnaire
+func (s *KAMKService) UpdateQuestionnaire(ctx context.Context, userID int32, id int64, input *apitypes.KamkUpdateQuestionnaireBody) error {
+	return s.c.exec(ctx, &request{method: http.MethodPatch, path: path("/v2/kamk/questionnaires/%s", id), params: kamkUser{userID}, body: input})
+}
+
+// DeleteQuestionnaire deletes one of a user's questionnaires
+func (s *KAMKService) DeleteQuestionnaire(ctx context.Context, userID int32, id int64) error {
+	return s.c.exec(ctx, &request{method: http.MethodDelete, path: path("/v2/kamk/questionnaires/%s", id), params: kamkUser{userID}})
+}
+
+// GetQuestionnaires lists a user's questionnaires; nil when there are none
+func (s *KAMKService) GetQuestionnaires(ctx context.Context, userID int32, page *PageParams) (*apitypes.KamkQuestionnairesListResponse, error) {
+	return call[apitypes.KamkQuestionnairesListResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/kamk/users/%s/questionnaires", userID), params: page})
+}
+
+// IsQuizDoneToday returns the user's questionnaires of a type answered
+// today; nil when there are none
+func (s *KAMKService) IsQuizDoneToday(ctx context.Context, userID, quizType int32) (*apitypes.KamkQuestionnairesListResponse, error) {
+	params := struct {
+		QuizType int32 `query:"quiz_type"`
+	}{quizType}
+	return call[apitypes.KamkQuestionnairesListResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/kamk/users/%s/questionnaires/done-today", userID), params: params})
+}
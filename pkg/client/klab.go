@@ -0,0 +1,32 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
+)
+
+// KLABService covers the KLAB endpoints. Users are keyed by Sportti ID.
+type KLABService struct{ c *Client }
+
+// InsertData uploads KLAB data keyed by customer ID, one customer per
+// request. Partial mode and an idempotency key are supported.
+func (s *KLABService) InsertData(ctx context.Context, data map[string]apitypes.KlabDataBundle, params *BulkParams) (*UploadResult, error) {
+	return s.c.upload(ctx, &request{method: http.MethodPost, path: "/v2/klab/data", body: data, params: params})
+}
+
+// GetUser returns a KLAB customer
+func (s *KLABService) GetUser(ctx context.Context, sporttiID string) (*apitypes.UserKlabResponse, error) {
+	return call[apitypes.UserKlabResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/klab/users/%s", sporttiID)})
+}
+
+// DeleteUser deletes a KLAB customer and their data
+func (s *KLABService) DeleteUser(ctx context.Context, sporttiID string) error {
+	return s.c.exec(ctx, &request{method: http.MethodDelete, path: path("/v2/klab/users/%s", sporttiID)})
+}
+
+// GetData returns a customer's KLAB data; the page applies to the measurements
+func (s *KLABService) GetData(ctx context.Context, sporttiID string, page *PageParams) (*apitypes.KlabDataResponse, error) {
+	return call[apitypes.KlabDataResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/klab/users/%s/data", sporttiID), params: page})
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"time"
+)
+
+// encodeParams turns a params struct into query values and headers. Fields
+// tagged query:"name" or header:"Name" are sent when set: zero values are
+// left out, pointers only when nil, slices are repeated and embedded structs
+// are flattened.
+func encodeParams(params any) (url.Values, http.Header) {
+	query := url.Values{}
+	header := http.Header{}
+	if params == nil {
+		return query, header
+	}
+	v := reflect.ValueOf(params)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return query, header
+		}
+		v = v.Elem()
+	}
+	encodeStruct(v, query, header)
+	return query, header
+}
+
+func encodeStruct(v reflect.Value, query url.Values, header http.Header) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		fv := v.Field(i)
+		if f.Anonymous && fv.Kind() == reflect.Struct {
+			encodeStruct(fv, query, header)
+			continue
+		}
+		name, inHeader := f.Tag.Get("query"), false
+		if name == "" {
+			if name = f.Tag.Get("header"); name == "" {
+				continue
+			}
+			inHeader = true
+		}
+
+		if fv.Kind() == reflect.Pointer {
+			if fv.IsNil() {
+				continue
+			}
+			fv = fv.Elem()
+		} else if fv.IsZero() {
+			continue
+		}
+
+		var values []string
+		if fv.Kind() == reflect.Slice {
+			for j := 0; j < fv.Len(); j++ {
+				values = append(values, formatValue(fv.Index(j)))
+			}
+		} else {
+			values = []string{formatValue(fv)}
+		}
+		for _, s := range values {
+			if inHeader {
+				header.Add(name, s)
+			} else {
+				query.Add(name, s)
+			}
+		}
+	}
+}
+
+func formatValue(v reflect.Value) string {
+	if t, ok := v.Interface().(time.Time); ok {
+		return t.Format(time.RFC3339)
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+// Int32 returns a pointer to v, for optional parameters where zero is a value
+func Int32(v int32) *int32 { return &v }
+
+// Int returns a pointer to v
+func Int(v int) *int { return &v }
+
+// Bool returns a pointer to v
+func Bool(v bool) *bool { return &v }
+
+// String returns a pointer to v
+func String(v string) *string { return &v }
@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
+)
+
+// TietoevryService covers the Tietoevry endpoints: users and their
+// exercises, symptoms, measurements, test results, questionnaire answers and
+// activity zones. User IDs are UUIDs.
+type TietoevryService struct{ c *Client }
+
+// ListParams filter a user's rows. From and To take YYYY-MM-DD or RFC3339,
+// UpdatedSince RFC3339 or YYYY-MM-DD.
+type ListParams struct {
+	From         string `query:"from"`
+	To           string `query:"to"`
+	UpdatedSince string `query:"updated_since"`
+	PageParams
+}
+
+// UpsertUser creates or updates a user
+func (s *TietoevryService) UpsertUser(ctx context.Context, user *apitypes.TietoevryUserUpsertInput) error {
+	return s.c.exec(ctx, &request{method: http.MethodPost, path: "/v2/tietoevry/users", body: user})
+}
+
+// GetUser returns a user
+func (s *TietoevryService) GetUser(ctx context.Context, id string) (*apitypes.TietoevryUserResponse, error) {
+	return call[apitypes.TietoevryUserResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/tietoevry/users/%s", id)})
+}
+
+// DeleteUser deletes a user and all of their data
+func (s *TietoevryService) DeleteUser(ctx context.Context, id string) error {
+	return s.c.exec(ctx, &request{method: http.MethodDelete, path: path("/v2/tietoevry/users/%s", id)})
+}
+
+// GetDeletedUsers lists the deleted users
+func (s *TietoevryService) GetDeletedUsers(ctx context.Context) (*apitypes.TietoevryDeletedUsersResponse, error) {
+	return call[apitypes.TietoevryDeletedUsersResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/tietoevry/deleted-users"})
+}
+
+// InsertExercisesBulk uploads exercises. Partial and async mode and an
+// idempotency key are supported.
+func (s *TietoevryService) InsertExercisesBulk(ctx context.Context, exercises *apitypes.TietoevryExercisesBulkInput, params *BulkParams) (*UploadResult, error) {
+	return s.c.upload(ctx, &request{method: http.MethodPost, path: "/v2/tietoevry/exercises", body: exercises, params: params})
+}
+
+// GetExercises lists a user's exercises
+func (s *TietoevryService) GetExercises(ctx context.Context, userID string, params *ListParams) (*apitypes.TietoevryExerciseResponse, error) {
+	return call[apitypes.TietoevryExerciseResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/tietoevry/users/%s/exercises", userID), params: params})
+}
+
+// InsertSymptomsBulk uploads symptoms
+func (s *TietoevryService) InsertSymptomsBulk(ctx context.Context, symptoms *apitypes.TietoevrySymptomsBulkInput) error {
+	return s.c.exec(ctx, &request{method: http.MethodPost, path: "/v2/tietoevry/symptoms", body: symptoms, gzip: true})
+}
+
+// GetSymptoms lists a user's symptoms
+func (s *TietoevryService) GetSymptoms(ctx context.Context, userID string, params *ListParams) (*apitypes.TietoevrySymptomResponse, error) {
+	return call[apitypes.TietoevrySymptomResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/tietoevry/users/%s/symptoms", userID), params: params})
+}
+
+// InsertMeasurementsBulk uploads measurements. Partial and async mode are
+// supported; the idempotency key is ignored.
+func (s *TietoevryService) InsertMeasurementsBulk(ctx context.Context, measurements *apitypes.TietoevryMeasurementsBulkInput, params *BulkParams) (*UploadResult, error) {
+	var mode *bulkMode
+	if params != nil {
+		mode = &bulkMode{params.Mode}
+	}
+	return s.c.upload(ctx, &request{method: http.MethodPost, path: "/v2/tietoevry/measurements", body: measurements, params: mode})
+}
+
+// GetMeasurements lists a user's measurements
+func (s *TietoevryService) GetMeasurements(ctx context.Context, userID string, params *ListParams) (*apitypes.TietoevryMeasurementResponse, error) {
+	return call[apitypes.TietoevryMeasurementResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/tietoevry/users/%s/measurements", userID), params: params})
+}
+
+// InsertTestResultsBulk uploads test results
+func (s *TietoevryService) InsertTestResultsBulk(ctx context.Context, results *apitypes.TietoevryTestResultsBulkInput) error {
+	return s.c.exec(ctx, &request{method: http.MethodPost, path: "/v2/tietoevry/test-results", body: results, gzip: true})
+}
+
+// GetTestResults lists a user's test results
+func (s *TietoevryService) GetTestResults(ctx context.Context, userID string, params *ListParams) (*apitypes.TietoevryTestResultResponse, error) {
+	return call[apitypes.TietoevryTestResultResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/tietoevry/users/%s/test-results", userID), params: params})
+}
+
+// InsertQuestionnaireAnswersBulk uploads questionnaire answers
+func (s *TietoevryService) InsertQuestionnaireAnswersBulk(ctx context.Context, answers *apitypes.TietoevryQuestionnaireAnswersBulkInput) error {
+	return s.c.exec(ctx, &request{method: http.MethodPost, path: "/v2/tietoevry/questionnaires", body: answers, gzip: true})
+}
+
+// GetQuestionnaires lists a user's questionnaire answers
+func (s *TietoevryService) GetQuestionnaires(ctx context.Context, userID string, params *ListParams) (*apitypes.TietoevryQuestionnaireAnswerResponse, error) {
+	return call[apitypes.TietoevryQuestionnaireAnswerResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/tietoevry/users/%s/questionnaires", userID), params: params})
+}
+
+// InsertActivityZonesBulk uploads activity zones
+func (s *TietoevryService) InsertActivityZonesBulk(ctx context.Context, zones *apitypes.TietoevryActivityZonesBulkInput) error {
+	return s.c.exec(ctx, &request{method: http.MethodPost, path: "/v2/tietoevry/activity-zones", body: zones, gzip: true})
+}
+
+// GetActivityZones lists a user's activity zones
+func (s *TietoevryService) GetActivityZones(ctx context.Context, userID string, params *ListParams) (*apitypes.TietoevryActivityZoneResponse, error) {
+	return call[apitypes.TietoevryActivityZoneResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/tietoevry/users/%s/activity-zones", userID), params: params})
+}
+
+// bulkMode is BulkParams without the Idempotency-Key, for uploads that take
+// a mode but are not deduplicated
+type bulkMode struct {
+	Mode string `query:"mode"`
+}
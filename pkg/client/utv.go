@@ -0,0 +1,359 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
+)
+
+// UTVService covers the UTV endpoints: users, wearable data and tokens of
+// Oura, Polar, Suunto and Garmin, and the KLAB, Archinisis and Coachtech links.
+// User IDs are UUIDs; dates are YYYY-MM-DD.
+type UTVService struct{ c *Client }
+
+// DateRange limits a listing to dates after and/or before the given days
+type DateRange struct {
+	AfterDate  string `query:"after_date"`
+	BeforeDate string `query:"before_date"`
+}
+
+// LatestParams filter GetLatestData. Type is required.
+type LatestParams struct {
+	Type   string `query:"type"`
+	Device string `query:"device"`
+	Limit  int    `query:"limit"`
+}
+
+// AllByTypeParams filter GetAllByType. Type is required.
+type AllByTypeParams struct {
+	Type string `query:"type"`
+	DateRange
+	Limit  int `query:"limit"`
+	Offset int `query:"offset"`
+}
+
+// GetUserIDBySportID returns the UTV user of a Sportti ID
+func (s *UTVService) GetUserIDBySportID(ctx context.Context, sportID string) (*apitypes.UserIDResponse, error) {
+	return call[apitypes.UserIDResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/users/by-sport-id/%s", sportID)})
+}
+
+// GetUserData returns a user's profile data
+func (s *UTVService) GetUserData(ctx context.Context, userID string) (*apitypes.UserDataResponse, error) {
+	return call[apitypes.UserDataResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/users/%s", userID)})
+}
+
+// UpsertUserData creates or replaces a user's profile data
+func (s *UTVService) UpsertUserData(ctx context.Context, userID string, data *apitypes.UserDataInput) error {
+	return s.c.exec(ctx, &request{method: http.MethodPut, path: path("/v2/utv/users/%s", userID), body: data})
+}
+
+// DeleteUserData deletes a user's profile data
+func (s *UTVService) DeleteUserData(ctx context.Context, userID string) error {
+	return s.c.exec(ctx, &request{method: http.MethodDelete, path: path("/v2/utv/users/%s", userID)})
+}
+
+// GetLinkedDevices reports which devices a user has connected
+func (s *UTVService) GetLinkedDevices(ctx context.Context, userID string) (*apitypes.DeviceStatusResponse, error) {
+	return call[apitypes.DeviceStatusResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/users/%s/devices", userID)})
+}
+
+// GetLatestData returns a user's latest data of a type across devices; nil
+// when there is none
+func (s *UTVService) GetLatestData(ctx context.Context, userID string, params *LatestParams) ([]apitypes.LatestDataResponse, error) {
+	return list[apitypes.LatestDataResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/users/%s/latest", userID), params: params})
+}
+
+// GetAllByType returns all of a user's data of a type; nil when there is none
+func (s *UTVService) GetAllByType(ctx context.Context, userID string, params *AllByTypeParams) ([]apitypes.LatestDataResponse, error) {
+	return list[apitypes.LatestDataResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/users/%s/data", userID), params: params})
+}
+
+// GetToken returns a user's token for a source (garmin, oura, polar, suunto);
+// nil when there is none
+func (s *UTVService) GetToken(ctx context.Context, userID, source string) (*apitypes.SourceTokenResponse, error) {
+	return call[apitypes.SourceTokenResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/users/%s/sources/%s/token", userID, source)})
+}
+
+// Disconnect removes a user's token and data of a source
+func (s *UTVService) Disconnect(ctx context.Context, userID, source string) error {
+	return s.c.exec(ctx, &request{method: http.MethodDelete, path: path("/v2/utv/users/%s/sources/%s", userID, source)})
+}
+
+// GetTokensForUpdate lists the tokens of a source not refreshed within hours
+func (s *UTVService) GetTokensForUpdate(ctx context.Context, source string, hours int) ([]apitypes.SourceUserDataResponse, error) {
+	params := struct {
+		Hours int `query:"hours"`
+	}{hours}
+	return list[apitypes.SourceUserDataResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/sources/%s/tokens/due", source), params: params})
+}
+
+// GetDataForUpdate lists the users of a source whose data was not fetched within hours
+func (s *UTVService) GetDataForUpdate(ctx context.Context, source string, hours int) ([]apitypes.SourceUserDataResponse, error) {
+	params := struct {
+		Hours int `query:"hours"`
+	}{hours}
+	return list[apitypes.SourceUserDataResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/sources/%s/data/due", source), params: params})
+}
+
+// GetAllDataTypes returns the cached data types, of one source when source is set
+func (s *UTVService) GetAllDataTypes(ctx context.Context, source string) (*apitypes.SourceCacheSingleResponse, error) {
+	params := struct {
+		Source string `query:"source"`
+	}{source}
+	return call[apitypes.SourceCacheSingleResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/utv/data-types", params: params})
+}
+
+// UpsertDataTypes stores the data types of a source
+func (s *UTVService) UpsertDataTypes(ctx context.Context, input *apitypes.SourceCacheUpsertInput) error {
+	return s.c.exec(ctx, &request{method: http.MethodPost, path: "/v2/utv/data-types", body: input})
+}
+
+// InsertOuraData stores a day of Oura data
+func (s *UTVService) InsertOuraData(ctx context.Context, data *apitypes.OuraPostDataInput) error {
+	return s.c.exec(ctx, &request{method: http.MethodPost, path: "/v2/utv/oura/data", body: data})
+}
+
+// UpsertOuraToken stores a user's Oura token
+func (s *UTVService) UpsertOuraToken(ctx context.Context, token *apitypes.OuraTokenInput) error {
+	return s.c.exec(ctx, &request{method: http.MethodPost, path: "/v2/utv/oura/tokens", body: token})
+}
+
+// GetOuraStatus reports whether a user has connected Oura
+func (s *UTVService) GetOuraStatus(ctx context.Context, userID string) (*apitypes.OuraStatusResponse, error) {
+	return call[apitypes.OuraStatusResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/users/%s/oura/status", userID)})
+}
+
+// GetOuraDates lists the days with Oura data; nil when there are none
+func (s *UTVService) GetOuraDates(ctx context.Context, userID string, params *DateRange) (*apitypes.DatesResponse, error) {
+	return call[apitypes.DatesResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/users/%s/oura/dates", userID), params: params})
+}
+
+// GetOuraTypes lists the Oura data types of a day; nil when there are none
+func (s *UTVService) GetOuraTypes(ctx context.Context, userID, date string) (*apitypes.OuraTypesResponse, error) {
+	params := struct {
+		Date string `query:"date"`
+	}{date}
+	return call[apitypes.OuraTypesResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/users/%s/oura/types", userID), params: params})
+}
+
+// GetOuraData returns a day of Oura data, only the given key when set; nil
+// when there is none
+func (s *UTVService) GetOuraData(ctx context.Context, userID, date, key string) (*apitypes.OuraDataResponse, error) {
+	params := struct {
+		Date string `query:"date"`
+		Key  string `query:"key"`
+	}{date, key}
+	return call[apitypes.OuraDataResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/users/%s/oura/data", userID), params: params})
+}
+
+// DeleteOuraData deletes all of a user's Oura data
+func (s *UTVService) DeleteOuraData(ctx context.Context, userID string) error {
+	return s.c.exec(ctx, &request{method: http.MethodDelete, path: path("/v2/utv/users/%s/oura/data", userID)})
+}
+
+// InsertPolarData stores a day of Polar data
+func (s *UTVService) InsertPolarData(ctx context.Context, data *apitypes.PolarPostDataInput) error {
+	return s.c.exec(ctx, &request{method: http.MethodPost, path: "/v2/utv/polar/data", body: data})
+}
+
+// UpsertPolarToken stores a user's Polar token
+func (s *UTVService) UpsertPolarToken(ctx context.Context, token *apitypes.PolarTokenInput) error {
+	return s.c.exec(ctx, &request{method: http.MethodPost, path: "/v2/utv/polar/tokens", body: token})
+}
+
+// GetPolarStatus reports whether a user has connected Polar
+func (s *UTVService) GetPolarStatus(ctx context.Context, userID string) (*apitypes.PolarStatusResponse, error) {
+	return call[apitypes.PolarStatusResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/users/%s/polar/status", userID)})
+}
+
+// GetPolarDates lists the days with Polar data; nil when there are none
+func (s *UTVService) GetPolarDates(ctx context.Context, userID string, params *DateRange) (*apitypes.DatesResponse, error) {
+	return call[apitypes.DatesResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/users/%s/polar/dates", userID), params: params})
+}
+
+// GetPolarTypes lists the Polar data types of a day; nil when there are none
+func (s *UTVService) GetPolarTypes(ctx context.Context, userID, date string) (*apitypes.PolarTypesResponse, error) {
+	params := struct {
+		Date string `query:"date"`
+	}{date}
+	return call[apitypes.PolarTypesResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/users/%s/polar/types", userID), params: params})
+}
+
+// GetPolarData returns a day of Polar data, only the given key when set; nil
+// when there is none
+func (s *UTVService) GetPolarData(ctx context.Context, userID, date, key string) (*apitypes.PolarDataResponse, error) {
+	params := struct {
+		Date string `query:"date"`
+		Key  string `query:"key"`
+	}{date, key}
+	return call[apitypes.PolarDataResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/users/%s/polar/data", userID), params: params})
+}
+
+// DeletePolarData deletes all of a user's Polar data
+func (s *UTVService) DeletePolarData(ctx context.Context, userID string) error {
+	return s.c.exec(ctx, &request{method: http.MethodDelete, path: path("/v2/utv/users/%s/polar/data", userID)})
+}
+
+// InsertSuuntoData stores a day of Suunto data
+func (s *UTVService) InsertSuuntoData(ctx context.Context, data *apitypes.SuuntoPostDataInput) error {
+	return s.c.exec(ctx, &request{method: http.MethodPost, path: "/v2/utv/suunto/data", body: data})
+}
+
+// UpsertSuuntoToken stores a user's Suunto token
+func (s *UTVService) UpsertSuuntoToken(ctx context.Context, token *apitypes.SuuntoTokenInput) error {
+	return s.c.exec(ctx, &request{method: http.MethodPost, path: "/v2/utv/suunto/tokens", body: token})
+}
+
+// GetSuuntoStatus reports whether a user has connected Suunto
+func (s *UTVService) GetSuuntoStatus(ctx context.Context, userID string) (*apitypes.SuuntoStatusResponse, error) {
+	return call[apitypes.SuuntoStatusResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/users/%s/suunto/status", userID)})
+}
+
+// GetSuuntoDates lists the days with Suunto data; nil when there are none
+func (s *UTVService) GetSuuntoDates(ctx context.Context, userID string, params *DateRange) (*apitypes.DatesResponse, error) {
+	return call[apitypes.DatesResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/users/%s/suunto/dates", userID), params: params})
+}
+
+// GetSuuntoTypes lists the Suunto data types of a day; nil when there are none
+func (s *UTVService) GetSuuntoTypes(ctx context.Context, userID, date string) (*apitypes.SuuntoTypesResponse, error) {
+	params := struct {
+		Date string `query:"date"`
+	}{date}
+	return call[apitypes.SuuntoTypesResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/users/%s/suunto/types", userID), params: params})
+}
+
+// GetSuuntoData returns a day of Suunto data, only the given key when set; nil
+// when there is none
+func (s *UTVService) GetSuuntoData(ctx context.Context, userID, date, key string) (*apitypes.SuuntoDataResponse, error) {
+	params := struct {
+		Date string `query:"date"`
+		Key  string `query:"key"`
+	}{date, key}
+	return call[apitypes.SuuntoDataResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/users/%s/suunto/data", userID), params: params})
+}
+
+// DeleteSuuntoData deletes all of a user's Suunto data
+func (s *UTVService) DeleteSuuntoData(ctx context.Context, userID string) error {
+	return s.c.exec(ctx, &request{method: http.MethodDelete, path: path("/v2/utv/users/%s/suunto/data", userID)})
+}
+
+// InsertGarminData stores a day of Garmin data
+func (s *UTVService) InsertGarminData(ctx context.Context, data *apitypes.GarminPostDataInput) error {
+	return s.c.exec(ctx, &request{method: http.MethodPost, path: "/v2/utv/garmin/data", body: data})
+}
+
+// UpsertGarminToken stores a user's Garmin token
+func (s *UTVService) UpsertGarminToken(ctx context.Context, token *apitypes.GarminTokenInput) error {
+	return s.c.exec(ctx, &request{method: http.MethodPost, path: "/v2/utv/garmin/tokens", body: token})
+}
+
+// GetGarminStatus reports whether a user has connected Garmin
+func (s *UTVService) GetGarminStatus(ctx context.Context, userID string) (*apitypes.GarminStatusResponse, error) {
+	return call[apitypes.GarminStatusResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/users/%s/garmin/status", userID)})
+}
+
+// GetGarminDates lists the days with Garmin data; nil when there are none
+func (s *UTVService) GetGarminDates(ctx context.Context, userID string, params *DateRange) (*apitypes.DatesResponse, error) {
+	return call[apitypes.DatesResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/users/%s/garmin/dates", userID), params: params})
+}
+
+// GetGarminTypes lists the Garmin data types of a day; nil when there are none
+func (s *UTVService) GetGarminTypes(ctx context.Context, userID, date string) (*apitypes.GarminTypesResponse, error) {
+	params := struct {
+		Date string `query:"date"`
+	}{date}
+	return call[apitypes.GarminTypesResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/users/%s/garmin/types", userID), params: params})
+}
+
+// GetGarminData returns a day of Garmin data, only the given key when set; nil
+// when there is none
+func (s *UTVService) GetGarminData(ctx context.Context, userID, date, key string) (*apitypes.GarminDataResponse, error) {
+	params := struct {
+		Date string `query:"date"`
+		Key  string `query:"key"`
+	}{date, key}
+	return call[apitypes.GarminDataResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/users/%s/garmin/data", userID), params: params})
+}
+
+// DeleteGarminData deletes all of a user's Garmin data
+func (s *UTVService) DeleteGarminData(ctx context.Context, userID string) error {
+	return s.c.exec(ctx, &request{method: http.MethodDelete, path: path("/v2/utv/users/%s/garmin/data", userID)})
+}
+
+// GetOuraTokenByID returns the token stored for an Oura user ID
+func (s *UTVService) GetOuraTokenByID(ctx context.Context, ouraID string) (*apitypes.OuraTokenByIDResponse, error) {
+	return call[apitypes.OuraTokenByIDResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/oura/tokens/%s", ouraID)})
+}
+
+// GetPolarTokenByID returns the token stored for a Polar user ID
+func (s *UTVService) GetPolarTokenByID(ctx context.Context, polarID string) (*apitypes.PolarTokenByIDResponse, error) {
+	return call[apitypes.PolarTokenByIDResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/polar/tokens/%s", polarID)})
+}
+
+// GetSuuntoTokenByUsername returns the token stored for a Suunto username
+func (s *UTVService) GetSuuntoTokenByUsername(ctx context.Context, username string) (*apitypes.SuuntoTokenByUsernameResponse, error) {
+	return call[apitypes.SuuntoTokenByUsernameResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/suunto/tokens/%s", username)})
+}
+
+// GarminTokenExists reports whether a Garmin access token is stored
+func (s *UTVService) GarminTokenExists(ctx context.Context, token string) (*apitypes.GarminTokenExistsResponse, error) {
+	params := struct {
+		Token string `query:"token"`
+	}{token}
+	return call[apitypes.GarminTokenExistsResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/utv/garmin/tokens/exists", params: params})
+}
+
+// GetGarminUserIDByToken returns the user a Garmin access token belongs to
+func (s *UTVService) GetGarminUserIDByToken(ctx context.Context, token string) (*apitypes.GarminUserIDResponse, error) {
+	params := struct {
+		Token string `query:"token"`
+	}{token}
+	return call[apitypes.GarminUserIDResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/utv/garmin/tokens/user-id", params: params})
+}
+
+// UpsertKlabToken links a user to KLAB
+func (s *UTVService) UpsertKlabToken(ctx context.Context, token *apitypes.KlabTokenInput) error {
+	return s.c.exec(ctx, &request{method: http.MethodPost, path: "/v2/utv/klab/tokens", body: token})
+}
+
+// GetKlabSportIDs lists the Sportti IDs of the users linked to KLAB
+func (s *UTVService) GetKlabSportIDs(ctx context.Context) (*apitypes.SportIDsResponse, error) {
+	return call[apitypes.SportIDsResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/utv/klab/sport-ids"})
+}
+
+// GetKlabStatus reports whether a user is linked to KLAB
+func (s *UTVService) GetKlabStatus(ctx context.Context, userID string) (*apitypes.KlabStatusResponse, error) {
+	return call[apitypes.KlabStatusResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/users/%s/klab/status", userID)})
+}
+
+// UpsertArchinisisToken links a user to Archinisis
+func (s *UTVService) UpsertArchinisisToken(ctx context.Context, token *apitypes.ArchinisisTokenInput) error {
+	return s.c.exec(ctx, &request{method: http.MethodPost, path: "/v2/utv/archinisis/tokens", body: token})
+}
+
+// GetArchinisisSportIDs lists the Sportti IDs of the users linked to Archinisis
+func (s *UTVService) GetArchinisisSportIDs(ctx context.Context) (*apitypes.SportIDsResponse, error) {
+	return call[apitypes.SportIDsResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/utv/archinisis/sport-ids"})
+}
+
+// GetArchinisisStatus reports whether a user is linked to Archinisis
+func (s *UTVService) GetArchinisisStatus(ctx context.Context, userID string) (*apitypes.ArchinisisStatusResponse, error) {
+	return call[apitypes.ArchinisisStatusResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/users/%s/archinisis/status", userID)})
+}
+
+// InsertCoachtechData stores Coachtech data
+func (s *UTVService) InsertCoachtechData(ctx context.Context, data *apitypes.CoachtechInsertInput) error {
+	return s.c.exec(ctx, &request{method: http.MethodPost, path: "/v2/utv/coachtech/data", body: data})
+}
+
+// GetCoachtechStatus reports whether a user has Coachtech data
+func (s *UTVService) GetCoachtechStatus(ctx context.Context, userID string) (*apitypes.CoachtechStatusResponse, error) {
+	return call[apitypes.CoachtechStatusResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/users/%s/coachtech/status", userID)})
+}
+
+// GetCoachtechData returns a user's Coachtech documents; nil when there are none
+func (s *UTVService) GetCoachtechData(ctx context.Context, userID string, params *DateRange) ([]json.RawMessage, error) {
+	return list[json.RawMessage](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/utv/users/%s/coachtech/data", userID), params: params})
+}
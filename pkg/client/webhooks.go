@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/DeRuina/KUHA-REST-API/pkg/apitypes"
+)
+
+// WebhooksService manages the caller's webhook subscriptions
+type WebhooksService struct{ c *Client }
+
+// DeliveryParams filter ListDeliveries
+type DeliveryParams struct {
+	// Status is pending, succeeded or dead
+	Status string `query:"status"`
+	PageParams
+}
+
+// Create subscribes a URL to events
+func (s *WebhooksService) Create(ctx context.Context, input *apitypes.WebhookInput) (*apitypes.WebhookResponse, error) {
+	return call[apitypes.WebhookResponse](ctx, s.c, &request{method: http.MethodPost, path: "/v2/webhooks", body: input})
+}
+
+// List returns the caller's subscriptions
+func (s *WebhooksService) List(ctx context.Context) (*apitypes.WebhookListResponse, error) {
+	return call[apitypes.WebhookListResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/webhooks"})
+}
+
+// ListDeadLetters lists deliveries that ran out of attempts
+func (s *WebhooksService) ListDeadLetters(ctx context.Context, page *PageParams) (*apitypes.WebhookDeliveryListResponse, error) {
+	return call[apitypes.WebhookDeliveryListResponse](ctx, s.c, &request{method: http.MethodGet, path: "/v2/webhooks/dead-letters", params: page})
+}
+
+// Redeliver queues a delivery again
+func (s *WebhooksService) Redeliver(ctx context.Context, deliveryID int64) (*apitypes.WebhookDeliveryResponse, error) {
+	return call[apitypes.WebhookDeliveryResponse](ctx, s.c, &request{method: http.MethodPost, path: path("/v2/webhooks/deliveries/%s/redeliver", deliveryID)})
+}
+
+// Get returns a subscription
+func (s *WebhooksService) Get(ctx context.Context, id int32) (*apitypes.WebhookResponse, error) {
+	return call[apitypes.WebhookResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/webhooks/%s", id)})
+}
+
+// Update replaces a subscription's URL, events and active flag
+func (s *WebhooksService) Update(ctx context.Context, id int32, input *apitypes.WebhookInput) (*apitypes.WebhookResponse, error) {
+	return call[apitypes.WebhookResponse](ctx, s.c, &request{method: http.MethodPut, path: path("/v2/webhooks/%s", id), body: input})
+}
+
+// Delete removes a subscription
+func (s *WebhooksService) Delete(ctx context.Context, id int32) error {
+	return s.c.exec(ctx, &request{method: http.MethodDelete, path: path("/v2/webhooks/%s", id)})
+}
+
+// ListDeliveries lists the deliveries of a subscription
+func (s *WebhooksService) ListDeliveries(ctx context.Context, id int32, params *DeliveryParams) (*apitypes.WebhookDeliveryListResponse, error) {
+	return call[apitypes.WebhookDeliveryListResponse](ctx, s.c, &request{method: http.MethodGet, path: path("/v2/webhooks/%s/deliveries", id), params: params})
+}